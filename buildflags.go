@@ -0,0 +1,37 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"github.com/ServiceWeaver/weaver/internal/weaver"
+)
+
+// RegisterBuildFlag records the value of a named build-time flag (e.g., a
+// feature gated behind a build tag). Deployers can fetch the registered flags
+// from a running weavelet (see envelope.Envelope.GetBuildFlags) to verify that
+// a binary has the expected features compiled in, for example before shifting
+// traffic to a new version.
+//
+// RegisterBuildFlag should be called from an init function, and at most once
+// per name. For example, in a file guarded by the "newcache" build tag:
+//
+//	//go:build newcache
+//
+//	func init() {
+//	    weaver.RegisterBuildFlag("newcache", "enabled")
+//	}
+func RegisterBuildFlag(name, value string) {
+	weaver.RegisterBuildFlag(name, value)
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ServiceWeaver/weaver/internal/reflection"
+	"github.com/ServiceWeaver/weaver/internal/weaver"
+)
+
+// RegisterCheckpoint registers functions that checkpoint and restore the state
+// of the stateful component implemented by Impl. Deployers can then ask a
+// weavelet to serialize the state of the component (e.g., before migrating it
+// to a different machine) and to restore that state in another weavelet.
+//
+// Only components that opt in by calling RegisterCheckpoint support
+// checkpointing. Checkpointing any other component fails with an error that
+// wraps envelope.ErrNotCheckpointable. RegisterCheckpoint should be called from an init
+// function, and at most once per component. For example:
+//
+//	func init() {
+//	    weaver.RegisterCheckpoint(
+//	        func(ctx context.Context, c *cache) ([]byte, error) { return c.marshal() },
+//	        func(ctx context.Context, c *cache, state []byte) error { return c.unmarshal(state) },
+//	    )
+//	}
+func RegisterCheckpoint[Impl any](checkpoint func(context.Context, *Impl) ([]byte, error), restore func(context.Context, *Impl, []byte) error) {
+	weaver.RegisterCheckpointer(reflection.Type[Impl](), weaver.Checkpointer{
+		Checkpoint: func(ctx context.Context, impl any) ([]byte, error) {
+			x, ok := impl.(*Impl)
+			if !ok {
+				return nil, fmt.Errorf("checkpoint: got %T, want %T", impl, x)
+			}
+			return checkpoint(ctx, x)
+		},
+		Restore: func(ctx context.Context, impl any, state []byte) error {
+			x, ok := impl.(*Impl)
+			if !ok {
+				return fmt.Errorf("restore: got %T, want %T", impl, x)
+			}
+			return restore(ctx, x, state)
+		},
+	})
+}
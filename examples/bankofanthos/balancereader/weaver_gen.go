@@ -117,7 +117,9 @@ func (s t_client_stub) GetBalance(ctx context.Context, a0 string) (r0 int64, err
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/balancereader/T", "GetBalance", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -129,11 +131,17 @@ func (s t_client_stub) GetBalance(ctx context.Context, a0 string) (r0 int64, err
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int64()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/balancereader/T", "GetBalance", codegen.DecodeResults)
 	return
 }
 
@@ -189,9 +197,11 @@ func (s t_server_stub) getBalance(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/balancereader/T", "GetBalance", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -199,9 +209,11 @@ func (s t_server_stub) getBalance(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.GetBalance(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int64(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/balancereader/T", "GetBalance", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
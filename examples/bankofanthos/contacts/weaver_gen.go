@@ -141,8 +141,10 @@ func (s t_client_stub) AddContact(ctx context.Context, a0 string, a1 Contact) (e
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
 	(a1).WeaverMarshal(enc)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/contacts/T", "AddContact", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -154,10 +156,16 @@ func (s t_client_stub) AddContact(ctx context.Context, a0 string, a1 Contact) (e
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/contacts/T", "AddContact", codegen.DecodeResults)
 	return
 }
 
@@ -197,7 +205,9 @@ func (s t_client_stub) GetContacts(ctx context.Context, a0 string) (r0 []Contact
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/contacts/T", "GetContacts", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -209,11 +219,17 @@ func (s t_client_stub) GetContacts(ctx context.Context, a0 string) (r0 []Contact
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = serviceweaver_dec_slice_Contact_d00a3378(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/contacts/T", "GetContacts", codegen.DecodeResults)
 	return
 }
 
@@ -271,11 +287,13 @@ func (s t_server_stub) addContact(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
 	var a1 Contact
 	(&a1).WeaverUnmarshal(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/contacts/T", "AddContact", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -283,8 +301,10 @@ func (s t_server_stub) addContact(ctx context.Context, args []byte) (res []byte,
 	appErr := s.impl.AddContact(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/contacts/T", "AddContact", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -297,9 +317,11 @@ func (s t_server_stub) getContacts(ctx context.Context, args []byte) (res []byte
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/contacts/T", "GetContacts", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -307,9 +329,11 @@ func (s t_server_stub) getContacts(ctx context.Context, args []byte) (res []byte
 	r0, appErr := s.impl.GetContacts(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	serviceweaver_enc_slice_Contact_d00a3378(enc, r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/contacts/T", "GetContacts", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -112,10 +112,12 @@ func (s t_client_stub) AddTransaction(ctx context.Context, a0 string, a1 string,
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.String(a0)
 	enc.String(a1)
 	(a2).WeaverMarshal(enc)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/ledgerwriter/T", "AddTransaction", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -127,10 +129,16 @@ func (s t_client_stub) AddTransaction(ctx context.Context, a0 string, a1 string,
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/ledgerwriter/T", "AddTransaction", codegen.DecodeResults)
 	return
 }
 
@@ -186,6 +194,7 @@ func (s t_server_stub) addTransaction(ctx context.Context, args []byte) (res []b
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
@@ -193,6 +202,7 @@ func (s t_server_stub) addTransaction(ctx context.Context, args []byte) (res []b
 	a1 = dec.String()
 	var a2 model.Transaction
 	(&a2).WeaverUnmarshal(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/ledgerwriter/T", "AddTransaction", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -200,8 +210,10 @@ func (s t_server_stub) addTransaction(ctx context.Context, args []byte) (res []b
 	appErr := s.impl.AddTransaction(ctx, a0, a1, a2)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/ledgerwriter/T", "AddTransaction", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
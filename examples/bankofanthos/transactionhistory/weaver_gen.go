@@ -118,7 +118,9 @@ func (s t_client_stub) GetTransactions(ctx context.Context, a0 string) (r0 []mod
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/transactionhistory/T", "GetTransactions", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -130,11 +132,17 @@ func (s t_client_stub) GetTransactions(ctx context.Context, a0 string) (r0 []mod
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = serviceweaver_dec_slice_Transaction_d2a36fba(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/transactionhistory/T", "GetTransactions", codegen.DecodeResults)
 	return
 }
 
@@ -190,9 +198,11 @@ func (s t_server_stub) getTransactions(ctx context.Context, args []byte) (res []
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/transactionhistory/T", "GetTransactions", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -200,9 +210,11 @@ func (s t_server_stub) getTransactions(ctx context.Context, args []byte) (res []
 	r0, appErr := s.impl.GetTransactions(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	serviceweaver_enc_slice_Transaction_d2a36fba(enc, r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/transactionhistory/T", "GetTransactions", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
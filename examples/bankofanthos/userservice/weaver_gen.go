@@ -140,7 +140,9 @@ func (s t_client_stub) CreateUser(ctx context.Context, a0 CreateUserRequest) (er
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	(a0).WeaverMarshal(enc)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/userservice/T", "CreateUser", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -152,10 +154,16 @@ func (s t_client_stub) CreateUser(ctx context.Context, a0 CreateUserRequest) (er
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/userservice/T", "CreateUser", codegen.DecodeResults)
 	return
 }
 
@@ -195,7 +203,9 @@ func (s t_client_stub) Login(ctx context.Context, a0 LoginRequest) (r0 string, e
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	(a0).WeaverMarshal(enc)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/userservice/T", "Login", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -207,11 +217,17 @@ func (s t_client_stub) Login(ctx context.Context, a0 LoginRequest) (r0 string, e
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.String()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/userservice/T", "Login", codegen.DecodeResults)
 	return
 }
 
@@ -269,9 +285,11 @@ func (s t_server_stub) createUser(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 CreateUserRequest
 	(&a0).WeaverUnmarshal(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/userservice/T", "CreateUser", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -279,8 +297,10 @@ func (s t_server_stub) createUser(ctx context.Context, args []byte) (res []byte,
 	appErr := s.impl.CreateUser(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/userservice/T", "CreateUser", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -293,9 +313,11 @@ func (s t_server_stub) login(ctx context.Context, args []byte) (res []byte, err
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 LoginRequest
 	(&a0).WeaverUnmarshal(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/userservice/T", "Login", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -303,9 +325,11 @@ func (s t_server_stub) login(ctx context.Context, args []byte) (res []byte, err
 	r0, appErr := s.impl.Login(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.String(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/bankofanthos/userservice/T", "Login", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
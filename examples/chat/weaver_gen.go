@@ -331,9 +331,11 @@ func (s imageScaler_client_stub) Scale(ctx context.Context, a0 []byte, a1 int, a
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	serviceweaver_enc_slice_byte_87461245(enc, a0)
 	enc.Int(a1)
 	enc.Int(a2)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/chat/ImageScaler", "Scale", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -345,11 +347,17 @@ func (s imageScaler_client_stub) Scale(ctx context.Context, a0 []byte, a1 int, a
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = serviceweaver_dec_slice_byte_87461245(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/chat/ImageScaler", "Scale", codegen.DecodeResults)
 	return
 }
 
@@ -398,7 +406,9 @@ func (s localCache_client_stub) Get(ctx context.Context, a0 string) (r0 string,
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/chat/LocalCache", "Get", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -410,11 +420,17 @@ func (s localCache_client_stub) Get(ctx context.Context, a0 string) (r0 string,
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.String()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/chat/LocalCache", "Get", codegen.DecodeResults)
 	return
 }
 
@@ -455,8 +471,10 @@ func (s localCache_client_stub) Put(ctx context.Context, a0 string, a1 string) (
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
 	enc.String(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/chat/LocalCache", "Put", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -468,10 +486,16 @@ func (s localCache_client_stub) Put(ctx context.Context, a0 string, a1 string) (
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/chat/LocalCache", "Put", codegen.DecodeResults)
 	return
 }
 
@@ -523,11 +547,13 @@ func (s sQLStore_client_stub) CreatePost(ctx context.Context, a0 string, a1 time
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.String(a0)
 	enc.EncodeBinaryMarshaler(&a1)
 	enc.Int64((int64)(a2))
 	enc.String(a3)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "CreatePost", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -539,10 +565,16 @@ func (s sQLStore_client_stub) CreatePost(ctx context.Context, a0 string, a1 time
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "CreatePost", codegen.DecodeResults)
 	return
 }
 
@@ -576,12 +608,14 @@ func (s sQLStore_client_stub) CreateThread(ctx context.Context, a0 string, a1 ti
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.String(a0)
 	enc.EncodeBinaryMarshaler(&a1)
 	serviceweaver_enc_slice_string_4af10117(enc, a2)
 	enc.String(a3)
 	serviceweaver_enc_slice_byte_87461245(enc, a4)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "CreateThread", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -593,11 +627,17 @@ func (s sQLStore_client_stub) CreateThread(ctx context.Context, a0 string, a1 ti
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	*(*int64)(&r0) = dec.Int64()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "CreateThread", codegen.DecodeResults)
 	return
 }
 
@@ -637,7 +677,9 @@ func (s sQLStore_client_stub) GetFeed(ctx context.Context, a0 string) (r0 []Thre
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "GetFeed", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -649,11 +691,17 @@ func (s sQLStore_client_stub) GetFeed(ctx context.Context, a0 string) (r0 []Thre
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = serviceweaver_dec_slice_Thread_511e1469(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "GetFeed", codegen.DecodeResults)
 	return
 }
 
@@ -694,8 +742,10 @@ func (s sQLStore_client_stub) GetImage(ctx context.Context, a0 string, a1 ImageI
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
 	enc.Int64((int64)(a1))
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "GetImage", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -707,11 +757,17 @@ func (s sQLStore_client_stub) GetImage(ctx context.Context, a0 string, a1 ImageI
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = serviceweaver_dec_slice_byte_87461245(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "GetImage", codegen.DecodeResults)
 	return
 }
 
@@ -767,6 +823,7 @@ func (s imageScaler_server_stub) scale(ctx context.Context, args []byte) (res []
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 []byte
 	a0 = serviceweaver_dec_slice_byte_87461245(dec)
@@ -774,6 +831,7 @@ func (s imageScaler_server_stub) scale(ctx context.Context, args []byte) (res []
 	a1 = dec.Int()
 	var a2 int
 	a2 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/chat/ImageScaler", "Scale", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -781,9 +839,11 @@ func (s imageScaler_server_stub) scale(ctx context.Context, args []byte) (res []
 	r0, appErr := s.impl.Scale(ctx, a0, a1, a2)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	serviceweaver_enc_slice_byte_87461245(enc, r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/chat/ImageScaler", "Scale", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -816,9 +876,11 @@ func (s localCache_server_stub) get(ctx context.Context, args []byte) (res []byt
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/chat/LocalCache", "Get", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -826,9 +888,11 @@ func (s localCache_server_stub) get(ctx context.Context, args []byte) (res []byt
 	r0, appErr := s.impl.Get(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.String(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/chat/LocalCache", "Get", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -841,11 +905,13 @@ func (s localCache_server_stub) put(ctx context.Context, args []byte) (res []byt
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
 	var a1 string
 	a1 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/chat/LocalCache", "Put", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -853,8 +919,10 @@ func (s localCache_server_stub) put(ctx context.Context, args []byte) (res []byt
 	appErr := s.impl.Put(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/chat/LocalCache", "Put", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -907,6 +975,7 @@ func (s sQLStore_server_stub) createPost(ctx context.Context, args []byte) (res
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
@@ -916,6 +985,7 @@ func (s sQLStore_server_stub) createPost(ctx context.Context, args []byte) (res
 	*(*int64)(&a2) = dec.Int64()
 	var a3 string
 	a3 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "CreatePost", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -923,8 +993,10 @@ func (s sQLStore_server_stub) createPost(ctx context.Context, args []byte) (res
 	appErr := s.impl.CreatePost(ctx, a0, a1, a2, a3)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "CreatePost", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -937,6 +1009,7 @@ func (s sQLStore_server_stub) createThread(ctx context.Context, args []byte) (re
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
@@ -948,6 +1021,7 @@ func (s sQLStore_server_stub) createThread(ctx context.Context, args []byte) (re
 	a3 = dec.String()
 	var a4 []byte
 	a4 = serviceweaver_dec_slice_byte_87461245(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "CreateThread", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -955,9 +1029,11 @@ func (s sQLStore_server_stub) createThread(ctx context.Context, args []byte) (re
 	r0, appErr := s.impl.CreateThread(ctx, a0, a1, a2, a3, a4)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int64((int64)(r0))
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "CreateThread", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -970,9 +1046,11 @@ func (s sQLStore_server_stub) getFeed(ctx context.Context, args []byte) (res []b
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "GetFeed", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -980,9 +1058,11 @@ func (s sQLStore_server_stub) getFeed(ctx context.Context, args []byte) (res []b
 	r0, appErr := s.impl.GetFeed(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	serviceweaver_enc_slice_Thread_511e1469(enc, r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "GetFeed", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -995,11 +1075,13 @@ func (s sQLStore_server_stub) getImage(ctx context.Context, args []byte) (res []
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
 	var a1 ImageID
 	*(*int64)(&a1) = dec.Int64()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "GetImage", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -1007,9 +1089,11 @@ func (s sQLStore_server_stub) getImage(ctx context.Context, args []byte) (res []
 	r0, appErr := s.impl.GetImage(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	serviceweaver_enc_slice_byte_87461245(enc, r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/chat/SQLStore", "GetImage", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
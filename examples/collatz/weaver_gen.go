@@ -193,7 +193,9 @@ func (s even_client_stub) Do(ctx context.Context, a0 int) (r0 int, err error) {
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/collatz/Even", "Do", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -205,11 +207,17 @@ func (s even_client_stub) Do(ctx context.Context, a0 int) (r0 int, err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/collatz/Even", "Do", codegen.DecodeResults)
 	return
 }
 
@@ -264,7 +272,9 @@ func (s odd_client_stub) Do(ctx context.Context, a0 int) (r0 int, err error) {
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/collatz/Odd", "Do", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -276,11 +286,17 @@ func (s odd_client_stub) Do(ctx context.Context, a0 int) (r0 int, err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/collatz/Odd", "Do", codegen.DecodeResults)
 	return
 }
 
@@ -336,9 +352,11 @@ func (s even_server_stub) do(ctx context.Context, args []byte) (res []byte, err
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/collatz/Even", "Do", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -346,9 +364,11 @@ func (s even_server_stub) do(ctx context.Context, args []byte) (res []byte, err
 	r0, appErr := s.impl.Do(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/collatz/Even", "Do", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -395,9 +415,11 @@ func (s odd_server_stub) do(ctx context.Context, args []byte) (res []byte, err e
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/collatz/Odd", "Do", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -405,9 +427,11 @@ func (s odd_server_stub) do(ctx context.Context, args []byte) (res []byte, err e
 	r0, appErr := s.impl.Do(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/collatz/Odd", "Do", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
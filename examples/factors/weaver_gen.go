@@ -148,7 +148,9 @@ func (s factorer_client_stub) Factors(ctx context.Context, a0 int) (r0 []int, er
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/factors/Factorer", "Factors", codegen.EncodeArgs)
 
 	// Set the shardKey.
 	var r router
@@ -163,11 +165,17 @@ func (s factorer_client_stub) Factors(ctx context.Context, a0 int) (r0 []int, er
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = serviceweaver_dec_slice_int_7c8c8866(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/factors/Factorer", "Factors", codegen.DecodeResults)
 	return
 }
 
@@ -230,9 +238,11 @@ func (s factorer_server_stub) factors(ctx context.Context, args []byte) (res []b
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/factors/Factorer", "Factors", codegen.DecodeArgs)
 	var r router
 	s.addLoad(_hashFactorer(r.Factors(ctx, a0)), 1.0)
 
@@ -242,9 +252,11 @@ func (s factorer_server_stub) factors(ctx context.Context, args []byte) (res []b
 	r0, appErr := s.impl.Factors(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	serviceweaver_enc_slice_int_7c8c8866(enc, r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/factors/Factorer", "Factors", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -120,11 +120,17 @@ func (s clock_client_stub) UnixMicro(ctx context.Context) (r0 int64, err error)
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int64()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/fakes/Clock", "UnixMicro", codegen.DecodeResults)
 	return
 }
 
@@ -185,9 +191,11 @@ func (s clock_server_stub) unixMicro(ctx context.Context, args []byte) (res []by
 	r0, appErr := s.impl.UnixMicro(ctx)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int64(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/fakes/Clock", "UnixMicro", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -151,7 +151,9 @@ func (s reverser_client_stub) Reverse(ctx context.Context, a0 string) (r0 string
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/reverser/Reverser", "Reverse", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -163,11 +165,17 @@ func (s reverser_client_stub) Reverse(ctx context.Context, a0 string) (r0 string
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.String()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/reverser/Reverser", "Reverse", codegen.DecodeResults)
 	return
 }
 
@@ -239,9 +247,11 @@ func (s reverser_server_stub) reverse(ctx context.Context, args []byte) (res []b
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/examples/reverser/Reverser", "Reverse", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -249,9 +259,11 @@ func (s reverser_server_stub) reverse(ctx context.Context, args []byte) (res []b
 	r0, appErr := s.impl.Reverse(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.String(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/examples/reverser/Reverser", "Reverse", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
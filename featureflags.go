@@ -0,0 +1,45 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"github.com/ServiceWeaver/weaver/internal/weaver"
+)
+
+// FeatureFlag is a runtime feature flag registered by RegisterFeatureFlag.
+// Call Value to read the flag's current value. The value may change at any
+// time, so read it every time it is needed instead of caching it.
+type FeatureFlag = weaver.FeatureFlag
+
+// RegisterFeatureFlag registers a named feature flag with the provided
+// initial value. Deployers can read the current values of the registered
+// flags (see envelope.Envelope.GetFeatureFlags) and override them at runtime
+// (see envelope.Envelope.SetFeatureFlag), which enables experimentation
+// without redeploying the application. An override is visible to all
+// subsequent calls to FeatureFlag.Value in the weavelet.
+//
+// RegisterFeatureFlag should be called at most once per name, typically when
+// initializing a package-level variable. For example:
+//
+//	var newRanker = weaver.RegisterFeatureFlag("new-ranker", "off")
+//
+//	func (r *ranker) Rank(ctx context.Context, ...) {
+//	    if newRanker.Value() == "on" {
+//	        ...
+//	    }
+//	}
+func RegisterFeatureFlag(name, value string) *FeatureFlag {
+	return weaver.RegisterFeatureFlag(name, value)
+}
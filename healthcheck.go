@@ -0,0 +1,51 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ServiceWeaver/weaver/internal/reflection"
+	"github.com/ServiceWeaver/weaver/internal/weaver"
+)
+
+// RegisterHealthCheck registers a named check of the health of the component
+// implemented by Impl, e.g., of the reachability of a database the component
+// depends on. The check returns an error if the component is unhealthy. When
+// a deployer asks a weavelet for its health, the weavelet runs the checks of
+// the components it has started, and reports the result of every check along
+// with the weavelet's overall status, which is unhealthy if any check fails.
+//
+// RegisterHealthCheck should be called from an init function, and at most
+// once per component and name. For example:
+//
+//	func init() {
+//	    weaver.RegisterHealthCheck("db", func(ctx context.Context, s *store) error {
+//	        return s.db.PingContext(ctx)
+//	    })
+//	}
+func RegisterHealthCheck[Impl any](name string, check func(context.Context, *Impl) error) {
+	weaver.RegisterHealthCheck(reflection.Type[Impl](), weaver.HealthCheck{
+		Name: name,
+		Check: func(ctx context.Context, impl any) error {
+			x, ok := impl.(*Impl)
+			if !ok {
+				return fmt.Errorf("health check: got %T, want %T", impl, x)
+			}
+			return check(ctx, x)
+		},
+	})
+}
@@ -771,8 +771,10 @@ func (s ping1_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping1", "PingC", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -784,11 +786,17 @@ func (s ping1_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping1", "PingC", codegen.DecodeResults)
 	return
 }
 
@@ -822,9 +830,11 @@ func (s ping1_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping1", "PingS", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -836,11 +846,17 @@ func (s ping1_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping1", "PingS", codegen.DecodeResults)
 	return
 }
 
@@ -890,8 +906,10 @@ func (s ping10_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping10", "PingC", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -903,11 +921,17 @@ func (s ping10_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping10", "PingC", codegen.DecodeResults)
 	return
 }
 
@@ -941,9 +965,11 @@ func (s ping10_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping10", "PingS", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -955,11 +981,17 @@ func (s ping10_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping10", "PingS", codegen.DecodeResults)
 	return
 }
 
@@ -1009,8 +1041,10 @@ func (s ping2_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping2", "PingC", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1022,11 +1056,17 @@ func (s ping2_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping2", "PingC", codegen.DecodeResults)
 	return
 }
 
@@ -1060,9 +1100,11 @@ func (s ping2_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping2", "PingS", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1074,11 +1116,17 @@ func (s ping2_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping2", "PingS", codegen.DecodeResults)
 	return
 }
 
@@ -1128,8 +1176,10 @@ func (s ping3_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping3", "PingC", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1141,11 +1191,17 @@ func (s ping3_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping3", "PingC", codegen.DecodeResults)
 	return
 }
 
@@ -1179,9 +1235,11 @@ func (s ping3_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping3", "PingS", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1193,11 +1251,17 @@ func (s ping3_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping3", "PingS", codegen.DecodeResults)
 	return
 }
 
@@ -1247,8 +1311,10 @@ func (s ping4_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping4", "PingC", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1260,11 +1326,17 @@ func (s ping4_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping4", "PingC", codegen.DecodeResults)
 	return
 }
 
@@ -1298,9 +1370,11 @@ func (s ping4_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping4", "PingS", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1312,11 +1386,17 @@ func (s ping4_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping4", "PingS", codegen.DecodeResults)
 	return
 }
 
@@ -1366,8 +1446,10 @@ func (s ping5_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping5", "PingC", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1379,11 +1461,17 @@ func (s ping5_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping5", "PingC", codegen.DecodeResults)
 	return
 }
 
@@ -1417,9 +1505,11 @@ func (s ping5_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping5", "PingS", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1431,11 +1521,17 @@ func (s ping5_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping5", "PingS", codegen.DecodeResults)
 	return
 }
 
@@ -1485,8 +1581,10 @@ func (s ping6_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping6", "PingC", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1498,11 +1596,17 @@ func (s ping6_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping6", "PingC", codegen.DecodeResults)
 	return
 }
 
@@ -1536,9 +1640,11 @@ func (s ping6_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping6", "PingS", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1550,11 +1656,17 @@ func (s ping6_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping6", "PingS", codegen.DecodeResults)
 	return
 }
 
@@ -1604,8 +1716,10 @@ func (s ping7_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping7", "PingC", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1617,11 +1731,17 @@ func (s ping7_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping7", "PingC", codegen.DecodeResults)
 	return
 }
 
@@ -1655,9 +1775,11 @@ func (s ping7_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping7", "PingS", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1669,11 +1791,17 @@ func (s ping7_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping7", "PingS", codegen.DecodeResults)
 	return
 }
 
@@ -1723,8 +1851,10 @@ func (s ping8_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping8", "PingC", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1736,11 +1866,17 @@ func (s ping8_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping8", "PingC", codegen.DecodeResults)
 	return
 }
 
@@ -1774,9 +1910,11 @@ func (s ping8_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping8", "PingS", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1788,11 +1926,17 @@ func (s ping8_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping8", "PingS", codegen.DecodeResults)
 	return
 }
 
@@ -1842,8 +1986,10 @@ func (s ping9_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping9", "PingC", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1855,11 +2001,17 @@ func (s ping9_client_stub) PingC(ctx context.Context, a0 payloadC, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping9", "PingC", codegen.DecodeResults)
 	return
 }
 
@@ -1893,9 +2045,11 @@ func (s ping9_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(a0).WeaverMarshal(enc)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping9", "PingS", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -1907,11 +2061,17 @@ func (s ping9_client_stub) PingS(ctx context.Context, a0 payloadS, a1 int) (r0 p
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping9", "PingS", codegen.DecodeResults)
 	return
 }
 
@@ -1969,11 +2129,13 @@ func (s ping1_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadC
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping1", "PingC", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -1981,9 +2143,11 @@ func (s ping1_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingC(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping1", "PingC", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -1996,11 +2160,13 @@ func (s ping1_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadS
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping1", "PingS", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2008,9 +2174,11 @@ func (s ping1_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingS(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping1", "PingS", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2043,11 +2211,13 @@ func (s ping10_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadC
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping10", "PingC", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2055,9 +2225,11 @@ func (s ping10_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingC(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping10", "PingC", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2070,11 +2242,13 @@ func (s ping10_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadS
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping10", "PingS", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2082,9 +2256,11 @@ func (s ping10_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingS(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping10", "PingS", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2117,11 +2293,13 @@ func (s ping2_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadC
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping2", "PingC", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2129,9 +2307,11 @@ func (s ping2_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingC(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping2", "PingC", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2144,11 +2324,13 @@ func (s ping2_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadS
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping2", "PingS", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2156,9 +2338,11 @@ func (s ping2_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingS(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping2", "PingS", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2191,11 +2375,13 @@ func (s ping3_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadC
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping3", "PingC", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2203,9 +2389,11 @@ func (s ping3_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingC(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping3", "PingC", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2218,11 +2406,13 @@ func (s ping3_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadS
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping3", "PingS", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2230,9 +2420,11 @@ func (s ping3_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingS(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping3", "PingS", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2265,11 +2457,13 @@ func (s ping4_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadC
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping4", "PingC", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2277,9 +2471,11 @@ func (s ping4_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingC(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping4", "PingC", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2292,11 +2488,13 @@ func (s ping4_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadS
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping4", "PingS", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2304,9 +2502,11 @@ func (s ping4_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingS(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping4", "PingS", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2339,11 +2539,13 @@ func (s ping5_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadC
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping5", "PingC", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2351,9 +2553,11 @@ func (s ping5_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingC(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping5", "PingC", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2366,11 +2570,13 @@ func (s ping5_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadS
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping5", "PingS", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2378,9 +2584,11 @@ func (s ping5_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingS(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping5", "PingS", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2413,11 +2621,13 @@ func (s ping6_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadC
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping6", "PingC", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2425,9 +2635,11 @@ func (s ping6_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingC(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping6", "PingC", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2440,11 +2652,13 @@ func (s ping6_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadS
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping6", "PingS", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2452,9 +2666,11 @@ func (s ping6_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingS(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping6", "PingS", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2487,11 +2703,13 @@ func (s ping7_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadC
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping7", "PingC", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2499,9 +2717,11 @@ func (s ping7_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingC(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping7", "PingC", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2514,11 +2734,13 @@ func (s ping7_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadS
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping7", "PingS", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2526,9 +2748,11 @@ func (s ping7_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingS(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping7", "PingS", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2561,11 +2785,13 @@ func (s ping8_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadC
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping8", "PingC", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2573,9 +2799,11 @@ func (s ping8_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingC(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping8", "PingC", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2588,11 +2816,13 @@ func (s ping8_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadS
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping8", "PingS", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2600,9 +2830,11 @@ func (s ping8_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingS(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping8", "PingS", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2635,11 +2867,13 @@ func (s ping9_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadC
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping9", "PingC", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2647,9 +2881,11 @@ func (s ping9_server_stub) pingC(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingC(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping9", "PingC", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -2662,11 +2898,13 @@ func (s ping9_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 payloadS
 	(&a0).WeaverUnmarshal(dec)
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping9", "PingS", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -2674,9 +2912,11 @@ func (s ping9_server_stub) pingS(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.PingS(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/benchmarks/Ping9", "PingS", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
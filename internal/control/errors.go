@@ -0,0 +1,39 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import "errors"
+
+// Errors returned by control component methods. They are defined in this
+// package so that both weavelets and envelopes can refer to them. Errors
+// returned by a control component method survive the trip across the control
+// socket, so callers can use errors.Is to check for them.
+var (
+	// ErrNotCheckpointable is returned when checkpointing or restoring a
+	// component that has not registered checkpoint functions.
+	ErrNotCheckpointable = errors.New("component is not checkpointable")
+
+	// ErrStaleRoutingUpdate is returned when updating the routing info of a
+	// component with a version lower than the currently applied version.
+	ErrStaleRoutingUpdate = errors.New("stale routing update")
+
+	// ErrUnknownFeatureFlag is returned when setting a feature flag that has
+	// not been registered.
+	ErrUnknownFeatureFlag = errors.New("unknown feature flag")
+
+	// ErrUnknownRawHandler is returned when issuing a raw RPC to a handler
+	// that has not been registered.
+	ErrUnknownRawHandler = errors.New("unknown raw handler")
+)
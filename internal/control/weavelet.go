@@ -30,6 +30,11 @@ const WeaveletPath = "github.com/ServiceWeaver/weaver/weaveletControl"
 //
 // Arguments and results are protobufs to allow deployers to evolve independently of
 // application binaries.
+//
+// The deadline of the context passed to a method, if any, is propagated to the
+// weavelet. The weavelet handles the method under a context with the same
+// deadline and passes that context on to the component calls it makes while
+// handling the method.
 type WeaveletControl interface {
 	// InitWeavelet initializes the weavelet.
 	InitWeavelet(context.Context, *protos.InitWeaveletRequest) (*protos.InitWeaveletReply, error)
@@ -41,6 +46,12 @@ type WeaveletControl interface {
 	// UpdateRoutingInfo updates the weavelet with a component's most recent routing info.
 	UpdateRoutingInfo(context.Context, *protos.UpdateRoutingInfoRequest) (*protos.UpdateRoutingInfoReply, error)
 
+	// Ping replies right away, without doing any work.
+	Ping(context.Context, *protos.PingRequest) (*protos.PingReply, error)
+
+	// GetTime returns the time of the weavelet's clock.
+	GetTime(context.Context, *protos.GetTimeRequest) (*protos.GetTimeReply, error)
+
 	// GetHealth fetches weavelet health information.
 	GetHealth(context.Context, *protos.GetHealthRequest) (*protos.GetHealthReply, error)
 
@@ -52,4 +63,117 @@ type WeaveletControl interface {
 
 	// GetProfile gets a profile from the weavelet.
 	GetProfile(context.Context, *protos.GetProfileRequest) (*protos.GetProfileReply, error)
+
+	// ListProfiles lists the profiles the weavelet is currently collecting.
+	ListProfiles(context.Context, *protos.ListProfilesRequest) (*protos.ListProfilesReply, error)
+
+	// CancelProfile cancels a profile the weavelet is currently collecting.
+	CancelProfile(context.Context, *protos.CancelProfileRequest) (*protos.CancelProfileReply, error)
+
+	// SetProfilingRate changes the block and mutex profiling rates of the
+	// weavelet, and returns the previous rates.
+	SetProfilingRate(context.Context, *protos.SetProfilingRateRequest) (*protos.SetProfilingRateReply, error)
+
+	// SetComponentEnabled enables or disables a component hosted by the
+	// weavelet. Disabling a component waits for in-flight calls to finish.
+	SetComponentEnabled(context.Context, *protos.SetComponentEnabledRequest) (*protos.SetComponentEnabledReply, error)
+
+	// ForwardSignal delivers an OS signal to the weavelet, which runs the
+	// handlers it installed for the signal.
+	ForwardSignal(context.Context, *protos.ForwardSignalRequest) (*protos.ForwardSignalReply, error)
+
+	// GetReadiness reports the status of the weavelet's readiness gates.
+	GetReadiness(context.Context, *protos.GetReadinessRequest) (*protos.GetReadinessReply, error)
+
+	// GetBuildFlags returns the build flags registered by the application.
+	GetBuildFlags(context.Context, *protos.GetBuildFlagsRequest) (*protos.GetBuildFlagsReply, error)
+
+	// SetGCPercent sets the weavelet's garbage collection target percentage.
+	SetGCPercent(context.Context, *protos.SetGCPercentRequest) (*protos.SetGCPercentReply, error)
+
+	// ForceGC runs a garbage collection in the weavelet.
+	ForceGC(context.Context, *protos.ForceGCRequest) (*protos.ForceGCReply, error)
+
+	// GetDependencies returns the call graph of the weavelet's components.
+	GetDependencies(context.Context, *protos.GetDependenciesRequest) (*protos.GetDependenciesReply, error)
+
+	// GetFDCount returns the number of file descriptors opened by the
+	// weavelet, along with its limits on open file descriptors.
+	GetFDCount(context.Context, *protos.GetFDCountRequest) (*protos.GetFDCountReply, error)
+
+	// GetRoutingInfo returns the routing info that the weavelet has applied
+	// for a component.
+	GetRoutingInfo(context.Context, *protos.GetRoutingInfoRequest) (*protos.GetRoutingInfoReply, error)
+
+	// GetFeatureFlags returns the current values of the feature flags
+	// registered by the application.
+	GetFeatureFlags(context.Context, *protos.GetFeatureFlagsRequest) (*protos.GetFeatureFlagsReply, error)
+
+	// SetFeatureFlag overrides the value of a registered feature flag. It
+	// returns ErrUnknownFeatureFlag if the flag has not been registered.
+	SetFeatureFlag(context.Context, *protos.SetFeatureFlagRequest) (*protos.SetFeatureFlagReply, error)
+
+	// RawRPC passes an opaque payload to a raw handler registered by the
+	// application. It returns ErrUnknownRawHandler if the handler has not
+	// been registered.
+	RawRPC(context.Context, *protos.RawRPCRequest) (*protos.RawRPCReply, error)
+
+	// Checkpoint serializes the state of a component. It returns
+	// ErrNotCheckpointable if the component does not support checkpointing.
+	Checkpoint(context.Context, *protos.CheckpointRequest) (*protos.CheckpointReply, error)
+
+	// Restore restores the state of a component from a checkpoint. It returns
+	// ErrNotCheckpointable if the component does not support checkpointing.
+	Restore(context.Context, *protos.RestoreRequest) (*protos.RestoreReply, error)
+
+	// QuiesceComponent disables a component, waits for its in-flight calls
+	// to finish, and checkpoints it. It returns ErrNotCheckpointable if the
+	// component does not support checkpointing.
+	QuiesceComponent(context.Context, *protos.QuiesceComponentRequest) (*protos.QuiesceComponentReply, error)
+
+	// Drain disables components and waits for their in-flight calls to
+	// finish, stage by stage.
+	Drain(context.Context, *protos.DrainRequest) (*protos.DrainReply, error)
+
+	// UpdateListenerTLS rotates the TLS material of a listener that was
+	// exported with TLS.
+	UpdateListenerTLS(context.Context, *protos.UpdateListenerTLSRequest) (*protos.UpdateListenerTLSReply, error)
+
+	// ReexportListeners exports all of the weavelet's listeners again.
+	ReexportListeners(context.Context, *protos.ReexportListenersRequest) (*protos.ReexportListenersReply, error)
+
+	// FlushTelemetry sends the log entries and trace spans buffered by the
+	// weavelet, returning once they have been delivered to the deployer.
+	FlushTelemetry(context.Context, *protos.FlushTelemetryRequest) (*protos.FlushTelemetryReply, error)
+
+	// GetInFlight returns the number of in-flight method calls of every
+	// component started in the weavelet.
+	GetInFlight(context.Context, *protos.GetInFlightRequest) (*protos.GetInFlightReply, error)
+
+	// ReloadConfig reloads the config of the weavelet's components from the
+	// provided config sections, for the components that support it.
+	ReloadConfig(context.Context, *protos.ReloadConfigRequest) (*protos.ReloadConfigReply, error)
+
+	// GetSnapshot returns a snapshot of the weavelet's health, load, runtime
+	// stats, and metrics, as requested, taken at a single moment.
+	GetSnapshot(context.Context, *protos.GetSnapshotRequest) (*protos.GetSnapshotReply, error)
+
+	// GetProfileBundle returns profiles of the requested types, collected
+	// concurrently.
+	GetProfileBundle(context.Context, *protos.GetProfileBundleRequest) (*protos.GetProfileBundleReply, error)
+
+	// GetRecentErrors returns the most recent error log entries of the
+	// weavelet.
+	GetRecentErrors(context.Context, *protos.GetRecentErrorsRequest) (*protos.GetRecentErrorsReply, error)
+
+	// ClearRecentErrors forgets the weavelet's recent error log entries.
+	ClearRecentErrors(context.Context, *protos.ClearRecentErrorsRequest) (*protos.ClearRecentErrorsReply, error)
+
+	// SetComponentLogLevel sets the minimum level of the log entries written
+	// by a component's logger.
+	SetComponentLogLevel(context.Context, *protos.SetComponentLogLevelRequest) (*protos.SetComponentLogLevelReply, error)
+
+	// GetLogLevels returns the component log levels set by
+	// SetComponentLogLevel.
+	GetLogLevels(context.Context, *protos.GetLogLevelsRequest) (*protos.GetLogLevelsReply, error)
 }
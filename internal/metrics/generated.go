@@ -21,6 +21,8 @@ const (
 	MethodLatenciesName    = "serviceweaver_method_latency_micros"
 	MethodBytesRequestName = "serviceweaver_method_bytes_request"
 	MethodBytesReplyName   = "serviceweaver_method_bytes_reply"
+
+	SerializationLatenciesName = "serviceweaver_method_serialization_latency_micros"
 )
 
 // GeneratedBuckets provides rounded bucket boundaries for histograms
@@ -0,0 +1,102 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package call
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// TokenBucket is a token bucket rate limiter. A bucket holds up to a maximum
+// number of tokens and is refilled at a fixed rate. Every allowed operation
+// takes a token from the bucket, and once the bucket is empty, operations are
+// rejected until it is refilled.
+//
+// A TokenBucket is safe for concurrent use.
+type TokenBucket struct {
+	mu        sync.Mutex
+	max       float64   // maximum number of available tokens
+	perSecond float64   // refill rate
+	available float64   // available tokens
+	last      time.Time // last refill time
+}
+
+// NewTokenBucket returns a full bucket that holds up to max tokens and is
+// refilled at perSecond tokens per second.
+func NewTokenBucket(max int, perSecond float64) *TokenBucket {
+	return &TokenBucket{
+		max:       float64(max),
+		perSecond: perSecond,
+		available: float64(max),
+	}
+}
+
+// Take takes a token from the bucket at the provided time, returning false if
+// the bucket is empty.
+func (b *TokenBucket) Take(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.last.IsZero() && now.After(b.last) {
+		refill := now.Sub(b.last).Seconds() * b.perSecond
+		b.available = math.Min(b.max, b.available+refill)
+	}
+	b.last = now
+	if b.available < 1 {
+		return false
+	}
+	b.available--
+	return true
+}
+
+// SetRate updates the size and refill rate of the bucket. Available tokens
+// are kept, up to the new maximum.
+func (b *TokenBucket) SetRate(max int, perSecond float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.max = float64(max)
+	b.perSecond = perSecond
+	b.available = math.Min(b.available, b.max)
+}
+
+// Rate returns the refill rate of the bucket, in tokens per second.
+func (b *TokenBucket) Rate() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.perSecond
+}
+
+// RetryBudget bounds the rate at which calls are retried. A budget holds up to
+// a maximum number of retries and is refilled at a fixed rate. Every retry
+// spends one retry from the budget, and once the budget is exhausted, calls
+// fail instead of being retried (see ErrRetryBudgetExceeded).
+//
+// A RetryBudget is safe for concurrent use, and can be shared by multiple
+// connections to bound the total rate of retries across all of them.
+type RetryBudget struct {
+	bucket *TokenBucket
+}
+
+// NewRetryBudget returns a budget that allows up to max retries in a burst,
+// and is refilled at perSecond retries per second.
+func NewRetryBudget(max int, perSecond float64) *RetryBudget {
+	return &RetryBudget{bucket: NewTokenBucket(max, perSecond)}
+}
+
+// spend spends a retry from the budget at the provided time, returning false
+// if the budget is exhausted.
+func (b *RetryBudget) spend(now time.Time) bool {
+	return b.bucket.Take(now)
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package call
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryBudgetRefill(t *testing.T) {
+	b := NewRetryBudget(2, 10)
+	now := time.Now()
+	for i := 0; i < 2; i++ {
+		if !b.spend(now) {
+			t.Fatalf("retry %d: budget exhausted", i)
+		}
+	}
+	if b.spend(now) {
+		t.Fatal("retry allowed beyond budget")
+	}
+
+	// The budget is refilled over time, but never beyond its maximum.
+	if !b.spend(now.Add(100 * time.Millisecond)) {
+		t.Fatal("retry not allowed after refill")
+	}
+	now = now.Add(time.Hour)
+	for i := 0; i < 2; i++ {
+		if !b.spend(now) {
+			t.Fatalf("retry %d: budget exhausted", i)
+		}
+	}
+	if b.spend(now) {
+		t.Fatal("retry allowed beyond budget")
+	}
+}
+
+func TestTokenBucketSetRate(t *testing.T) {
+	b := NewTokenBucket(4, 1)
+	now := time.Now()
+	if !b.Take(now) {
+		t.Fatal("bucket empty")
+	}
+
+	// Shrinking the bucket drops the tokens beyond the new maximum.
+	b.SetRate(2, 1)
+	for i := 0; i < 2; i++ {
+		if !b.Take(now) {
+			t.Fatalf("take %d: bucket empty", i)
+		}
+	}
+	if b.Take(now) {
+		t.Fatal("token taken beyond maximum")
+	}
+
+	// The bucket is refilled at the new rate.
+	b.SetRate(2, 10)
+	if got, want := b.Rate(), 10.0; got != want {
+		t.Fatalf("Rate: got %v, want %v", got, want)
+	}
+	if !b.Take(now.Add(100 * time.Millisecond)) {
+		t.Fatal("token not available after refill")
+	}
+}
@@ -171,6 +171,8 @@ type clientConnection struct {
 
 	wlock sync.Mutex // Guards writes to c
 
+	rbuf *bufio.Reader // Read buffer reused across connections (see connectOnce)
+
 	// Guarded by rc.mu
 	state          connState        // current connection state
 	loggedShutdown bool             // Have we logged a shutdown error?
@@ -178,8 +180,26 @@ type clientConnection struct {
 	c              net.Conn         // Active network connection, or nil
 	cbuf           *bufio.Reader    // Buffered reader wrapped around c
 	version        version          // Version number to use for connection
+	checksum       bool             // Use checksums on connection?
 	calls          map[uint64]*call // In-progress calls
 	lastID         uint64           // Last assigned request ID for a call
+	epoch          uint64           // Session epoch of the network connection
+}
+
+// Request ids are session-aware: the high sessionBits bits of the id of a
+// call hold the epoch of the session (i.e., network connection) the call was
+// started on, and the remaining bits count the calls started in the session.
+// Every reconnection starts a new epoch, so the id of a call never collides
+// with the id of a call of an earlier session, and a late reply from an
+// earlier session is never delivered to a new call (see ErrStaleSession).
+const (
+	sessionBits  = 24
+	sessionShift = 64 - sessionBits
+)
+
+// sessionOf returns the session epoch of the provided request id.
+func sessionOf(id uint64) uint64 {
+	return id >> sessionShift
 }
 
 var _ ReplicaConnection = &clientConnection{}
@@ -188,6 +208,8 @@ var _ ReplicaConnection = &clientConnection{}
 type call struct {
 	id         uint64
 	doneSignal chan struct{}
+	checksum   bool   // Use checksums for messages of this call?
+	stats      string // If non-empty, record messages of this call as stats for this method
 
 	// Fields below are accessed across goroutines, but their access is
 	// synchronized via doneSignal, i.e., it is never concurrent.
@@ -209,6 +231,7 @@ type serverConnection struct {
 	mu          sync.Mutex
 	closed      bool              // has c been closed?
 	version     version           // Version number to use for connection
+	checksum    bool              // Use checksums on connection?
 	cancelFuncs map[uint64]func() // Cancellation functions for in-progress calls
 }
 
@@ -228,10 +251,8 @@ func Serve(ctx context.Context, l Listener, opts ServerOptions) error {
 	l = &onceCloseListener{Listener: l, closer: sync.OnceValue(l.Close)}
 
 	// Arrange to close the listener when the context is canceled.
-	go func() {
-		<-ctx.Done()
-		l.Close()
-	}()
+	stopClosing := context.AfterFunc(ctx, func() { l.Close() })
+	defer stopClosing()
 
 	for {
 		conn, hmap, err := l.Accept()
@@ -367,12 +388,20 @@ func (rc *reconnectingConnection) Close() {
 
 // Call makes an RPC over connection c, retrying it on network errors if retries are allowed.
 func (rc *reconnectingConnection) Call(ctx context.Context, h MethodKey, arg []byte, opts CallOptions) ([]byte, error) {
-	if !opts.Retry {
+	if !opts.Retry || opts.NoReply {
 		return rc.callOnce(ctx, h, arg, opts)
 	}
-	for r := retry.Begin(); r.Continue(ctx); {
+	attempt := 0
+	for r := retry.BeginWithBackoff(rc.opts.NewBackoff()); r.Continue(ctx); {
 		response, err := rc.callOnce(ctx, h, arg, opts)
 		if errors.Is(err, Unreachable) || errors.Is(err, CommunicationError) {
+			if b := rc.opts.RetryBudget; b != nil && !b.spend(rc.opts.Clock.Now()) {
+				return nil, fmt.Errorf("%w: %w", ErrRetryBudgetExceeded, err)
+			}
+			attempt++
+			if rc.opts.OnRetry != nil {
+				rc.opts.OnRetry(h, attempt, err)
+			}
 			continue
 		}
 		return response, err
@@ -407,16 +436,28 @@ func (rc *reconnectingConnection) callOnce(ctx context.Context, h MethodKey, arg
 
 	rpc := &call{}
 	rpc.doneSignal = make(chan struct{})
+	if sampled(rc.opts.MessageStatsSampling) {
+		rpc.stats = methodName(h)
+	}
 
 	// TODO: Arrange to obey deadline in any reconnection done inside startCall.
 	conn, nc, err := rc.startCall(ctx, rpc, opts)
 	if err != nil {
 		return nil, err
 	}
-	if err := writeMessage(nc, &conn.wlock, requestMessage, rpc.id, hdrSlice, arg, rc.opts.WriteFlattenLimit); err != nil {
-		conn.shutdown("client send request", err)
+	if err := writeMessage(nc, &conn.wlock, requestMessage, rpc.id, hdrSlice, arg, rc.opts.WriteFlattenLimit, rpc.checksum); err != nil {
+		conn.shutdown(ErrSendFailed, "client send request", err)
 		conn.endCall(rpc)
-		return nil, fmt.Errorf("%w: %s", CommunicationError, err)
+		return nil, fmt.Errorf("%w: %w: %w", CommunicationError, ErrSendFailed, err)
+	}
+	if rpc.stats != "" {
+		recordMessage(rpc.stats, "send", requestMessage, len(hdrSlice)+len(arg))
+	}
+
+	if opts.NoReply {
+		// The reply, once received, has no caller and is dropped.
+		conn.endCall(rpc)
+		return nil, nil
 	}
 
 	if rc.opts.OptimisticSpinDuration > 0 {
@@ -438,8 +479,10 @@ func (rc *reconnectingConnection) callOnce(ctx context.Context, h MethodKey, arg
 
 			if !haveDeadline || time.Now().Before(deadline) {
 				// Early cancellation. Tell server about it.
-				if err := writeMessage(nc, &conn.wlock, cancelMessage, rpc.id, nil, nil, rc.opts.WriteFlattenLimit); err != nil {
-					conn.shutdown("client send cancel", err)
+				if err := writeMessage(nc, &conn.wlock, cancelMessage, rpc.id, nil, nil, rc.opts.WriteFlattenLimit, rpc.checksum); err != nil {
+					conn.shutdown(ErrSendFailed, "client send cancel", err)
+				} else if rpc.stats != "" {
+					recordMessage(rpc.stats, "send", cancelMessage, 0)
 				}
 			}
 
@@ -458,7 +501,7 @@ func (rc *reconnectingConnection) callOnce(ctx context.Context, h MethodKey, arg
 func (rc *reconnectingConnection) watchResolver(ctx context.Context, version *Version) {
 	defer rc.resolverDone.Done()
 
-	for r := retry.Begin(); r.Continue(ctx); {
+	for r := retry.BeginWithBackoff(rc.opts.NewBackoff()); r.Continue(ctx); {
 		endpoints, newVersion, err := rc.resolver.Resolve(ctx, version)
 		if err != nil {
 			logError(rc.opts.Logger, "watchResolver", err)
@@ -528,7 +571,7 @@ func (rc *reconnectingConnection) updateEndpoints(ctx context.Context, endpoints
 // startCall registers a new in-progress call.
 // REQUIRES: rc.mu is not held.
 func (rc *reconnectingConnection) startCall(ctx context.Context, rpc *call, opts CallOptions) (*clientConnection, net.Conn, error) {
-	for r := retry.Begin(); r.Continue(ctx); {
+	for r := retry.BeginWithBackoff(rc.opts.NewBackoff()); r.Continue(ctx); {
 		rc.mu.Lock()
 		if rc.closed {
 			rc.mu.Unlock()
@@ -549,6 +592,7 @@ func (rc *reconnectingConnection) startCall(ctx context.Context, rpc *call, opts
 
 		c.lastID++
 		rpc.id = c.lastID
+		rpc.checksum = c.checksum
 		c.calls[rpc.id] = rpc
 		c.callstart()
 		nc := c.c
@@ -620,14 +664,15 @@ func (c *clientConnection) lastdone() {
 	}
 }
 
-func (c *clientConnection) fail(details string, err error) {
+func (c *clientConnection) fail(kind error, details string, err error) {
 	if !c.loggedShutdown {
 		c.loggedShutdown = true
 		logError(c.logger, details, err)
 	}
 
-	// endCalls here so we can supply good errors.
-	c.endCalls(fmt.Errorf("%w: %s: %s", CommunicationError, details, err))
+	// endCalls here so we can supply good errors. kind (e.g., ErrSendFailed)
+	// lets callers tell which operation failed.
+	c.endCalls(fmt.Errorf("%w: %w: %w", CommunicationError, kind, err))
 
 	switch c.state {
 	case checking, idle, active:
@@ -740,7 +785,10 @@ func (c *clientConnection) endCall(rpc *call) {
 	}
 }
 
-func (c *clientConnection) findAndEndCall(id uint64) *call {
+// findAndEndCall returns the in-progress call with the provided id, or nil if
+// there is no such call. In the latter case, it also returns an error if id
+// was never assigned to a call in the current session of the connection.
+func (c *clientConnection) findAndEndCall(id uint64) (*call, error) {
 	c.rc.mu.Lock()
 	defer c.rc.mu.Unlock()
 	rpc := c.calls[id]
@@ -749,17 +797,25 @@ func (c *clientConnection) findAndEndCall(id uint64) *call {
 		if len(c.calls) == 0 {
 			c.lastdone()
 		}
+		return rpc, nil
 	}
-	return rpc
+	switch {
+	case id&(1<<sessionShift-1) != 0 && sessionOf(id) < c.epoch:
+		return nil, fmt.Errorf("%w: reply %d from session %d received in session %d", ErrStaleSession, id, sessionOf(id), c.epoch)
+	case id&(1<<sessionShift-1) == 0 || id > c.lastID || sessionOf(id) != c.epoch:
+		return nil, fmt.Errorf("%w %d", ErrUnknownReplyID, id)
+	}
+	// The call may have been canceled, or this is a duplicate reply.
+	return nil, nil
 }
 
 // shutdown processes an error detected while operating on a connection.
 // It closes the network connection and cancels all requests in progress on the connection.
 // REQUIRES: c.mu is not held.
-func (c *clientConnection) shutdown(details string, err error) {
+func (c *clientConnection) shutdown(kind error, details string, err error) {
 	c.rc.mu.Lock()
 	defer c.rc.mu.Unlock()
-	c.fail(details, err)
+	c.fail(kind, details, err)
 }
 
 // endCalls closes the network connection and ends any in-progress calls.
@@ -775,7 +831,7 @@ func (c *clientConnection) endCalls(err error) {
 
 // manage handles a live clientConnection until it becomes missing.
 func (c *clientConnection) manage(ctx context.Context) {
-	for r := retry.Begin(); r.Continue(ctx); {
+	for r := retry.BeginWithBackoff(c.rc.opts.NewBackoff()); r.Continue(ctx); {
 		progress := c.connectOnce(ctx)
 		if progress {
 			r.Reset()
@@ -797,74 +853,113 @@ func (c *clientConnection) connectOnce(ctx context.Context) bool {
 	c.rc.mu.Lock()
 	defer c.rc.mu.Unlock() // Also temporarily unlocked below
 	c.c = nc
-	c.cbuf = bufio.NewReader(nc)
+	// Reuse the read buffer of earlier connections, if any, to reduce
+	// allocations when the peer restarts repeatedly. This is safe since only
+	// this goroutine reads from the connection.
+	if c.rbuf == nil {
+		c.rbuf = bufio.NewReader(nc)
+	} else {
+		c.rbuf.Reset(nc)
+	}
+	c.cbuf = c.rbuf
 	c.loggedShutdown = false
+	c.epoch = (c.epoch + 1) % (1 << sessionBits)
+	c.lastID = c.epoch << sessionShift
 	c.connected()
 
 	// Handshake to get the peer version and verify that it is live.
-	if err := c.exchangeVersions(); err != nil {
-		c.fail("handshake", err)
+	if err := c.exchangeVersions(ctx); err != nil {
+		c.fail(ErrHandshakeFailed, "handshake", err)
 		return false
 	}
 	c.checked()
 
 	for c.state == idle || c.state == active || c.state == draining {
 		if err := c.readAndProcessMessage(); err != nil {
-			c.fail("client read", err)
+			c.fail(ErrRecvFailed, "client read", err)
 		}
 	}
 	return true
 }
 
-// exchangeVersions sends client version to server and waits for the server version.
-func (c *clientConnection) exchangeVersions() error {
+// exchangeVersions sends client version to server and waits for the server
+// version. It returns early if ctx is cancelled or the handshake timeout (see
+// ClientOptions.HandshakeTimeout) expires.
+func (c *clientConnection) exchangeVersions(ctx context.Context) error {
 	nc, buf := c.c, c.cbuf
 
 	// Do not hold mutex while reading from the network.
 	c.rc.mu.Unlock()
 	defer c.rc.mu.Lock()
 
-	if err := writeVersion(nc, &c.wlock); err != nil {
+	// Unblock pending reads and writes by expiring the connection deadline.
+	if timeout := c.rc.opts.HandshakeTimeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = WithTimeout(ctx, c.rc.opts.Clock, timeout)
+		defer cancel()
+	}
+	stop := context.AfterFunc(ctx, func() { nc.SetDeadline(time.Now()) })
+	defer func() {
+		if stop() {
+			nc.SetDeadline(time.Time{})
+		}
+	}()
+
+	var flags versionFlags
+	if c.rc.opts.Checksum {
+		flags |= checksumFlag
+	}
+	if err := writeVersion(nc, &c.wlock, flags); err != nil {
 		return err
 	}
-	mt, id, msg, err := readMessage(buf)
+	mt, id, msg, err := readMessage(buf, false)
 	if err != nil {
 		return err
 	}
 	if mt != versionMessage {
 		return fmt.Errorf("wrong message type %d, expecting %d", mt, versionMessage)
 	}
-	v, err := getVersion(id, msg)
+	v, peerFlags, err := getVersion(id, msg)
 	if err != nil {
 		return err
 	}
 	c.version = v
+	c.checksum = flags&peerFlags&checksumFlag != 0
 	return nil
 }
 
 // readAndProcessMessage reads and handles one message sent from the server.
 func (c *clientConnection) readAndProcessMessage() error {
-	buf := c.cbuf
+	buf, checksum := c.cbuf, c.checksum
 
 	// Do not hold mutex while reading from the network.
 	c.rc.mu.Unlock()
 	defer c.rc.mu.Lock()
 
-	mt, id, msg, err := readMessage(buf)
+	mt, id, msg, err := readMessage(buf, checksum)
 	if err != nil {
 		return err
 	}
 	switch mt {
 	case versionMessage:
-		_, err := getVersion(id, msg)
+		_, _, err := getVersion(id, msg)
 		if err != nil {
 			return err
 		}
 		// Ignore versions sent after initial hand-shake
 	case responseMessage, responseError:
-		rpc := c.findAndEndCall(id)
+		rpc, err := c.findAndEndCall(id)
 		if rpc == nil {
-			return nil // May have been canceled
+			if err != nil {
+				logError(c.logger, "client read", err)
+			}
+			// Otherwise, the call may have been canceled, or this is a
+			// duplicate reply. Either way, there is no caller to deliver the
+			// reply to.
+			return nil
+		}
+		if rpc.stats != "" {
+			recordMessage(rpc.stats, "recv", mt, len(msg))
 		}
 		if mt == responseError {
 			if err, ok := decodeError(msg); ok {
@@ -886,7 +981,10 @@ func (c *clientConnection) readAndProcessMessage() error {
 // readRequests runs on the server side reading messages sent over a connection by the client.
 func (c *serverConnection) readRequests(ctx context.Context, hmap *HandlerMap, onDone func()) {
 	for ctx.Err() == nil {
-		mt, id, msg, err := readMessage(c.cbuf)
+		c.mu.Lock()
+		checksum := c.checksum
+		c.mu.Unlock()
+		mt, id, msg, err := readMessage(c.cbuf, checksum)
 		if err != nil {
 			c.shutdown("server read", err)
 			onDone()
@@ -895,18 +993,24 @@ func (c *serverConnection) readRequests(ctx context.Context, hmap *HandlerMap, o
 
 		switch mt {
 		case versionMessage:
-			v, err := getVersion(id, msg)
+			v, peerFlags, err := getVersion(id, msg)
 			if err != nil {
 				c.shutdown("server read version", err)
 				onDone()
 				return
 			}
+			var flags versionFlags
+			if c.opts.Checksum {
+				flags = peerFlags & checksumFlag
+			}
 			c.mu.Lock()
 			c.version = v
+			c.checksum = flags&checksumFlag != 0
 			c.mu.Unlock()
 
-			// Respond with my version.
-			if err := writeVersion(c.c, &c.wlock); err != nil {
+			// Respond with my version. Note that the version message itself
+			// never carries a checksum.
+			if err := writeVersion(c.c, &c.wlock, flags); err != nil {
 				c.shutdown("server send version", err)
 				onDone()
 				return
@@ -966,6 +1070,10 @@ func (c *serverConnection) runHandler(hmap *HandlerMap, id uint64, msg []byte) {
 	} else {
 		methodName = logging.ShortenComponent(methodName)
 	}
+	stats := sampled(c.opts.MessageStatsSampling)
+	if stats {
+		recordMessage(methodName, "recv", requestMessage, len(msg))
+	}
 
 	var cancelFunc func()
 	if micros != 0 {
@@ -1016,7 +1124,15 @@ func (c *serverConnection) runHandler(hmap *HandlerMap, id uint64, msg []byte) {
 		span.SetStatus(codes.Error, err.Error())
 	}
 
-	if err := writeMessage(c.c, &c.wlock, mt, id, nil, result, c.opts.WriteFlattenLimit); err != nil {
+	c.mu.Lock()
+	checksum := c.checksum
+	c.mu.Unlock()
+	if stats {
+		// Record the response before sending it, so that it is recorded by
+		// the time the client receives it.
+		recordMessage(methodName, "send", mt, len(result))
+	}
+	if err := writeMessage(c.c, &c.wlock, mt, id, nil, result, c.opts.WriteFlattenLimit, checksum); err != nil {
 		c.shutdown("server write "+hmap.names[hkey], err)
 	}
 }
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"log"
 	"log/slog"
+	"maps"
 	"math/rand"
 	"net"
 	"os"
@@ -38,6 +39,7 @@ import (
 	"github.com/ServiceWeaver/weaver/internal/net/call"
 	"github.com/ServiceWeaver/weaver/internal/traceio"
 	"github.com/ServiceWeaver/weaver/runtime/logging"
+	"github.com/ServiceWeaver/weaver/runtime/metrics"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -725,6 +727,30 @@ func TestTracePropagation(t *testing.T) {
 	}
 }
 
+// TestNoReply tests that calls made with CallOptions.NoReply return without
+// waiting for the handler, and don't report handler errors.
+func TestNoReply(t *testing.T) {
+	ct := startTest(t)
+	client := ct.connect(call.NewConstantResolver(ct.startTCPServer()))
+	opts := call.CallOptions{NoReply: true}
+
+	// The sleep handler runs until the server shuts down.
+	result, err := client.Call(ct.ctx, sleepKey, []byte(testTimeout.String()), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != nil {
+		t.Fatalf("Call: got result %q, want nil", result)
+	}
+	if _, err := client.Call(ct.ctx, errorKey, []byte("ignored"), opts); err != nil {
+		t.Fatalf("Call: unexpected error %v", err)
+	}
+
+	// Discarded replies don't disturb later calls.
+	testCall(ct.ctx, t, client)
+	testError(t, client)
+}
+
 // TestMultipleEndpoints tests that RPC calls succeed when the resolver returns
 // a constant set of multiple endpoints.
 func TestMultipleEndpoints(t *testing.T) {
@@ -1310,10 +1336,313 @@ func TestWriteError(t *testing.T) {
 			if !errors.Is(err2, call.CommunicationError) {
 				t.Errorf("unexpected error: %v", err2)
 			}
+
+			// Only request writes fail on the client. Other errors cause
+			// the client to fail to read a response.
+			kind := call.ErrRecvFailed
+			if test.writeError == "WriteRequest" {
+				kind = call.ErrSendFailed
+			}
+			for _, err := range []error{err1, err2} {
+				if !errors.Is(err, kind) {
+					t.Errorf("got %v, want %v", err, kind)
+				}
+			}
 		})
 	}
 }
 
+func TestUnknownReplyID(t *testing.T) {
+	ctx, cancelFunc := context.WithDeadline(context.Background(), time.Now().Add(testTimeout))
+	defer cancelFunc()
+
+	// Make the server send a bogus reply before every real reply.
+	c, s := pipe(t)
+	s = &bogusReplyInjector{connWrapper{s}}
+	call.ServeOn(ctx, s, handlers, call.ServerOptions{Logger: logger(t)})
+
+	var logs syncBuffer
+	copts := call.ClientOptions{Logger: slog.New(slog.NewTextHandler(&logs, nil))}
+	client, err := call.Connect(ctx, call.NewConstantResolver(&connEndpoint{"server", c}), copts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	// The bogus reply should be dropped, rather than delivered to the caller.
+	res, err := client.Call(ctx, echoKey, []byte("hello"), call.CallOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(res), "hello"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if !strings.Contains(logs.String(), call.ErrUnknownReplyID.Error()) {
+		t.Fatalf("bogus reply not logged; logs:\n%s", logs.String())
+	}
+}
+
+func TestStaleSession(t *testing.T) {
+	ctx, cancelFunc := context.WithDeadline(context.Background(), time.Now().Add(testTimeout))
+	defer cancelFunc()
+
+	// The server of the first connection holds on to its replies, and the
+	// server of the second connection sends them, late, before its own.
+	c1, s1 := pipe(t)
+	c2, s2 := pipe(t)
+	held := &replyHolder{connWrapper: connWrapper{s1}, hold: true}
+	call.ServeOn(ctx, held, handlers, call.ServerOptions{Logger: logger(t)})
+	call.ServeOn(ctx, &replyHolder{connWrapper: connWrapper{s2}, late: held}, handlers, call.ServerOptions{Logger: logger(t)})
+
+	var logs syncBuffer
+	copts := call.ClientOptions{Logger: slog.New(slog.NewTextHandler(&logs, nil))}
+	endpoint := &connsEndpoint{name: "server", conns: []net.Conn{c1, c2}}
+	client, err := call.Connect(ctx, call.NewConstantResolver(endpoint), copts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	// Start a call, and force a reconnection while it is in flight.
+	errs := make(chan error, 1)
+	go func() {
+		_, err := client.Call(ctx, echoKey, []byte("hello"), call.CallOptions{})
+		errs <- err
+	}()
+	held.waitForReply()
+	c1.Close()
+	if err := <-errs; !errors.Is(err, call.CommunicationError) {
+		t.Fatalf("in-flight call: got %v, want %v", err, call.CommunicationError)
+	}
+
+	// The late reply to the first call is dropped, rather than delivered to
+	// a call of the new session.
+	res, err := client.Call(ctx, echoKey, []byte("world"), call.CallOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(res), "world"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if !strings.Contains(logs.String(), call.ErrStaleSession.Error()) {
+		t.Fatalf("stale reply not logged; logs:\n%s", logs.String())
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	for _, corrupt := range []bool{false, true} {
+		t.Run(fmt.Sprintf("corrupt=%t", corrupt), func(t *testing.T) {
+			ctx, cancelFunc := context.WithDeadline(context.Background(), time.Now().Add(testTimeout))
+			defer cancelFunc()
+
+			c, s := pipe(t)
+			if corrupt {
+				s = &replyCorrupter{connWrapper{s}}
+			}
+			sopts := call.ServerOptions{Logger: logger(t), Checksum: true}
+			call.ServeOn(ctx, s, handlers, sopts)
+
+			var logs syncBuffer
+			copts := call.ClientOptions{
+				Logger:   slog.New(slog.NewTextHandler(&logs, nil)),
+				Checksum: true,
+			}
+			client, err := call.Connect(ctx, call.NewConstantResolver(&connEndpoint{"server", c}), copts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+
+			res, err := client.Call(ctx, echoKey, []byte("hello"), call.CallOptions{})
+			if !corrupt {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got, want := string(res), "hello"; got != want {
+					t.Fatalf("got %q, want %q", got, want)
+				}
+				return
+			}
+
+			// The corrupted reply should fail the call instead of being
+			// delivered to the caller.
+			if !errors.Is(err, call.CommunicationError) {
+				t.Fatalf("got %q, %v; want %v", res, err, call.CommunicationError)
+			}
+			if !strings.Contains(logs.String(), call.ErrCorruptFrame.Error()) {
+				t.Fatalf("corrupt reply not logged; logs:\n%s", logs.String())
+			}
+		})
+	}
+}
+
+func TestMessageStats(t *testing.T) {
+	// messageBytesSum returns the sum of the sizes of the messages of the echo
+	// method with the provided type recorded in the given direction.
+	messageBytesSum := func(typ, direction string) float64 {
+		labels := map[string]string{"method": ".echo", "type": typ, "direction": direction}
+		for _, m := range metrics.Snapshot() {
+			if m.Name == "serviceweaver_call_message_bytes" && maps.Equal(m.Labels, labels) {
+				return m.Value
+			}
+		}
+		return 0
+	}
+	sums := func() [4]float64 {
+		return [4]float64{
+			messageBytesSum("request", "send"),
+			messageBytesSum("request", "recv"),
+			messageBytesSum("response", "send"),
+			messageBytesSum("response", "recv"),
+		}
+	}
+
+	for _, sampling := range []float64{0, 1} {
+		t.Run(fmt.Sprint(sampling), func(t *testing.T) {
+			ctx, cancelFunc := context.WithDeadline(context.Background(), time.Now().Add(testTimeout))
+			defer cancelFunc()
+
+			c, s := pipe(t)
+			sopts := call.ServerOptions{Logger: logger(t), MessageStatsSampling: sampling}
+			call.ServeOn(ctx, s, handlers, sopts)
+			copts := call.ClientOptions{Logger: logger(t), MessageStatsSampling: sampling}
+			client, err := call.Connect(ctx, call.NewConstantResolver(&connEndpoint{"server", c}), copts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+
+			before := sums()
+			if _, err := client.Call(ctx, echoKey, []byte("hello"), call.CallOptions{}); err != nil {
+				t.Fatal(err)
+			}
+			after := sums()
+
+			sentRequest, recvRequest := after[0]-before[0], after[1]-before[1]
+			sentResponse, recvResponse := after[2]-before[2], after[3]-before[3]
+			if sampling == 0 {
+				if after != before {
+					t.Fatalf("messages recorded without sampling: got %v, want %v", after, before)
+				}
+				return
+			}
+			if sentRequest <= float64(len("hello")) || sentRequest != recvRequest {
+				t.Errorf("request bytes: got %v sent and %v received, want the same number, more than %d", sentRequest, recvRequest, len("hello"))
+			}
+			if want := float64(len("hello")); sentResponse != want || recvResponse != want {
+				t.Errorf("response bytes: got %v sent and %v received, want %v", sentResponse, recvResponse, want)
+			}
+		})
+	}
+}
+
+func TestRetryBudget(t *testing.T) {
+	ctx, cancelFunc := context.WithDeadline(context.Background(), time.Now().Add(testTimeout))
+	defer cancelFunc()
+
+	// Every connection fails when the server writes a large reply.
+	const n = 5
+	endpoint := &connsEndpoint{name: "server"}
+	for i := 0; i < n; i++ {
+		c, s := pipe(t)
+		s = &writeErrorInjector{connWrapper: connWrapper{s}, limit: 100}
+		call.ServeOn(ctx, s, handlers, call.ServerOptions{Logger: logger(t)})
+		endpoint.conns = append(endpoint.conns, c)
+	}
+
+	// Allow a single retry.
+	copts := call.ClientOptions{
+		Logger:      logger(t),
+		RetryBudget: call.NewRetryBudget(1, 1e-9),
+	}
+	client, err := call.Connect(ctx, call.NewConstantResolver(endpoint), copts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	_, err = client.Call(ctx, echoKey, make([]byte, 200), call.CallOptions{Retry: true})
+	if !errors.Is(err, call.ErrRetryBudgetExceeded) || !errors.Is(err, call.CommunicationError) {
+		t.Fatalf("got %v, want %v and %v", err, call.ErrRetryBudgetExceeded, call.CommunicationError)
+	}
+}
+
+func TestOnRetry(t *testing.T) {
+	ctx, cancelFunc := context.WithDeadline(context.Background(), time.Now().Add(testTimeout))
+	defer cancelFunc()
+
+	// The first two connections fail when the server writes a large reply.
+	// The third connection is served normally.
+	const n = 2
+	endpoint := &connsEndpoint{name: "server"}
+	for i := 0; i < n+1; i++ {
+		c, s := pipe(t)
+		if i < n {
+			s = &writeErrorInjector{connWrapper: connWrapper{s}, limit: 100}
+		}
+		call.ServeOn(ctx, s, handlers, call.ServerOptions{Logger: logger(t)})
+		endpoint.conns = append(endpoint.conns, c)
+	}
+
+	var mu sync.Mutex
+	var attempts []int
+	copts := call.ClientOptions{
+		Logger: logger(t),
+		OnRetry: func(method call.MethodKey, attempt int, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if method != echoKey {
+				t.Errorf("OnRetry: got method %v, want %v", method, echoKey)
+			}
+			if !errors.Is(err, call.CommunicationError) {
+				t.Errorf("OnRetry: got %v, want %v", err, call.CommunicationError)
+			}
+			attempts = append(attempts, attempt)
+		},
+	}
+	client, err := call.Connect(ctx, call.NewConstantResolver(endpoint), copts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	if _, err := client.Call(ctx, echoKey, make([]byte, 200), call.CallOptions{Retry: true}); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if got, want := fmt.Sprint(attempts), "[1 2]"; got != want {
+		t.Fatalf("OnRetry attempts: got %s, want %s", got, want)
+	}
+}
+
+func TestHandshakeTimeout(t *testing.T) {
+	ctx, cancelFunc := context.WithDeadline(context.Background(), time.Now().Add(testTimeout))
+	defer cancelFunc()
+
+	// The first connection is never read from, so the client's handshake
+	// blocks. The second connection is served normally.
+	stuck, _ := pipe(t)
+	c, s := pipe(t)
+	call.ServeOn(ctx, s, handlers, call.ServerOptions{Logger: logger(t)})
+	endpoint := &connsEndpoint{name: "server", conns: []net.Conn{stuck, c}}
+
+	copts := call.ClientOptions{
+		Logger:           logger(t),
+		HandshakeTimeout: 10 * time.Millisecond,
+	}
+	client, err := call.Connect(ctx, call.NewConstantResolver(endpoint), copts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	// The call succeeds once the client gives up on the first connection.
+	if _, err := client.Call(ctx, echoKey, []byte("hello"), call.CallOptions{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestReconnect(t *testing.T) {
 	for name, maker := range resolverMakers {
 		t.Run(name, func(t *testing.T) {
@@ -1738,6 +2067,131 @@ func (c *writeErrorInjector) Write(b []byte) (int, error) {
 	return n, err
 }
 
+// bogusReplyInjector precedes every reply written to the connection with a
+// copy of the reply that has an id that was never assigned by the client.
+type bogusReplyInjector struct {
+	connWrapper
+}
+
+var _ net.Conn = &bogusReplyInjector{}
+
+func (c *bogusReplyInjector) Write(b []byte) (int, error) {
+	// See the message format in msg.go. Byte 8 holds the message type, and
+	// 2 is the type of a reply.
+	if len(b) >= 16 && b[8] == 2 {
+		bogus := bytes.Clone(b)
+		bogus[7] = 0xff // make the id huge
+		if _, err := c.connWrapper.Write(bogus); err != nil {
+			return 0, err
+		}
+	}
+	return c.connWrapper.Write(b)
+}
+
+// replyHolder holds on to the replies written to the connection, if hold is
+// true, or precedes the replies written to the connection with the replies
+// held by late, if late is not nil.
+type replyHolder struct {
+	connWrapper
+	hold bool
+	late *replyHolder
+
+	mu      sync.Mutex
+	replies [][]byte      // held replies
+	waiting chan struct{} // closed when a reply is held, if not nil
+}
+
+var _ net.Conn = &replyHolder{}
+
+func (c *replyHolder) Write(b []byte) (int, error) {
+	// See the message format in msg.go. Byte 8 holds the message type, and
+	// 2 is the type of a reply.
+	if len(b) < 16 || b[8] != 2 {
+		return c.connWrapper.Write(b)
+	}
+	if c.hold {
+		c.add(b)
+		return len(b), nil
+	}
+	if c.late != nil {
+		for _, reply := range c.late.take() {
+			if _, err := c.connWrapper.Write(reply); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return c.connWrapper.Write(b)
+}
+
+// add holds on to the provided reply.
+func (c *replyHolder) add(reply []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.replies = append(c.replies, bytes.Clone(reply))
+	if c.waiting != nil {
+		close(c.waiting)
+		c.waiting = nil
+	}
+}
+
+// waitForReply waits until a reply is held.
+func (c *replyHolder) waitForReply() {
+	c.mu.Lock()
+	if len(c.replies) > 0 {
+		c.mu.Unlock()
+		return
+	}
+	c.waiting = make(chan struct{})
+	waiting := c.waiting
+	c.mu.Unlock()
+	<-waiting
+}
+
+// take returns and forgets the held replies.
+func (c *replyHolder) take() [][]byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	replies := c.replies
+	c.replies = nil
+	return replies
+}
+
+// replyCorrupter flips a bit in the payload of every reply written to the
+// connection.
+type replyCorrupter struct {
+	connWrapper
+}
+
+var _ net.Conn = &replyCorrupter{}
+
+func (c *replyCorrupter) Write(b []byte) (int, error) {
+	// See the message format in msg.go. Byte 8 holds the message type, and
+	// 2 is the type of a reply.
+	if len(b) > 16 && b[8] == 2 {
+		b = bytes.Clone(b)
+		b[16] ^= 1
+	}
+	return c.connWrapper.Write(b)
+}
+
+// syncBuffer is a bytes.Buffer that is safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
 // readErrorInjector injects an error on writes after some number of bytes are read.
 type readErrorInjector struct {
 	connWrapper
@@ -0,0 +1,138 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package call
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time used for timing related logic (e.g., timeouts,
+// retry budgets, and rate limits). Tests can provide a fake Clock to
+// deterministically trigger timeouts without real sleeps.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTimer returns a new Timer that fires after the provided duration.
+	NewTimer(time.Duration) Timer
+
+	// After waits for the provided duration to elapse and then sends the
+	// current time on the returned channel.
+	After(time.Duration) <-chan time.Time
+}
+
+// Timer is a single event timer created by a Clock. It mirrors time.Timer.
+type Timer interface {
+	// C returns the channel on which the time is delivered when the timer
+	// fires.
+	C() <-chan time.Time
+
+	// Stop prevents the timer from firing. See time.Timer.Stop.
+	Stop() bool
+
+	// Reset changes the timer to expire after the provided duration. See
+	// time.Timer.Reset.
+	Reset(time.Duration) bool
+}
+
+// RealClock is a Clock implemented using the time package.
+type RealClock struct{}
+
+var _ Clock = RealClock{}
+
+// Now implements the Clock interface.
+func (RealClock) Now() time.Time { return time.Now() }
+
+// NewTimer implements the Clock interface.
+func (RealClock) NewTimer(d time.Duration) Timer { return realTimer{time.NewTimer(d)} }
+
+// After implements the Clock interface.
+func (RealClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// realTimer is a Timer implemented using a time.Timer.
+type realTimer struct{ t *time.Timer }
+
+var _ Timer = realTimer{}
+
+func (r realTimer) C() <-chan time.Time        { return r.t.C }
+func (r realTimer) Stop() bool                 { return r.t.Stop() }
+func (r realTimer) Reset(d time.Duration) bool { return r.t.Reset(d) }
+
+// WithTimeout is like context.WithTimeout, but the timeout is measured by the
+// provided clock. The deadline reported by the returned context is in real
+// time, like the deadlines of all contexts, so that it can be propagated to
+// other processes.
+func WithTimeout(ctx context.Context, clock Clock, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := clock.(RealClock); ok {
+		return context.WithTimeout(ctx, timeout)
+	}
+	c := &clockContext{
+		Context:  ctx,
+		deadline: time.Now().Add(timeout),
+		done:     make(chan struct{}),
+	}
+	if d, ok := ctx.Deadline(); ok && d.Before(c.deadline) {
+		c.deadline = d
+	}
+	timer := clock.NewTimer(timeout)
+	go func() {
+		defer timer.Stop()
+		select {
+		case <-timer.C():
+			c.cancel(context.DeadlineExceeded)
+		case <-ctx.Done():
+			c.cancel(ctx.Err())
+		case <-c.done:
+		}
+	}()
+	return c, func() { c.cancel(context.Canceled) }
+}
+
+// clockContext is a context that expires when a Clock's timer fires. See
+// WithTimeout.
+type clockContext struct {
+	context.Context // parent
+	deadline        time.Time
+	done            chan struct{}
+
+	mu  sync.Mutex
+	err error // non-nil once done is closed
+}
+
+// Deadline implements the context.Context interface.
+func (c *clockContext) Deadline() (time.Time, bool) { return c.deadline, true }
+
+// Done implements the context.Context interface.
+func (c *clockContext) Done() <-chan struct{} { return c.done }
+
+// Err implements the context.Context interface.
+func (c *clockContext) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// cancel cancels the context with the provided error, if it isn't already
+// cancelled.
+func (c *clockContext) cancel(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err == nil {
+		c.err = err
+		close(c.done)
+	}
+}
@@ -15,11 +15,49 @@
 package call
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/ServiceWeaver/weaver/runtime/codegen"
 )
 
+// ErrUnknownReplyID is logged when a client receives a reply with an id that
+// was never assigned to a request on the connection, which indicates a
+// misbehaving server. Such replies are dropped instead of being delivered to
+// an unrelated caller.
+var ErrUnknownReplyID = errors.New("reply with unknown id")
+
+// ErrStaleSession is logged when a client receives a reply to a call started
+// on an earlier network connection to the same server, e.g., a late reply
+// delivered after a reconnection. Such calls have already failed with a
+// communication error, so the replies are dropped instead of being delivered
+// to a call of the new connection.
+var ErrStaleSession = errors.New("reply from a stale session")
+
+// ErrCorruptFrame is the error that causes a connection to be closed when a
+// message fails checksum verification (see ClientOptions.Checksum), which
+// indicates that the message, or its length prefix, was corrupted in transit.
+var ErrCorruptFrame = errors.New("corrupt message frame")
+
+// ErrRetryBudgetExceeded is returned by a call that failed with a
+// communication error and was not retried because the connection's retry
+// budget was exhausted (see ClientOptions.RetryBudget). The error also wraps
+// the error of the last attempt.
+var ErrRetryBudgetExceeded = errors.New("retry budget exceeded")
+
+// Errors wrapped by communication errors (see CommunicationError) to identify
+// the operation on a connection that failed. Check for them via errors.Is.
+var (
+	// ErrSendFailed indicates that sending a message failed.
+	ErrSendFailed = errors.New("send failed")
+
+	// ErrRecvFailed indicates that receiving a message failed.
+	ErrRecvFailed = errors.New("receive failed")
+
+	// ErrHandshakeFailed indicates that the connection handshake failed.
+	ErrHandshakeFailed = errors.New("handshake failed")
+)
+
 type transportError int
 
 const (
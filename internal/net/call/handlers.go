@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"github.com/ServiceWeaver/weaver/runtime/logging"
 )
 
 // MethodKey identifies a particular method on a component (formed by
@@ -31,6 +32,7 @@ func MakeMethodKey(component, method string) MethodKey {
 	sig := sha256.Sum256([]byte(component + "." + method))
 	var fp MethodKey
 	copy(fp[:], sig[:])
+	methodNames.LoadOrStore(fp, logging.ShortenComponent(component+"."+method))
 	return fp
 }
 
@@ -17,6 +17,7 @@ package call
 import (
 	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"net"
 	"sync"
@@ -46,6 +47,18 @@ const (
 
 const currentVersion = initialVersion
 
+// versionFlags holds optional features requested in a version message.
+type versionFlags uint32
+
+const (
+	// checksumFlag requests that the header and payload of every message after
+	// the version exchange are followed by checksums. Checksums are used on a connection iff both
+	// sides request them.
+	checksumFlag versionFlags = 1 << iota
+)
+
+const checksumLen = 4 // size of each checksum included in a message, if any
+
 const hdrLenLen = uint32(4) // size of the header length included in each message
 
 // # Message formats
@@ -60,6 +73,20 @@ const hdrLenLen = uint32(4) // size of the header length included in each messag
 //
 // versionMessage: this is the first message sent on a connection by both sides.
 //    version  [4]byte
+//    flags    [4]byte  -- optional versionFlags; assumed zero if missing
+//
+// If checksums are enabled on a connection (see checksumFlag), every message
+// sent after the version messages has the following format instead:
+//    id        [8]byte       -- identifier used to track the message
+//    type      [1]byte       -- messageType
+//    length    [7]byte       -- length of the remainder of the message,
+//                               excluding hdrsum
+//    hdrsum    [4]byte       -- CRC-32 (IEEE) of id, type, and length
+//    payload   [length-4]byte
+//    checksum  [4]byte       -- CRC-32 (IEEE) of id, type, length, and payload
+//
+// The header checksum is verified before the payload is read, so that a
+// corrupted length is never trusted.
 //
 // requestMessage:
 //    headerLen         [4]byte         -- length of the encoded header
@@ -91,30 +118,44 @@ const hdrLenLen = uint32(4) // size of the header length included in each messag
 // (Allowing two arguments to form the payload avoids unnecessary allocation
 // and copying when we want to prepend some data to application supplied data).
 //
+// If checksum is true, the message is followed by a checksum.
+//
 // The write is guarded by wlock, which must not be locked when passed in.
-func writeMessage(w io.Writer, wlock *sync.Mutex, mt messageType, id uint64, extraHdr []byte, payload []byte, flattenLimit int) error {
-	nh, np := len(extraHdr), len(payload)
-	size := 16 + nh + np
+func writeMessage(w io.Writer, wlock *sync.Mutex, mt messageType, id uint64, extraHdr []byte, payload []byte, flattenLimit int, checksum bool) error {
+	size := 16 + len(extraHdr) + len(payload)
 	if size > flattenLimit {
-		return writeChunked(w, wlock, mt, id, extraHdr, payload)
+		return writeChunked(w, wlock, mt, id, extraHdr, payload, checksum)
 	}
-	return writeFlat(w, wlock, mt, id, extraHdr, payload)
+	return writeFlat(w, wlock, mt, id, extraHdr, payload, checksum)
 }
 
 // writeChunked writes the header, extra header, and the payload into w using
-// three different w.Write() calls.
-func writeChunked(w io.Writer, wlock *sync.Mutex, mt messageType, id uint64, extraHdr []byte, payload []byte) error {
-	// We use an iovec with up to three entries.
-	var vec [3][]byte
+// three different w.Write() calls (five, if checksum is true).
+func writeChunked(w io.Writer, wlock *sync.Mutex, mt messageType, id uint64, extraHdr []byte, payload []byte, checksum bool) error {
+	// We use an iovec with up to five entries.
+	var vec [5][]byte
 
-	nh, np := len(extraHdr), len(payload)
+	nh, np, nc := len(extraHdr), len(payload), 0
+	if checksum {
+		nc = checksumLen
+	}
 	var hdr [16]byte
 	binary.LittleEndian.PutUint64(hdr[0:], id)
-	binary.LittleEndian.PutUint64(hdr[8:], uint64(mt)|(uint64(nh+np)<<8))
+	binary.LittleEndian.PutUint64(hdr[8:], uint64(mt)|(uint64(nh+np+nc)<<8))
 
 	vec[0] = hdr[:]
-	vec[1] = extraHdr
-	vec[2] = payload
+	vec[2] = extraHdr
+	vec[3] = payload
+	var hdrSum, sum [checksumLen]byte
+	if checksum {
+		crc := crc32.ChecksumIEEE(hdr[:])
+		binary.LittleEndian.PutUint32(hdrSum[:], crc)
+		vec[1] = hdrSum[:]
+		crc = crc32.Update(crc, crc32.IEEETable, extraHdr)
+		crc = crc32.Update(crc, crc32.IEEETable, payload)
+		binary.LittleEndian.PutUint32(sum[:], crc)
+		vec[4] = sum[:]
+	}
 	buf := net.Buffers(vec[:])
 
 	// buf.WriteTo is not guaranteed to write the entire contents of buf
@@ -123,7 +164,7 @@ func writeChunked(w io.Writer, wlock *sync.Mutex, mt messageType, id uint64, ext
 	wlock.Lock()
 	defer wlock.Unlock()
 	n, err := buf.WriteTo(w)
-	if err == nil && n != 16+int64(nh)+int64(np) {
+	if err == nil && n != 16+int64(nh)+int64(np)+2*int64(nc) {
 		err = fmt.Errorf("partial write")
 	}
 	return err
@@ -131,14 +172,26 @@ func writeChunked(w io.Writer, wlock *sync.Mutex, mt messageType, id uint64, ext
 
 // writeFlat concatenates the header, extra header, and the payload into
 // a single flat byte slice, and writes it into w using a single w.Write() call.
-func writeFlat(w io.Writer, wlock *sync.Mutex, mt messageType, id uint64, extraHdr []byte, payload []byte) error {
-	nh, np := len(extraHdr), len(payload)
-	data := make([]byte, 16+nh+np)
+// If checksum is true, the header and payload checksums are included in the
+// slice.
+func writeFlat(w io.Writer, wlock *sync.Mutex, mt messageType, id uint64, extraHdr []byte, payload []byte, checksum bool) error {
+	nh, np, nc := len(extraHdr), len(payload), 0
+	if checksum {
+		nc = checksumLen
+	}
+	data := make([]byte, 16+nh+np+2*nc)
 	binary.LittleEndian.PutUint64(data[0:], id)
-	val := uint64(mt) | (uint64(nh+np) << 8)
+	val := uint64(mt) | (uint64(nh+np+nc) << 8)
 	binary.LittleEndian.PutUint64(data[8:], val)
-	copy(data[16:], extraHdr)
-	copy(data[16+nh:], payload)
+	copy(data[16+nc:], extraHdr)
+	copy(data[16+nc+nh:], payload)
+	if checksum {
+		crc := crc32.ChecksumIEEE(data[:16])
+		binary.LittleEndian.PutUint32(data[16:], crc)
+		end := 16 + nc + nh + np
+		crc = crc32.Update(crc, crc32.IEEETable, data[16+nc:end])
+		binary.LittleEndian.PutUint32(data[end:], crc)
+	}
 
 	// Write while holding the lock, since we don't know if the underlying
 	// io.Write is atomic.
@@ -153,8 +206,11 @@ func writeFlat(w io.Writer, wlock *sync.Mutex, mt messageType, id uint64, extraH
 	return err
 }
 
-// readMessage reads, parses, and returns the next message from r.
-func readMessage(r io.Reader) (messageType, uint64, []byte, error) {
+// readMessage reads, parses, and returns the next message from r. If checksum
+// is true, the message header and payload must be followed by valid
+// checksums, and readMessage returns an error wrapping ErrCorruptFrame if they
+// are not. The header checksum is verified before the payload is read.
+func readMessage(r io.Reader, checksum bool) (messageType, uint64, []byte, error) {
 	// Read the header.
 	const headerSize = 16
 	var hdr [headerSize]byte
@@ -162,6 +218,19 @@ func readMessage(r io.Reader) (messageType, uint64, []byte, error) {
 		return 0, 0, nil, err
 	}
 
+	// Verify the header checksum before trusting the header contents.
+	var crc uint32
+	if checksum {
+		var hdrSum [checksumLen]byte
+		if _, err := io.ReadFull(r, hdrSum[:]); err != nil {
+			return 0, 0, nil, err
+		}
+		crc = crc32.ChecksumIEEE(hdr[:])
+		if crc != binary.LittleEndian.Uint32(hdrSum[:]) {
+			return 0, 0, nil, fmt.Errorf("%w: header checksum mismatch", ErrCorruptFrame)
+		}
+	}
+
 	// Extract header contents (see writeMessage for header format).
 	id := binary.LittleEndian.Uint64(hdr[0:])
 	w2 := binary.LittleEndian.Uint64(hdr[8:])
@@ -171,37 +240,56 @@ func readMessage(r io.Reader) (messageType, uint64, []byte, error) {
 	if dataLen > maxSize {
 		return 0, 0, nil, fmt.Errorf("overly large message length %d", dataLen)
 	}
+	if checksum && dataLen < checksumLen {
+		return 0, 0, nil, fmt.Errorf("%w: message length %d too small for checksum", ErrCorruptFrame, dataLen)
+	}
 
 	// Read the payload.
 	msg := make([]byte, int(dataLen))
 	if _, err := io.ReadFull(r, msg); err != nil {
 		return 0, 0, nil, err
 	}
+
+	// Verify the checksum.
+	if checksum {
+		end := len(msg) - checksumLen
+		want := binary.LittleEndian.Uint32(msg[end:])
+		msg = msg[:end]
+		got := crc32.Update(crc, crc32.IEEETable, msg)
+		if got != want {
+			return 0, 0, nil, fmt.Errorf("%w: checksum mismatch for message %d", ErrCorruptFrame, id)
+		}
+	}
 	return mt, id, msg, nil
 }
 
-// writeVersion sends my version number to the peer.
-func writeVersion(w io.Writer, wlock *sync.Mutex) error {
-	var msg [4]byte
+// writeVersion sends my version number and the provided flags to the peer.
+func writeVersion(w io.Writer, wlock *sync.Mutex, flags versionFlags) error {
+	var msg [8]byte
 	binary.LittleEndian.PutUint32(msg[:], uint32(currentVersion))
-	return writeFlat(w, wlock, versionMessage, 0, nil, msg[:])
+	binary.LittleEndian.PutUint32(msg[4:], uint32(flags))
+	return writeFlat(w, wlock, versionMessage, 0, nil, msg[:], false)
 }
 
-// getVersion extracts the version number sent by the peer and picks the
-// appropriate version number to use for communicating with the peer.
-func getVersion(id uint64, msg []byte) (version, error) {
+// getVersion extracts the version number and flags sent by the peer and picks
+// the appropriate version number to use for communicating with the peer.
+func getVersion(id uint64, msg []byte) (version, versionFlags, error) {
 	if id != 0 {
-		return 0, fmt.Errorf("invalid ID %d in handshake", id)
+		return 0, 0, fmt.Errorf("invalid ID %d in handshake", id)
 	}
 	// Allow messages longer than needed so that future updates can send more info.
 	if len(msg) < 4 {
-		return 0, fmt.Errorf("bad version message length %d, must be >= 4", len(msg))
+		return 0, 0, fmt.Errorf("bad version message length %d, must be >= 4", len(msg))
 	}
 	v := binary.LittleEndian.Uint32(msg)
+	var flags versionFlags
+	if len(msg) >= 8 {
+		flags = versionFlags(binary.LittleEndian.Uint32(msg[4:]))
+	}
 
 	// We use the minimum of the peer and my version numbers.
 	if v < uint32(currentVersion) {
-		return version(v), nil
+		return version(v), flags, nil
 	}
-	return currentVersion, nil
+	return currentVersion, flags, nil
 }
@@ -16,6 +16,7 @@ package call
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"math/rand"
 	"net"
@@ -50,7 +51,7 @@ func TestConcurrentWrites(t *testing.T) {
 			if rand.Int()%2 == 0 {
 				flattenLimit = 9999999
 			}
-			if err := writeMessage(client, &wlock, requestMessage, uint64(id), extraHdr, payload, flattenLimit); err != nil {
+			if err := writeMessage(client, &wlock, requestMessage, uint64(id), extraHdr, payload, flattenLimit, false); err != nil {
 				return err
 			}
 			id += numWriters
@@ -60,7 +61,7 @@ func TestConcurrentWrites(t *testing.T) {
 
 	reader := func() error {
 		for i := 0; i < numWriters*numWrites; i++ {
-			mt, id, payload, err := readMessage(server)
+			mt, id, payload, err := readMessage(server, false)
 			if err != nil {
 				return err
 			}
@@ -100,6 +101,50 @@ func TestConcurrentWrites(t *testing.T) {
 	}
 }
 
+func TestChecksum(t *testing.T) {
+	extraHdr := []byte{1, 2, 3, 4}
+	payload := []byte{10, 20, 30, 40, 50}
+	want := append(extraHdr, payload...)
+	for _, flatten := range []bool{true, false} {
+		t.Run(fmt.Sprintf("flatten=%t", flatten), func(t *testing.T) {
+			var buf bytes.Buffer
+			var mu sync.Mutex
+			var err error
+			if flatten {
+				err = writeFlat(&buf, &mu, requestMessage, 42, extraHdr, payload, true)
+			} else {
+				err = writeChunked(&buf, &mu, requestMessage, 42, extraHdr, payload, true)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			frame := buf.Bytes()
+
+			// An intact message is read back.
+			mt, id, got, err := readMessage(bytes.NewReader(frame), true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if mt != requestMessage || id != 42 || !bytes.Equal(got, want) {
+				t.Fatalf("readMessage: got (%d, %d, %v), want (%d, 42, %v)", mt, id, got, requestMessage, want)
+			}
+
+			// Corrupting any byte of the message is detected.
+			for i := range frame {
+				corrupted := bytes.Clone(frame)
+				corrupted[i] ^= 0x10
+				_, _, _, err := readMessage(bytes.NewReader(corrupted), true)
+				if err == nil {
+					t.Fatalf("byte %d: corruption not detected", i)
+				}
+				if !errors.Is(err, ErrCorruptFrame) {
+					t.Fatalf("byte %d: got %v, want %v", i, err, ErrCorruptFrame)
+				}
+			}
+		})
+	}
+}
+
 func BenchmarkReadWrite(b *testing.B) {
 	for _, network := range []string{"tcp"} {
 		out, in := net.Pipe()
@@ -115,7 +160,7 @@ func BenchmarkReadWrite(b *testing.B) {
 					done := make(chan bool)
 					go func() {
 						for n := 0; n < numIters; n++ {
-							if _, _, _, err := readMessage(in); err != nil {
+							if _, _, _, err := readMessage(in, false); err != nil {
 								panic(fmt.Sprint(err))
 							}
 						}
@@ -123,11 +168,11 @@ func BenchmarkReadWrite(b *testing.B) {
 					}()
 					for n := 0; n < numIters; n++ {
 						if flatten == "Flatten" {
-							if err := writeFlat(out, &mu, requestMessage, 0, extraHdr[:], payload); err != nil {
+							if err := writeFlat(out, &mu, requestMessage, 0, extraHdr[:], payload, false); err != nil {
 								b.Fatal(err)
 							}
 						} else {
-							if err := writeChunked(out, &mu, requestMessage, 0, extraHdr[:], payload); err != nil {
+							if err := writeChunked(out, &mu, requestMessage, 0, extraHdr[:], payload, false); err != nil {
 								b.Fatal(err)
 							}
 						}
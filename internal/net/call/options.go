@@ -20,6 +20,7 @@ import (
 
 	"github.com/ServiceWeaver/weaver/internal/traceio"
 	"github.com/ServiceWeaver/weaver/runtime/logging"
+	"github.com/ServiceWeaver/weaver/runtime/retry"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -44,6 +45,48 @@ type ClientOptions struct {
 	// buffer before being written on the connection. If zero, an appropriate
 	// value is picked automatically. If negative, no flattening is done.
 	WriteFlattenLimit int
+
+	// If true, request that every message on a connection carries a
+	// checksum. Checksums are used only if the server enables them as well
+	// (see ServerOptions.Checksum). A message that fails verification closes
+	// the connection, failing pending calls with a CommunicationError, instead
+	// of desynchronizing the connection. Checksums are unnecessary on trusted
+	// transports (e.g., unix domain sockets).
+	Checksum bool
+
+	// If not nil, retries of calls that failed with communication errors are
+	// limited by the provided budget. Calls that cannot be retried because
+	// the budget is exhausted fail with ErrRetryBudgetExceeded. If nil,
+	// retries are unlimited.
+	RetryBudget *RetryBudget
+
+	// If non-zero, the connection handshake must complete within the given
+	// duration. A handshake that takes longer (e.g., because the server
+	// never reads or never replies) fails, and the connection is redialed.
+	// Handshakes are always abandoned when the client is closed.
+	HandshakeTimeout time.Duration
+
+	// The clock used to measure the handshake timeout and to refill the
+	// retry budget. Defaults to RealClock.
+	Clock Clock
+
+	// The fraction, between 0 and 1, of method calls whose messages are
+	// recorded as metrics labeled by method and message type (see
+	// serviceweaver_call_message_bytes). This is useful for performance
+	// tuning but adds a small cost to every sampled call, so it is zero
+	// (disabled) by default.
+	MessageStatsSampling float64
+
+	// If not nil, returns the backoff used by each of the connection's retry
+	// loops, e.g., the loops that redial a server and that retry calls.
+	// Defaults to retry.Exponential(retry.DefaultOptions).
+	NewBackoff func() retry.Backoff
+
+	// If not nil, called every time a call that failed with a communication
+	// error is about to be retried, with the call's method, the number of the
+	// retry (starting at 1), and the error. OnRetry is called synchronously
+	// and should not block.
+	OnRetry func(method MethodKey, attempt int, err error)
 }
 
 // ServerOption are the options to configure an RPC server.
@@ -65,6 +108,14 @@ type ServerOptions struct {
 	// buffer before being written on the connection. If zero, an appropriate
 	// value is picked automatically. If negative, no flattening is done.
 	WriteFlattenLimit int
+
+	// If true, use per-message checksums on connections from clients that
+	// request them (see ClientOptions.Checksum).
+	Checksum bool
+
+	// The fraction of method calls whose messages are recorded (see
+	// ClientOptions.MessageStatsSampling).
+	MessageStatsSampling float64
 }
 
 // CallOptions are call-specific options.
@@ -79,6 +130,14 @@ type CallOptions struct {
 	// TODO(mwhittaker): Figure out a way to have 0 be a valid shard key. Could
 	// change to *uint64 for example.
 	ShardKey uint64
+
+	// NoReply, if true, makes Call return as soon as the request has been
+	// written to a connection, without waiting for the reply, which is
+	// discarded. The call is executed at most once: it is never retried, and
+	// the error returned by Call only reflects whether the request was sent,
+	// not whether the handler succeeded. Calls made with NoReply return a nil
+	// result.
+	NoReply bool
 }
 
 // withDefaults returns a copy of the ClientOptions with zero values replaced
@@ -93,6 +152,12 @@ func (c ClientOptions) withDefaults() ClientOptions {
 	if c.WriteFlattenLimit == 0 {
 		c.WriteFlattenLimit = defaultWriteFlattenLimit
 	}
+	if c.NewBackoff == nil {
+		c.NewBackoff = func() retry.Backoff { return retry.Exponential(retry.DefaultOptions) }
+	}
+	if c.Clock == nil {
+		c.Clock = RealClock{}
+	}
 	return c
 }
 
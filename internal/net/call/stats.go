@@ -0,0 +1,83 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package call
+
+import (
+	"math/rand/v2"
+	"sync"
+
+	imetrics "github.com/ServiceWeaver/weaver/internal/metrics"
+	"github.com/ServiceWeaver/weaver/metrics"
+)
+
+// Message statistics are recorded only for a sample of the method calls on
+// connections that enable them (see ClientOptions.MessageStatsSampling and
+// ServerOptions.MessageStatsSampling). The time spent encoding and decoding
+// the messages is recorded by the component stubs instead (see
+// codegen.SetSerializationSampling).
+var messageBytes = metrics.NewHistogramMap[messageLabels](
+	"serviceweaver_call_message_bytes",
+	"Number of bytes in a message of a sampled method call, excluding the fixed size message header",
+	imetrics.GeneratedBuckets,
+)
+
+type messageLabels struct {
+	Method    string // component method (e.g., "weaver.deployerControl.LogBatch")
+	Type      string // message type (e.g., "request")
+	Direction string // "send" or "recv"
+}
+
+var messageTypeNames = []string{
+	versionMessage:  "version",
+	requestMessage:  "request",
+	responseMessage: "response",
+	responseError:   "error",
+	cancelMessage:   "cancel",
+}
+
+func (mt messageType) String() string {
+	if int(mt) < len(messageTypeNames) {
+		return messageTypeNames[mt]
+	}
+	return "unknown"
+}
+
+// methodNames maps the method keys returned by MakeMethodKey to the
+// corresponding "<component>.<method>" names, so that clients can label the
+// messages of a method call.
+var methodNames sync.Map // MethodKey -> string
+
+// methodName returns the name of the method with the provided key, or
+// "unknown" if the key wasn't made by MakeMethodKey.
+func methodName(h MethodKey) string {
+	if name, ok := methodNames.Load(h); ok {
+		return name.(string)
+	}
+	return "unknown"
+}
+
+// sampled returns whether to record the messages of a method call, given the
+// fraction of method calls to sample.
+func sampled(rate float64) bool {
+	return rate > 0 && (rate >= 1 || rand.Float64() < rate)
+}
+
+// recordMessage records the size of a message of type mt with n payload bytes
+// that was sent or received (as indicated by direction) as part of a call to
+// the provided method.
+func recordMessage(method, direction string, mt messageType, n int) {
+	labels := messageLabels{Method: method, Type: mt.String(), Direction: direction}
+	messageBytes.Get(labels).Put(float64(n))
+}
@@ -52,6 +52,17 @@ func (s *stub) Tracer() trace.Tracer {
 	return s.tracer
 }
 
+// noReplyKey is the context key that marks calls made without waiting for a
+// reply (see WithNoReply).
+type noReplyKey struct{}
+
+// WithNoReply returns a context that makes the calls issued with it through a
+// stub returned by NewStub use CallOptions.NoReply. Such calls return as soon
+// as they are sent, and the stub returns a nil result for them.
+func WithNoReply(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noReplyKey{}, true)
+}
+
 // Run implements the codegen.Stub interface.
 func (s *stub) Run(ctx context.Context, method int, args []byte, shardKey uint64) (result []byte, err error) {
 	m := s.methods[method]
@@ -59,8 +70,13 @@ func (s *stub) Run(ctx context.Context, method int, args []byte, shardKey uint64
 		Retry:    m.retry,
 		ShardKey: shardKey,
 	}
+	if noReply, _ := ctx.Value(noReplyKey{}).(bool); noReply {
+		// NoReply calls are executed at most once.
+		opts.Retry = false
+		opts.NoReply = true
+	}
 	n := 1
-	if m.retry {
+	if opts.Retry {
 		n += s.injectRetries
 	}
 	for i := 0; i < n; i++ {
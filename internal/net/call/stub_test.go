@@ -334,3 +334,43 @@ func weaverDecode(d *codegen.Decoder, output interface{}) interface{} {
 		panic(fmt.Errorf("Unable to decode type %v with Service Weaver decoder\n", x))
 	}
 }
+
+// optionsRecorder is a Connection that records the options of every call.
+type optionsRecorder struct {
+	opts []CallOptions
+}
+
+var _ Connection = &optionsRecorder{}
+
+func (c *optionsRecorder) Call(_ context.Context, _ MethodKey, _ []byte, opts CallOptions) ([]byte, error) {
+	c.opts = append(c.opts, opts)
+	if opts.NoReply {
+		return nil, nil
+	}
+	return []byte{0}, nil
+}
+
+func (c *optionsRecorder) Close() {}
+
+// TestWithNoReply tests that the stub forwards WithNoReply as
+// CallOptions.NoReply, and never retries such calls.
+func TestWithNoReply(t *testing.T) {
+	conn := &optionsRecorder{}
+	stub := stub{
+		conn: conn,
+		methods: []stubMethod{
+			{key: MakeMethodKey("", "test"), retry: true},
+		},
+		injectRetries: 2,
+	}
+	out, err := stub.Run(WithNoReply(context.Background()), 0, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != nil {
+		t.Errorf("Run: got result %v, want nil", out)
+	}
+	if want := []CallOptions{{NoReply: true}}; !reflect.DeepEqual(conn.opts, want) {
+		t.Errorf("call options: got %+v, want %+v", conn.opts, want)
+	}
+}
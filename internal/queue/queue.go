@@ -16,11 +16,17 @@ package queue
 
 import (
 	"context"
+	"errors"
 	"sync"
+	"time"
 
 	"github.com/ServiceWeaver/weaver/internal/cond"
 )
 
+// ErrTimeout is returned by PopWithTimeout if the queue stays empty for the
+// provided duration.
+var ErrTimeout = errors.New("queue: timed out waiting for an element")
+
 // Queue is a thread-safe queue.
 //
 // Unlike a Go channel, Queue doesn't have any constraints on how many
@@ -60,6 +66,28 @@ func (q *Queue[T]) Pop(ctx context.Context) (elem T, err error) {
 	return
 }
 
+// PopWithTimeout is like Pop, but it returns ErrTimeout if the queue stays
+// empty for the provided duration. It lets a consumer do periodic work (e.g.,
+// flush buffers) while the queue is idle:
+//
+//	for {
+//	    elem, err := q.PopWithTimeout(ctx, time.Second)
+//	    if errors.Is(err, queue.ErrTimeout) {
+//	        flush()
+//	        continue
+//	    }
+//	    ...
+//	}
+func (q *Queue[T]) PopWithTimeout(ctx context.Context, d time.Duration) (T, error) {
+	dctx, cancel := context.WithTimeoutCause(ctx, d, ErrTimeout)
+	defer cancel()
+	elem, err := q.Pop(dctx)
+	if err != nil && ctx.Err() == nil && context.Cause(dctx) == ErrTimeout {
+		return elem, ErrTimeout
+	}
+	return elem, err
+}
+
 // init initializes the queue.
 //
 // REQUIRES: q.mu is held
@@ -104,3 +104,26 @@ func TestContextCancel(t *testing.T) {
 		t.Fatalf("Pop: got %v, want %v", err, context.Canceled)
 	}
 }
+
+func TestPopWithTimeout(t *testing.T) {
+	var q queue.Queue[int]
+	if _, err := q.PopWithTimeout(context.Background(), 10*time.Millisecond); !errors.Is(err, queue.ErrTimeout) {
+		t.Fatalf("PopWithTimeout: got %v, want %v", err, queue.ErrTimeout)
+	}
+
+	q.Push(x)
+	got, err := q.PopWithTimeout(context.Background(), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != x {
+		t.Fatalf("PopWithTimeout: got %v, want %v", got, x)
+	}
+
+	// Canceling the context is not a timeout.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := q.PopWithTimeout(ctx, time.Minute); !errors.Is(err, context.Canceled) {
+		t.Fatalf("PopWithTimeout: got %v, want %v", err, context.Canceled)
+	}
+}
@@ -79,6 +79,17 @@ func TestTwoWritesPanics(t *testing.T) {
 	t.Fatal("second Write unexpectedly succeeded")
 }
 
+func TestTryRead(t *testing.T) {
+	var r register.WriteOnce[int]
+	if got, ok := r.TryRead(); ok {
+		t.Fatalf("TryRead: got %v before write", got)
+	}
+	r.Write(x)
+	if got, ok := r.TryRead(); !ok || got != x {
+		t.Fatalf("TryRead: got %v, %v, want %v, true", got, ok, x)
+	}
+}
+
 func TestTryWrite(t *testing.T) {
 	var r register.WriteOnce[int]
 	if got, want := r.TryWrite(x), true; got != want {
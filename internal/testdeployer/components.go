@@ -19,6 +19,11 @@ package testdeployer
 
 import (
 	"context"
+	"encoding/binary"
+	"fmt"
+	"runtime/pprof"
+	"sync/atomic"
+	"time"
 
 	"github.com/ServiceWeaver/weaver"
 	"github.com/ServiceWeaver/weaver/metrics"
@@ -46,17 +51,30 @@ type d interface {
 
 type aimpl struct {
 	weaver.Implements[a]
-	lis weaver.Listener //lint:ignore U1000 used in remoteweavelet_test.go
+	lis weaver.Listener
 	b   weaver.Ref[b]
 }
 
 type bimpl struct {
 	weaver.Implements[b]
+	weaver.WithConfig[bconfig]
 	c weaver.Ref[c]
 }
 
+// bconfig is the config of b, which b can reload.
+type bconfig struct {
+	Greeting string
+}
+
 type cimpl struct {
 	weaver.Implements[c]
+	weaver.WithConfig[cconfig]
+	calls atomic.Uint64 // number of calls to C, checkpointed
+}
+
+// cconfig is the config of c, which c can't reload.
+type cconfig struct {
+	Size int
 }
 
 type dimpl struct {
@@ -73,14 +91,76 @@ func (b *bimpl) B(ctx context.Context, x int) (int, error) {
 	return b.c.Get().C(ctx, x)
 }
 
+// bReloaded is the latest config reloaded by b.
+var bReloaded atomic.Pointer[bconfig]
+
+// ReloadConfig reloads the config of b. It fails for the greeting "fail".
+func (b *bimpl) ReloadConfig(_ context.Context, config any) error {
+	cfg := config.(*bconfig)
+	if cfg.Greeting == "fail" {
+		return fmt.Errorf("simulated ReloadConfig failure")
+	}
+	bReloaded.Store(cfg)
+	return nil
+}
+
 var c_calls = metrics.NewCounter("c_calls", "Number of calls to c.C")
 
+// cLabel is the "component" profiling label of the latest call to c.C that
+// had one.
+var cLabel atomic.Pointer[string]
+
+// cHook, if set, is called by every call to c.C.
+var cHook atomic.Pointer[func()]
+
 func (c *cimpl) C(ctx context.Context, x int) (int, error) {
 	c.Logger(ctx).Debug("C")
+	if label, ok := pprof.Label(ctx, "component"); ok {
+		cLabel.Store(&label)
+	}
+	if hook := cHook.Load(); hook != nil {
+		(*hook)()
+	}
 	c_calls.Inc()
+	c.calls.Add(1)
 	return x, nil
 }
 
+// checkpointDeadline is the deadline of the context passed to the latest
+// checkpoint of c, or the zero time if the context had no deadline.
+var checkpointDeadline atomic.Pointer[time.Time]
+
+func init() {
+	// Make c checkpointable to test Checkpoint and Restore.
+	weaver.RegisterCheckpoint(
+		func(ctx context.Context, c *cimpl) ([]byte, error) {
+			deadline, _ := ctx.Deadline()
+			checkpointDeadline.Store(&deadline)
+			return binary.LittleEndian.AppendUint64(nil, c.calls.Load()), nil
+		},
+		func(_ context.Context, c *cimpl, state []byte) error {
+			if len(state) != 8 {
+				return fmt.Errorf("invalid checkpoint of length %d", len(state))
+			}
+			c.calls.Store(binary.LittleEndian.Uint64(state))
+			return nil
+		},
+	)
+}
+
+// cHealth, if set, is the error returned by the "calls" health check of c.
+var cHealth atomic.Pointer[error]
+
+func init() {
+	// Give c a health check to test application-defined health checks.
+	weaver.RegisterHealthCheck("calls", func(context.Context, *cimpl) error {
+		if err := cHealth.Load(); err != nil {
+			return *err
+		}
+		return nil
+	})
+}
+
 func (d *dimpl) D(ctx context.Context) (string, error) {
 	return d.Weaver().DeploymentID, nil
 }
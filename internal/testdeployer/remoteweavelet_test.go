@@ -15,27 +15,52 @@
 package testdeployer
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
+	goruntime "runtime"
+	"runtime/debug"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"testing"
 	"time"
 
+	"github.com/ServiceWeaver/weaver/internal/control"
+	"github.com/ServiceWeaver/weaver/internal/net/call"
 	"github.com/ServiceWeaver/weaver/internal/reflection"
 	"github.com/ServiceWeaver/weaver/internal/weaver"
+	wmetrics "github.com/ServiceWeaver/weaver/metrics"
 	"github.com/ServiceWeaver/weaver/runtime"
 	"github.com/ServiceWeaver/weaver/runtime/codegen"
 	"github.com/ServiceWeaver/weaver/runtime/deployers"
 	"github.com/ServiceWeaver/weaver/runtime/envelope"
 	"github.com/ServiceWeaver/weaver/runtime/logging"
+	"github.com/ServiceWeaver/weaver/runtime/metrics"
 	"github.com/ServiceWeaver/weaver/runtime/protomsg"
 	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/ServiceWeaver/weaver/runtime/retry"
+	"github.com/ServiceWeaver/weaver/runtime/scriptedweavelet"
+	"github.com/google/go-cmp/cmp"
 	"github.com/google/pprof/profile"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/testing/protocmp"
 )
 
 // TODO(mwhittaker): In addition to the tests that are currently failing, here
@@ -57,26 +82,38 @@ type weavelet struct {
 	cancel  context.CancelFunc     // shuts down the weavelet
 	env     *envelope.Envelope     // envelope
 	wlet    *weaver.RemoteWeavelet // weavelet
+	args    *protos.WeaveletArgs   // arguments passed to the weavelet
 	threads *errgroup.Group        // background threads
 }
 
-// spawn spawns a weavelet with the provided info and handler.
-func spawn(ctx context.Context, info *protos.WeaveletArgs, handler envelope.EnvelopeHandler, log *slog.Logger, tmpDir string) (*weavelet, error) {
+// spawn spawns a weavelet with the provided info, handler, and envelope
+// options. opts.Child is overwritten.
+func spawn(ctx context.Context, info *protos.WeaveletArgs, handler envelope.EnvelopeHandler, opts envelope.Options) (*weavelet, error) {
+	return spawnWithOptions(ctx, info, handler, opts, weaver.RemoteWeaveletOptions{})
+}
+
+// spawnWithOptions is like spawn, but creates the weavelet with the provided
+// options.
+func spawnWithOptions(ctx context.Context, info *protos.WeaveletArgs, handler envelope.EnvelopeHandler, opts envelope.Options, wopts weaver.RemoteWeaveletOptions) (*weavelet, error) {
+	return spawnHandler(ctx, info, func(*envelope.Envelope) (envelope.EnvelopeHandler, error) {
+		return handler, nil
+	}, opts, wopts)
+}
+
+// spawnHandler is like spawnWithOptions, but serves the envelope with the
+// handler returned by newHandler.
+func spawnHandler(ctx context.Context, info *protos.WeaveletArgs, newHandler func(*envelope.Envelope) (envelope.EnvelopeHandler, error), opts envelope.Options, wopts weaver.RemoteWeaveletOptions) (*weavelet, error) {
 	// envelope.NewEnvelope blocks performing a handshake with the weavelet, so
 	// we have to run it in a separate goroutine.
 	ctx, cancel := context.WithCancel(ctx)
 	threads, ctx := errgroup.WithContext(ctx)
 	errs := make(chan error)
 	child := envelope.NewInProcessChild()
+	opts.Child = child
 	var env *envelope.Envelope
 	go func() {
 		var err error
-		env, err = envelope.NewEnvelope(ctx, info, &protos.AppConfig{},
-			envelope.Options{
-				TmpDir: tmpDir,
-				Logger: log,
-				Child:  child,
-			})
+		env, err = envelope.NewEnvelope(ctx, info, &protos.AppConfig{}, opts)
 		errs <- err
 	}()
 
@@ -87,7 +124,7 @@ func spawn(ctx context.Context, info *protos.WeaveletArgs, handler envelope.Enve
 		runtime.Bootstrap{
 			Args: child.Args(),
 		},
-		weaver.RemoteWeaveletOptions{},
+		wopts,
 	)
 	if err != nil {
 		cancel()
@@ -108,6 +145,11 @@ func spawn(ctx context.Context, info *protos.WeaveletArgs, handler envelope.Enve
 		}
 		return nil
 	})
+	handler, err := newHandler(env)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("spawn: %w", err)
+	}
 	threads.Go(func() error {
 		if err := env.Serve(handler); err != nil && ctx.Err() == nil {
 			return err
@@ -119,6 +161,7 @@ func spawn(ctx context.Context, info *protos.WeaveletArgs, handler envelope.Enve
 		cancel:  cancel,
 		env:     env,
 		wlet:    wlet,
+		args:    child.Args(),
 		threads: threads,
 	}, nil
 }
@@ -214,7 +257,11 @@ func deployWithInfo(t *testing.T, ctx context.Context, placement map[string][]st
 	for name := range placement {
 		info := d.info
 		info.Id = uuid.New().String()
-		weavelet, err := spawn(ctx, info, d, logger, tmpDir)
+		weavelet, err := spawn(ctx, info, d, envelope.Options{
+			TmpDir:           tmpDir,
+			Logger:           logger,
+			OnOrderViolation: func(err error) { t.Error(err) },
+		})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -420,6 +467,132 @@ func TestErrorFreeDistributedExecution(t *testing.T) {
 	testComponents(d)
 }
 
+func TestComponentProfilingLabels(t *testing.T) {
+	// Place c in its own weavelet, so that calls to c are remote.
+	placement := map[string][]string{
+		"1": {componenta, componentb},
+		"2": {componentc},
+	}
+	d := deploy(t, context.Background(), placement)
+	defer d.shutdown()
+	cLabel.Store(nil)
+	testComponents(d)
+
+	label := cLabel.Load()
+	if label == nil {
+		t.Fatal("c.C: no component profiling label")
+	}
+	if got, want := *label, componentc; got != want {
+		t.Fatalf("c.C: got component profiling label %q, want %q", got, want)
+	}
+}
+
+func TestGetInFlight(t *testing.T) {
+	// Place c in its own weavelet, so that calls to c are remote.
+	placement := map[string][]string{
+		"1": {componenta, componentb},
+		"2": {componentc},
+	}
+	d := deploy(t, context.Background(), placement)
+	defer d.shutdown()
+	testComponents(d)
+
+	env := d.weavelets["2"].env
+	getInFlight := func() int {
+		t.Helper()
+		calls, err := env.GetInFlight()
+		if err != nil {
+			t.Fatal(err)
+		}
+		n, ok := calls[componentc]
+		if !ok {
+			t.Fatalf("GetInFlight: no count for %s in %v", componentc, calls)
+		}
+		return n
+	}
+	if got, want := getInFlight(), 0; got != want {
+		t.Fatalf("GetInFlight: got %d, want %d", got, want)
+	}
+
+	// Block a call to c.
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	hook := func() {
+		entered <- struct{}{}
+		<-release
+	}
+	cHook.Store(&hook)
+	defer cHook.Store(nil)
+	x, err := d.weavelets["1"].wlet.GetIntf(reflection.Type[a]())
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		_, err := x.(a).A(d.ctx, 42)
+		done <- err
+	}()
+	<-entered
+	if got, want := getInFlight(), 1; got != want {
+		t.Fatalf("GetInFlight: got %d, want %d", got, want)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if got, want := getInFlight(), 0; got != want {
+		t.Fatalf("GetInFlight: got %d, want %d", got, want)
+	}
+}
+
+// failingHandler is an EnvelopeHandler that fails to handle logs and traces.
+type failingHandler struct {
+	*deployer
+}
+
+// LogBatch implements the EnvelopeHandler interface.
+func (failingHandler) LogBatch(context.Context, *protos.LogEntryBatch) error {
+	return fmt.Errorf("simulated LogBatch failure")
+}
+
+// HandleTraceSpans implements the EnvelopeHandler interface.
+func (failingHandler) HandleTraceSpans(context.Context, *protos.TraceSpans) error {
+	return fmt.Errorf("simulated HandleTraceSpans failure")
+}
+
+func TestHandlerErrorsKeepServing(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	wlet, err := spawn(d.ctx, info, failingHandler{d}, envelope.Options{
+		TmpDir: t.TempDir(),
+		Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+
+	// The weavelet logs every routing info update, and the failed LogBatch
+	// RPCs are returned to the weavelet without terminating the connection.
+	for i := 0; i < 3; i++ {
+		routing := &protos.RoutingInfo{Component: componentc, Local: true}
+		if err := wlet.env.UpdateRoutingInfo(routing); err != nil {
+			t.Fatal(err)
+		}
+		if err := wlet.env.FlushTelemetry(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := wlet.env.State(), envelope.StateServing; got != want {
+		t.Fatalf("State: got %v, want %v", got, want)
+	}
+}
+
 func TestFailActivateComponent(t *testing.T) {
 	d := deploy(t, context.Background(), colocated)
 	defer d.shutdown()
@@ -447,6 +620,34 @@ func TestFailActivateComponent(t *testing.T) {
 	testComponents(d)
 }
 
+func TestPanicActivateComponent(t *testing.T) {
+	d := deploy(t, context.Background(), colocated)
+	defer d.shutdown()
+
+	// Panic in ActivateComponent a number of times. The panics should be
+	// converted into errors, and the weavelet should retry.
+	const n = 3
+	panics := map[string]int{}
+	d.activateComponent = func(ctx context.Context, req *protos.ActivateComponentRequest) (*protos.ActivateComponentReply, error) {
+		if panics[req.Component] < n {
+			panics[req.Component]++
+			panic(fmt.Sprintf("simulated ActivateComponent(%q) panic", req.Component))
+		}
+
+		routing := &protos.UpdateRoutingInfoRequest{RoutingInfo: &protos.RoutingInfo{Component: req.Component, Local: true}}
+		if _, err := d.weavelets["1"].wlet.UpdateRoutingInfo(ctx, routing); err != nil {
+			return nil, err
+		}
+		components := &protos.UpdateComponentsRequest{Components: []string{req.Component}}
+		if _, err := d.weavelets["1"].wlet.UpdateComponents(ctx, components); err != nil {
+			return nil, err
+		}
+		return &protos.ActivateComponentReply{}, nil
+	}
+
+	testComponents(d)
+}
+
 func TestFailGetListenerAddress(t *testing.T) {
 	t.Skip("TODO(mwhittaker): Make this test pass.")
 
@@ -531,6 +732,132 @@ func TestFailExportListener(t *testing.T) {
 	testComponents(d)
 }
 
+func TestUnixListener(t *testing.T) {
+	// Note that we don't use t.TempDir() because unix socket paths are limited
+	// to roughly 100 bytes.
+	dir, err := os.MkdirTemp("", "lis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "lis.sock")
+
+	d := deploy(t, context.Background(), colocated)
+	defer d.shutdown()
+
+	var mu sync.Mutex
+	var exported string
+	d.getListenerAddress = func(ctx context.Context, req *protos.GetListenerAddressRequest) (*protos.GetListenerAddressReply, error) {
+		return &protos.GetListenerAddressReply{Address: "unix://" + path}, nil
+	}
+	d.exportListener = func(ctx context.Context, req *protos.ExportListenerRequest) (*protos.ExportListenerReply, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		exported = req.Address
+		return &protos.ExportListenerReply{}, nil
+	}
+	testComponents(d)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if got, want := exported, "unix://"+path; got != want {
+		t.Fatalf("ExportListener: got address %q, want %q", got, want)
+	}
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+}
+
+func TestExportListenerToken(t *testing.T) {
+	d := deploy(t, context.Background(), colocated)
+	defer d.shutdown()
+
+	// Fail ExportListener once, so that it is retried.
+	var mu sync.Mutex
+	var tokens []string
+	d.exportListener = func(ctx context.Context, req *protos.ExportListenerRequest) (*protos.ExportListenerReply, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		tokens = append(tokens, req.Token)
+		if len(tokens) == 1 {
+			return nil, fmt.Errorf("simulated ExportListener(%q) error", req.Listener)
+		}
+		return &protos.ExportListenerReply{}, nil
+	}
+	testComponents(d)
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := d.weavelets["1"].wlet.Info().Id + "/lis"
+	if len(tokens) != 2 || tokens[0] != want || tokens[1] != want {
+		t.Fatalf("ExportListener tokens: got %v, want two copies of %q", tokens, want)
+	}
+}
+
+func TestExportListenerHealthCheck(t *testing.T) {
+	d := deploy(t, context.Background(), colocated)
+	defer d.shutdown()
+
+	var mu sync.Mutex
+	var checks []*protos.ListenerHealthCheck
+	d.exportListener = func(ctx context.Context, req *protos.ExportListenerRequest) (*protos.ExportListenerReply, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		checks = append(checks, req.HealthCheck)
+		return &protos.ExportListenerReply{}, nil
+	}
+	testComponents(d)
+
+	// Component a doesn't configure the health check of its listener, so the
+	// listener is exported with the default health check.
+	mu.Lock()
+	defer mu.Unlock()
+	want := []*protos.ListenerHealthCheck{{
+		Path:       "/debug/weaver/healthz",
+		Status:     http.StatusOK,
+		IntervalNs: int64(10 * time.Second),
+	}}
+	if diff := cmp.Diff(want, checks, protocmp.Transform()); diff != "" {
+		t.Fatalf("ExportListener health checks (-want +got):\n%s", diff)
+	}
+}
+
+func TestReexportListeners(t *testing.T) {
+	d := deploy(t, context.Background(), colocated)
+	defer d.shutdown()
+
+	var mu sync.Mutex
+	var requests []*protos.ExportListenerRequest
+	d.exportListener = func(ctx context.Context, req *protos.ExportListenerRequest) (*protos.ExportListenerReply, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, req)
+		return &protos.ExportListenerReply{}, nil
+	}
+	testComponents(d)
+
+	// Forget the exported listeners, as a restarted deployer would.
+	mu.Lock()
+	exported := requests
+	requests = nil
+	mu.Unlock()
+
+	listeners, err := d.weavelets["1"].env.ReexportListeners()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"lis"}, listeners); diff != "" {
+		t.Fatalf("ReexportListeners (-want +got):\n%s", diff)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if diff := cmp.Diff(exported, requests, protocmp.Transform()); diff != "" {
+		t.Fatalf("re-exported listeners (-want +got):\n%s", diff)
+	}
+}
+
 func TestExportListenerReturnsError(t *testing.T) {
 	t.Skip("TODO(mwhittaker): Make this test pass.")
 
@@ -564,6 +891,38 @@ func TestUpdateMissingComponents(t *testing.T) {
 	testComponents(d)
 }
 
+func TestCheckComponents(t *testing.T) {
+	d := deploy(t, context.Background(), colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	env := d.weavelets["1"].env
+	if err := env.SetComponentEnabled(componentb, false); err != nil {
+		t.Fatal(err)
+	}
+	defer env.SetComponentEnabled(componentb, true)
+
+	unknown, disabled, err := env.CheckComponents([]string{"foo", componentb, componentd})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"foo"}; !slices.Equal(unknown, want) {
+		t.Errorf("unknown components: got %v, want %v", unknown, want)
+	}
+	if want := []string{componentb}; !slices.Equal(disabled, want) {
+		t.Errorf("disabled components: got %v, want %v", disabled, want)
+	}
+
+	// A dry run doesn't start any components.
+	reply, err := d.weavelets["1"].wlet.GetHealth(context.Background(), &protos.GetHealthRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slices.Contains(reply.HealthyComponents, componentd) {
+		t.Errorf("dry run started %s", componentd)
+	}
+}
+
 func TestUpdateExistingComponents(t *testing.T) {
 	d := deploy(t, context.Background(), colocated)
 	defer d.shutdown()
@@ -614,6 +973,32 @@ func TestUpdateRoutingInfoMissingComponent(t *testing.T) {
 	testComponents(d)
 }
 
+func TestUpdateRoutingInfoUnknownPinnedReplica(t *testing.T) {
+	ctx := context.Background()
+	placement := map[string][]string{
+		"1": {componenta, componentb},
+		"2": {componentc},
+	}
+	d := deploy(t, ctx, placement)
+	defer d.shutdown()
+	testComponents(d)
+
+	// Pin keys to a replica that doesn't host the component.
+	routing := &protos.UpdateRoutingInfoRequest{
+		RoutingInfo: &protos.RoutingInfo{
+			Component: componentc,
+			Replicas:  []string{d.weavelets["2"].env.WeaveletAddress()},
+			Pins: []*protos.PinRule{
+				{Start: 1, End: 100, Replica: "tcp://1.1.1.1:9999"},
+			},
+		},
+	}
+	if _, err := d.weavelets["1"].wlet.UpdateRoutingInfo(ctx, routing); err == nil {
+		t.Fatal("UpdateRoutingInfo: unexpected success")
+	}
+	testComponents(d)
+}
+
 func TestUpdateRoutingInfoNotStartedComponent(t *testing.T) {
 	ctx := context.Background()
 	d := deploy(t, ctx, colocated)
@@ -688,68 +1073,407 @@ func TestFailReplica(t *testing.T) {
 	testComponents(d)
 }
 
-func TestUpdateBadRoutingInfo(t *testing.T) {
+func TestStaleRoutingInfo(t *testing.T) {
 	ctx := context.Background()
 	placement := map[string][]string{
 		"1": {componenta},
-		"2": {componentb},
-		"3": {componentc},
+		"2": {componentb, componentc},
 	}
 	d := deploy(t, ctx, placement)
 	defer d.shutdown()
+	testComponents(d)
 
-	// When activating components, provide incorrect routing info. Later, send
-	// the correct routing info. Incorrect routing info should stall, but not
-	// crash the weavelets.
-	var mu sync.Mutex
-	var activateErr error
-	d.activateComponent = func(ctx context.Context, req *protos.ActivateComponentRequest) (*protos.ActivateComponentReply, error) {
-		// Update the component.
-		components := &protos.UpdateComponentsRequest{Components: []string{req.Component}}
-		weavelets := map[string]*weavelet{
-			componenta: d.weavelets["1"],
-			componentb: d.weavelets["2"],
-			componentc: d.weavelets["3"],
-		}
-		weavelet := weavelets[req.Component]
-		if _, err := weavelet.wlet.UpdateComponents(ctx, components); err != nil {
-			return nil, err
-		}
-
-		// Provide incorrect routing info.
-		routing := &protos.UpdateRoutingInfoRequest{
-			RoutingInfo: &protos.RoutingInfo{
-				Component: req.Component,
-				Replicas:  []string{"tcp://1.1.1.1:9999"},
-			},
-		}
-		for _, weavelet := range d.weavelets {
-			if _, err := weavelet.wlet.UpdateRoutingInfo(ctx, routing); err != nil {
-				return nil, err
-			}
-		}
-
-		// Update to the correct routing info after a short delay.
-		routing.RoutingInfo.Replicas = []string{weavelet.env.WeaveletAddress()}
-		go func() {
-			time.Sleep(100 * time.Millisecond)
-			for _, weavelet := range d.weavelets {
-				if _, err := weavelet.wlet.UpdateRoutingInfo(ctx, routing); err != nil {
-					mu.Lock()
-					activateErr = err
-					mu.Unlock()
-				}
-			}
-		}()
-
-		return &protos.ActivateComponentReply{}, nil
+	update := func(version uint64, replicas ...string) error {
+		return d.weavelets["1"].env.UpdateRoutingInfo(&protos.RoutingInfo{
+			Component: componentb,
+			Replicas:  replicas,
+			Version:   version,
+		})
+	}
+	good := d.weavelets["2"].env.WeaveletAddress()
+	if err := update(2, good); err != nil {
+		t.Fatal(err)
+	}
+	// Re-applying the same version is allowed.
+	if err := update(2, good); err != nil {
+		t.Fatal(err)
 	}
 
+	// A stale update must not roll back the routing info.
+	if err := update(1, "tcp://1.1.1.1:9999"); !errors.Is(err, envelope.ErrStaleRoutingUpdate) {
+		t.Fatalf("UpdateRoutingInfo: got %v, want %v", err, envelope.ErrStaleRoutingUpdate)
+	}
 	testComponents(d)
-	d.shutdown()
 
-	if activateErr != nil {
-		t.Fatal(activateErr)
+	if err := update(3, good); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetRoutingInfo(t *testing.T) {
+	ctx := context.Background()
+	placement := map[string][]string{
+		"1": {componenta},
+		"2": {componentb, componentc},
+	}
+	d := deploy(t, ctx, placement)
+	defer d.shutdown()
+	testComponents(d)
+
+	env := d.weavelets["1"].env
+	want := &protos.RoutingInfo{
+		Component: componentb,
+		Replicas:  []string{d.weavelets["2"].env.WeaveletAddress()},
+		Version:   2,
+	}
+	if err := env.UpdateRoutingInfo(want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := env.GetRoutingInfo(componentb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Fatalf("GetRoutingInfo (-want +got):\n%s", diff)
+	}
+
+	// A rejected update doesn't change the routing info.
+	stale := &protos.RoutingInfo{Component: componentb, Replicas: []string{"tcp://1.1.1.1:9999"}, Version: 1}
+	if err := env.UpdateRoutingInfo(stale); !errors.Is(err, envelope.ErrStaleRoutingUpdate) {
+		t.Fatalf("UpdateRoutingInfo: got %v, want %v", err, envelope.ErrStaleRoutingUpdate)
+	}
+	got, err = env.GetRoutingInfo(componentb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Fatalf("GetRoutingInfo (-want +got):\n%s", diff)
+	}
+
+	// Component d was never activated, so there is no routing info for it.
+	got, err = env.GetRoutingInfo(componentd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Fatalf("GetRoutingInfo: got %v, want nil", got)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+	placement := map[string][]string{
+		"1": {componenta},
+		"2": {componentb, componentc},
+	}
+	d := deploy(t, ctx, placement)
+	defer d.shutdown()
+	testComponents(d)
+
+	env := d.weavelets["1"].env
+	update := func(breaker *protos.CircuitBreaker) {
+		t.Helper()
+		if err := env.UpdateRoutingInfo(&protos.RoutingInfo{
+			Component:      componentb,
+			Replicas:       []string{d.weavelets["2"].env.WeaveletAddress()},
+			CircuitBreaker: breaker,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	state := func() protos.CircuitBreakerState_State {
+		t.Helper()
+		s, err := env.GetCircuitBreakerState(componentb)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s == nil {
+			t.Fatal("GetCircuitBreakerState: nil state")
+		}
+		return s.State
+	}
+
+	// Trip the breaker for b, which is called by a.
+	update(&protos.CircuitBreaker{ForceOpen: true})
+	if got, want := state(), protos.CircuitBreakerState_OPEN; got != want {
+		t.Fatalf("GetCircuitBreakerState: got %v, want %v", got, want)
+	}
+	x, err := d.weavelets["1"].wlet.GetIntf(reflection.Type[a]())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := x.(a).A(ctx, 42); !errors.Is(err, weaver.ErrCircuitOpen) {
+		t.Fatalf("A: got error %v, want %v", err, weaver.ErrCircuitOpen)
+	}
+
+	// Close the breaker.
+	update(&protos.CircuitBreaker{FailureThreshold: 3})
+	if got, want := state(), protos.CircuitBreakerState_CLOSED; got != want {
+		t.Fatalf("GetCircuitBreakerState: got %v, want %v", got, want)
+	}
+	testComponents(d)
+
+	// Remove the breaker.
+	update(nil)
+	if s, err := env.GetCircuitBreakerState(componentb); err != nil || s != nil {
+		t.Fatalf("GetCircuitBreakerState: got (%v, %v), want (nil, nil)", s, err)
+	}
+}
+
+func TestCircuitBreakerFailures(t *testing.T) {
+	ctx := context.Background()
+	placement := map[string][]string{
+		"1": {componenta, componentb},
+		"2": {componentc},
+	}
+	d := deploy(t, ctx, placement)
+	defer d.shutdown()
+	testComponents(d)
+
+	// Call c from a weavelet whose breakers follow a manual clock.
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	clock := newManualClock()
+	wlet, err := spawnWithOptions(d.ctx, info, d, envelope.Options{
+		TmpDir: t.TempDir(),
+		Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+	}, weaver.RemoteWeaveletOptions{Clock: clock})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+
+	// The deployer doesn't route components for the weavelet, so we do.
+	const openFor = time.Minute
+	if err := wlet.env.UpdateRoutingInfo(&protos.RoutingInfo{
+		Component: componentc,
+		Replicas:  []string{d.weavelets["2"].env.WeaveletAddress()},
+		CircuitBreaker: &protos.CircuitBreaker{
+			FailureThreshold: 2,
+			OpenDurationNs:   int64(openFor),
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	x, err := wlet.wlet.GetIntf(reflection.Type[c]())
+	if err != nil {
+		t.Fatal(err)
+	}
+	call := func() error {
+		_, err := x.(c).C(ctx, 42)
+		return err
+	}
+	state := func(want protos.CircuitBreakerState_State) {
+		t.Helper()
+		s, err := wlet.env.GetCircuitBreakerState(componentc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.GetState() != want {
+			t.Fatalf("GetCircuitBreakerState: got %v, want %v", s.GetState(), want)
+		}
+	}
+	enable := func(enabled bool) {
+		t.Helper()
+		if err := d.weavelets["2"].env.SetComponentEnabled(componentc, enabled); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Calls to a disabled c fail, and trip the breaker.
+	if err := call(); err != nil {
+		t.Fatal(err)
+	}
+	enable(false)
+	for i := 0; i < 2; i++ {
+		if err := call(); err == nil || errors.Is(err, weaver.ErrCircuitOpen) {
+			t.Fatalf("C: got error %v, want a remote failure", err)
+		}
+	}
+	state(protos.CircuitBreakerState_OPEN)
+	enable(true)
+	if err := call(); !errors.Is(err, weaver.ErrCircuitOpen) {
+		t.Fatalf("C: got error %v, want %v", err, weaver.ErrCircuitOpen)
+	}
+
+	// Once open for long enough, the breaker lets a probe through, and
+	// closes when it succeeds.
+	clock.Advance(openFor)
+	state(protos.CircuitBreakerState_HALF_OPEN)
+	if err := call(); err != nil {
+		t.Fatalf("C: probe failed: %v", err)
+	}
+	state(protos.CircuitBreakerState_CLOSED)
+	if err := call(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	ctx := context.Background()
+	placement := map[string][]string{
+		"1": {componenta},
+		"2": {componentb},
+		"3": {componentc},
+	}
+	d := deploy(t, ctx, placement)
+	defer d.shutdown()
+	testComponents(d)
+
+	// Get c from the calling weavelets first, so that activating c doesn't
+	// reset the routing info updated below.
+	cs := map[string]c{}
+	for _, wlet := range []string{"1", "2"} {
+		x, err := d.weavelets[wlet].wlet.GetIntf(reflection.Type[c]())
+		if err != nil {
+			t.Fatal(err)
+		}
+		cs[wlet] = x.(c)
+	}
+
+	// Limit the rate at which weavelet 3 serves c to a burst of two calls.
+	update := func(rate float64) {
+		t.Helper()
+		if err := d.weavelets["3"].env.UpdateRoutingInfo(&protos.RoutingInfo{
+			Component:            componentc,
+			Replicas:             []string{d.weavelets["3"].env.WeaveletAddress()},
+			MaxRequestsPerSecond: rate,
+			MaxBurst:             2,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	update(1e-9)
+
+	// call calls c from the provided weavelet.
+	call := func(wlet string) error {
+		_, err := cs[wlet].C(ctx, 42)
+		return err
+	}
+
+	// The limit applies to all callers together.
+	for _, wlet := range []string{"1", "2"} {
+		if err := call(wlet); err != nil {
+			t.Fatalf("C from weavelet %s: %v", wlet, err)
+		}
+	}
+	for _, wlet := range []string{"1", "2"} {
+		err := call(wlet)
+		if !errors.Is(err, weaver.ErrRateLimited) {
+			t.Fatalf("C from weavelet %s: got error %v, want %v", wlet, err, weaver.ErrRateLimited)
+		}
+	}
+
+	// Remove the limit.
+	update(0)
+	if err := call("1"); err != nil {
+		t.Fatal(err)
+	}
+	testComponents(d)
+}
+
+func TestUpdateRoutingInfoNoAck(t *testing.T) {
+	ctx := context.Background()
+	placement := map[string][]string{
+		"1": {componenta},
+		"2": {componentb, componentc},
+	}
+	d := deploy(t, ctx, placement)
+	defer d.shutdown()
+	testComponents(d)
+
+	env := d.weavelets["1"].env
+	good := d.weavelets["2"].env.WeaveletAddress()
+	for _, routing := range []*protos.RoutingInfo{
+		{Component: componentb, Replicas: []string{good}, Version: 2},
+		// The stale update is rejected by the weavelet, but the rejection is
+		// not reported.
+		{Component: componentb, Replicas: []string{"tcp://1.1.1.1:9999"}, Version: 1},
+		{Component: componentb, Replicas: []string{good}, Version: 3},
+	} {
+		if err := env.UpdateRoutingInfoNoAck(routing); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The updates are applied asynchronously, so wait for them.
+	for start := time.Now(); ; time.Sleep(10 * time.Millisecond) {
+		routing, err := env.GetRoutingInfo(componentb)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if routing.GetVersion() == 3 {
+			break
+		}
+		if time.Since(start) > 10*time.Second {
+			t.Fatalf("routing info version 3 not applied, got %v", routing)
+		}
+	}
+	testComponents(d)
+}
+
+func TestUpdateBadRoutingInfo(t *testing.T) {
+	ctx := context.Background()
+	placement := map[string][]string{
+		"1": {componenta},
+		"2": {componentb},
+		"3": {componentc},
+	}
+	d := deploy(t, ctx, placement)
+	defer d.shutdown()
+
+	// When activating components, provide incorrect routing info. Later, send
+	// the correct routing info. Incorrect routing info should stall, but not
+	// crash the weavelets.
+	var mu sync.Mutex
+	var activateErr error
+	d.activateComponent = func(ctx context.Context, req *protos.ActivateComponentRequest) (*protos.ActivateComponentReply, error) {
+		// Update the component.
+		components := &protos.UpdateComponentsRequest{Components: []string{req.Component}}
+		weavelets := map[string]*weavelet{
+			componenta: d.weavelets["1"],
+			componentb: d.weavelets["2"],
+			componentc: d.weavelets["3"],
+		}
+		weavelet := weavelets[req.Component]
+		if _, err := weavelet.wlet.UpdateComponents(ctx, components); err != nil {
+			return nil, err
+		}
+
+		// Provide incorrect routing info.
+		routing := &protos.UpdateRoutingInfoRequest{
+			RoutingInfo: &protos.RoutingInfo{
+				Component: req.Component,
+				Replicas:  []string{"tcp://1.1.1.1:9999"},
+			},
+		}
+		for _, weavelet := range d.weavelets {
+			if _, err := weavelet.wlet.UpdateRoutingInfo(ctx, routing); err != nil {
+				return nil, err
+			}
+		}
+
+		// Update to the correct routing info after a short delay.
+		routing.RoutingInfo.Replicas = []string{weavelet.env.WeaveletAddress()}
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			for _, weavelet := range d.weavelets {
+				if _, err := weavelet.wlet.UpdateRoutingInfo(ctx, routing); err != nil {
+					mu.Lock()
+					activateErr = err
+					mu.Unlock()
+				}
+			}
+		}()
+
+		return &protos.ActivateComponentReply{}, nil
+	}
+
+	testComponents(d)
+	d.shutdown()
+
+	if activateErr != nil {
+		t.Fatal(activateErr)
 	}
 }
 
@@ -794,56 +1518,3591 @@ func TestProfile(t *testing.T) {
 	}
 }
 
-func TestMetrics(t *testing.T) {
-	// Ensure a component is started.
+func TestGetTypedProfile(t *testing.T) {
 	ctx := context.Background()
-	const group = "1"
-	placement := map[string][]string{group: {componentc}}
-	d := deploy(t, ctx, placement)
+	d := deploy(t, ctx, colocated)
 	defer d.shutdown()
-	target := d.weavelets[group]
+	testComponents(d)
 
-	// Helper that calls a component method.
-	call := func() {
-		t.Helper()
-		comp, err := target.wlet.GetIntf(reflection.Type[c]())
-		if err != nil {
-			t.Fatal(err)
-		}
-		const want = 42
-		got, err := comp.(c).C(d.ctx, want)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if got != want {
-			t.Fatalf("C(%d): got %d, want %d", want, got, want)
-		}
+	env := d.weavelets["1"].env
+	for _, typ := range []envelope.ProfileType{
+		envelope.ProfileCPU,
+		envelope.ProfileHeap,
+		envelope.ProfileBlock,
+		envelope.ProfileMutex,
+		envelope.ProfileGoroutine,
+		envelope.ProfileAllocs,
+	} {
+		t.Run(typ.String(), func(t *testing.T) {
+			data, err := env.GetTypedProfile(ctx, typ, 100*time.Millisecond)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := profile.ParseData(data); err != nil {
+				t.Fatal(err)
+			}
+		})
 	}
 
-	// Helper for maintaining fetched metrics.
-	names := map[uint64]string{}    // map from metric id to name
-	metrics := map[string]float64{} // map from metric name to value
-	readMetrics := func() {
-		t.Helper()
-		m, err := target.wlet.GetMetrics(d.ctx, &protos.GetMetricsRequest{})
+	// Invalid requests are rejected before they reach the weavelet.
+	if _, err := env.GetTypedProfile(ctx, protos.ProfileType_Unspecified, 0); err == nil {
+		t.Error("GetTypedProfile(Unspecified): unexpected success")
+	}
+	if _, err := env.GetTypedProfile(ctx, envelope.ProfileCPU, 0); err == nil {
+		t.Error("GetTypedProfile(CPU, 0): unexpected success")
+	}
+}
+
+func TestListAndCancelProfiles(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+	env := d.weavelets["1"].env
+
+	// Start a long CPU profile.
+	errs := make(chan error, 1)
+	go func() {
+		_, err := env.GetProfile(&protos.GetProfileRequest{
+			ProfileType:   protos.ProfileType_CPU,
+			CpuDurationNs: int64(time.Hour),
+		})
+		errs <- err
+	}()
+
+	// Wait for the profile to show up.
+	var profiles []*protos.ProfileInfo
+	for r := retry.Begin(); r.Continue(ctx); {
+		var err error
+		profiles, err = env.ListProfiles()
 		if err != nil {
-			t.Fatal("GetMetrics failed", err)
+			t.Fatal(err)
 		}
-		for _, def := range m.Update.Defs {
-			names[def.Id] = def.Name
+		if len(profiles) > 0 {
+			break
 		}
-		for _, val := range m.Update.Values {
-			metrics[names[val.Id]] = val.Value
+	}
+	if got, want := len(profiles), 1; got != want {
+		t.Fatalf("ListProfiles: got %d profiles, want %d", got, want)
+	}
+	if got, want := profiles[0].ProfileType, protos.ProfileType_CPU; got != want {
+		t.Errorf("ListProfiles: got type %v, want %v", got, want)
+	}
+
+	// Cancel the profile.
+	if err := env.CancelProfile(profiles[0].Id); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errs; err == nil {
+		t.Fatal("GetProfile: unexpected success after cancellation")
+	}
+	profiles, err := env.ListProfiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profiles) != 0 {
+		t.Fatalf("ListProfiles: got %v, want no profiles", profiles)
+	}
+
+	// Cancelling an unknown profile fails.
+	if err := env.CancelProfile("unknown"); err == nil {
+		t.Fatal("CancelProfile: unexpected success for unknown profile")
+	}
+}
+
+func TestSetProfilingRate(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+	env := d.weavelets["1"].env
+
+	// Enable block and mutex profiling.
+	prevBlock, prevMutex, err := env.SetProfilingRate(1000, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer env.SetProfilingRate(prevBlock, prevMutex)
+	if prevBlock != 0 {
+		t.Fatalf("SetProfilingRate: got previous block rate %d, want 0", prevBlock)
+	}
+
+	// Negative rates leave the rates unchanged.
+	block, mutex, err := env.SetProfilingRate(-1, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if block != 1000 || mutex != 5 {
+		t.Fatalf("SetProfilingRate: got previous rates (%d, %d), want (1000, 5)", block, mutex)
+	}
+
+	// Disable block and mutex profiling.
+	if _, _, err := env.SetProfilingRate(0, 0); err != nil {
+		t.Fatal(err)
+	}
+	block, mutex, err = env.SetProfilingRate(-1, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if block != 0 || mutex != 0 {
+		t.Fatalf("SetProfilingRate: got previous rates (%d, %d), want (0, 0)", block, mutex)
+	}
+}
+
+func TestSetComponentEnabled(t *testing.T) {
+	ctx := context.Background()
+	placement := map[string][]string{
+		"1": {componenta},
+		"2": {componentb, componentc},
+	}
+	d := deploy(t, ctx, placement)
+	defer d.shutdown()
+	testComponents(d)
+
+	// Disable b, which is called by a.
+	env := d.weavelets["2"].env
+	if err := env.SetComponentEnabled(componentb, false); err != nil {
+		t.Fatal(err)
+	}
+	health := env.GetHealth()
+	if got, want := health.DisabledComponents, []string{componentb}; !slices.Equal(got, want) {
+		t.Fatalf("GetHealth: got disabled components %v, want %v", got, want)
+	}
+	if slices.Contains(health.HealthyComponents, componentb) {
+		t.Fatalf("GetHealth: disabled component %s reported as healthy", componentb)
+	}
+	x, err := d.weavelets["1"].wlet.GetIntf(reflection.Type[a]())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := x.(a).A(ctx, 42); err == nil || !strings.Contains(err.Error(), "disabled") {
+		t.Fatalf("A: got error %v, want disabled component error", err)
+	}
+
+	// Re-enable b.
+	if err := env.SetComponentEnabled(componentb, true); err != nil {
+		t.Fatal(err)
+	}
+	testComponents(d)
+}
+
+func TestHealthChecks(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+	env := d.weavelets["1"].env
+
+	// c's check passes.
+	health := env.GetHealth()
+	if got, want := health.Status, protos.HealthStatus_HEALTHY; got != want {
+		t.Fatalf("GetHealth: got status %v, want %v", got, want)
+	}
+	want := []*protos.HealthCheckResult{{Component: componentc, Name: "calls", Healthy: true}}
+	if diff := cmp.Diff(want, health.Checks, protocmp.Transform()); diff != "" {
+		t.Fatalf("GetHealth: checks (-want +got):\n%s", diff)
+	}
+
+	// Fail c's check.
+	err := fmt.Errorf("simulated health check failure")
+	cHealth.Store(&err)
+	defer cHealth.Store(nil)
+	health = env.GetHealth()
+	if got, want := health.Status, protos.HealthStatus_UNHEALTHY; got != want {
+		t.Fatalf("GetHealth: got status %v, want %v", got, want)
+	}
+	if slices.Contains(health.HealthyComponents, componentc) {
+		t.Fatalf("GetHealth: unhealthy component %s reported as healthy", componentc)
+	}
+	want = []*protos.HealthCheckResult{{Component: componentc, Name: "calls", Error: err.Error()}}
+	if diff := cmp.Diff(want, health.Checks, protocmp.Transform()); diff != "" {
+		t.Fatalf("GetHealth: checks (-want +got):\n%s", diff)
+	}
+}
+
+func TestForwardSignal(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	// Note that the weavelet runs in the same process as the test.
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+	defer signal.Stop(sigs)
+
+	if err := d.weavelets["1"].env.ForwardSignal(syscall.SIGHUP); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case sig := <-sigs:
+		if sig != syscall.SIGHUP {
+			t.Fatalf("got signal %v, want %v", sig, syscall.SIGHUP)
 		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for forwarded signal")
 	}
-	readMetrics()
-	start := int(metrics["c_calls"])
+}
 
-	for i := 0; i < 5; i++ {
-		readMetrics()
-		if m := int(metrics["c_calls"]); m != start+i {
-			t.Fatalf("c_calls = %d, expecting %d", m, start+i)
+// cacheWarm is the state of the "test-cache-warm" readiness gate.
+var cacheWarm atomic.Bool
+
+func init() {
+	weaver.AddReadinessGate("test-cache-warm", func(context.Context) bool {
+		return cacheWarm.Load()
+	})
+}
+
+func TestGetReadiness(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	cacheWarm.Store(false)
+	defer cacheWarm.Store(false)
+	gates, err := d.weavelets["1"].env.GetReadiness()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ready, ok := gates["test-cache-warm"]; !ok || ready {
+		t.Fatalf("GetReadiness: got %v, want test-cache-warm not ready", gates)
+	}
+
+	cacheWarm.Store(true)
+	reply, err := d.weavelets["1"].wlet.GetReadiness(ctx, &protos.GetReadinessRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reply.Ready || !reply.Gates["test-cache-warm"] {
+		t.Fatalf("GetReadiness: got %v, want all gates ready", reply)
+	}
+}
+
+func TestReaddReadinessGate(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	// A component re-initialized in the same process adds its gates again.
+	// The latest gate replaces the earlier one.
+	weaver.AddReadinessGate("test-reinit", func(context.Context) bool { return false })
+	weaver.AddReadinessGate("test-reinit", func(context.Context) bool { return true })
+	gates, err := d.weavelets["1"].env.GetReadiness()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ready, ok := gates["test-reinit"]; !ok || !ready {
+		t.Fatalf("GetReadiness: got %v, want test-reinit ready", gates)
+	}
+}
+
+func init() {
+	weaver.RegisterBuildFlag("test-build-flag", "enabled")
+}
+
+func TestGetBuildFlags(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	flags, err := d.weavelets["1"].env.GetBuildFlags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := flags["test-build-flag"], "enabled"; got != want {
+		t.Fatalf("GetBuildFlags: got %q for test-build-flag, want %q", got, want)
+	}
+}
+
+var testFeatureFlag = weaver.RegisterFeatureFlag("test-feature-flag", "off")
+
+func TestFeatureFlags(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	// Note that the weavelet runs in the same process as the test.
+	env := d.weavelets["1"].env
+	defer env.SetFeatureFlag(testFeatureFlag.Name(), "off")
+	flags, err := env.GetFeatureFlags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := flags["test-feature-flag"], "off"; got != want {
+		t.Fatalf("GetFeatureFlags: got %q for test-feature-flag, want %q", got, want)
+	}
+
+	if err := env.SetFeatureFlag("test-feature-flag", "on"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := testFeatureFlag.Value(), "on"; got != want {
+		t.Fatalf("Value: got %q, want %q", got, want)
+	}
+	flags, err = env.GetFeatureFlags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := flags["test-feature-flag"], "on"; got != want {
+		t.Fatalf("GetFeatureFlags: got %q for test-feature-flag, want %q", got, want)
+	}
+
+	err = env.SetFeatureFlag("unknown-feature-flag", "on")
+	if !errors.Is(err, envelope.ErrUnknownFeatureFlag) {
+		t.Fatalf("SetFeatureFlag: got %v, want %v", err, envelope.ErrUnknownFeatureFlag)
+	}
+}
+
+// The number of test-concurrency raw handlers running, and the maximum
+// number observed.
+var rawRunning, rawMaxRunning atomic.Int64
+
+// If set, test-block raw handlers block until the channel is closed, after
+// sending to rawBlocked.
+var rawBlock atomic.Pointer[chan struct{}]
+var rawBlocked = make(chan struct{}, 1)
+
+func init() {
+	weaver.RegisterRawHandler("test-reverse", func(_ context.Context, payload []byte) ([]byte, error) {
+		reversed := slices.Clone(payload)
+		slices.Reverse(reversed)
+		return reversed, nil
+	})
+	weaver.RegisterRawHandler("test-concurrency", func(context.Context, []byte) ([]byte, error) {
+		n := rawRunning.Add(1)
+		defer rawRunning.Add(-1)
+		for m := rawMaxRunning.Load(); n > m && !rawMaxRunning.CompareAndSwap(m, n); m = rawMaxRunning.Load() {
 		}
-		call()
+		time.Sleep(10 * time.Millisecond)
+		return nil, nil
+	})
+	weaver.RegisterRawHandler("test-block", func(ctx context.Context, _ []byte) ([]byte, error) {
+		if block := rawBlock.Load(); block != nil {
+			rawBlocked <- struct{}{}
+			select {
+			case <-*block:
+			case <-ctx.Done():
+			}
+		}
+		return nil, nil
+	})
+}
+
+func TestRawRPC(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	env := d.weavelets["1"].env
+	got, err := env.RawRPC(ctx, "test-reverse", []byte("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "olleh"; string(got) != want {
+		t.Fatalf("RawRPC: got %q, want %q", got, want)
+	}
+
+	_, err = env.RawRPC(ctx, "unknown-handler", nil)
+	if !errors.Is(err, envelope.ErrUnknownRawHandler) {
+		t.Fatalf("RawRPC: got %v, want %v", err, envelope.ErrUnknownRawHandler)
+	}
+}
+
+func TestMaxConcurrentRPCs(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	// The weavelet picks up the limit from its WeaveletArgs.
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	info.MaxConcurrentRpcs = 2
+	wlet, err := spawnWithOptions(d.ctx, info, d, envelope.Options{
+		TmpDir: t.TempDir(),
+		Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+	}, weaver.RemoteWeaveletOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+
+	rawMaxRunning.Store(0)
+	var group errgroup.Group
+	for i := 0; i < 20; i++ {
+		group.Go(func() error {
+			_, err := wlet.env.RawRPC(ctx, "test-concurrency", nil)
+			return err
+		})
+	}
+	if err := group.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rawMaxRunning.Load(), int64(2); got != want {
+		t.Fatalf("concurrent RPCs: got %d, want %d", got, want)
+	}
+}
+
+func TestControlChannelWhileSaturated(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	wlet, err := spawnWithOptions(d.ctx, info, d, envelope.Options{
+		TmpDir: t.TempDir(),
+		Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+	}, weaver.RemoteWeaveletOptions{MaxConcurrentRPCs: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+
+	channel, err := wlet.env.OpenControlChannel(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Saturate the envelope's connection with a blocked RPC, and queue
+	// another one behind it.
+	block := make(chan struct{})
+	rawBlock.Store(&block)
+	defer rawBlock.Store(nil)
+	var group errgroup.Group
+	for i := 0; i < 2; i++ {
+		group.Go(func() error {
+			_, err := wlet.env.RawRPC(ctx, "test-block", nil)
+			return err
+		})
+	}
+	<-rawBlocked
+
+	// RPCs on the control channel still make progress.
+	hctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	reply, err := channel.GetHealth(hctx, &protos.GetHealthRequest{})
+	if err != nil {
+		t.Fatalf("GetHealth while saturated: %v", err)
+	}
+	if reply.Status != protos.HealthStatus_HEALTHY {
+		t.Fatalf("GetHealth: got %v, want %v", reply.Status, protos.HealthStatus_HEALTHY)
+	}
+
+	close(block)
+	<-rawBlocked // the queued RPC
+	if err := group.Wait(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOpenControlChannel(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	controller, err := d.weavelets["1"].env.OpenControlChannel(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply, err := controller.GetHealth(ctx, &protos.GetHealthRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.Status != protos.HealthStatus_HEALTHY {
+		t.Fatalf("GetHealth: got %v, want %v", reply.Status, protos.HealthStatus_HEALTHY)
+	}
+}
+
+func TestGC(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	// Note that the weavelet runs in the same process as the test.
+	env := d.weavelets["1"].env
+	original, err := env.SetGCPercent(50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer debug.SetGCPercent(original)
+	previous, err := env.SetGCPercent(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if previous != 50 {
+		t.Fatalf("SetGCPercent: got previous %d, want 50", previous)
+	}
+
+	var before goruntime.MemStats
+	goruntime.ReadMemStats(&before)
+	if err := env.ForceGC(); err != nil {
+		t.Fatal(err)
+	}
+	var after goruntime.MemStats
+	goruntime.ReadMemStats(&after)
+	if after.NumForcedGC <= before.NumForcedGC {
+		t.Fatalf("ForceGC: got %d forced GCs, want > %d", after.NumForcedGC, before.NumForcedGC)
+	}
+}
+
+func TestGetDependencies(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		placement map[string][]string
+		want      protos.CallGraphEdge_Routing
+	}{
+		{"Colocated", colocated, protos.CallGraphEdge_LOCAL},
+		{"Distributed", map[string][]string{"1": {componenta, componentb, componentc}, "2": {componentb, componentc}}, protos.CallGraphEdge_REMOTE},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			d := deploy(t, ctx, test.placement)
+			defer d.shutdown()
+
+			// Before any component is activated, routing is unknown.
+			edges := func() map[string]protos.CallGraphEdge_Routing {
+				t.Helper()
+				graph, err := d.weavelets["1"].env.GetDependencies()
+				if err != nil {
+					t.Fatal(err)
+				}
+				edges := map[string]protos.CallGraphEdge_Routing{}
+				for _, edge := range graph.Edges {
+					edges[edge.Caller+" -> "+edge.Callee] = edge.Routing
+				}
+				return edges
+			}
+			want := map[string]protos.CallGraphEdge_Routing{
+				componenta + " -> " + componentb: protos.CallGraphEdge_UNKNOWN,
+				componentb + " -> " + componentc: protos.CallGraphEdge_UNKNOWN,
+			}
+			if diff := cmp.Diff(want, edges()); diff != "" {
+				t.Fatalf("GetDependencies (-want +got):\n%s", diff)
+			}
+
+			testComponents(d)
+			for edge := range want {
+				want[edge] = test.want
+			}
+			if diff := cmp.Diff(want, edges()); diff != "" {
+				t.Fatalf("GetDependencies (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	ctx := context.Background()
+	placement := map[string][]string{
+		"1": {componenta},
+		"2": {componentb},
+		"3": {componentc},
+	}
+	d := deploy(t, ctx, placement)
+	defer d.shutdown()
+	var envelopes []*envelope.Envelope
+	for _, w := range d.weavelets {
+		envelopes = append(envelopes, w.env)
+	}
+	failing := d.weavelets["2"].env
+
+	// Run the calls two at a time. The call on the failing envelope fails,
+	// without affecting the other calls.
+	var mu sync.Mutex
+	var inflight, peak int
+	results := envelope.Broadcast(ctx, envelopes, func(_ context.Context, e *envelope.Envelope) error {
+		mu.Lock()
+		inflight++
+		peak = max(peak, inflight)
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			defer mu.Unlock()
+			inflight--
+		}()
+		time.Sleep(10 * time.Millisecond)
+		if e == failing {
+			return fmt.Errorf("simulated failure")
+		}
+		_, err := e.MeasureRTT()
+		return err
+	}, 2)
+	if got, want := len(results), len(envelopes); got != want {
+		t.Fatalf("Broadcast: got %d results, want %d", got, want)
+	}
+	for _, e := range envelopes {
+		if err := results[e]; (err != nil) != (e == failing) {
+			t.Errorf("Broadcast: weavelet %s: unexpected result %v", e.WeaveletID(), err)
+		}
+	}
+	if peak > 2 {
+		t.Errorf("Broadcast: got %d concurrent calls, want at most 2", peak)
+	}
+
+	// Calls are skipped if the context is cancelled.
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	var calls atomic.Int32
+	results = envelope.Broadcast(cancelled, envelopes, func(context.Context, *envelope.Envelope) error {
+		calls.Add(1)
+		return nil
+	}, 0)
+	if got := calls.Load(); got != 0 {
+		t.Errorf("Broadcast: got %d calls with a cancelled context, want 0", got)
+	}
+	for _, e := range envelopes {
+		if err := results[e]; !errors.Is(err, context.Canceled) {
+			t.Errorf("Broadcast: weavelet %s: got %v, want %v", e.WeaveletID(), err, context.Canceled)
+		}
+	}
+}
+
+func TestServeTwice(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	// Components can only be activated once the deployer is serving the
+	// envelope.
+	testComponents(d)
+	if err := d.weavelets["1"].env.Serve(d); !errors.Is(err, envelope.ErrAlreadyServing) {
+		t.Fatalf("Serve: got %v, want %v", err, envelope.ErrAlreadyServing)
+	}
+	testComponents(d)
+}
+
+// logsOnly is a partial envelope handler that only handles log entries.
+type logsOnly struct {
+	mu      sync.Mutex
+	entries []*protos.LogEntry
+}
+
+// LogBatch implements the envelope.LogHandler interface.
+func (l *logsOnly) LogBatch(_ context.Context, batch *protos.LogEntryBatch) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, entry := range batch.Entries {
+		l.entries = append(l.entries, protomsg.Clone(entry))
+	}
+	return nil
+}
+
+func TestServePartialHandler(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	// Serve a weavelet with a handler that only handles logs. Components are
+	// activated and run locally by default.
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	handler := &logsOnly{}
+	wlet, err := spawnHandler(d.ctx, info, func(env *envelope.Envelope) (envelope.EnvelopeHandler, error) {
+		return envelope.CompleteHandler(env, handler)
+	}, envelope.Options{
+		TmpDir: t.TempDir(),
+		Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+	}, weaver.RemoteWeaveletOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+
+	x, err := wlet.wlet.GetIntf(reflection.Type[a]())
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = 42
+	got, err := x.(a).A(ctx, want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Fatalf("A(%d): got %d, want %d", want, got, want)
+	}
+	if err := wlet.env.FlushTelemetry(); err != nil {
+		t.Fatal(err)
+	}
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	if !slices.ContainsFunc(handler.entries, func(e *protos.LogEntry) bool { return e.Msg == "C" }) {
+		t.Fatalf("no log entry from component c in %v", handler.entries)
+	}
+}
+
+func TestCompleteEmptyHandler(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	// A handler must implement at least one part of an EnvelopeHandler.
+	if _, err := envelope.CompleteHandler(d.weavelets["1"].env, struct{}{}); err == nil {
+		t.Fatal("CompleteHandler: unexpected success")
+	}
+}
+
+func TestEnvelopeState(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	// Components can only be activated once the deployer is serving the
+	// envelope.
+	testComponents(d)
+	w := d.weavelets["1"]
+	if got, want := w.env.State(), envelope.StateServing; got != want {
+		t.Fatalf("State: got %v, want %v", got, want)
+	}
+
+	w.cancel()
+	if err := w.threads.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.env.State(), envelope.StateStopped; got != want {
+		t.Fatalf("State: got %v, want %v", got, want)
+	}
+}
+
+func TestWatchHealth(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	next := func(statuses <-chan protos.HealthStatus) protos.HealthStatus {
+		t.Helper()
+		select {
+		case s := <-statuses:
+			return s
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for health status")
+			return 0
+		}
+	}
+	env := d.weavelets["1"].env
+	const interval = 10 * time.Millisecond
+	health := env.WatchHealth(ctx, interval)
+	component := env.WatchComponentHealth(ctx, componentb, interval)
+	if got, want := next(health), protos.HealthStatus_HEALTHY; got != want {
+		t.Fatalf("WatchHealth: got %v, want %v", got, want)
+	}
+	if got, want := next(component), protos.HealthStatus_HEALTHY; got != want {
+		t.Fatalf("WatchComponentHealth: got %v, want %v", got, want)
+	}
+
+	// Disabling the component is a transition.
+	if err := env.SetComponentEnabled(componentb, false); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := next(component), protos.HealthStatus_UNHEALTHY; got != want {
+		t.Fatalf("WatchComponentHealth: got %v, want %v", got, want)
+	}
+
+	// Stopping the weavelet emits a final status.
+	d.weavelets["1"].cancel()
+	if got, want := next(health), protos.HealthStatus_TERMINATED; got != want {
+		t.Fatalf("WatchHealth: got %v, want %v", got, want)
+	}
+	if _, ok := <-health; ok {
+		t.Fatal("WatchHealth: channel not closed")
+	}
+}
+
+func TestGetFDCount(t *testing.T) {
+	if goruntime.GOOS == "windows" {
+		t.Skip("file descriptor counts are not supported on windows")
+	}
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	reply, err := d.weavelets["1"].env.GetFDCount()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.Count <= 0 {
+		t.Errorf("GetFDCount: got count %d, want > 0", reply.Count)
+	}
+	if reply.SoftLimit == 0 || reply.SoftLimit > reply.HardLimit {
+		t.Errorf("GetFDCount: got limits (%d, %d), want 0 < soft <= hard", reply.SoftLimit, reply.HardLimit)
+	}
+}
+
+func TestResourceLimits(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	limits := d.weavelets["1"].env.ResourceLimits()
+	if limits == nil {
+		t.Fatal("ResourceLimits: got nil")
+	}
+	if got, want := limits.Gomaxprocs, int64(goruntime.GOMAXPROCS(0)); got != want {
+		t.Errorf("ResourceLimits: got GOMAXPROCS %d, want %d", got, want)
+	}
+	if got, want := limits.NumCpu, int64(goruntime.NumCPU()); got != want {
+		t.Errorf("ResourceLimits: got %d CPUs, want %d", got, want)
+	}
+}
+
+func TestSupportsCapability(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	env := d.weavelets["1"].env
+	for _, test := range []struct {
+		capability envelope.Capability
+		want       bool
+	}{
+		{protos.Capability_CAPABILITY_ACTIVATE_COMPONENT, true},
+		{protos.Capability_CAPABILITY_EXPORT_LISTENER, true},
+		{protos.Capability_CAPABILITY_LOG_BATCH, true},
+		{protos.Capability_CAPABILITY_HANDLE_TRACE_SPANS, true},
+		// mTLS is disabled.
+		{protos.Capability_CAPABILITY_GET_SELF_CERTIFICATE, false},
+		{protos.Capability_CAPABILITY_UNKNOWN, false},
+	} {
+		if got := env.SupportsCapability(test.capability); got != test.want {
+			t.Errorf("SupportsCapability(%v): got %t, want %t", test.capability, got, test.want)
+		}
+	}
+}
+
+func TestListenerReadiness(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	reply, err := d.weavelets["1"].wlet.GetHealth(ctx, &protos.GetHealthRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(reply.Listeners), 1; got != want {
+		t.Fatalf("GetHealth: got %d listeners, want %d", got, want)
+	}
+
+	// Component a never accepts connections on its listener.
+	l := reply.Listeners[0]
+	if l.Listener != "lis" || l.Address == "" || l.Ready {
+		t.Fatalf("GetHealth: got listener %v, want bound listener \"lis\" that is not ready", l)
+	}
+}
+
+func TestSubscribeLogs(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	entries := make(chan *protos.LogEntry, 100)
+	filter := envelope.LogFilter{Components: []string{componentb}, Levels: []string{"debug"}}
+	unsubscribe := d.weavelets["1"].env.Subscribe(filter, func(entry *protos.LogEntry) {
+		entries <- protomsg.Clone(entry)
+	})
+	defer unsubscribe()
+	testComponents(d)
+
+	select {
+	case entry := <-entries:
+		if entry.Component != componentb || entry.Msg != "B" {
+			t.Fatalf("got entry %v, want debug entry \"B\" from %s", entry, componentb)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for log entry")
+	}
+}
+
+func TestUnsubscribeFromSubscriber(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	// The subscriber unsubscribes itself on the first entry it receives.
+	env := d.weavelets["1"].env
+	var mu sync.Mutex
+	var unsubscribe func()
+	var received int
+	mu.Lock()
+	unsubscribe = env.Subscribe(envelope.LogFilter{}, func(*protos.LogEntry) {
+		mu.Lock()
+		defer mu.Unlock()
+		received++
+		unsubscribe()
+	})
+	mu.Unlock()
+
+	// Delivering entries after the subscriber unsubscribed must not
+	// deadlock.
+	testComponents(d)
+	if err := env.FlushTelemetry(); err != nil {
+		t.Fatal(err)
+	}
+	testComponents(d)
+	if err := env.FlushTelemetry(); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if received == 0 {
+		t.Fatal("subscriber received no entries")
+	}
+}
+
+// crashableChild is an envelope.Child that runs a RemoteWeavelet in the
+// current process and that can be crashed.
+type crashableChild struct {
+	*envelope.InProcessChild
+	stdout  *io.PipeReader
+	stdoutW *io.PipeWriter
+	cancel  context.CancelFunc
+}
+
+func newCrashableChild() *crashableChild {
+	r, w := io.Pipe()
+	return &crashableChild{InProcessChild: envelope.NewInProcessChild(), stdout: r, stdoutW: w}
+}
+
+// Start implements the envelope.Child interface.
+func (c *crashableChild) Start(ctx context.Context, config *protos.AppConfig, args *protos.WeaveletArgs) error {
+	ctx, c.cancel = context.WithCancel(ctx)
+	if err := c.InProcessChild.Start(ctx, config, args); err != nil {
+		return err
+	}
+	go func() {
+		// Like the stdout of a process, stdout is closed when the weavelet
+		// is killed.
+		<-ctx.Done()
+		c.stdoutW.Close()
+	}()
+	go func() {
+		// Note that NewRemoteWeavelet blocks until the envelope performs a
+		// handshake with it.
+		wlet, err := weaver.NewRemoteWeavelet(ctx, codegen.Registered(), runtime.Bootstrap{Args: c.Args()}, weaver.RemoteWeaveletOptions{})
+		if err == nil {
+			wlet.Wait()
+		}
+	}()
+	return nil
+}
+
+// Stdout implements the envelope.Child interface. The envelope stops serving
+// when stdout is closed.
+func (c *crashableChild) Stdout() io.ReadCloser { return c.stdout }
+
+// crash crashes the weavelet.
+func (c *crashableChild) crash() {
+	c.cancel()
+}
+
+func TestSupervisor(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	var mu sync.Mutex
+	var children []*crashableChild
+	events := make(chan envelope.SupervisorEvent, 100)
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	s := envelope.NewSupervisor(info, &protos.AppConfig{}, d, envelope.SupervisorOptions{
+		Options: envelope.Options{Logger: slog.New(&logging.LogHandler{Write: d.logger.Log})},
+		NewChild: func() envelope.Child {
+			mu.Lock()
+			defer mu.Unlock()
+			child := newCrashableChild()
+			children = append(children, child)
+			return child
+		},
+		OnStart: func(e *envelope.Envelope) error {
+			return e.UpdateComponents([]string{componentc})
+		},
+		OnEvent: func(e envelope.SupervisorEvent) { events <- e },
+	})
+	stopped := make(chan error, 1)
+	go func() { stopped <- s.Run(ctx) }()
+
+	expect := func(want envelope.SupervisorEventType) {
+		t.Helper()
+		select {
+		case e := <-events:
+			if e.Type != want {
+				t.Fatalf("got event %v (err: %v), want %v", e.Type, e.Err, want)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for event %v", want)
+		}
+	}
+
+	// Crash the weavelet and check that it is restarted.
+	expect(envelope.WeaveletStarted)
+	expect(envelope.WeaveletReady)
+	first := s.Envelope()
+	mu.Lock()
+	children[0].crash()
+	mu.Unlock()
+	expect(envelope.WeaveletExited)
+	expect(envelope.WeaveletStarted)
+	expect(envelope.WeaveletReady)
+	if s.Envelope() == nil || s.Envelope() == first {
+		t.Fatalf("Envelope: weavelet not restarted")
+	}
+
+	// Stop the supervisor.
+	cancel()
+	expect(envelope.SupervisorStopped)
+	if err := <-stopped; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run: got %v, want %v", err, context.Canceled)
+	}
+	if s.Envelope() != nil {
+		t.Fatalf("Envelope: got running weavelet after shutdown")
+	}
+}
+
+// countingBackoff is a retry.Backoff that doesn't sleep and counts the calls
+// to Next.
+type countingBackoff struct {
+	nexts atomic.Int32
+}
+
+func (b *countingBackoff) Next() time.Duration { b.nexts.Add(1); return 0 }
+func (b *countingBackoff) Reset()              {}
+
+func TestSupervisorBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	// Fail the first start of the weavelet, so that it is restarted after a
+	// backoff.
+	var starts atomic.Int32
+	backoff := &countingBackoff{}
+	events := make(chan envelope.SupervisorEvent, 100)
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	s := envelope.NewSupervisor(info, &protos.AppConfig{}, d, envelope.SupervisorOptions{
+		Options:  envelope.Options{Logger: slog.New(&logging.LogHandler{Write: d.logger.Log})},
+		NewChild: func() envelope.Child { return newCrashableChild() },
+		OnStart: func(*envelope.Envelope) error {
+			if starts.Add(1) == 1 {
+				return fmt.Errorf("simulated OnStart failure")
+			}
+			return nil
+		},
+		OnEvent:    func(e envelope.SupervisorEvent) { events <- e },
+		NewBackoff: func() retry.Backoff { return backoff },
+	})
+	go s.Run(ctx)
+
+	for _, want := range []envelope.SupervisorEventType{
+		envelope.WeaveletStarted,
+		envelope.WeaveletExited,
+		envelope.WeaveletStarted,
+		envelope.WeaveletReady,
+	} {
+		select {
+		case e := <-events:
+			if e.Type != want {
+				t.Fatalf("got event %v (err: %v), want %v", e.Type, e.Err, want)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for event %v", want)
+		}
+	}
+	if got, want := backoff.nexts.Load(), int32(1); got != want {
+		t.Fatalf("Next called %d times, want %d", got, want)
+	}
+}
+
+// stderrChild is an envelope.Child that writes a line to stderr before
+// running a RemoteWeavelet in the current process. If crash is true, the
+// weavelet exits right after writing the line, before the handshake with the
+// envelope.
+type stderrChild struct {
+	*envelope.InProcessChild
+	stderr  *io.PipeReader
+	stderrW *io.PipeWriter
+	line    string
+	crash   bool
+}
+
+func newStderrChild(line string, crash bool) *stderrChild {
+	r, w := io.Pipe()
+	return &stderrChild{InProcessChild: envelope.NewInProcessChild(), stderr: r, stderrW: w, line: line, crash: crash}
+}
+
+// Start implements the envelope.Child interface.
+func (c *stderrChild) Start(ctx context.Context, config *protos.AppConfig, args *protos.WeaveletArgs) error {
+	if err := c.InProcessChild.Start(ctx, config, args); err != nil {
+		return err
+	}
+	go func() {
+		fmt.Fprintln(c.stderrW, c.line)
+		if c.crash {
+			c.stderrW.Close()
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			c.stderrW.Close()
+		}()
+		wlet, err := weaver.NewRemoteWeavelet(ctx, codegen.Registered(), runtime.Bootstrap{Args: c.Args()}, weaver.RemoteWeaveletOptions{})
+		if err == nil {
+			wlet.Wait()
+		}
+	}()
+	return nil
+}
+
+// Stderr implements the envelope.Child interface.
+func (c *stderrChild) Stderr() io.ReadCloser { return c.stderr }
+
+// logRecorder is an EnvelopeHandler that records the log entries it receives.
+type logRecorder struct {
+	*deployer
+	recordMu sync.Mutex
+	entries  []*protos.LogEntry
+}
+
+// LogBatch implements the EnvelopeHandler interface.
+func (r *logRecorder) LogBatch(ctx context.Context, batch *protos.LogEntryBatch) error {
+	r.recordMu.Lock()
+	for _, entry := range batch.Entries {
+		r.entries = append(r.entries, protomsg.Clone(entry))
+	}
+	r.recordMu.Unlock()
+	return r.deployer.LogBatch(ctx, batch)
+}
+
+// slowLogRecorder is a logRecorder that is slow to handle log batches.
+type slowLogRecorder struct {
+	logRecorder
+}
+
+// LogBatch implements the EnvelopeHandler interface.
+func (r *slowLogRecorder) LogBatch(ctx context.Context, batch *protos.LogEntryBatch) error {
+	time.Sleep(50 * time.Millisecond)
+	return r.logRecorder.LogBatch(ctx, batch)
+}
+
+// seqRecorder is a handler that records the sequence numbers of the log
+// batches it receives.
+type seqRecorder struct {
+	*deployer
+	mu   sync.Mutex
+	seqs []uint64
+}
+
+// LogBatch implements the EnvelopeHandler interface.
+func (r *seqRecorder) LogBatch(ctx context.Context, batch *protos.LogEntryBatch) error {
+	r.mu.Lock()
+	if batch.Seq != 0 {
+		// Lines of weavelet stdout and stderr are not sequenced.
+		r.seqs = append(r.seqs, batch.Seq)
+	}
+	r.mu.Unlock()
+	return r.deployer.LogBatch(ctx, batch)
+}
+
+func TestCheckMessageOrder(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	recorder := &seqRecorder{deployer: d}
+	var mu sync.Mutex
+	var violations []error
+	wlet, err := spawn(d.ctx, info, recorder, envelope.Options{
+		TmpDir: t.TempDir(),
+		Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		OnOrderViolation: func(err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			violations = append(violations, err)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+
+	// The weavelet logs "Updated routing info" before replying.
+	for i := 0; i < 10; i++ {
+		routing := &protos.RoutingInfo{Component: componentc, Local: true}
+		if err := wlet.env.UpdateRoutingInfo(routing); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := wlet.env.FlushTelemetry(); err != nil {
+		t.Fatal(err)
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if len(recorder.seqs) == 0 {
+		t.Fatal("no sequenced log batches received")
+	}
+	for i, seq := range recorder.seqs {
+		if want := uint64(i + 1); seq != want {
+			t.Fatalf("batch %d: got sequence number %d, want %d (all: %v)", i, seq, want, recorder.seqs)
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(violations) > 0 {
+		t.Fatalf("unexpected order violations: %v", violations)
+	}
+}
+
+func TestFlushTelemetry(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	recorder := &slowLogRecorder{logRecorder{deployer: d}}
+	wlet, err := spawn(d.ctx, info, recorder, envelope.Options{
+		TmpDir:         t.TempDir(),
+		Logger:         slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		TraceQueueSize: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+
+	// The weavelet logs "Updated routing info" before replying.
+	for i := 0; i < 3; i++ {
+		routing := &protos.RoutingInfo{Component: componentc, Local: true}
+		if err := wlet.env.UpdateRoutingInfo(routing); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := wlet.env.FlushTelemetry(); err != nil {
+		t.Fatal(err)
+	}
+	recorder.recordMu.Lock()
+	defer recorder.recordMu.Unlock()
+	var updates int
+	for _, entry := range recorder.entries {
+		if entry.Msg == "Updated routing info" {
+			updates++
+		}
+	}
+	if got, want := updates, 3; got != want {
+		t.Fatalf("FlushTelemetry: got %d routing info updates logged, want %d", got, want)
+	}
+}
+
+// unavailableLogRecorder is a logRecorder that fails to handle log batches
+// until it becomes available.
+type unavailableLogRecorder struct {
+	logRecorder
+	available atomic.Bool
+}
+
+// LogBatch implements the EnvelopeHandler interface.
+func (r *unavailableLogRecorder) LogBatch(ctx context.Context, batch *protos.LogEntryBatch) error {
+	if !r.available.Load() {
+		return fmt.Errorf("simulated log sink outage")
+	}
+	return r.logRecorder.LogBatch(ctx, batch)
+}
+
+// countRoutingUpdates returns the number of "Updated routing info" log
+// entries recorded by r.
+func countRoutingUpdates(r *logRecorder) int {
+	r.recordMu.Lock()
+	defer r.recordMu.Unlock()
+	var updates int
+	for _, entry := range r.entries {
+		if entry.Msg == "Updated routing info" {
+			updates++
+		}
+	}
+	return updates
+}
+
+func TestLogSpool(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	recorder := &unavailableLogRecorder{logRecorder: logRecorder{deployer: d}}
+	wlet, err := spawn(d.ctx, info, recorder, envelope.Options{
+		TmpDir:   t.TempDir(),
+		Logger:   slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		LogSpool: &envelope.LogSpool{Dir: t.TempDir()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+
+	// The weavelet logs "Updated routing info" before replying. The log
+	// entries are spooled while the recorder is unavailable.
+	for i := 0; i < 3; i++ {
+		routing := &protos.RoutingInfo{Component: componentc, Local: true}
+		if err := wlet.env.UpdateRoutingInfo(routing); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := wlet.env.WeaveletControl().FlushTelemetry(ctx, &protos.FlushTelemetryRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := countRoutingUpdates(&recorder.logRecorder), 0; got != want {
+		t.Fatalf("got %d routing info updates logged during outage, want %d", got, want)
+	}
+
+	recorder.available.Store(true)
+	if err := wlet.env.FlushTelemetry(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := countRoutingUpdates(&recorder.logRecorder), 3; got != want {
+		t.Fatalf("got %d routing info updates logged after outage, want %d", got, want)
+	}
+}
+
+func TestLogSpoolFull(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	recorder := &unavailableLogRecorder{logRecorder: logRecorder{deployer: d}}
+	wlet, err := spawn(d.ctx, info, recorder, envelope.Options{
+		TmpDir:   t.TempDir(),
+		Logger:   slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		LogSpool: &envelope.LogSpool{Dir: t.TempDir(), MaxBytes: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+
+	// The spool is too small to hold any log entry, so they are dropped.
+	for i := 0; i < 3; i++ {
+		routing := &protos.RoutingInfo{Component: componentc, Local: true}
+		if err := wlet.env.UpdateRoutingInfo(routing); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := wlet.env.WeaveletControl().FlushTelemetry(ctx, &protos.FlushTelemetryRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Flushing doesn't wait for the dropped log entries.
+	recorder.available.Store(true)
+	flushed := make(chan error, 1)
+	go func() { flushed <- wlet.env.FlushTelemetry() }()
+	select {
+	case err := <-flushed:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("FlushTelemetry blocked on dropped log entries")
+	}
+	if got, want := countRoutingUpdates(&recorder.logRecorder), 0; got != want {
+		t.Fatalf("got %d routing info updates logged, want %d", got, want)
+	}
+}
+
+func TestLogSpoolRecovery(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	// Stop a weavelet while its log entries are spooled.
+	spool := &envelope.LogSpool{Dir: t.TempDir()}
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	wlet, err := spawn(d.ctx, info, failingHandler{d}, envelope.Options{
+		TmpDir:   t.TempDir(),
+		Logger:   slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		LogSpool: spool,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		routing := &protos.RoutingInfo{Component: componentc, Local: true}
+		if err := wlet.env.UpdateRoutingInfo(routing); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := wlet.env.WeaveletControl().FlushTelemetry(ctx, &protos.FlushTelemetryRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	wlet.cancel()
+	if err := wlet.threads.Wait(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new envelope using the same spool delivers the spooled log entries.
+	info = protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	recorder := &logRecorder{deployer: d}
+	wlet, err = spawn(d.ctx, info, recorder, envelope.Options{
+		TmpDir:   t.TempDir(),
+		Logger:   slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		LogSpool: spool,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+	if err := wlet.env.FlushTelemetry(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := countRoutingUpdates(recorder), 3; got != want {
+		t.Fatalf("got %d recovered routing info updates, want %d", got, want)
+	}
+}
+
+func TestLogSpoolCrash(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	// Spool a few log entries, while the log sink is unavailable.
+	dir := t.TempDir()
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	wlet, err := spawn(d.ctx, info, failingHandler{d}, envelope.Options{
+		TmpDir:   t.TempDir(),
+		Logger:   slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		LogSpool: &envelope.LogSpool{Dir: dir},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+	for i := 0; i < 3; i++ {
+		routing := &protos.RoutingInfo{Component: componentc, Local: true}
+		if err := wlet.env.UpdateRoutingInfo(routing); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := wlet.env.WeaveletControl().FlushTelemetry(ctx, &protos.FlushTelemetryRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a crash of the envelope by copying the spool while the
+	// envelope is still running.
+	crashed := t.TempDir()
+	if err := os.CopyFS(crashed, os.DirFS(dir)); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new envelope using the copy delivers the spooled log entries.
+	info = protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	recorder := &logRecorder{deployer: d}
+	recovered, err := spawn(d.ctx, info, recorder, envelope.Options{
+		TmpDir:   t.TempDir(),
+		Logger:   slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		LogSpool: &envelope.LogSpool{Dir: crashed},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer recovered.cancel()
+	if err := recovered.env.FlushTelemetry(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := countRoutingUpdates(recorder), 3; got != want {
+		t.Fatalf("got %d recovered routing info updates, want %d", got, want)
+	}
+}
+
+func TestCaptureStderrBeforeServe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	const line = "written before the handshake"
+	child := newStderrChild(line, false)
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	env, err := envelope.NewEnvelope(ctx, info, &protos.AppConfig{}, envelope.Options{
+		TmpDir: t.TempDir(),
+		Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		Child:  child,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder := &logRecorder{deployer: d}
+	served := make(chan error, 1)
+	go func() { served <- env.Serve(recorder) }()
+	defer func() {
+		cancel()
+		<-served
+	}()
+
+	// The line written before Serve is delivered once Serve is called.
+	captured := func() bool {
+		recorder.recordMu.Lock()
+		defer recorder.recordMu.Unlock()
+		for _, entry := range recorder.entries {
+			if entry.Level == "stderr" && entry.Msg == line {
+				return true
+			}
+		}
+		return false
+	}
+	for start := time.Now(); !captured(); time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > 10*time.Second {
+			t.Fatalf("stderr line %q not delivered", line)
+		}
+	}
+}
+
+// garbledChild is an envelope.Child that replies to the envelope's handshake
+// with bytes that can't be decoded, like a weavelet with a corrupted stream.
+type garbledChild struct {
+	ctx context.Context
+}
+
+// garbledReply is the reply of a garbledChild to the handshake.
+const garbledReply = "\xffnot a reply"
+
+// Start implements the envelope.Child interface.
+func (c *garbledChild) Start(ctx context.Context, _ *protos.AppConfig, args *protos.WeaveletArgs) error {
+	c.ctx = ctx
+	lis, err := net.Listen("unix", args.ControlSocket)
+	if err != nil {
+		return err
+	}
+	handlers := call.NewHandlerMap()
+	handlers.Set(control.WeaveletPath, "InitWeavelet", func(context.Context, []byte) ([]byte, error) {
+		return []byte(garbledReply), nil
+	})
+	go call.Serve(ctx, garbledListener{lis, handlers}, call.ServerOptions{})
+	return nil
+}
+
+func (c *garbledChild) Wait() error           { <-c.ctx.Done(); return nil }
+func (c *garbledChild) Stdout() io.ReadCloser { return nil }
+func (c *garbledChild) Stderr() io.ReadCloser { return nil }
+func (c *garbledChild) Pid() (int, bool)      { return 0, false }
+
+// garbledListener is a call.Listener that serves the provided handlers.
+type garbledListener struct {
+	net.Listener
+	handlers *call.HandlerMap
+}
+
+// Accept implements the call.Listener interface.
+func (l garbledListener) Accept() (net.Conn, *call.HandlerMap, error) {
+	c, err := l.Listener.Accept()
+	return c, l.handlers, err
+}
+
+// silentChild is an envelope.Child that accepts connections to the control
+// socket, but never replies to the envelope's handshake.
+type silentChild struct {
+	ctx      context.Context
+	accepted chan struct{} // receives every accepted connection
+}
+
+// Start implements the envelope.Child interface.
+func (c *silentChild) Start(ctx context.Context, _ *protos.AppConfig, args *protos.WeaveletArgs) error {
+	c.ctx = ctx
+	lis, err := net.Listen("unix", args.ControlSocket)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		lis.Close()
+	}()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			select {
+			case c.accepted <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (c *silentChild) Wait() error           { <-c.ctx.Done(); return nil }
+func (c *silentChild) Stdout() io.ReadCloser { return nil }
+func (c *silentChild) Stderr() io.ReadCloser { return nil }
+func (c *silentChild) Pid() (int, bool)      { return 0, false }
+
+func TestHandshakeTimeout(t *testing.T) {
+	info := &protos.WeaveletArgs{
+		App:             "remoteweavelet_test.go",
+		DeploymentId:    fmt.Sprint(os.Getpid()),
+		Id:              uuid.New().String(),
+		InternalAddress: "localhost:0",
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	clock := newManualClock()
+	child := &silentChild{accepted: make(chan struct{})}
+	errs := make(chan error, 1)
+	go func() {
+		_, err := envelope.NewEnvelope(ctx, info, &protos.AppConfig{}, envelope.Options{
+			TmpDir:           t.TempDir(),
+			Logger:           slog.New(&logging.LogHandler{Write: func(*protos.LogEntry) {}}),
+			Child:            child,
+			Clock:            clock,
+			HandshakeTimeout: time.Minute,
+		})
+		errs <- err
+	}()
+
+	// The envelope redials the weavelet only once the handshake times out on
+	// the envelope's clock.
+	<-child.accepted
+	select {
+	case <-child.accepted:
+		t.Fatal("redialed before the handshake timed out")
+	case <-time.After(100 * time.Millisecond):
+	}
+	timeout := time.After(10 * time.Second)
+	for redialed := false; !redialed; {
+		clock.Advance(time.Minute)
+		select {
+		case <-child.accepted:
+			redialed = true
+		case <-time.After(10 * time.Millisecond):
+		case <-timeout:
+			t.Fatal("the envelope didn't redial after the handshake timed out")
+		}
+	}
+
+	cancel()
+	if err := <-errs; err == nil {
+		t.Fatal("NewEnvelope: unexpected success")
+	}
+}
+
+func TestInitFailureRawReply(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		child   envelope.Child
+		decoded bool // whether the reply can be decoded
+	}{
+		{"Garbled", &garbledChild{}, false},
+		{"VersionMismatch", scriptedweavelet.New(&protos.InitWeaveletReply{
+			DialAddr: "tcp://127.0.0.1:0",
+			Version:  &protos.SemVer{Major: 999},
+		}), true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			info := &protos.WeaveletArgs{
+				App:             "remoteweavelet_test.go",
+				DeploymentId:    fmt.Sprint(os.Getpid()),
+				Id:              uuid.New().String(),
+				InternalAddress: "localhost:0",
+			}
+			var called bool
+			var gotReply *protos.InitWeaveletReply
+			var gotRaw []byte
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			_, err := envelope.NewEnvelope(ctx, info, &protos.AppConfig{}, envelope.Options{
+				TmpDir:     t.TempDir(),
+				Logger:     slog.New(&logging.LogHandler{Write: func(*protos.LogEntry) {}}),
+				Child:      test.child,
+				RPCTimeout: time.Second,
+				OnInitFailure: func(reply *protos.InitWeaveletReply, raw []byte, _ error) {
+					called = true
+					gotReply = reply
+					gotRaw = slices.Clone(raw)
+				},
+			})
+			if err == nil {
+				t.Fatal("NewEnvelope: unexpected success")
+			}
+			if !called {
+				t.Fatal("OnInitFailure not called")
+			}
+			if len(gotRaw) == 0 {
+				t.Fatal("OnInitFailure: got no raw reply")
+			}
+			if got := gotReply != nil; got != test.decoded {
+				t.Fatalf("OnInitFailure: got decoded reply %v, want decoded %t", gotReply, test.decoded)
+			}
+			if !test.decoded {
+				if !bytes.Equal(gotRaw, []byte(garbledReply)) {
+					t.Errorf("OnInitFailure: got raw reply %q, want %q", gotRaw, garbledReply)
+				}
+				if want := fmt.Sprintf("%q", garbledReply); !strings.Contains(err.Error(), want) {
+					t.Errorf("NewEnvelope: error %q doesn't contain the raw reply %s", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestLogStderrOnInitFailure(t *testing.T) {
+	var mu sync.Mutex
+	var logged []*protos.LogEntry
+	logger := slog.New(&logging.LogHandler{Write: func(entry *protos.LogEntry) {
+		mu.Lock()
+		defer mu.Unlock()
+		logged = append(logged, entry)
+	}})
+
+	// The weavelet crashes before the handshake, so NewEnvelope fails. The
+	// crash output should be logged.
+	const line = "panic: crashed before the handshake"
+	info := &protos.WeaveletArgs{
+		App:             "remoteweavelet_test.go",
+		DeploymentId:    fmt.Sprint(os.Getpid()),
+		Id:              uuid.New().String(),
+		InternalAddress: "localhost:0",
+	}
+	_, err := envelope.NewEnvelope(context.Background(), info, &protos.AppConfig{}, envelope.Options{
+		TmpDir:     t.TempDir(),
+		Logger:     logger,
+		Child:      newStderrChild(line, true),
+		RPCTimeout: 100 * time.Millisecond,
+	})
+	if err == nil {
+		t.Fatal("NewEnvelope: unexpected success")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, entry := range logged {
+		if entry.Msg == "Weavelet output" && slices.Contains(entry.Attrs, line) {
+			return
+		}
+	}
+	t.Fatalf("stderr line %q not logged", line)
+}
+
+func TestLogStderrTailOnInitFailure(t *testing.T) {
+	var mu sync.Mutex
+	var logged []*protos.LogEntry
+	logger := slog.New(&logging.LogHandler{Write: func(entry *protos.LogEntry) {
+		mu.Lock()
+		defer mu.Unlock()
+		logged = append(logged, entry)
+	}})
+
+	// The weavelet writes more lines than the envelope buffers before
+	// crashing. The last lines, which include the panic, should be logged,
+	// and the oldest lines dropped.
+	const n = 1500
+	var lines []string
+	for i := 0; i < n; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	const panicLine = "panic: crashed before the handshake"
+	lines = append(lines, panicLine)
+	info := &protos.WeaveletArgs{
+		App:             "remoteweavelet_test.go",
+		DeploymentId:    fmt.Sprint(os.Getpid()),
+		Id:              uuid.New().String(),
+		InternalAddress: "localhost:0",
+	}
+	_, err := envelope.NewEnvelope(context.Background(), info, &protos.AppConfig{}, envelope.Options{
+		TmpDir:     t.TempDir(),
+		Logger:     logger,
+		Child:      newStderrChild(strings.Join(lines, "\n"), true),
+		RPCTimeout: 100 * time.Millisecond,
+	})
+	if err == nil {
+		t.Fatal("NewEnvelope: unexpected success")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	var output []string
+	var dropped bool
+	for _, entry := range logged {
+		switch entry.Msg {
+		case "Weavelet output":
+			output = append(output, entry.Attrs[len(entry.Attrs)-1])
+		case "Dropped the oldest weavelet output":
+			dropped = true
+		}
+	}
+	if len(output) == 0 || output[len(output)-1] != panicLine {
+		t.Fatalf("last logged line: got %v, want %q", output[max(len(output)-1, 0):], panicLine)
+	}
+	if slices.Contains(output, "line 0") {
+		t.Errorf("oldest line %q logged, want it dropped", "line 0")
+	}
+	if !dropped {
+		t.Error("dropped lines not reported")
+	}
+}
+
+func TestMetricLabels(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	wlet, err := spawn(d.ctx, info, d, envelope.Options{
+		TmpDir: t.TempDir(),
+		Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		Labels: map[string]string{
+			"region":            "us-east1",
+			"serviceweaver_app": "overridden",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+
+	snapshots, err := wlet.env.GetMetrics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) == 0 {
+		t.Fatal("GetMetrics: no metrics")
+	}
+	for _, s := range snapshots {
+		if got, want := s.Labels["region"], "us-east1"; got != want {
+			t.Errorf("metric %s: got region %q, want %q", s.Name, got, want)
+		}
+		// Labels set by the weavelet take precedence.
+		if got, want := s.Labels["serviceweaver_app"], d.info.App; got != want {
+			t.Errorf("metric %s: got app %q, want %q", s.Name, got, want)
+		}
+	}
+}
+
+func TestWriteOpenMetrics(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	env := d.weavelets["1"].env
+	if _, err := env.GetMetrics(); err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err := env.WriteOpenMetrics(&b); err != nil {
+		t.Fatal(err)
+	}
+	got := b.String()
+	if !strings.HasSuffix(got, "# EOF\n") {
+		t.Fatalf("WriteOpenMetrics: missing # EOF marker:\n%s", got)
+	}
+	for _, want := range []string{
+		// A weavelet metric, suffixed with _total.
+		"# TYPE serviceweaver_method_count counter\n",
+		"serviceweaver_method_count_total{",
+		// Connection metrics, labeled like weavelet metrics.
+		fmt.Sprintf(`serviceweaver_envelope_rpcs_total{method="GetMetrics",serviceweaver_app=%q,serviceweaver_node=%q,serviceweaver_version=%q} 1`, d.info.App, d.info.Id, d.info.DeploymentId),
+		"serviceweaver_envelope_bytes_received_total{",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("WriteOpenMetrics: missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestMetricsImporter(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	importer := &metrics.Importer{}
+	wlet, err := spawn(d.ctx, info, d, envelope.Options{
+		TmpDir:   t.TempDir(),
+		Logger:   slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		Importer: importer,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+
+	snapshots, err := wlet.env.GetMetrics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) == 0 {
+		t.Fatal("GetMetrics: no metrics")
+	}
+	for _, s := range snapshots {
+		if s == nil {
+			t.Fatal("GetMetrics: nil snapshot")
+		}
+	}
+
+	// The envelope imported the metrics using the provided importer, so
+	// importing the same definitions again must fail.
+	update := &protos.MetricUpdate{Defs: []*protos.MetricDef{{Id: snapshots[0].Id}}}
+	if _, err := importer.Import(update); err == nil {
+		t.Fatal("Import: unexpected success for duplicate metric definition")
+	}
+}
+
+func TestResyncMetrics(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	env := d.weavelets["1"].env
+	before, err := env.GetMetrics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	after, err := env.ResyncMetrics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(after), len(before); got < want {
+		t.Fatalf("ResyncMetrics: got %d metrics, want at least %d", got, want)
+	}
+
+	// Deltas still work after a resync.
+	if _, err := env.GetMetrics(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+var concurrentCounter = wmetrics.NewCounter("testdeployer_concurrent_counter", "")
+
+func TestConcurrentMetrics(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	// Concurrently scrape and resync the metrics, while they change. Every
+	// scrape must succeed: an incremental update imported out of order, or
+	// into a discarded snapshot, fails to import.
+	env := d.weavelets["1"].env
+	value := func() float64 {
+		t.Helper()
+		snapshots, err := env.GetMetricsWithPrefixes("testdeployer_concurrent_counter")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(snapshots) != 1 {
+			t.Fatalf("GetMetricsWithPrefixes: got %d metrics, want 1", len(snapshots))
+		}
+		return snapshots[0].Value
+	}
+	before := value()
+	const n = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, 3*n)
+	for i := 0; i < n; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			concurrentCounter.Inc()
+			_, err := env.GetMetrics()
+			errs <- err
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := env.GetMetricsWithPrefixes("testdeployer_concurrent_counter")
+			errs <- err
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := env.ResyncMetrics()
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The envelope has the latest value of the metric.
+	if got, want := value()-before, float64(n); got != want {
+		t.Fatalf("GetMetricsWithPrefixes: got %v more, want %v more", got, want)
+	}
+}
+
+type shardLabels struct {
+	Shard string
+	Zone  string
+}
+
+var aggregatedLoad = wmetrics.NewGaugeMap[shardLabels]("testdeployer_aggregated_load", "")
+
+func TestGetAggregatedMetrics(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	for i, l := range []shardLabels{{"1", "us"}, {"2", "us"}, {"3", "eu"}} {
+		aggregatedLoad.Get(l).Set(float64(i + 1))
+	}
+
+	env := d.weavelets["1"].env
+	for _, test := range []struct {
+		function protos.AggregationFunction
+		want     map[string]float64 // zone -> value
+	}{
+		{protos.AggregationFunction_AGGREGATE_SUM, map[string]float64{"us": 3, "eu": 3}},
+		{protos.AggregationFunction_AGGREGATE_MAX, map[string]float64{"us": 2, "eu": 3}},
+		{protos.AggregationFunction_AGGREGATE_MIN, map[string]float64{"us": 1, "eu": 3}},
+	} {
+		t.Run(test.function.String(), func(t *testing.T) {
+			agg := &protos.MetricAggregation{
+				DropLabels: []string{"shard", "serviceweaver_node"},
+				Function:   test.function,
+			}
+			snapshots, err := env.GetAggregatedMetrics(agg, "testdeployer_aggregated_load")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := map[string]float64{}
+			for _, s := range snapshots {
+				for _, label := range agg.DropLabels {
+					if _, ok := s.Labels[label]; ok {
+						t.Errorf("metric %s: unexpected label %q", s.Name, label)
+					}
+				}
+				got[s.Labels["zone"]] = s.Value
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("GetAggregatedMetrics (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	// Aggregated scrapes don't affect incremental ones.
+	snapshots, err := env.GetMetricsWithPrefixes("testdeployer_aggregated_load")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(snapshots), 3; got != want {
+		t.Fatalf("GetMetricsWithPrefixes: got %d metrics, want %d", got, want)
+	}
+}
+
+func TestGetAggregatedMetricsNilAggregation(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	if _, err := d.weavelets["1"].env.GetAggregatedMetrics(nil); err == nil {
+		t.Fatal("GetAggregatedMetrics(nil): unexpected success")
+	}
+}
+
+func TestGetAggregatedMetricsEmptyReply(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	// A scripted weavelet replies to GetMetrics with no metric update.
+	w := scriptedweavelet.New(nil)
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	env, err := envelope.NewEnvelope(ctx, info, &protos.AppConfig{}, envelope.Options{
+		TmpDir: t.TempDir(),
+		Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		Child:  w,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go env.Serve(d)
+
+	agg := &protos.MetricAggregation{Function: protos.AggregationFunction_AGGREGATE_SUM}
+	snapshots, err := env.GetAggregatedMetrics(agg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 0 {
+		t.Fatalf("GetAggregatedMetrics: got %v, want no metrics", snapshots)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	// Ensure a component is started.
+	ctx := context.Background()
+	const group = "1"
+	placement := map[string][]string{group: {componentc}}
+	d := deploy(t, ctx, placement)
+	defer d.shutdown()
+	target := d.weavelets[group]
+
+	// Helper that calls a component method.
+	call := func() {
+		t.Helper()
+		comp, err := target.wlet.GetIntf(reflection.Type[c]())
+		if err != nil {
+			t.Fatal(err)
+		}
+		const want = 42
+		got, err := comp.(c).C(d.ctx, want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Fatalf("C(%d): got %d, want %d", want, got, want)
+		}
+	}
+
+	// Helper for maintaining fetched metrics.
+	names := map[uint64]string{}    // map from metric id to name
+	metrics := map[string]float64{} // map from metric name to value
+	readMetrics := func() {
+		t.Helper()
+		m, err := target.wlet.GetMetrics(d.ctx, &protos.GetMetricsRequest{})
+		if err != nil {
+			t.Fatal("GetMetrics failed", err)
+		}
+		for _, def := range m.Update.Defs {
+			names[def.Id] = def.Name
+		}
+		for _, val := range m.Update.Values {
+			metrics[names[val.Id]] = val.Value
+		}
+	}
+	readMetrics()
+	start := int(metrics["c_calls"])
+
+	for i := 0; i < 5; i++ {
+		readMetrics()
+		if m := int(metrics["c_calls"]); m != start+i {
+			t.Fatalf("c_calls = %d, expecting %d", m, start+i)
+		}
+		call()
+	}
+}
+
+func TestCheckpointRestore(t *testing.T) {
+	ctx := context.Background()
+	src := deploy(t, ctx, colocated)
+	defer src.shutdown()
+	testComponents(src)
+
+	// Checkpoint c, which has been called once by testComponents.
+	state, err := src.weavelets["1"].env.Checkpoint(componentc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Restore the checkpoint in a different deployment and checkpoint again.
+	dst := deploy(t, ctx, colocated)
+	defer dst.shutdown()
+	if err := dst.weavelets["1"].env.Restore(componentc, state); err != nil {
+		t.Fatal(err)
+	}
+	got, err := dst.weavelets["1"].env.Checkpoint(componentc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, state) {
+		t.Fatalf("Checkpoint after Restore: got %v, want %v", got, state)
+	}
+}
+
+func TestQuiesceComponent(t *testing.T) {
+	// Place c in its own weavelet, so that calls to c are remote.
+	placement := map[string][]string{
+		"1": {componenta, componentb},
+		"2": {componentc},
+	}
+	d := deploy(t, context.Background(), placement)
+	defer d.shutdown()
+	testComponents(d)
+	env := d.weavelets["2"].env
+	disabled := func() bool {
+		return slices.Contains(env.GetHealth().DisabledComponents, componentc)
+	}
+
+	// Block a call to c.
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	hook := func() {
+		entered <- struct{}{}
+		<-release
+	}
+	cHook.Store(&hook)
+	defer cHook.Store(nil)
+	x, err := d.weavelets["1"].wlet.GetIntf(reflection.Type[a]())
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		_, err := x.(a).A(d.ctx, 42)
+		done <- err
+	}()
+	<-entered
+
+	// Quiescing c times out while the call is in flight, and leaves c
+	// enabled.
+	if _, err := env.QuiesceComponent(componentc, 10*time.Millisecond); err == nil || !strings.Contains(err.Error(), "draining calls") {
+		t.Fatalf("QuiesceComponent: got error %v, want draining error", err)
+	}
+	if disabled() {
+		t.Fatalf("QuiesceComponent: %s disabled after failure", componentc)
+	}
+
+	// Quiescing c succeeds once the call finishes, and leaves c disabled.
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	state, err := env.QuiesceComponent(componentc, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !disabled() {
+		t.Fatalf("QuiesceComponent: %s not disabled", componentc)
+	}
+	want, err := env.Checkpoint(componentc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(state, want) {
+		t.Fatalf("QuiesceComponent: got state %v, want %v", state, want)
+	}
+}
+
+func TestDrain(t *testing.T) {
+	// Place b and c in their own weavelet, so that calls to b are remote and
+	// calls from b to c are local.
+	placement := map[string][]string{
+		"1": {componenta},
+		"2": {componentb, componentc},
+	}
+	d := deploy(t, context.Background(), placement)
+	defer d.shutdown()
+	testComponents(d)
+	env := d.weavelets["2"].env
+	disabled := func(component string) bool {
+		return slices.Contains(env.GetHealth().DisabledComponents, component)
+	}
+
+	// An invalid order disables no component.
+	if err := env.Drain([][]string{{componentb}, {"unknown"}}, time.Minute); err == nil {
+		t.Fatal("Drain with unknown component: unexpected success")
+	}
+	if err := env.Drain([][]string{{componentb}, {componentb}}, time.Minute); err == nil {
+		t.Fatal("Drain with duplicate component: unexpected success")
+	}
+	if disabled(componentb) {
+		t.Fatalf("Drain: %s disabled after invalid order", componentb)
+	}
+
+	// Block a call to b, in its call to c.
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	hook := func() {
+		entered <- struct{}{}
+		<-release
+	}
+	cHook.Store(&hook)
+	defer cHook.Store(nil)
+	x, err := d.weavelets["1"].wlet.GetIntf(reflection.Type[a]())
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		_, err := x.(a).A(d.ctx, 42)
+		done <- err
+	}()
+	<-entered
+
+	// The first stage doesn't drain while the call is in flight, so the
+	// second stage is left enabled, and c keeps serving the call.
+	order := [][]string{{componentb}, {componentc}}
+	if err := env.Drain(order, 10*time.Millisecond); err == nil || !strings.Contains(err.Error(), "draining calls") {
+		t.Fatalf("Drain: got error %v, want draining error", err)
+	}
+	if !disabled(componentb) {
+		t.Fatalf("Drain: %s not disabled", componentb)
+	}
+	if disabled(componentc) {
+		t.Fatalf("Drain: %s disabled before %s drained", componentc, componentb)
+	}
+
+	// Once the call finishes, all stages drain.
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if err := env.Drain(order, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	for _, component := range []string{componentb, componentc} {
+		if !disabled(component) {
+			t.Fatalf("Drain: %s not disabled", component)
+		}
+	}
+}
+
+func TestQuiesceComponentNotCheckpointable(t *testing.T) {
+	d := deploy(t, context.Background(), colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	// Component a did not register checkpoint functions.
+	env := d.weavelets["1"].env
+	if _, err := env.QuiesceComponent(componenta, time.Minute); !errors.Is(err, envelope.ErrNotCheckpointable) {
+		t.Fatalf("QuiesceComponent(%q): got %v, want %v", componenta, err, envelope.ErrNotCheckpointable)
+	}
+	if got := env.GetHealth().DisabledComponents; len(got) != 0 {
+		t.Fatalf("GetHealth: got disabled components %v, want none", got)
+	}
+}
+
+func TestRPCTimeout(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	const timeout = time.Minute
+	wlet, err := spawn(d.ctx, info, d, envelope.Options{
+		TmpDir:     t.TempDir(),
+		Logger:     slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		RPCTimeout: timeout,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+	if err := wlet.env.UpdateComponents([]string{componentc}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Checkpoint c, once it has started. The deadline of the checkpoint RPC
+	// is propagated to the checkpoint function.
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	start := time.Now()
+	for r := retry.Begin(); r.Continue(ctx); {
+		if _, err = wlet.env.Checkpoint(componentc); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadline := *checkpointDeadline.Load()
+	if deadline.Before(start) || deadline.After(time.Now().Add(timeout)) {
+		t.Fatalf("Checkpoint: got deadline %v, want deadline within %v of %v", deadline, timeout, start)
+	}
+}
+
+// manualClock is an envelope.Clock whose time only advances when Advance is
+// called.
+type manualClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers map[*manualTimer]bool // pending timers
+}
+
+func newManualClock() *manualClock {
+	return &manualClock{now: time.Now(), timers: map[*manualTimer]bool{}}
+}
+
+// Now implements the envelope.Clock interface.
+func (c *manualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTimer implements the envelope.Clock interface.
+func (c *manualClock) NewTimer(d time.Duration) envelope.Timer {
+	t := &manualTimer{clock: c, c: make(chan time.Time, 1)}
+	t.Reset(d)
+	return t
+}
+
+// After implements the envelope.Clock interface.
+func (c *manualClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+// Advance advances the clock by d, firing the timers that expire.
+func (c *manualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	for t := range c.timers {
+		if !t.when.After(c.now) {
+			delete(c.timers, t)
+			t.c <- c.now
+		}
+	}
+}
+
+// manualTimer is the envelope.Timer of a manualClock.
+type manualTimer struct {
+	clock *manualClock
+	c     chan time.Time
+	when  time.Time // guarded by clock.mu
+}
+
+func (t *manualTimer) C() <-chan time.Time { return t.c }
+
+func (t *manualTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	pending := t.clock.timers[t]
+	delete(t.clock.timers, t)
+	return pending
+}
+
+func (t *manualTimer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	pending := t.clock.timers[t]
+	t.when = t.clock.now.Add(d)
+	t.clock.timers[t] = true
+	return pending
+}
+
+func TestRPCTimeoutManualClock(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	clock := newManualClock()
+	wlet, err := spawn(d.ctx, info, d, envelope.Options{
+		TmpDir:     t.TempDir(),
+		Logger:     slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		Clock:      clock,
+		RPCTimeout: time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+
+	block := make(chan struct{})
+	rawBlock.Store(&block)
+	defer rawBlock.Store(nil)
+	defer close(block)
+	errs := make(chan error, 1)
+	go func() {
+		_, err := wlet.env.RawRPC(ctx, "test-block", nil)
+		errs <- err
+	}()
+	<-rawBlocked
+
+	// The RPC doesn't time out until the clock reaches its deadline.
+	clock.Advance(time.Hour - time.Second)
+	select {
+	case err := <-errs:
+		t.Fatalf("RawRPC: returned %v before its deadline", err)
+	default:
+	}
+	clock.Advance(time.Second)
+	if err := <-errs; !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("RawRPC: got %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestCheckpointNotCheckpointable(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	// Component a did not register checkpoint functions.
+	_, err := d.weavelets["1"].env.Checkpoint(componenta)
+	if !errors.Is(err, envelope.ErrNotCheckpointable) {
+		t.Fatalf("Checkpoint(%q): got %v, want %v", componenta, err, envelope.ErrNotCheckpointable)
+	}
+	err = d.weavelets["1"].env.Restore(componenta, nil)
+	if !errors.Is(err, envelope.ErrNotCheckpointable) {
+		t.Fatalf("Restore(%q): got %v, want %v", componenta, err, envelope.ErrNotCheckpointable)
+	}
+}
+
+func TestReloadConfig(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+	env := d.weavelets["1"].env
+
+	// b reloads its config; c doesn't support reloading.
+	config, restart, err := env.ReloadConfig(&protos.AppConfig{
+		Binary: "changed",
+		Sections: map[string]string{
+			componentb: `Greeting = "hello"`,
+			componentc: `Size = 10`,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"binary", componentc}; !slices.Equal(restart, want) {
+		t.Errorf("ReloadConfig: got restart %v, want %v", restart, want)
+	}
+	if got := bReloaded.Load(); got == nil || got.Greeting != "hello" {
+		t.Errorf("b: got reloaded config %v, want greeting %q", got, "hello")
+	}
+	want := map[string]string{componentb: `Greeting = "hello"`}
+	if diff := cmp.Diff(want, config.Sections); diff != "" {
+		t.Errorf("ReloadConfig: sections (-want +got):\n%s", diff)
+	}
+
+	// An invalid section is rejected before any component is reloaded.
+	_, _, err = env.ReloadConfig(&protos.AppConfig{
+		Sections: map[string]string{
+			componentb: `Greeting = "bye"`,
+			componentc: `Unknown = 1`,
+		},
+	})
+	if err == nil {
+		t.Fatal("ReloadConfig: unexpected success with invalid section")
+	}
+	if got := bReloaded.Load().Greeting; got != "hello" {
+		t.Errorf("b: got greeting %q after failed reload, want %q", got, "hello")
+	}
+
+	// A component whose reload fails must be restarted.
+	_, restart, err = env.ReloadConfig(&protos.AppConfig{
+		Sections: map[string]string{componentb: `Greeting = "fail"`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{componentb}; !slices.Equal(restart, want) {
+		t.Errorf("ReloadConfig: got restart %v, want %v", restart, want)
+	}
+}
+
+func TestRecentErrors(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+	env := d.weavelets["1"].env
+
+	// A failed config reload logs an error.
+	if _, _, err := env.ReloadConfig(&protos.AppConfig{
+		Sections: map[string]string{componentb: `Greeting = "fail"`},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	reloadFailed := func(entries []*protos.LogEntry) bool {
+		return slices.ContainsFunc(entries, func(e *protos.LogEntry) bool {
+			return e.Msg == "Failed to reload config"
+		})
+	}
+	entries, err := env.GetRecentErrors(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || !reloadFailed(entries) {
+		t.Fatalf("GetRecentErrors(1): got %v, want the failed reload", entries)
+	}
+
+	if err := env.ClearRecentErrors(); err != nil {
+		t.Fatal(err)
+	}
+	entries, err = env.GetRecentErrors(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloadFailed(entries) {
+		t.Fatalf("GetRecentErrors(0) after ClearRecentErrors: got %v, want no failed reload", entries)
+	}
+}
+
+func TestComponentLogLevel(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	recorder := &logRecorder{deployer: d}
+	wlet, err := spawn(d.ctx, info, recorder, envelope.Options{
+		TmpDir: t.TempDir(),
+		Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+
+	routing := &protos.UpdateRoutingInfoRequest{RoutingInfo: &protos.RoutingInfo{Component: componentc, Local: true}}
+	if _, err := wlet.wlet.UpdateRoutingInfo(ctx, routing); err != nil {
+		t.Fatal(err)
+	}
+	components := &protos.UpdateComponentsRequest{Components: []string{componentc}}
+	if _, err := wlet.wlet.UpdateComponents(ctx, components); err != nil {
+		t.Fatal(err)
+	}
+
+	// debugLogs calls c.C, which logs "C" at debug level, and returns the
+	// number of "C" entries received so far.
+	debugLogs := func() int {
+		t.Helper()
+		x, err := wlet.wlet.GetIntf(reflection.Type[c]())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := x.(c).C(ctx, 42); err != nil {
+			t.Fatal(err)
+		}
+		if err := wlet.env.FlushTelemetry(); err != nil {
+			t.Fatal(err)
+		}
+		recorder.recordMu.Lock()
+		defer recorder.recordMu.Unlock()
+		var n int
+		for _, entry := range recorder.entries {
+			if entry.Msg == "C" {
+				n++
+			}
+		}
+		return n
+	}
+
+	// By default, debug entries are written.
+	if got, want := debugLogs(), 1; got != want {
+		t.Fatalf("default level: got %d debug entries, want %d", got, want)
+	}
+	levels, err := wlet.env.GetLogLevels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(levels) != 0 {
+		t.Fatalf("GetLogLevels: got %v, want none", levels)
+	}
+
+	// With the level of c raised to info, they are dropped.
+	if err := wlet.env.SetComponentLogLevel(componentc, slog.LevelInfo); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := debugLogs(), 1; got != want {
+		t.Fatalf("info level: got %d debug entries, want %d", got, want)
+	}
+	levels, err = wlet.env.GetLogLevels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]slog.Level{componentc: slog.LevelInfo}, levels); diff != "" {
+		t.Fatalf("GetLogLevels (-want +got):\n%s", diff)
+	}
+
+	// Lowering it back to debug brings them back.
+	if err := wlet.env.SetComponentLogLevel(componentc, slog.LevelDebug); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := debugLogs(), 2; got != want {
+		t.Fatalf("debug level: got %d debug entries, want %d", got, want)
+	}
+
+	// Clearing the level restores the default, and removes the override.
+	if err := wlet.env.SetComponentLogLevel(componentc, slog.LevelError); err != nil {
+		t.Fatal(err)
+	}
+	if err := wlet.env.ClearComponentLogLevel(componentc); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := debugLogs(), 3; got != want {
+		t.Fatalf("cleared level: got %d debug entries, want %d", got, want)
+	}
+	levels, err = wlet.env.GetLogLevels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(levels) != 0 {
+		t.Fatalf("GetLogLevels after clear: got %v, want none", levels)
+	}
+
+	// Components not hosted by the weavelet are rejected.
+	if err := wlet.env.SetComponentLogLevel("unknown", slog.LevelDebug); err == nil {
+		t.Fatal("SetComponentLogLevel(unknown): unexpected success")
+	}
+}
+
+func TestConnRegistry(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	var registry envelope.ConnRegistry
+	ids := []string{"b-" + uuid.New().String(), "a-" + uuid.New().String()}
+	var wlets []*weavelet
+	for _, id := range ids {
+		info := protomsg.Clone(d.info)
+		info.Id = id
+		wlet, err := spawn(d.ctx, info, d, envelope.Options{
+			TmpDir:   t.TempDir(),
+			Logger:   slog.New(&logging.LogHandler{Write: d.logger.Log}),
+			Registry: &registry,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer wlet.cancel()
+		wlets = append(wlets, wlet)
+	}
+
+	// The envelopes are listed by weavelet id.
+	var got []string
+	for _, env := range registry.List() {
+		got = append(got, env.WeaveletID())
+	}
+	if want := []string{ids[1], ids[0]}; !slices.Equal(got, want) {
+		t.Fatalf("List: got %v, want %v", got, want)
+	}
+
+	// A closed envelope is removed.
+	wlets[0].env.Close()
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	for r := retry.Begin(); registry.Len() != 1; {
+		if !r.Continue(ctx) {
+			t.Fatalf("Len: got %d, want 1", registry.Len())
+		}
+	}
+	if got := registry.List(); len(got) != 1 || got[0] != wlets[1].env {
+		t.Fatalf("List: got %v, want the envelope of %s", got, ids[1])
+	}
+}
+
+// offsetClock is an envelope.Clock whose time is offset from the real time.
+type offsetClock struct {
+	offset time.Duration
+}
+
+// Now implements the envelope.Clock interface.
+func (c offsetClock) Now() time.Time { return time.Now().Add(c.offset) }
+
+// NewTimer implements the envelope.Clock interface.
+func (offsetClock) NewTimer(d time.Duration) envelope.Timer { return offsetTimer{time.NewTimer(d)} }
+
+// After implements the envelope.Clock interface.
+func (c offsetClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// offsetTimer is the envelope.Timer of an offsetClock.
+type offsetTimer struct{ t *time.Timer }
+
+func (t offsetTimer) C() <-chan time.Time        { return t.t.C }
+func (t offsetTimer) Stop() bool                 { return t.t.Stop() }
+func (t offsetTimer) Reset(d time.Duration) bool { return t.t.Reset(d) }
+
+func TestClockSkew(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	// The envelope's clock is an hour behind the weavelet's clock.
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	recorder := &logRecorder{deployer: d}
+	wlet, err := spawn(d.ctx, info, recorder, envelope.Options{
+		TmpDir:            t.TempDir(),
+		Logger:            slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		Clock:             offsetClock{-time.Hour},
+		SkewInterval:      10 * time.Millisecond,
+		CorrectTimestamps: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+	near := func(x, y time.Duration) bool {
+		return (x - y).Abs() < 10*time.Second
+	}
+
+	// The skew is measured periodically.
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	for r := retry.Begin(); !near(wlet.env.ClockSkew(), time.Hour); {
+		if !r.Continue(ctx) {
+			t.Fatalf("ClockSkew: got %v, want %v", wlet.env.ClockSkew(), time.Hour)
+		}
+	}
+
+	weaveletTime, rtt, err := wlet.env.GetTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(time.Since(weaveletTime), 0) || rtt < 0 {
+		t.Fatalf("GetTime: got time %v and rtt %v, want time near %v", weaveletTime, rtt, time.Now())
+	}
+
+	// Log entries are timestamped on the envelope's clock. The weavelet logs
+	// "Updated routing info" before replying.
+	routing := &protos.RoutingInfo{Component: componentc, Local: true}
+	if err := wlet.env.UpdateRoutingInfo(routing); err != nil {
+		t.Fatal(err)
+	}
+	if err := wlet.env.FlushTelemetry(); err != nil {
+		t.Fatal(err)
+	}
+	recorder.recordMu.Lock()
+	defer recorder.recordMu.Unlock()
+	i := slices.IndexFunc(recorder.entries, func(e *protos.LogEntry) bool {
+		return e.Msg == "Updated routing info"
+	})
+	if i < 0 {
+		t.Fatal("no routing info update logged")
+	}
+	logged := time.UnixMicro(recorder.entries[i].TimeMicros)
+	if !near(time.Since(logged), time.Hour) {
+		t.Fatalf("log entry time: got %v, want near %v", logged, time.Now().Add(-time.Hour))
+	}
+}
+
+func TestConnSummary(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	summaries := make(chan envelope.ConnSummary, 1)
+	wlet, err := spawn(d.ctx, info, d, envelope.Options{
+		TmpDir:  t.TempDir(),
+		Logger:  slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		OnClose: func(s envelope.ConnSummary) { summaries <- s },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		routing := &protos.RoutingInfo{Component: componentc, Local: true}
+		if err := wlet.env.UpdateRoutingInfo(routing); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := wlet.env.FlushTelemetry(); err != nil {
+		t.Fatal(err)
+	}
+	wlet.cancel()
+
+	var s envelope.ConnSummary
+	select {
+	case s = <-summaries:
+	case <-time.After(10 * time.Second):
+		t.Fatal("OnClose not called")
+	}
+	if s.Err == nil {
+		t.Error("ConnSummary: got nil termination error")
+	}
+	if s.Uptime <= 0 {
+		t.Errorf("ConnSummary: got uptime %v, want > 0", s.Uptime)
+	}
+	for _, method := range []string{"InitWeavelet", "UpdateRoutingInfo", "LogBatch"} {
+		if s.RPCs[method] == 0 {
+			t.Errorf("ConnSummary: no %s RPCs in %v", method, s.RPCs)
+		}
+	}
+	if got, want := s.RPCs["UpdateRoutingInfo"], int64(2); got != want {
+		t.Errorf("ConnSummary: got %d UpdateRoutingInfo RPCs, want %d", got, want)
+	}
+	var rpcs int64
+	for _, n := range s.RPCs {
+		rpcs += n
+	}
+	// Every RPC has a request and, unless interrupted by the shutdown, a reply.
+	if msgs := s.MessagesSent + s.MessagesReceived; msgs <= rpcs || msgs > 2*rpcs {
+		t.Errorf("ConnSummary: got %d messages sent and %d received for %d RPCs", s.MessagesSent, s.MessagesReceived, rpcs)
+	}
+	if s.BytesSent == 0 || s.BytesReceived == 0 {
+		t.Errorf("ConnSummary: got %d bytes sent and %d received, want > 0", s.BytesSent, s.BytesReceived)
+	}
+}
+
+func TestNewEnvelopeInvalidArgs(t *testing.T) {
+	valid := func() *protos.WeaveletArgs {
+		return &protos.WeaveletArgs{App: "app", DeploymentId: uuid.New().String(), Id: uuid.New().String()}
+	}
+	for _, test := range []struct {
+		name   string
+		wlet   func(*protos.WeaveletArgs) *protos.WeaveletArgs
+		config *protos.AppConfig
+		want   string
+	}{
+		{"NilArgs", func(*protos.WeaveletArgs) *protos.WeaveletArgs { return nil }, &protos.AppConfig{}, "WeaveletArgs: nil"},
+		{"NoApp", func(w *protos.WeaveletArgs) *protos.WeaveletArgs { w.App = ""; return w }, &protos.AppConfig{}, "missing app name"},
+		{"NoDeploymentId", func(w *protos.WeaveletArgs) *protos.WeaveletArgs { w.DeploymentId = ""; return w }, &protos.AppConfig{}, "missing deployment id"},
+		{"NoId", func(w *protos.WeaveletArgs) *protos.WeaveletArgs { w.Id = ""; return w }, &protos.AppConfig{}, "missing weavelet id"},
+		{"BadAddress", func(w *protos.WeaveletArgs) *protos.WeaveletArgs { w.InternalAddress = "localhost"; return w }, &protos.AppConfig{}, "invalid internal address"},
+		{"NilConfig", func(w *protos.WeaveletArgs) *protos.WeaveletArgs { return w }, nil, "AppConfig: nil"},
+		{"NameMismatch", func(w *protos.WeaveletArgs) *protos.WeaveletArgs { return w }, &protos.AppConfig{Name: "other"}, "doesn't match"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			child := envelope.NewInProcessChild()
+			_, err := envelope.NewEnvelope(context.Background(), test.wlet(valid()), test.config, envelope.Options{
+				TmpDir: t.TempDir(),
+				Child:  child,
+			})
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Fatalf("NewEnvelope: got error %v, want error containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestGetSnapshot(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+	env := d.weavelets["1"].env
+
+	snapshot, err := env.GetSnapshot(envelope.SnapshotOptions{
+		Health:         true,
+		Load:           true,
+		RuntimeStats:   true,
+		Metrics:        true,
+		MetricPrefixes: []string{"c_calls"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.TimeUnixMicros == 0 {
+		t.Error("GetSnapshot: missing time")
+	}
+	if got, want := snapshot.Health.GetStatus(), protos.HealthStatus_HEALTHY; got != want {
+		t.Errorf("GetSnapshot: got health %v, want %v", got, want)
+	}
+	if snapshot.Load == nil {
+		t.Error("GetSnapshot: missing load")
+	}
+	if stats := snapshot.RuntimeStats; stats.GetGoroutines() == 0 || stats.GetHeapAllocBytes() == 0 || stats.GetUptimeMicros() == 0 {
+		t.Errorf("GetSnapshot: got runtime stats %v, want nonzero stats", stats)
+	}
+	if len(snapshot.Metrics) == 0 {
+		t.Error("GetSnapshot: missing metrics")
+	}
+	for _, m := range snapshot.Metrics {
+		if m.Name != "c_calls" {
+			t.Errorf("GetSnapshot: got metric %q, want only c_calls", m.Name)
+		}
+	}
+
+	// Sections that aren't requested are omitted.
+	snapshot, err = env.GetSnapshot(envelope.SnapshotOptions{Health: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.Health == nil || snapshot.Load != nil || snapshot.RuntimeStats != nil || snapshot.Metrics != nil {
+		t.Errorf("GetSnapshot: got %v, want health only", snapshot)
+	}
+}
+
+func TestStopServing(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	recorder := &logRecorder{deployer: d}
+	wlet, err := spawn(d.ctx, info, recorder, envelope.Options{
+		TmpDir: t.TempDir(),
+		Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wlet.cancel()
+
+	// The weavelet logs "Updated routing info" before replying.
+	routing := &protos.RoutingInfo{Component: componentc, Local: true}
+	if err := wlet.env.UpdateRoutingInfo(routing); err != nil {
+		t.Fatal(err)
+	}
+	if err := wlet.env.FlushTelemetry(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := countRoutingUpdates(recorder), 1; got != want {
+		t.Fatalf("got %d routing info updates logged, want %d", got, want)
+	}
+
+	// Once serving stops, log entries are no longer handled, but RPCs to the
+	// weavelet still succeed.
+	wlet.env.StopServing()
+	if err := wlet.env.UpdateRoutingInfo(routing); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := wlet.env.GetHealth().Status, protos.HealthStatus_HEALTHY; got != want {
+		t.Fatalf("GetHealth: got %v, want %v", got, want)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if got, want := countRoutingUpdates(recorder), 1; got != want {
+		t.Fatalf("got %d routing info updates logged after StopServing, want %d", got, want)
+	}
+	if got, want := wlet.env.State(), envelope.StateServing; got != want {
+		t.Fatalf("State: got %v, want %v", got, want)
+	}
+
+	// Closing the envelope stops the weavelet.
+	wlet.env.Close()
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	for r := retry.Begin(); wlet.env.State() != envelope.StateStopped; {
+		if !r.Continue(ctx) {
+			t.Fatalf("State: got %v, want %v", wlet.env.State(), envelope.StateStopped)
+		}
+	}
+}
+
+func TestLastActivity(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	env := d.weavelets["1"].env
+
+	// The handshake reply counts as activity.
+	before := env.LastActivity()
+	if before.IsZero() {
+		t.Fatal("LastActivity: got zero time after handshake")
+	}
+
+	// So does any RPC reply.
+	time.Sleep(10 * time.Millisecond)
+	env.GetHealth()
+	after := env.LastActivity()
+	if !after.After(before) {
+		t.Fatalf("LastActivity: got %v after GetHealth, want after %v", after, before)
+	}
+	if after.After(time.Now()) {
+		t.Fatalf("LastActivity: got %v, want before now", after)
+	}
+}
+
+func TestMeasureRTT(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	env := d.weavelets["1"].env
+
+	start := time.Now()
+	rtt, err := env.MeasureRTT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); rtt <= 0 || rtt > elapsed {
+		t.Fatalf("MeasureRTT: got %v, want in (0, %v]", rtt, elapsed)
+	}
+}
+
+func TestActivateComponentAnnotations(t *testing.T) {
+	d := deploy(t, context.Background(), colocated)
+	defer d.shutdown()
+
+	// Annotate c with a deployment color.
+	d.activateComponent = func(ctx context.Context, req *protos.ActivateComponentRequest) (*protos.ActivateComponentReply, error) {
+		routing := &protos.UpdateRoutingInfoRequest{RoutingInfo: &protos.RoutingInfo{Component: req.Component, Local: true}}
+		if _, err := d.weavelets["1"].wlet.UpdateRoutingInfo(ctx, routing); err != nil {
+			return nil, err
+		}
+		components := &protos.UpdateComponentsRequest{Components: []string{req.Component}}
+		if _, err := d.weavelets["1"].wlet.UpdateComponents(ctx, components); err != nil {
+			return nil, err
+		}
+		reply := &protos.ActivateComponentReply{}
+		if req.Component == componentc {
+			reply.Annotations = map[string]string{"color": "blue", "component": "ignored"}
+		}
+		return reply, nil
+	}
+	testComponents(d)
+
+	// The method metrics of c, and only those, are labeled with the color.
+	reply, err := d.weavelets["1"].wlet.GetMetrics(d.ctx, &protos.GetMetricsRequest{
+		NamePrefixes: []string{"serviceweaver_method_count"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var annotated int
+	for _, def := range reply.Update.Defs {
+		color, ok := def.Labels["color"]
+		switch component := def.Labels["component"]; {
+		case component == componentc && color == "blue":
+			annotated++
+		case component == componentc:
+			t.Errorf("metric %s of c: got labels %v, want color=blue", def.Name, def.Labels)
+		case ok:
+			t.Errorf("metric %s of %s: got color %q, want none", def.Name, component, color)
+		}
+	}
+	if annotated == 0 {
+		t.Error("no annotated metrics for c")
+	}
+}
+
+func TestGetProfileBundle(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+	testComponents(d)
+
+	env := d.weavelets["1"].env
+	const duration = 200 * time.Millisecond
+	types := []envelope.ProfileType{envelope.ProfileCPU, envelope.ProfileHeap, envelope.ProfileGoroutine}
+	profiles, err := env.GetProfileBundle(ctx, types, duration)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(profiles), len(types); got != want {
+		t.Fatalf("GetProfileBundle: got %d profiles, want %d", got, want)
+	}
+	times := map[envelope.ProfileType]time.Time{}
+	for _, typ := range types {
+		p, err := profile.ParseData(profiles[typ])
+		if err != nil {
+			t.Fatalf("%v profile: %v", typ, err)
+		}
+		times[typ] = time.Unix(0, p.TimeNanos)
+	}
+
+	// The heap and goroutine profiles are collected while the CPU is being
+	// profiled.
+	for _, typ := range []envelope.ProfileType{envelope.ProfileHeap, envelope.ProfileGoroutine} {
+		if delta := times[typ].Sub(times[envelope.ProfileCPU]).Abs(); delta > duration {
+			t.Errorf("%v profile: collected %v away from the CPU profile, want at most %v", typ, delta, duration)
+		}
+	}
+
+	// Invalid requests are rejected.
+	if _, err := env.GetProfileBundle(ctx, []envelope.ProfileType{envelope.ProfileHeap, envelope.ProfileHeap}, 0); err == nil {
+		t.Error("GetProfileBundle(Heap, Heap): unexpected success")
+	}
+	if _, err := env.GetProfileBundle(ctx, []envelope.ProfileType{envelope.ProfileCPU}, 0); err == nil {
+		t.Error("GetProfileBundle(CPU, 0): unexpected success")
+	}
+}
+
+// traceRecorder is an EnvelopeHandler that records the span of the contexts
+// passed to HandleTraceSpans.
+type traceRecorder struct {
+	*deployer
+	spans chan trace.Span
+}
+
+// HandleTraceSpans implements the EnvelopeHandler interface.
+func (r *traceRecorder) HandleTraceSpans(ctx context.Context, _ *protos.TraceSpans) error {
+	r.spans <- trace.SpanFromContext(ctx)
+	return nil
+}
+
+func TestHandlerTraceContext(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	// The trace context of the weavelet's operation.
+	parent := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1, 2, 3},
+		SpanID:     trace.SpanID{4, 5, 6},
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	for _, test := range []struct {
+		name   string
+		tracer func(*tracetest.SpanRecorder) trace.Tracer
+	}{
+		{"NoTracer", func(*tracetest.SpanRecorder) trace.Tracer { return nil }},
+		{"Tracer", func(r *tracetest.SpanRecorder) trace.Tracer {
+			return sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(r)).Tracer("envelope")
+		}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			handler := &traceRecorder{deployer: d, spans: make(chan trace.Span, 1)}
+			info := protomsg.Clone(d.info)
+			info.Id = uuid.New().String()
+			w, err := spawn(d.ctx, info, handler, envelope.Options{
+				TmpDir: t.TempDir(),
+				Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+				Tracer: test.tracer(recorder),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer w.cancel()
+
+			// Send a message to the envelope, as the weavelet would.
+			reg, ok := codegen.Find(control.DeployerPath)
+			if !ok {
+				t.Fatalf("component %s not found", control.DeployerPath)
+			}
+			endpoint, err := call.ParseNetEndpoint(w.args.Redirects[0].Address)
+			if err != nil {
+				t.Fatal(err)
+			}
+			conn, err := call.Connect(ctx, call.NewConstantResolver(endpoint), call.ClientOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+			stub := reg.ClientStubFn(call.NewStub(control.DeployerPath, reg, conn, noop.NewTracerProvider().Tracer(""), 0), "test")
+			ctl := stub.(control.DeployerControl)
+			if err := ctl.HandleTraceSpans(trace.ContextWithSpanContext(ctx, parent), &protos.TraceSpans{}); err != nil {
+				t.Fatal(err)
+			}
+
+			span := <-handler.spans
+			got := span.SpanContext()
+			if got.TraceID() != parent.TraceID() {
+				t.Errorf("trace id: got %v, want %v", got.TraceID(), parent.TraceID())
+			}
+			if test.name == "NoTracer" {
+				// The handler's context carries the weavelet's span context.
+				if got.SpanID() != parent.SpanID() {
+					t.Errorf("span id: got %v, want %v", got.SpanID(), parent.SpanID())
+				}
+				return
+			}
+
+			// The handler runs in a server span, a child of the weavelet's span.
+			span.End()
+			ended := recorder.Ended()
+			if len(ended) != 1 {
+				t.Fatalf("got %d ended spans, want 1", len(ended))
+			}
+			if got := ended[0].Parent().SpanID(); got != parent.SpanID() {
+				t.Errorf("parent span id: got %v, want %v", got, parent.SpanID())
+			}
+		})
+	}
+}
+
+// flakyExporter is a span exporter that fails the first failures exports.
+type flakyExporter struct {
+	*tracetest.InMemoryExporter
+	failures atomic.Int32
+	attempts atomic.Int32
+}
+
+// ExportSpans implements the sdktrace.SpanExporter interface.
+func (e *flakyExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if e.attempts.Add(1) <= e.failures.Load() {
+		return fmt.Errorf("simulated export failure")
+	}
+	return e.InMemoryExporter.ExportSpans(ctx, spans)
+}
+
+func TestOTLPTraceHandler(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	exporter := &flakyExporter{InMemoryExporter: tracetest.NewInMemoryExporter()}
+	exporter.failures.Store(1)
+	otlp, err := envelope.NewOTLPTraceHandler(envelope.OTLPOptions{
+		Exporter: exporter,
+		ResourceAttributes: []attribute.KeyValue{
+			attribute.String("service.name", "configured"),
+			attribute.String("region", "us-east1"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer otlp.Shutdown(ctx)
+
+	str := func(key, value string) *protos.Span_Attribute {
+		return &protos.Span_Attribute{Key: key, Value: &protos.Span_Attribute_Value{
+			Type:  protos.Span_Attribute_Value_STRING,
+			Value: &protos.Span_Attribute_Value_Str{Str: value},
+		}}
+	}
+	span := &protos.Span{
+		Name:         "span",
+		TraceId:      bytes.Repeat([]byte{1}, 16),
+		SpanId:       bytes.Repeat([]byte{2}, 8),
+		ParentSpanId: bytes.Repeat([]byte{3}, 8),
+		Kind:         protos.Span_SERVER,
+		Attributes:   []*protos.Span_Attribute{str("method", "C")},
+		Resource: &protos.Span_Resource{Attributes: []*protos.Span_Attribute{
+			str("service.name", "weavelet"),
+			str("custom", "value"),
+		}},
+	}
+	w := scriptedweavelet.New(nil, scriptedweavelet.TraceSpans(span))
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	env, err := envelope.NewEnvelope(ctx, info, &protos.AppConfig{}, envelope.Options{
+		TmpDir: t.TempDir(),
+		Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		Child:  w,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go env.Serve(&otlpRecorder{deployer: d, otlp: otlp})
+	select {
+	case <-w.Done():
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the script to end")
+	}
+	if err := w.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first export fails, and is retried.
+	if err := otlp.ForceFlush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := exporter.attempts.Load(), int32(2); got != want {
+		t.Fatalf("export attempts: got %d, want %d", got, want)
+	}
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("got %d exported spans, want 1", len(spans))
+	}
+	got := spans[0]
+	if got.Name != "span" {
+		t.Errorf("name: got %q, want %q", got.Name, "span")
+	}
+	if got.SpanKind != trace.SpanKindServer {
+		t.Errorf("kind: got %v, want %v", got.SpanKind, trace.SpanKindServer)
+	}
+	if !got.SpanContext.IsSampled() {
+		t.Error("exported span is not sampled")
+	}
+	if got, want := got.Parent.SpanID(), trace.SpanID(bytes.Repeat([]byte{3}, 8)); got != want {
+		t.Errorf("parent span id: got %v, want %v", got, want)
+	}
+	if diff := cmp.Diff([]attribute.KeyValue{attribute.String("method", "C")}, got.Attributes, cmp.Comparer(func(x, y attribute.Value) bool { return x == y })); diff != "" {
+		t.Errorf("attributes (-want +got):\n%s", diff)
+	}
+
+	// The configured resource attributes take precedence over the span's.
+	resource := map[string]string{}
+	for _, kv := range got.Resource.Attributes() {
+		resource[string(kv.Key)] = kv.Value.Emit()
+	}
+	want := map[string]string{
+		"service.name": "configured",
+		"region":       "us-east1",
+		"custom":       "value",
+	}
+	if diff := cmp.Diff(want, resource); diff != "" {
+		t.Fatalf("resource attributes (-want +got):\n%s", diff)
+	}
+}
+
+func BenchmarkServe(b *testing.B) {
+	// Each envelope serves an in-process weavelet, so the reported number of
+	// goroutines includes the goroutines of the weavelet. Note that every
+	// envelope and weavelet pair uses a handful of file descriptors, so large
+	// values of n may require raising the limit on open files.
+	for _, n := range []int{100, 10000} {
+		b.Run(fmt.Sprintf("N=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ctx, cancel := context.WithCancel(context.Background())
+				d := &deployer{ctx: ctx, logger: logging.NewTestLogger(b, false)}
+				info := &protos.WeaveletArgs{
+					App:             "remoteweavelet_test.go",
+					DeploymentId:    fmt.Sprint(os.Getpid()),
+					InternalAddress: "localhost:0",
+				}
+				before := goruntime.NumGoroutine()
+				weavelets := make([]*weavelet, n)
+				for j := range weavelets {
+					info.Id = uuid.New().String()
+					w, err := spawn(ctx, info, d, envelope.Options{
+						TmpDir: b.TempDir(),
+						Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+					})
+					if err != nil {
+						b.Fatal(err)
+					}
+					weavelets[j] = w
+				}
+				b.ReportMetric(float64(goruntime.NumGoroutine()-before)/float64(n), "goroutines/weavelet")
+				cancel()
+				for _, w := range weavelets {
+					w.threads.Wait()
+				}
+			}
+		})
+	}
+}
+
+// tenantKey is the context key of the tenant in TestHandlerContext.
+type tenantKey struct{}
+
+// tenantRecorder is an EnvelopeHandler that records the tenant of the
+// contexts passed to ActivateComponent.
+type tenantRecorder struct {
+	*deployer
+	tenants chan any
+}
+
+// ActivateComponent implements the EnvelopeHandler interface.
+func (r *tenantRecorder) ActivateComponent(ctx context.Context, req *protos.ActivateComponentRequest) (*protos.ActivateComponentReply, error) {
+	r.tenants <- ctx.Value(tenantKey{})
+	return r.deployer.ActivateComponent(ctx, req)
+}
+
+func TestHandlerContext(t *testing.T) {
+	d := deploy(t, context.Background(), colocated)
+	defer d.shutdown()
+
+	handler := &tenantRecorder{deployer: d, tenants: make(chan any, 10)}
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	w, err := spawn(d.ctx, info, handler, envelope.Options{
+		TmpDir: t.TempDir(),
+		Logger: slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		HandlerContext: func(ctx context.Context) context.Context {
+			return context.WithValue(ctx, tenantKey{}, "tenant")
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.cancel()
+
+	// Starting a activates b, its dependency.
+	if err := w.env.UpdateComponents([]string{componenta}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case got := <-handler.tenants:
+		if got != "tenant" {
+			t.Fatalf("ActivateComponent: got tenant %v, want %q", got, "tenant")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for ActivateComponent")
+	}
+}
+
+// otlpRecorder is an EnvelopeHandler that exports trace spans with an
+// OTLPTraceHandler.
+type otlpRecorder struct {
+	*deployer
+	otlp *envelope.OTLPTraceHandler
+}
+
+// HandleTraceSpans implements the EnvelopeHandler interface.
+func (r *otlpRecorder) HandleTraceSpans(ctx context.Context, spans *protos.TraceSpans) error {
+	return r.otlp.HandleTraceSpans(ctx, spans)
+}
+
+func TestSpanResource(t *testing.T) {
+	ctx := context.Background()
+	d := deploy(t, ctx, colocated)
+	defer d.shutdown()
+
+	exporter := tracetest.NewInMemoryExporter()
+	otlp, err := envelope.NewOTLPTraceHandler(envelope.OTLPOptions{Exporter: exporter})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer otlp.Shutdown(ctx)
+
+	// The weavelet sends a span with a stale weavelet id, and an attribute
+	// of its own.
+	span := &protos.Span{
+		Name:         "span",
+		TraceId:      make([]byte, 16),
+		SpanId:       make([]byte, 8),
+		ParentSpanId: make([]byte, 8),
+		Resource: &protos.Span_Resource{Attributes: []*protos.Span_Attribute{
+			{Key: "serviceweaver.weavelet_id", Value: &protos.Span_Attribute_Value{
+				Type:  protos.Span_Attribute_Value_STRING,
+				Value: &protos.Span_Attribute_Value_Str{Str: "stale"},
+			}},
+			{Key: "custom", Value: &protos.Span_Attribute_Value{
+				Type:  protos.Span_Attribute_Value_STRING,
+				Value: &protos.Span_Attribute_Value_Str{Str: "value"},
+			}},
+		}},
+	}
+	w := scriptedweavelet.New(nil, scriptedweavelet.TraceSpans(span))
+	info := protomsg.Clone(d.info)
+	info.Id = uuid.New().String()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	env, err := envelope.NewEnvelope(ctx, info, &protos.AppConfig{}, envelope.Options{
+		TmpDir:       t.TempDir(),
+		Logger:       slog.New(&logging.LogHandler{Write: d.logger.Log}),
+		Child:        w,
+		SpanResource: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go env.Serve(&otlpRecorder{deployer: d, otlp: otlp})
+	select {
+	case <-w.Done():
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the script to end")
+	}
+	if err := w.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if err := otlp.ForceFlush(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("got %d exported spans, want 1", len(spans))
+	}
+	got := map[string]string{}
+	for _, kv := range spans[0].Resource.Attributes() {
+		got[string(kv.Key)] = kv.Value.Emit()
+	}
+	want := map[string]string{
+		"service.name":                info.App,
+		"service.instance.id":         info.Id,
+		"serviceweaver.app":           info.App,
+		"serviceweaver.deployment_id": info.DeploymentId,
+		"serviceweaver.weavelet_id":   info.Id,
+		"custom":                      "value",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("resource attributes (-want +got):\n%s", diff)
 	}
 }
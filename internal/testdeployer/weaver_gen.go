@@ -265,7 +265,9 @@ func (s a_client_stub) A(ctx context.Context, a0 int) (r0 int, err error) {
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/testdeployer/a", "A", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -277,11 +279,17 @@ func (s a_client_stub) A(ctx context.Context, a0 int) (r0 int, err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/testdeployer/a", "A", codegen.DecodeResults)
 	return
 }
 
@@ -329,7 +337,9 @@ func (s b_client_stub) B(ctx context.Context, a0 int) (r0 int, err error) {
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/testdeployer/b", "B", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -341,11 +351,17 @@ func (s b_client_stub) B(ctx context.Context, a0 int) (r0 int, err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/testdeployer/b", "B", codegen.DecodeResults)
 	return
 }
 
@@ -393,7 +409,9 @@ func (s c_client_stub) C(ctx context.Context, a0 int) (r0 int, err error) {
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/testdeployer/c", "C", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -405,11 +423,17 @@ func (s c_client_stub) C(ctx context.Context, a0 int) (r0 int, err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/testdeployer/c", "C", codegen.DecodeResults)
 	return
 }
 
@@ -460,11 +484,17 @@ func (s d_client_stub) D(ctx context.Context) (r0 string, err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.String()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/testdeployer/d", "D", codegen.DecodeResults)
 	return
 }
 
@@ -520,9 +550,11 @@ func (s a_server_stub) a(ctx context.Context, args []byte) (res []byte, err erro
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/testdeployer/a", "A", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -530,9 +562,11 @@ func (s a_server_stub) a(ctx context.Context, args []byte) (res []byte, err erro
 	r0, appErr := s.impl.A(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/testdeployer/a", "A", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -563,9 +597,11 @@ func (s b_server_stub) b(ctx context.Context, args []byte) (res []byte, err erro
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/testdeployer/b", "B", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -573,9 +609,11 @@ func (s b_server_stub) b(ctx context.Context, args []byte) (res []byte, err erro
 	r0, appErr := s.impl.B(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/testdeployer/b", "B", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -606,9 +644,11 @@ func (s c_server_stub) c(ctx context.Context, args []byte) (res []byte, err erro
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/testdeployer/c", "C", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -616,9 +656,11 @@ func (s c_server_stub) c(ctx context.Context, args []byte) (res []byte, err erro
 	r0, appErr := s.impl.C(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/testdeployer/c", "C", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -654,9 +696,11 @@ func (s d_server_stub) d(ctx context.Context, args []byte) (res []byte, err erro
 	r0, appErr := s.impl.D(ctx)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.String(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/testdeployer/d", "D", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
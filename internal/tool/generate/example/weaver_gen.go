@@ -215,6 +215,7 @@ func (s a_client_stub) M1(ctx context.Context, a0 int, a1 string, a2 bool, a3 [1
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(a0)
 	enc.String(a1)
@@ -223,6 +224,7 @@ func (s a_client_stub) M1(ctx context.Context, a0 int, a1 string, a2 bool, a3 [1
 	serviceweaver_enc_slice_string_4af10117(enc, a4)
 	serviceweaver_enc_map_bool_int_acb668fa(enc, a5)
 	(a6).WeaverMarshal(enc)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/A", "M1", codegen.EncodeArgs)
 
 	// Set the shardKey.
 	var r router
@@ -237,11 +239,17 @@ func (s a_client_stub) M1(ctx context.Context, a0 int, a1 string, a2 bool, a3 [1
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/A", "M1", codegen.DecodeResults)
 	return
 }
 
@@ -275,6 +283,7 @@ func (s a_client_stub) M2(ctx context.Context, a0 int, a1 string, a2 bool, a3 [1
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(a0)
 	enc.String(a1)
@@ -283,6 +292,7 @@ func (s a_client_stub) M2(ctx context.Context, a0 int, a1 string, a2 bool, a3 [1
 	serviceweaver_enc_slice_string_4af10117(enc, a4)
 	serviceweaver_enc_map_bool_int_acb668fa(enc, a5)
 	(a6).WeaverMarshal(enc)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/A", "M2", codegen.EncodeArgs)
 
 	// Set the shardKey.
 	var r router
@@ -297,11 +307,17 @@ func (s a_client_stub) M2(ctx context.Context, a0 int, a1 string, a2 bool, a3 [1
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/A", "M2", codegen.DecodeResults)
 	return
 }
 
@@ -344,6 +360,7 @@ func (s b_client_stub) M1(ctx context.Context, a0 int, a1 string, a2 bool, a3 [1
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(a0)
 	enc.String(a1)
@@ -352,6 +369,7 @@ func (s b_client_stub) M1(ctx context.Context, a0 int, a1 string, a2 bool, a3 [1
 	serviceweaver_enc_slice_string_4af10117(enc, a4)
 	serviceweaver_enc_map_bool_int_acb668fa(enc, a5)
 	(a6).WeaverMarshal(enc)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/B", "M1", codegen.EncodeArgs)
 
 	// Set the shardKey.
 	var r router
@@ -366,11 +384,17 @@ func (s b_client_stub) M1(ctx context.Context, a0 int, a1 string, a2 bool, a3 [1
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/B", "M1", codegen.DecodeResults)
 	return
 }
 
@@ -404,6 +428,7 @@ func (s b_client_stub) M2(ctx context.Context, a0 int, a1 string, a2 bool, a3 [1
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(a0)
 	enc.String(a1)
@@ -412,6 +437,7 @@ func (s b_client_stub) M2(ctx context.Context, a0 int, a1 string, a2 bool, a3 [1
 	serviceweaver_enc_slice_string_4af10117(enc, a4)
 	serviceweaver_enc_map_bool_int_acb668fa(enc, a5)
 	(a6).WeaverMarshal(enc)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/B", "M2", codegen.EncodeArgs)
 
 	// Set the shardKey.
 	var r router
@@ -426,11 +452,17 @@ func (s b_client_stub) M2(ctx context.Context, a0 int, a1 string, a2 bool, a3 [1
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/B", "M2", codegen.DecodeResults)
 	return
 }
 
@@ -488,6 +520,7 @@ func (s a_server_stub) m1(ctx context.Context, args []byte) (res []byte, err err
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
@@ -503,6 +536,7 @@ func (s a_server_stub) m1(ctx context.Context, args []byte) (res []byte, err err
 	a5 = serviceweaver_dec_map_bool_int_acb668fa(dec)
 	var a6 message
 	(&a6).WeaverUnmarshal(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/A", "M1", codegen.DecodeArgs)
 	var r router
 	s.addLoad(_hashA(r.M1(ctx, a0, a1, a2, a3, a4, a5, a6)), 1.0)
 
@@ -512,9 +546,11 @@ func (s a_server_stub) m1(ctx context.Context, args []byte) (res []byte, err err
 	r0, appErr := s.impl.M1(ctx, a0, a1, a2, a3, a4, a5, a6)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/A", "M1", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -527,6 +563,7 @@ func (s a_server_stub) m2(ctx context.Context, args []byte) (res []byte, err err
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
@@ -542,6 +579,7 @@ func (s a_server_stub) m2(ctx context.Context, args []byte) (res []byte, err err
 	a5 = serviceweaver_dec_map_bool_int_acb668fa(dec)
 	var a6 message
 	(&a6).WeaverUnmarshal(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/A", "M2", codegen.DecodeArgs)
 	var r router
 	s.addLoad(_hashA(r.M2(ctx, a0, a1, a2, a3, a4, a5, a6)), 1.0)
 
@@ -551,9 +589,11 @@ func (s a_server_stub) m2(ctx context.Context, args []byte) (res []byte, err err
 	r0, appErr := s.impl.M2(ctx, a0, a1, a2, a3, a4, a5, a6)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/A", "M2", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -586,6 +626,7 @@ func (s b_server_stub) m1(ctx context.Context, args []byte) (res []byte, err err
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
@@ -601,6 +642,7 @@ func (s b_server_stub) m1(ctx context.Context, args []byte) (res []byte, err err
 	a5 = serviceweaver_dec_map_bool_int_acb668fa(dec)
 	var a6 message
 	(&a6).WeaverUnmarshal(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/B", "M1", codegen.DecodeArgs)
 	var r router
 	s.addLoad(_hashB(r.M1(ctx, a0, a1, a2, a3, a4, a5, a6)), 1.0)
 
@@ -610,9 +652,11 @@ func (s b_server_stub) m1(ctx context.Context, args []byte) (res []byte, err err
 	r0, appErr := s.impl.M1(ctx, a0, a1, a2, a3, a4, a5, a6)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/B", "M1", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -625,6 +669,7 @@ func (s b_server_stub) m2(ctx context.Context, args []byte) (res []byte, err err
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
@@ -640,6 +685,7 @@ func (s b_server_stub) m2(ctx context.Context, args []byte) (res []byte, err err
 	a5 = serviceweaver_dec_map_bool_int_acb668fa(dec)
 	var a6 message
 	(&a6).WeaverUnmarshal(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/B", "M2", codegen.DecodeArgs)
 	var r router
 	s.addLoad(_hashB(r.M2(ctx, a0, a1, a2, a3, a4, a5, a6)), 1.0)
 
@@ -649,9 +695,11 @@ func (s b_server_stub) m2(ctx context.Context, args []byte) (res []byte, err err
 	r0, appErr := s.impl.M2(ctx, a0, a1, a2, a3, a4, a5, a6)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/internal/tool/generate/example/B", "M2", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -1463,6 +1463,7 @@ func (g *generator) generateClientStubs(p printFn) {
 			if mt.Params().Len() > 1 {
 				p(``)
 				p(`	// Encode arguments.`)
+				p(`	encStart := %s()`, g.codegen().qualify("BeginSerialization"))
 				if !preallocated {
 					p("	enc := %s", g.codegen().qualify("NewEncoder()"))
 				}
@@ -1472,6 +1473,9 @@ func (g *generator) generateClientStubs(p printFn) {
 				arg := fmt.Sprintf("a%d", i-1)
 				p(`	%s`, g.encode("enc", arg, at))
 			}
+			if mt.Params().Len() > 1 {
+				p(`	%s(encStart, %q, %q, %s)`, g.codegen().qualify("EndSerialization"), comp.fullIntfName(), m.Name(), g.codegen().qualify("EncodeArgs"))
+			}
 
 			// Set the routing key, if there is one.
 			if comp.routedMethods[m.Name()] {
@@ -1504,11 +1508,16 @@ func (g *generator) generateClientStubs(p printFn) {
 			p(`		err = %s(%s, err)`, g.errorsPackage().qualify("Join"), g.weaver().qualify("RemoteCallError"))
 			p(`		return`)
 			p(`	}`)
+			p(`	if results == nil {`)
+			p(`		// The call was sent without waiting for a reply.`)
+			p(`		return`)
+			p(`	}`)
 
 			// Invoke call.Decode.
 			b.Reset()
 			p(``)
 			p(`	// Decode the results.`)
+			p(`	decStart := %s()`, g.codegen().qualify("BeginSerialization"))
 			p(`	dec := %s(results)`, g.codegen().qualify("NewDecoder"))
 			for i := 0; i < mt.Results().Len()-1; i++ { // Skip final error
 				rt := mt.Results().At(i).Type()
@@ -1527,6 +1536,7 @@ func (g *generator) generateClientStubs(p printFn) {
 				}
 			}
 			p(`	err = dec.Error()`)
+			p(`	%s(decStart, %q, %q, %s)`, g.codegen().qualify("EndSerialization"), comp.fullIntfName(), m.Name(), g.codegen().qualify("DecodeResults"))
 
 			p(`	return`)
 			p(`}`)
@@ -1892,6 +1902,7 @@ func (g *generator) generateServerStubs(p printFn) {
 			if mt.Params().Len() > 1 {
 				p(``)
 				p(`	// Decode arguments.`)
+				p(`	decStart := %s()`, g.codegen().qualify("BeginSerialization"))
 				p(`	dec := %s(args)`, g.codegen().qualify("NewDecoder"))
 			}
 			b.Reset()
@@ -1912,6 +1923,9 @@ func (g *generator) generateServerStubs(p printFn) {
 					p(`	%s`, g.decode("dec", ref(arg), at))
 				}
 			}
+			if mt.Params().Len() > 1 {
+				p(`	%s(decStart, %q, %q, %s)`, g.codegen().qualify("EndSerialization"), comp.fullIntfName(), m.Name(), g.codegen().qualify("DecodeArgs"))
+			}
 
 			b.Reset()
 			fmt.Fprintf(&b, "ctx")
@@ -1954,6 +1968,7 @@ func (g *generator) generateServerStubs(p printFn) {
 
 			p(``)
 			p(`	// Encode the results.`)
+			p(`	encStart := %s()`, g.codegen().qualify("BeginSerialization"))
 			p(` enc := %s()`, g.codegen().qualify("NewEncoder"))
 
 			b.Reset()
@@ -1963,6 +1978,7 @@ func (g *generator) generateServerStubs(p printFn) {
 				p(`	%s`, g.encode("enc", res, rt))
 			}
 			p(`	enc.Error(appErr)`)
+			p(`	%s(encStart, %q, %q, %s)`, g.codegen().qualify("EndSerialization"), comp.fullIntfName(), m.Name(), g.codegen().qualify("EncodeResults"))
 			p(`	return enc.Data(), nil`)
 			p(`}`)
 		}
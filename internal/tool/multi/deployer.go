@@ -97,6 +97,9 @@ type proxyInfo struct {
 	listener string       // listener associated with the proxy
 	proxy    *proxy.Proxy // the proxy
 	addr     string       // dialable address of the proxy
+
+	// Tokens of the exported backends (see ExportListenerRequest.token).
+	tokens map[string]bool
 }
 
 // handler handles a connection to a weavelet.
@@ -563,7 +566,10 @@ func (d *deployer) ExportListener(_ context.Context, req *protos.ExportListenerR
 
 	// Update the proxy.
 	if p, ok := d.proxies[req.Listener]; ok {
-		p.proxy.AddBackend(req.Address)
+		if req.Token == "" || !p.tokens[req.Token] {
+			p.proxy.AddBackend(req.Address)
+			p.tokens[req.Token] = true
+		}
 		return &protos.ExportListenerReply{ProxyAddress: p.addr}, nil
 	}
 
@@ -590,6 +596,7 @@ func (d *deployer) ExportListener(_ context.Context, req *protos.ExportListenerR
 		listener: req.Listener,
 		proxy:    proxy,
 		addr:     addr,
+		tokens:   map[string]bool{req.Token: true},
 	}
 	go func() {
 		if err := serveHTTP(d.ctx, lis, proxy); err != nil {
@@ -137,6 +137,9 @@ type proxyInfo struct {
 	listener string       // listener name
 	proxy    *proxy.Proxy // the proxy
 	addr     string       // dialable address of the proxy
+
+	// Tokens of the exported backends (see ExportListenerRequest.token).
+	tokens map[string]bool
 }
 
 type groupReplicaInfo struct {
@@ -494,7 +497,10 @@ func (m *manager) exportListener(_ context.Context, req *protos.ExportListenerRe
 
 	// Update the proxy.
 	if p, ok := m.proxies[req.Listener]; ok {
-		p.proxy.AddBackend(req.Address)
+		if req.Token == "" || !p.tokens[req.Token] {
+			p.proxy.AddBackend(req.Address)
+			p.tokens[req.Token] = true
+		}
 		return &protos.ExportListenerReply{ProxyAddress: p.addr}, nil
 	}
 
@@ -521,6 +527,7 @@ func (m *manager) exportListener(_ context.Context, req *protos.ExportListenerRe
 		listener: req.Listener,
 		proxy:    proxy,
 		addr:     addr,
+		tokens:   map[string]bool{req.Token: true},
 	}
 	go func() {
 		if err := serveHTTP(m.ctx, lis, proxy); err != nil {
@@ -0,0 +1,55 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Checkpointer checkpoints and restores the state of a component
+// implementation. impl is a pointer to the implementation struct.
+type Checkpointer struct {
+	Checkpoint func(ctx context.Context, impl any) ([]byte, error)
+	Restore    func(ctx context.Context, impl any, state []byte) error
+}
+
+var (
+	checkpointersMu sync.Mutex
+	checkpointers   = map[reflect.Type]Checkpointer{} // by component impl type
+)
+
+// RegisterCheckpointer registers the checkpointer for the component
+// implemented by the provided implementation struct type. It panics if a
+// checkpointer has already been registered for the type.
+func RegisterCheckpointer(impl reflect.Type, c Checkpointer) {
+	checkpointersMu.Lock()
+	defer checkpointersMu.Unlock()
+	if _, ok := checkpointers[impl]; ok {
+		panic(fmt.Sprintf("checkpointer for %v already registered", impl))
+	}
+	checkpointers[impl] = c
+}
+
+// getCheckpointer returns the checkpointer registered for the provided
+// implementation struct type, if any.
+func getCheckpointer(impl reflect.Type) (Checkpointer, bool) {
+	checkpointersMu.Lock()
+	defer checkpointersMu.Unlock()
+	c, ok := checkpointers[impl]
+	return c, ok
+}
@@ -0,0 +1,201 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/internal/net/call"
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultOpenDuration is the duration a circuit breaker stays open if
+// CircuitBreaker.open_duration_ns is not positive.
+const defaultOpenDuration = 10 * time.Second
+
+// ErrCircuitOpen is returned by method calls on a component whose circuit
+// breaker is open. See CircuitBreaker in runtime/protos/runtime.proto.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// circuitBreaker is a circuit breaker for the remote calls to a component.
+// The breaker opens after a number of consecutive failed calls, and calls
+// fail fast while it is open. Once it has been open for a while, the breaker
+// is half-open: it lets a single probe call through, and it closes if the
+// probe succeeds and opens again otherwise. The zero value is a disabled
+// breaker that allows all calls.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	config    *protos.CircuitBreaker // nil if disabled
+	threshold int                    // consecutive failures that open the breaker
+	openFor   time.Duration          // how long the breaker stays open
+	state     protos.CircuitBreakerState_State
+	failures  int       // consecutive failures, while closed
+	opened    time.Time // when the breaker last opened
+	probing   bool      // is a probe in flight?
+}
+
+// configure updates the breaker's parameters. A nil config disables the
+// breaker. The state of the breaker is kept unless the config changes.
+func (b *circuitBreaker) configure(config *protos.CircuitBreaker) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if proto.Equal(b.config, config) {
+		// Routing info is updated often, usually with the same breaker.
+		return
+	}
+	b.config = config
+	b.state = protos.CircuitBreakerState_CLOSED
+	b.failures = 0
+	b.opened = time.Time{}
+	b.probing = false
+	if config == nil {
+		return
+	}
+	b.threshold = int(config.FailureThreshold)
+	b.openFor = time.Duration(config.OpenDurationNs)
+	if b.openFor <= 0 {
+		b.openFor = defaultOpenDuration
+	}
+}
+
+// allow returns whether a call may be issued at the provided time, and
+// whether the call is a probe. The outcome of every allowed call must be
+// passed to done.
+func (b *circuitBreaker) allow(now time.Time) (ok bool, probe bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch {
+	case b.config == nil:
+		return true, false
+	case b.config.ForceOpen:
+		return false, false
+	}
+	switch b.state {
+	case protos.CircuitBreakerState_OPEN:
+		if now.Sub(b.opened) < b.openFor {
+			return false, false
+		}
+		b.state = protos.CircuitBreakerState_HALF_OPEN
+		fallthrough
+	case protos.CircuitBreakerState_HALF_OPEN:
+		if b.probing {
+			return false, false
+		}
+		b.probing = true
+		return true, true
+	default:
+		return true, false
+	}
+}
+
+// done records the outcome of a call allowed by allow. Calls that ended
+// without an outcome (e.g., because the caller cancelled them) are neither
+// successes nor failures.
+func (b *circuitBreaker) done(now time.Time, probe bool, failed, cancelled bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.config == nil || b.config.ForceOpen {
+		return
+	}
+	if probe {
+		if b.state != protos.CircuitBreakerState_HALF_OPEN || !b.probing {
+			// The breaker was reconfigured while the probe was in flight.
+			return
+		}
+		b.probing = false
+		switch {
+		case cancelled:
+			// Let the next call probe.
+		case failed:
+			b.open(now)
+		default:
+			b.state = protos.CircuitBreakerState_CLOSED
+			b.failures = 0
+		}
+		return
+	}
+	if b.state != protos.CircuitBreakerState_CLOSED || cancelled {
+		// Calls issued before the breaker opened don't affect it.
+		return
+	}
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.threshold > 0 && b.failures >= b.threshold {
+		b.open(now)
+	}
+}
+
+// open opens the breaker at the provided time.
+//
+// REQUIRES: b.mu is held.
+func (b *circuitBreaker) open(now time.Time) {
+	b.state = protos.CircuitBreakerState_OPEN
+	b.failures = 0
+	b.opened = now
+}
+
+// snapshot returns the state of the breaker at the provided time, or nil if
+// the breaker is disabled.
+func (b *circuitBreaker) snapshot(now time.Time) *protos.CircuitBreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.config == nil {
+		return nil
+	}
+	if b.config.ForceOpen {
+		return &protos.CircuitBreakerState{State: protos.CircuitBreakerState_OPEN}
+	}
+	state := &protos.CircuitBreakerState{
+		State:               b.state,
+		ConsecutiveFailures: int32(b.failures),
+	}
+	if b.state == protos.CircuitBreakerState_OPEN && now.Sub(b.opened) >= b.openFor {
+		// The next call is a probe.
+		state.State = protos.CircuitBreakerState_HALF_OPEN
+	}
+	if !b.opened.IsZero() {
+		state.OpenedUnixMicros = b.opened.UnixMicro()
+	}
+	return state
+}
+
+// circuitBreakerStub is a codegen.Stub that rejects calls while the circuit
+// breaker of a component is open.
+type circuitBreakerStub struct {
+	codegen.Stub
+	component string
+	breaker   *circuitBreaker
+	clock     call.Clock
+}
+
+// Run implements the codegen.Stub interface.
+func (s circuitBreakerStub) Run(ctx context.Context, method int, args []byte, shardKey uint64) ([]byte, error) {
+	ok, probe := s.breaker.allow(s.clock.Now())
+	if !ok {
+		return nil, fmt.Errorf("component %q: %w", s.component, ErrCircuitOpen)
+	}
+	result, err := s.Stub.Run(ctx, method, args, shardKey)
+	s.breaker.done(s.clock.Now(), probe, err != nil, err != nil && ctx.Err() != nil)
+	return result, err
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var b circuitBreaker
+	now := time.Now()
+
+	// call issues a call at the provided time, if allowed, and records its
+	// outcome.
+	call := func(now time.Time, failed bool) bool {
+		t.Helper()
+		ok, probe := b.allow(now)
+		if ok {
+			b.done(now, probe, failed, false)
+		}
+		return ok
+	}
+	state := func(now time.Time) protos.CircuitBreakerState_State {
+		t.Helper()
+		s := b.snapshot(now)
+		if s == nil {
+			t.Fatal("snapshot: nil state")
+		}
+		return s.State
+	}
+
+	// A disabled breaker allows all calls.
+	for i := 0; i < 10; i++ {
+		if !call(now, true) {
+			t.Fatalf("call %d: rejected by disabled breaker", i)
+		}
+	}
+	if s := b.snapshot(now); s != nil {
+		t.Fatalf("snapshot: got %v, want nil", s)
+	}
+
+	// Three consecutive failures open the breaker. Successes reset the count.
+	b.configure(&protos.CircuitBreaker{FailureThreshold: 3, OpenDurationNs: int64(time.Second)})
+	call(now, true)
+	call(now, true)
+	call(now, false)
+	call(now, true)
+	call(now, true)
+	if got, want := state(now), protos.CircuitBreakerState_CLOSED; got != want {
+		t.Fatalf("state: got %v, want %v", got, want)
+	}
+	call(now, true)
+	if got, want := state(now), protos.CircuitBreakerState_OPEN; got != want {
+		t.Fatalf("state: got %v, want %v", got, want)
+	}
+	if call(now.Add(500*time.Millisecond), false) {
+		t.Fatal("call allowed by open breaker")
+	}
+
+	// Once the open duration elapses, a single probe is allowed.
+	now = now.Add(time.Second)
+	if got, want := state(now), protos.CircuitBreakerState_HALF_OPEN; got != want {
+		t.Fatalf("state: got %v, want %v", got, want)
+	}
+	ok, probe := b.allow(now)
+	if !ok || !probe {
+		t.Fatalf("allow: got (%t, %t), want (true, true)", ok, probe)
+	}
+	if ok, _ := b.allow(now); ok {
+		t.Fatal("second call allowed while probing")
+	}
+
+	// A failed probe opens the breaker again.
+	b.done(now, true, true, false)
+	if got, want := state(now), protos.CircuitBreakerState_OPEN; got != want {
+		t.Fatalf("state: got %v, want %v", got, want)
+	}
+
+	// A cancelled probe lets the next call probe.
+	now = now.Add(time.Second)
+	ok, probe = b.allow(now)
+	if !ok || !probe {
+		t.Fatalf("allow: got (%t, %t), want (true, true)", ok, probe)
+	}
+	b.done(now, true, true, true)
+	if got, want := state(now), protos.CircuitBreakerState_HALF_OPEN; got != want {
+		t.Fatalf("state: got %v, want %v", got, want)
+	}
+
+	// A successful probe closes the breaker.
+	if !call(now, false) {
+		t.Fatal("probe rejected")
+	}
+	if got, want := state(now), protos.CircuitBreakerState_CLOSED; got != want {
+		t.Fatalf("state: got %v, want %v", got, want)
+	}
+
+	// A forced breaker rejects all calls, and never probes.
+	b.configure(&protos.CircuitBreaker{ForceOpen: true})
+	if call(now.Add(time.Hour), false) {
+		t.Fatal("call allowed by forced breaker")
+	}
+	if got, want := state(now), protos.CircuitBreakerState_OPEN; got != want {
+		t.Fatalf("state: got %v, want %v", got, want)
+	}
+
+	// Disabling the breaker allows all calls.
+	b.configure(nil)
+	if !call(now, false) {
+		t.Fatal("call rejected after disabling breaker")
+	}
+}
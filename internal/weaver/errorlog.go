@@ -0,0 +1,74 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// errorLogCapacity is the number of error log entries kept by an errorLog.
+const errorLogCapacity = 100
+
+// errorLog is a ring buffer of the most recent error log entries of a
+// weavelet (see GetRecentErrors).
+type errorLog struct {
+	mu      sync.Mutex
+	entries [errorLogCapacity]*protos.LogEntry
+	next    int // index of the slot for the next entry
+	n       int // number of entries in the buffer
+}
+
+// add records the provided entry, if it is an error log entry, evicting
+// the oldest entry if the buffer is full.
+func (l *errorLog) add(entry *protos.LogEntry) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(entry.Level)); err != nil || level < slog.LevelError {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries[l.next] = entry
+	l.next = (l.next + 1) % errorLogCapacity
+	if l.n < errorLogCapacity {
+		l.n++
+	}
+}
+
+// recent returns the n most recent entries, oldest first, or all of the
+// entries if n is not positive.
+func (l *errorLog) recent(n int) []*protos.LogEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if n <= 0 || n > l.n {
+		n = l.n
+	}
+	entries := make([]*protos.LogEntry, n)
+	for i := range entries {
+		entries[i] = l.entries[(l.next-n+i+errorLogCapacity)%errorLogCapacity]
+	}
+	return entries
+}
+
+// clear removes all entries.
+func (l *errorLog) clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	clear(l.entries[:])
+	l.next = 0
+	l.n = 0
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package weaver
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// getFDCount returns the number of file descriptors opened by this process,
+// along with the process's limits on open file descriptors.
+func getFDCount() (*protos.GetFDCountReply, error) {
+	// /proc/self/fd is specific to Linux. Other Unix systems (e.g., macOS)
+	// provide /dev/fd instead.
+	var count int
+	var err error
+	for _, dir := range []string{"/proc/self/fd", "/dev/fd"} {
+		var entries []os.DirEntry
+		entries, err = os.ReadDir(dir)
+		if err == nil {
+			// Don't count the file descriptor used to read the directory.
+			count = len(entries) - 1
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("count file descriptors: %w", err)
+	}
+
+	var limit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &limit); err != nil {
+		return nil, fmt.Errorf("get file descriptor limit: %w", err)
+	}
+	return &protos.GetFDCountReply{
+		Count:     int64(count),
+		SoftLimit: uint64(limit.Cur),
+		HardLimit: uint64(limit.Max),
+	}, nil
+}
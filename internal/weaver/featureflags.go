@@ -0,0 +1,71 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/ServiceWeaver/weaver/internal/control"
+)
+
+// FeatureFlag is a named string value that can be overridden at runtime.
+type FeatureFlag struct {
+	name  string
+	value atomic.Pointer[string]
+}
+
+// Name returns the name of the flag.
+func (f *FeatureFlag) Name() string {
+	return f.name
+}
+
+// Value returns the current value of the flag.
+func (f *FeatureFlag) Value() string {
+	return *f.value.Load()
+}
+
+var featureFlags = newRegistry[*FeatureFlag]("feature flag")
+
+// RegisterFeatureFlag registers a feature flag with the provided name and
+// initial value. It panics if a flag with the same name has already been
+// registered.
+func RegisterFeatureFlag(name, value string) *FeatureFlag {
+	f := &FeatureFlag{name: name}
+	f.value.Store(&value)
+	featureFlags.register(name, f)
+	return f
+}
+
+// getFeatureFlags returns the current values of the registered feature flags.
+func getFeatureFlags() map[string]string {
+	flags := featureFlags.all()
+	values := make(map[string]string, len(flags))
+	for name, f := range flags {
+		values[name] = f.Value()
+	}
+	return values
+}
+
+// setFeatureFlag sets the value of the registered feature flag with the
+// provided name.
+func setFeatureFlag(name, value string) error {
+	f, ok := featureFlags.get(name)
+	if !ok {
+		return fmt.Errorf("feature flag %q: %w", name, control.ErrUnknownFeatureFlag)
+	}
+	f.value.Store(&value)
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// HealthCheck is a named check of the health of a component implementation.
+// impl is a pointer to the implementation struct. Check returns an error if
+// the component is unhealthy.
+type HealthCheck struct {
+	Name  string
+	Check func(ctx context.Context, impl any) error
+}
+
+var (
+	healthChecksMu sync.Mutex
+	healthChecks   = map[reflect.Type][]HealthCheck{} // by component impl type
+)
+
+// RegisterHealthCheck registers a health check for the component implemented
+// by the provided implementation struct type. It panics if a check with the
+// same name has already been registered for the type.
+func RegisterHealthCheck(impl reflect.Type, c HealthCheck) {
+	healthChecksMu.Lock()
+	defer healthChecksMu.Unlock()
+	for _, existing := range healthChecks[impl] {
+		if existing.Name == c.Name {
+			panic(fmt.Sprintf("health check %q for %v already registered", c.Name, impl))
+		}
+	}
+	healthChecks[impl] = append(healthChecks[impl], c)
+}
+
+// getHealthChecks returns the health checks registered for the provided
+// implementation struct type, in registration order.
+func getHealthChecks(impl reflect.Type) []HealthCheck {
+	healthChecksMu.Lock()
+	defer healthChecksMu.Unlock()
+	return slices.Clone(healthChecks[impl])
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// Defaults for the unspecified fields of a ListenerHealthCheck.
+const (
+	defaultHealthCheckPath     = "/debug/weaver/healthz" // see weaver.HealthzURL
+	defaultHealthCheckStatus   = http.StatusOK
+	defaultHealthCheckInterval = 10 * time.Second
+)
+
+// ListenerHealthCheck configures the health check of a listener.
+type ListenerHealthCheck struct {
+	Path     string        `toml:"path"`     // URL path to request
+	Status   int           `toml:"status"`   // HTTP status code of a healthy reply
+	Interval time.Duration `toml:"interval"` // interval between checks
+}
+
+// ListenerHealthChecks configures the health checks of the listeners of a
+// component, by listener name.
+type ListenerHealthChecks map[string]ListenerHealthCheck
+
+// listenerHealthCheck returns the health check configured for the provided
+// listener, if any.
+func (l ListenerHealthChecks) listenerHealthCheck(name string) (ListenerHealthCheck, bool) {
+	c, ok := l[name]
+	return c, ok
+}
+
+// healthCheckConfig is implemented by component configs that embed
+// ListenerHealthChecks.
+type healthCheckConfig interface {
+	listenerHealthCheck(name string) (ListenerHealthCheck, bool)
+}
+
+// listenerHealthCheck returns the health check of the provided listener,
+// derived from the provided component config, which may be nil, with defaults
+// for the unspecified fields.
+func listenerHealthCheck(config any, name string) (*protos.ListenerHealthCheck, error) {
+	var c ListenerHealthCheck
+	if h, ok := config.(healthCheckConfig); ok {
+		c, _ = h.listenerHealthCheck(name)
+	}
+	if c.Path == "" {
+		c.Path = defaultHealthCheckPath
+	}
+	if c.Status == 0 {
+		c.Status = defaultHealthCheckStatus
+	}
+	if c.Interval == 0 {
+		c.Interval = defaultHealthCheckInterval
+	}
+	if c.Path[0] != '/' {
+		return nil, fmt.Errorf("listener %q: health check path %q doesn't start with /", name, c.Path)
+	}
+	if c.Status < 100 || c.Status > 599 {
+		return nil, fmt.Errorf("listener %q: invalid health check status %d", name, c.Status)
+	}
+	if c.Interval < 0 {
+		return nil, fmt.Errorf("listener %q: negative health check interval %v", name, c.Interval)
+	}
+	return &protos.ListenerHealthCheck{
+		Path:       c.Path,
+		Status:     int32(c.Status),
+		IntervalNs: int64(c.Interval),
+	}, nil
+}
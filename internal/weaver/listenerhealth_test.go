@@ -0,0 +1,108 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"testing"
+
+	"github.com/ServiceWeaver/weaver/runtime"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// healthConfig is a component config with listener health checks.
+type healthConfig struct {
+	Size                 int
+	ListenerHealthChecks `toml:"health_checks"`
+}
+
+func TestListenerHealthCheck(t *testing.T) {
+	const section = `
+Size = 10
+
+[health_checks.api]
+path = "/ready"
+status = 204
+interval = "5s"
+
+[health_checks.admin]
+path = "/admin/healthz"
+`
+	config := &healthConfig{}
+	sections := map[string]string{"component": section}
+	if err := runtime.ParseConfigSection("component", "", sections, config); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name     string
+		config   any
+		listener string
+		want     *protos.ListenerHealthCheck
+	}{
+		{
+			name:     "Configured",
+			config:   config,
+			listener: "api",
+			want:     &protos.ListenerHealthCheck{Path: "/ready", Status: 204, IntervalNs: 5e9},
+		},
+		{
+			name:     "PartiallyConfigured",
+			config:   config,
+			listener: "admin",
+			want:     &protos.ListenerHealthCheck{Path: "/admin/healthz", Status: 200, IntervalNs: 10e9},
+		},
+		{
+			name:     "NotConfigured",
+			config:   config,
+			listener: "other",
+			want:     &protos.ListenerHealthCheck{Path: "/debug/weaver/healthz", Status: 200, IntervalNs: 10e9},
+		},
+		{
+			name:     "NoConfig",
+			listener: "api",
+			want:     &protos.ListenerHealthCheck{Path: "/debug/weaver/healthz", Status: 200, IntervalNs: 10e9},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := listenerHealthCheck(test.config, test.listener)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("listenerHealthCheck (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInvalidListenerHealthCheck(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		check ListenerHealthCheck
+	}{
+		{"RelativePath", ListenerHealthCheck{Path: "healthz"}},
+		{"InvalidStatus", ListenerHealthCheck{Status: 42}},
+		{"NegativeInterval", ListenerHealthCheck{Interval: -1}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			config := &healthConfig{ListenerHealthChecks: ListenerHealthChecks{"api": test.check}}
+			if _, err := listenerHealthCheck(config, "api"); err == nil {
+				t.Fatal("unexpected success")
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"sync/atomic"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// listenerTLS holds the TLS configuration of a listener exported with TLS. The
+// configuration can be rotated while the listener is serving.
+type listenerTLS struct {
+	config atomic.Pointer[tls.Config] // current configuration
+}
+
+// newListenerTLS returns a new listenerTLS with the provided material.
+func newListenerTLS(material *protos.ListenerTLS) (*listenerTLS, error) {
+	l := &listenerTLS{}
+	if err := l.update(material); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// update replaces the TLS material used for new connections.
+func (l *listenerTLS) update(material *protos.ListenerTLS) error {
+	config, err := tlsConfig(material)
+	if err != nil {
+		return err
+	}
+	l.config.Store(config)
+	return nil
+}
+
+// wrap returns a listener that serves TLS connections on top of lis, using the
+// latest TLS material for every new connection.
+func (l *listenerTLS) wrap(lis net.Listener) net.Listener {
+	return tls.NewListener(lis, &tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return l.config.Load(), nil
+		},
+	})
+}
+
+// tlsConfig returns the server TLS config for the provided material.
+func tlsConfig(material *protos.ListenerTLS) (*tls.Config, error) {
+	if material == nil {
+		return nil, fmt.Errorf("nil TLS material")
+	}
+	cert, err := tls.X509KeyPair(material.Cert, material.Key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TLS certificate: %w", err)
+	}
+	config := &tls.Config{Certificates: []tls.Certificate{cert}}
+	if len(material.Ca) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(material.Ca) {
+			return nil, fmt.Errorf("invalid TLS CA certificates")
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"bytes"
+	"crypto/tls"
+	"net"
+	"testing"
+
+	"github.com/ServiceWeaver/weaver/internal/tool/certs"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// newTestListenerTLS returns new self-signed TLS material and the DER encoding
+// of its certificate.
+func newTestListenerTLS(t *testing.T) (*protos.ListenerTLS, []byte) {
+	t.Helper()
+	cert, key, err := certs.GenerateCACert()
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, keyPEM, err := certs.PEMEncode(cert, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &protos.ListenerTLS{Cert: certPEM, Key: keyPEM}, cert.Raw
+}
+
+// servedCert dials lis and returns the DER encoding of the certificate served
+// by it.
+func servedCert(t *testing.T, lis net.Listener) []byte {
+	t.Helper()
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.(*tls.Conn).Handshake()
+	}()
+	conn, err := tls.Dial("tcp", lis.Addr().String(), &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	return conn.ConnectionState().PeerCertificates[0].Raw
+}
+
+func TestListenerTLSRotation(t *testing.T) {
+	first, firstCert := newTestListenerTLS(t)
+	l, err := newListenerTLS(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inner, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis := l.wrap(inner)
+	defer lis.Close()
+
+	if got := servedCert(t, lis); !bytes.Equal(got, firstCert) {
+		t.Fatal("listener does not serve the initial certificate")
+	}
+
+	// Rotate the TLS material.
+	second, secondCert := newTestListenerTLS(t)
+	if err := l.update(second); err != nil {
+		t.Fatal(err)
+	}
+	if got := servedCert(t, lis); !bytes.Equal(got, secondCert) {
+		t.Fatal("listener does not serve the rotated certificate")
+	}
+}
+
+func TestListenerTLSInvalidMaterial(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		material *protos.ListenerTLS
+	}{
+		{"Nil", nil},
+		{"Empty", &protos.ListenerTLS{}},
+		{"BadCA", &protos.ListenerTLS{Ca: []byte("not a certificate")}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if test.name == "BadCA" {
+				valid, _ := newTestListenerTLS(t)
+				test.material.Cert, test.material.Key = valid.Cert, valid.Key
+			}
+			if _, err := newListenerTLS(test.material); err == nil {
+				t.Fatal("unexpected success")
+			}
+		})
+	}
+}
@@ -18,7 +18,10 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sync"
+	"sync/atomic"
 
+	"github.com/ServiceWeaver/weaver/internal/cond"
 	"github.com/ServiceWeaver/weaver/runtime/logging"
 	"github.com/ServiceWeaver/weaver/runtime/protos"
 )
@@ -29,6 +32,12 @@ type remoteLogger struct {
 	c        chan *protos.LogEntry
 	fallback io.Writer              // Fallback destination when dst() returns an error
 	pp       *logging.PrettyPrinter // Used when sending to dst fails
+	logged   atomic.Uint64          // Number of entries passed to log()
+	errors   errorLog               // Recent error entries passed to log()
+
+	mu   sync.Mutex
+	sent uint64     // Number of entries passed to dst() or written to fallback
+	done *cond.Cond // Signalled when sent increases
 }
 
 const logBufferCount = 1000
@@ -39,14 +48,50 @@ func newRemoteLogger(fallback io.Writer) *remoteLogger {
 		fallback: fallback,
 		pp:       logging.NewPrettyPrinter(false),
 	}
+	rl.done = cond.NewCond(&rl.mu)
 	return rl
 }
 
 func (rl *remoteLogger) log(entry *protos.LogEntry) {
 	// TODO(sanjay): Drop if too many entries are buffered?
+	rl.logged.Add(1)
+	rl.errors.add(entry)
 	rl.c <- entry
 }
 
+// flush blocks until all entries passed to log() before the call to flush
+// have been sent, or until ctx is done.
+func (rl *remoteLogger) flush(ctx context.Context) error {
+	// Entries are sent in the order they are received from rl.c, and every
+	// entry passed to log() before now has been either received or counted
+	// as logged before any later entry, so it has been sent once sent
+	// reaches the number of logged entries.
+	watermark := rl.logged.Load()
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	for rl.sent < watermark {
+		if err := rl.done.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sequenceBatches returns a function that stamps the batches passed to it
+// with increasing sequence numbers, starting at 1, before passing them to dst.
+// See LogEntryBatch.seq.
+//
+// REQUIRES: The returned function is not called concurrently, as is the case
+// for the dst passed to run.
+func sequenceBatches(dst func(context.Context, *protos.LogEntryBatch) error) func(context.Context, *protos.LogEntryBatch) error {
+	var seq uint64
+	return func(ctx context.Context, batch *protos.LogEntryBatch) error {
+		seq++
+		batch.Seq = seq
+		return dst(ctx, batch)
+	}
+}
+
 // run collects log entries passed to log() and, and passes theme to dst. At
 // most one call to dst is outstanding at a time. Log entries that arrive while
 // a call is in progress are buffered and sent in the next call.
@@ -80,6 +125,10 @@ func (rl *remoteLogger) run(ctx context.Context, dst func(context.Context, *prot
 					fmt.Fprintln(rl.fallback, rl.pp.Format(e))
 				}
 			}
+			rl.mu.Lock()
+			rl.sent += uint64(len(batch.Entries))
+			rl.mu.Unlock()
+			rl.done.Broadcast()
 			batch.Entries = batch.Entries[:0]
 		}
 	}
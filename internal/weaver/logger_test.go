@@ -101,6 +101,76 @@ func TestLoggerError(t *testing.T) {
 	}
 }
 
+func TestLoggerFlush(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var got []string
+	rl := newRemoteLogger(os.Stderr)
+	go rl.run(ctx, func(ctx context.Context, batch *protos.LogEntryBatch) error {
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		for _, e := range batch.Entries {
+			got = append(got, e.Msg)
+		}
+		return nil
+	})
+
+	var want []string
+	for i := 0; i < 100; i++ {
+		msg := fmt.Sprint(i)
+		want = append(want, msg)
+		rl.log(&protos.LogEntry{Msg: msg})
+	}
+	if err := rl.flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("flush (-want,+got):\n%s", diff)
+	}
+}
+
+func TestLoggerRecentErrors(t *testing.T) {
+	rl := newRemoteLogger(os.Stderr)
+	msgs := func(entries []*protos.LogEntry) []string {
+		var got []string
+		for _, e := range entries {
+			got = append(got, e.Msg)
+		}
+		return got
+	}
+
+	// Only error entries are kept, and only the most recent ones.
+	var want []string
+	for i := 0; i < errorLogCapacity+10; i++ {
+		msg := fmt.Sprint(i)
+		rl.errors.add(&protos.LogEntry{Level: "INFO", Msg: "info " + msg})
+		rl.errors.add(&protos.LogEntry{Level: "ERROR", Msg: msg})
+		if i >= 10 {
+			want = append(want, msg)
+		}
+	}
+	if diff := cmp.Diff(want, msgs(rl.errors.recent(0))); diff != "" {
+		t.Errorf("recent(0) (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(want[len(want)-3:], msgs(rl.errors.recent(3))); diff != "" {
+		t.Errorf("recent(3) (-want,+got):\n%s", diff)
+	}
+
+	rl.errors.clear()
+	if got := rl.errors.recent(0); len(got) != 0 {
+		t.Errorf("recent(0) after clear: got %v, want no entries", msgs(got))
+	}
+	rl.errors.add(&protos.LogEntry{Level: "ERROR+2", Msg: "again"})
+	if diff := cmp.Diff([]string{"again"}, msgs(rl.errors.recent(10))); diff != "" {
+		t.Errorf("recent(10) (-want,+got):\n%s", diff)
+	}
+}
+
 // collector is a thread-safe destination for log messages.
 type collector chan string
 
@@ -0,0 +1,73 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"log/slog"
+	"math"
+	"sync"
+)
+
+// allLevels is the level of a component logger without a level override. It
+// is below every level, so all log entries are written.
+const allLevels = slog.Level(math.MinInt)
+
+// logLevels holds the log levels of the component loggers of a weavelet (see
+// SetComponentLogLevel).
+type logLevels struct {
+	mu     sync.Mutex
+	levels map[string]*slog.LevelVar // component name -> level
+}
+
+// leveler returns the level of the provided component's logger. The returned
+// level reflects later calls to set.
+func (l *logLevels) leveler(component string) *slog.LevelVar {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if v, ok := l.levels[component]; ok {
+		return v
+	}
+	if l.levels == nil {
+		l.levels = map[string]*slog.LevelVar{}
+	}
+	v := &slog.LevelVar{}
+	v.Set(allLevels)
+	l.levels[component] = v
+	return v
+}
+
+// set overrides the level of the provided component's logger.
+func (l *logLevels) set(component string, level slog.Level) {
+	l.leveler(component).Set(level)
+}
+
+// clear removes the override of the level of the provided component's
+// logger, if any.
+func (l *logLevels) clear(component string) {
+	l.leveler(component).Set(allLevels)
+}
+
+// overrides returns the overridden levels, by component name.
+func (l *logLevels) overrides() map[string]slog.Level {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	overrides := map[string]slog.Level{}
+	for component, v := range l.levels {
+		if level := v.Level(); level != allLevels {
+			overrides[component] = level
+		}
+	}
+	return overrides
+}
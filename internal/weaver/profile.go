@@ -18,12 +18,109 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"runtime"
 	"runtime/pprof"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/google/uuid"
 )
 
+// activeProfiles tracks the profiles that are currently being collected, so
+// that operators can list and cancel them. The zero value is ready to use.
+type activeProfiles struct {
+	mu       sync.Mutex
+	profiles map[string]*activeProfile // by profile id
+}
+
+// activeProfile is a profile that is being collected.
+type activeProfile struct {
+	info   *protos.ProfileInfo
+	cancel context.CancelFunc
+}
+
+// start registers a new profile for the provided request. It returns the
+// context the profile should be collected under, which is cancelled if the
+// profile is cancelled, and a function that must be called when the profile
+// is done.
+func (a *activeProfiles) start(ctx context.Context, req *protos.GetProfileRequest) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	id := uuid.New().String()
+	p := &activeProfile{
+		info: &protos.ProfileInfo{
+			Id:            id,
+			ProfileType:   req.ProfileType,
+			StartMicros:   time.Now().UnixMicro(),
+			CpuDurationNs: req.CpuDurationNs,
+		},
+		cancel: cancel,
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.profiles == nil {
+		a.profiles = map[string]*activeProfile{}
+	}
+	a.profiles[id] = p
+	return ctx, func() {
+		cancel()
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		delete(a.profiles, id)
+	}
+}
+
+// list returns the profiles that are currently being collected, oldest first.
+func (a *activeProfiles) list() []*protos.ProfileInfo {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	infos := make([]*protos.ProfileInfo, 0, len(a.profiles))
+	for _, p := range a.profiles {
+		infos = append(infos, p.info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].StartMicros < infos[j].StartMicros
+	})
+	return infos
+}
+
+// cancel cancels the profile with the provided id.
+func (a *activeProfiles) cancel(id string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	p, ok := a.profiles[id]
+	if !ok {
+		return fmt.Errorf("profile %q not found", id)
+	}
+	p.cancel()
+	return nil
+}
+
+// profilingRates tracks the block and mutex profiling rates of the process.
+// The runtime has no way to read the block profiling rate, so profilingRates
+// assumes it is only ever changed by set, and is zero initially. The zero
+// value is ready to use.
+type profilingRates struct {
+	mu    sync.Mutex
+	block int // current block profiling rate
+}
+
+// set sets the block and mutex profiling rates, leaving negative rates
+// unchanged, and returns the previous rates.
+func (r *profilingRates) set(block, mutex int) (prevBlock, prevMutex int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	prevBlock = r.block
+	if block >= 0 {
+		runtime.SetBlockProfileRate(block)
+		r.block = block
+	}
+	return prevBlock, runtime.SetMutexProfileFraction(mutex)
+}
+
 // getProfile collects a profile of this process.
 func getProfile(ctx context.Context, req *protos.GetProfileRequest) ([]byte, error) {
 	var buf bytes.Buffer
@@ -48,6 +145,11 @@ func getProfile(ctx context.Context, req *protos.GetProfileRequest) ([]byte, err
 			// All done
 		}
 		pprof.StopCPUProfile()
+	case protos.ProfileType_Block, protos.ProfileType_Mutex, protos.ProfileType_Goroutine, protos.ProfileType_Allocs:
+		name := strings.ToLower(req.ProfileType.String())
+		if err := pprof.Lookup(name).WriteTo(&buf, 0); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("unspecified profile collection type")
 	}
@@ -0,0 +1,45 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"errors"
+	"math"
+
+	"github.com/ServiceWeaver/weaver/internal/net/call"
+)
+
+// ErrRateLimited is returned by method calls on a component that were
+// rejected because the weavelet hosting the component serves calls at a
+// limited rate. See RoutingInfo.max_requests_per_second in
+// runtime/protos/runtime.proto.
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// setRateLimit updates the rate at which the weavelet serves remote calls to
+// the component. A non-positive rate removes the limit. The burst is rounded
+// up to at least 1. REQUIRES: c.routingMu is held.
+func (c *component) setRateLimit(rate float64, burst int) {
+	if rate <= 0 {
+		c.limiter.Store(nil)
+		return
+	}
+	burst = int(math.Max(float64(burst), 1))
+	if l := c.limiter.Load(); l != nil {
+		l.SetRate(burst, rate)
+		return
+	}
+	// Start with a full bucket.
+	c.limiter.Store(call.NewTokenBucket(burst, rate))
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetRateLimit(t *testing.T) {
+	var c component
+	now := time.Now()
+
+	// No limit.
+	if c.limiter.Load() != nil {
+		t.Fatal("limited without a limit")
+	}
+
+	// A burst of 3 is allowed, then calls are throttled.
+	c.setRateLimit(10, 3)
+	l := c.limiter.Load()
+	for i := 0; i < 3; i++ {
+		if !l.Take(now) {
+			t.Fatalf("call %d: throttled within burst", i)
+		}
+	}
+	if l.Take(now) {
+		t.Fatal("call allowed beyond burst")
+	}
+
+	// Updating the limit keeps the bucket, so it doesn't reset the burst.
+	c.setRateLimit(20, 3)
+	if c.limiter.Load() != l {
+		t.Fatal("limit update replaced the bucket")
+	}
+	if l.Take(now) {
+		t.Fatal("call allowed beyond burst after limit update")
+	}
+
+	// The burst is at least 1.
+	c.setRateLimit(20, 0)
+	if !l.Take(now.Add(time.Hour)) {
+		t.Fatal("call throttled with zero burst")
+	}
+
+	// Removing the limit allows all calls.
+	c.setRateLimit(0, 0)
+	if c.limiter.Load() != nil {
+		t.Fatal("limited after removing limit")
+	}
+}
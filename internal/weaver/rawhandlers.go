@@ -0,0 +1,40 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ServiceWeaver/weaver/internal/control"
+)
+
+var rawHandlers = newRegistry[func(context.Context, []byte) ([]byte, error)]("raw handler")
+
+// RegisterRawHandler registers a named handler for raw RPCs. It panics if a
+// handler with the same name has already been registered.
+func RegisterRawHandler(name string, handler func(context.Context, []byte) ([]byte, error)) {
+	rawHandlers.register(name, handler)
+}
+
+// handleRawRPC passes the provided payload to the raw handler with the
+// provided name and returns the handler's result.
+func handleRawRPC(ctx context.Context, name string, payload []byte) ([]byte, error) {
+	handler, ok := rawHandlers.get(name)
+	if !ok {
+		return nil, fmt.Errorf("raw handler %q: %w", name, control.ErrUnknownRawHandler)
+	}
+	return handler(ctx, payload)
+}
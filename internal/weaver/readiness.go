@@ -0,0 +1,45 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+)
+
+var readinessGates = newRegistry[func(context.Context) bool]("readiness gate")
+
+// AddReadinessGate registers a named readiness gate, replacing the gate with
+// the same name, if any. Gates are typically added by component Init methods,
+// which run again every time a component is created in the same process
+// (e.g., by every weavertest run).
+func AddReadinessGate(name string, ready func(context.Context) bool) {
+	readinessGates.set(name, ready)
+}
+
+// evalReadinessGates evaluates all registered readiness gates and returns
+// whether every gate is ready, along with the readiness of every gate.
+func evalReadinessGates(ctx context.Context) (bool, map[string]bool) {
+	gates := readinessGates.all()
+
+	// Note that gates are evaluated without holding the lock, since they may
+	// be slow (e.g., a gate may ping a dependency).
+	all := true
+	results := make(map[string]bool, len(gates))
+	for name, ready := range gates {
+		results[name] = ready(ctx)
+		all = all && results[name]
+	}
+	return all, results
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"fmt"
+	"maps"
+	"sync"
+)
+
+// registry is a process-wide set of values registered by name, such as the
+// raw handlers or the feature flags of a weavelet. It is safe for concurrent
+// use. The zero value is not ready to use; use newRegistry instead.
+type registry[V any] struct {
+	kind   string // kind of the registered values, e.g., "feature flag"
+	mu     sync.Mutex
+	values map[string]V // by name
+}
+
+// newRegistry returns an empty registry of values of the provided kind.
+func newRegistry[V any](kind string) *registry[V] {
+	return &registry[V]{kind: kind, values: map[string]V{}}
+}
+
+// register registers v under the provided name. It panics if a value with the
+// same name has already been registered.
+func (r *registry[V]) register(name string, v V) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.values[name]; ok {
+		panic(fmt.Sprintf("%s %q already registered", r.kind, name))
+	}
+	r.values[name] = v
+}
+
+// set registers v under the provided name, replacing the value with the same
+// name, if any.
+func (r *registry[V]) set(name string, v V) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.values[name] = v
+}
+
+// get returns the value registered under the provided name, if any.
+func (r *registry[V]) get(name string) (V, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	v, ok := r.values[name]
+	return v, ok
+}
+
+// all returns a copy of the registered values, by name.
+func (r *registry[V]) all() map[string]V {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return maps.Clone(r.values)
+}
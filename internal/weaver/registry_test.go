@@ -0,0 +1,51 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRegistry(t *testing.T) {
+	r := newRegistry[int]("number")
+	r.register("one", 1)
+	r.register("two", 2)
+	r.set("two", 22) // replaces
+	r.set("three", 3)
+
+	if v, ok := r.get("two"); !ok || v != 22 {
+		t.Errorf("get(two): got %d, %t, want 22, true", v, ok)
+	}
+	if v, ok := r.get("four"); ok {
+		t.Errorf("get(four): got %d, want none", v)
+	}
+	want := map[string]int{"one": 1, "two": 22, "three": 3}
+	if diff := cmp.Diff(want, r.all()); diff != "" {
+		t.Errorf("all (-want +got):\n%s", diff)
+	}
+}
+
+func TestRegistryDuplicate(t *testing.T) {
+	r := newRegistry[int]("number")
+	r.register("one", 1)
+	defer func() {
+		if x := recover(); x == nil {
+			t.Fatal("register(one) twice: unexpected success")
+		}
+	}()
+	r.register("one", 1)
+}
@@ -25,10 +25,15 @@ import (
 	"os"
 	"os/signal"
 	"reflect"
+	goruntime "runtime"
+	"runtime/debug"
+	"runtime/pprof"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"syscall"
+	"time"
 
 	"github.com/ServiceWeaver/weaver/internal/config"
 	"github.com/ServiceWeaver/weaver/internal/control"
@@ -40,9 +45,11 @@ import (
 	"github.com/ServiceWeaver/weaver/runtime/deployers"
 	"github.com/ServiceWeaver/weaver/runtime/logging"
 	"github.com/ServiceWeaver/weaver/runtime/metrics"
+	"github.com/ServiceWeaver/weaver/runtime/protomsg"
 	"github.com/ServiceWeaver/weaver/runtime/protos"
 	"github.com/ServiceWeaver/weaver/runtime/retry"
 	"github.com/ServiceWeaver/weaver/runtime/version"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
@@ -56,6 +63,16 @@ const readyMethodName = "ready"
 type RemoteWeaveletOptions struct {
 	Fakes         map[reflect.Type]any // component fakes, by component interface type
 	InjectRetries int                  // Number of artificial retries to inject per retriable call
+
+	// If positive, the maximum number of concurrent RPCs the envelope may
+	// issue to the weavelet. It is advertised to the envelope during the
+	// handshake, and enforced by the envelope. If zero, it defaults to
+	// WeaveletArgs.MaxConcurrentRpcs.
+	MaxConcurrentRPCs int
+
+	// The clock used by rate limits and circuit breakers. Defaults to
+	// call.RealClock.
+	Clock call.Clock
 }
 
 // RemoteWeavelet is a weavelet that runs some components locally, but
@@ -71,20 +88,29 @@ type RemoteWeavelet struct {
 	args       *protos.WeaveletArgs    // info from envelope
 	dialAddr   string                  // Address dialed by other components
 	id         string                  // unique id for this weavelet
+	started    time.Time               // when the weavelet was created
 	weaverInfo *WeaverInfo             // application runtime information
 	deployer   control.DeployerControl // component to control deployer
 	logDst     *remoteLogger           // for writing log entries
+	logLevels  logLevels               // component log levels
 	syslogger  *slog.Logger            // system logger
 	tracer     trace.Tracer            // tracer used by all components
+	metricsMu  sync.Mutex              // guards metrics
 	metrics    metrics.Exporter        // helper for sending metrics to envelope
 
+	// The provider of tracer, used to flush buffered trace spans.
+	traces *sdktrace.TracerProvider
+
 	// state to synchronize with envelope initiated initialization handshake.
 	initMu     sync.Mutex
 	initCalled bool
 	initDone   chan struct{}
 
-	// Ready to use by the time initDone is closed.
+	// Ready to use by the time initDone is closed. Guarded by configMu
+	// afterwards, as it is replaced by ReloadConfig.
 	sectionConfig map[string]string
+	configMu      sync.Mutex
+	reloadMu      sync.Mutex // serializes ReloadConfig calls
 
 	// channel that is closed when deployer is ready.
 	deployerReady chan struct{}
@@ -96,6 +122,9 @@ type RemoteWeavelet struct {
 
 	lismu     sync.Mutex           // guards listeners
 	listeners map[string]*listener // listeners, by name
+
+	profiles activeProfiles // profiles being collected
+	rates    profilingRates // block and mutex profiling rates
 }
 
 var _ control.WeaveletControl = (*RemoteWeavelet)(nil)
@@ -115,6 +144,10 @@ type component struct {
 	activateInit sync.Once // used to activate the component
 	activateErr  error     // non-nil if activation fails
 
+	// Annotations returned by the deployer when activating the component (see
+	// ActivateComponentReply.annotations), or nil if none.
+	annotations atomic.Pointer[map[string]string]
+
 	implInit   sync.Once      // used to initialize impl, severStub
 	implErr    error          // non-nil if impl creation fails
 	implReady  atomic.Bool    // true only after impl creation succeeds
@@ -130,13 +163,44 @@ type component struct {
 
 	local register.WriteOnce[bool] // routed locally?
 	load  *loadCollector           // non-nil for routed components
+
+	routingMu      sync.Mutex          // serializes routing info updates
+	routingVersion uint64              // version of the last applied routing info
+	routingInfo    *protos.RoutingInfo // last applied routing info, guarded by routingMu
+
+	limiter  atomic.Pointer[call.TokenBucket] // limits the rate of calls from other weavelets; nil if unlimited
+	breaker  circuitBreaker                   // fails remote calls fast while the component is failing
+	disabled atomic.Bool                      // has the component been disabled?
+	inflight atomic.Int64                     // number of in-flight calls from other weavelets
+
+	// The config of impl, if any, and the config section it was parsed from.
+	// Guarded by RemoteWeavelet.configMu.
+	config        any // nil if impl has no config
+	configSection string
 }
 
 // listener is a network listener and the proxy address that should be used to
 // reach that listener.
 type listener struct {
 	lis       net.Listener
+	addr      string // address lis is bound to
 	proxyAddr string
+	health    *protos.ListenerHealthCheck // exported with the listener
+	tls       *listenerTLS                // nil if the listener doesn't use TLS
+	ready     atomic.Bool                 // has the application called lis.Accept?
+}
+
+// readyListener is a net.Listener that records when the application starts
+// accepting connections on it.
+type readyListener struct {
+	net.Listener
+	ready *atomic.Bool
+}
+
+// Accept implements the net.Listener interface.
+func (l readyListener) Accept() (net.Conn, error) {
+	l.ready.Store(true)
+	return l.Listener.Accept()
 }
 
 // NewRemoteWeavelet returns a new RemoteWeavelet that hosts the components
@@ -151,6 +215,12 @@ func NewRemoteWeavelet(ctx context.Context, regs []*codegen.Registration, bootst
 	if err := runtime.CheckWeaveletArgs(args); err != nil {
 		return nil, err
 	}
+	if opts.MaxConcurrentRPCs == 0 {
+		opts.MaxConcurrentRPCs = int(args.MaxConcurrentRpcs)
+	}
+	if opts.Clock == nil {
+		opts.Clock = call.RealClock{}
+	}
 
 	// Make internal listener.
 	lis, err := net.Listen("tcp", args.InternalAddress)
@@ -178,6 +248,7 @@ func NewRemoteWeavelet(ctx context.Context, regs []*codegen.Registration, bootst
 		opts:             opts,
 		args:             args,
 		dialAddr:         dialAddr,
+		started:          time.Now(),
 		weaverInfo:       &WeaverInfo{DeploymentID: args.DeploymentId},
 		logDst:           newRemoteLogger(os.Stderr),
 		initDone:         make(chan struct{}),
@@ -201,7 +272,7 @@ func NewRemoteWeavelet(ctx context.Context, regs []*codegen.Registration, bootst
 
 	// Set up tracing.
 	exporter := traceio.NewWriter(w.sendTraceSpans)
-	w.tracer = tracer(exporter, info.App, info.DeploymentId, info.Id)
+	w.tracer, w.traces = tracer(exporter, info.App, info.DeploymentId, info.Id)
 
 	// Initialize the component structs.
 	for _, reg := range regs {
@@ -253,8 +324,12 @@ func NewRemoteWeavelet(ctx context.Context, regs []*codegen.Registration, bootst
 	close(w.deployerReady)
 
 	// Wire-up log writing.
+	logBatch := w.deployer.LogBatch
+	if args.CheckMessageOrder {
+		logBatch = sequenceBatches(logBatch)
+	}
 	servers.Go(func() error {
-		w.logDst.run(ctx, w.deployer.LogBatch)
+		w.logDst.run(ctx, logBatch)
 		return nil
 	})
 
@@ -329,9 +404,31 @@ func (w *RemoteWeavelet) InitWeavelet(ctx context.Context, req *protos.InitWeave
 			Minor: version.DeployerMinor,
 			Patch: 0,
 		},
+		Resources:         getResourceLimits(),
+		Capabilities:      w.capabilities(),
+		MaxConcurrentRpcs: int64(w.opts.MaxConcurrentRPCs),
 	}, nil
 }
 
+// capabilities returns the envelope handler methods this weavelet may invoke.
+func (w *RemoteWeavelet) capabilities() []protos.Capability {
+	capabilities := []protos.Capability{
+		protos.Capability_CAPABILITY_ACTIVATE_COMPONENT,
+		protos.Capability_CAPABILITY_GET_LISTENER_ADDRESS,
+		protos.Capability_CAPABILITY_EXPORT_LISTENER,
+		protos.Capability_CAPABILITY_LOG_BATCH,
+		protos.Capability_CAPABILITY_HANDLE_TRACE_SPANS,
+	}
+	if w.args.Mtls {
+		capabilities = append(capabilities,
+			protos.Capability_CAPABILITY_GET_SELF_CERTIFICATE,
+			protos.Capability_CAPABILITY_VERIFY_CLIENT_CERTIFICATE,
+			protos.Capability_CAPABILITY_VERIFY_SERVER_CERTIFICATE,
+		)
+	}
+	return capabilities
+}
+
 // Wait waits for the RemoteWeavelet to fully shut down after its context has
 // been cancelled.
 func (w *RemoteWeavelet) Wait() error {
@@ -367,7 +464,11 @@ func (w *RemoteWeavelet) getIntf(t reflect.Type, requester string) (any, error)
 				Component: c.reg.Name,
 				Routed:    c.reg.Routed,
 			}
-			_, err := w.deployer.ActivateComponent(w.ctx, request)
+			reply, err := w.deployer.ActivateComponent(w.ctx, request)
+			if err == nil && len(reply.GetAnnotations()) > 0 {
+				annotations := maps.Clone(reply.Annotations)
+				c.annotations.Store(&annotations)
+			}
 			return err
 		})
 		if c.activateErr != nil {
@@ -386,7 +487,7 @@ func (w *RemoteWeavelet) getIntf(t reflect.Type, requester string) (any, error)
 		if err != nil {
 			return nil, err
 		}
-		return c.reg.LocalStubFn(impl, requester, w.tracer), nil
+		return c.reg.LocalStubFn(impl, requester, w.componentTracer(c)), nil
 	}
 
 	// Return a remote stub.
@@ -413,7 +514,10 @@ func (w *RemoteWeavelet) redirect(requester string, c *component, target, addres
 		}
 		resolver := call.NewConstantResolver(endpoint)
 		// TODO(sanjay): Pass retry info from the target component.
-		c.stub, c.stubErr = w.makeStub(target, c.reg, resolver, nil, false)
+		//
+		// Redirects point at the envelope, so we request checksums to
+		// detect corrupted frames on the envelope connection.
+		c.stub, c.stubErr = w.makeStub(target, c.reg, resolver, nil, false, true, w.tracer)
 	})
 	if c.stubErr != nil {
 		return nil, c.stubErr
@@ -466,8 +570,16 @@ func (w *RemoteWeavelet) createComponent(ctx context.Context, reg *codegen.Regis
 	obj := v.Interface()
 
 	// Fill config if necessary.
-	if cfg := config.Config(v); cfg != nil {
-		if err := runtime.ParseConfigSection(reg.Name, "", w.sectionConfig, cfg); err != nil {
+	cfg := config.Config(v)
+	if cfg != nil {
+		w.configMu.Lock()
+		err := runtime.ParseConfigSection(reg.Name, "", w.sectionConfig, cfg)
+		if c := w.componentsByName[reg.Name]; err == nil && c != nil {
+			c.config = cfg
+			c.configSection = w.sectionConfig[reg.Name]
+		}
+		w.configMu.Unlock()
+		if err != nil {
 			return nil, err
 		}
 	}
@@ -491,7 +603,11 @@ func (w *RemoteWeavelet) createComponent(ctx context.Context, reg *codegen.Regis
 
 	// Fill listener fields.
 	if err := FillListeners(obj, func(name string) (net.Listener, string, error) {
-		lis, err := w.listener(ctx, name)
+		health, err := listenerHealthCheck(cfg, name)
+		if err != nil {
+			return nil, "", err
+		}
+		lis, err := w.listener(ctx, name, health)
 		if err != nil {
 			return nil, "", err
 		}
@@ -500,31 +616,58 @@ func (w *RemoteWeavelet) createComponent(ctx context.Context, reg *codegen.Regis
 		return nil, err
 	}
 
-	// Call Init if available.
+	// Call Init if available. Goroutines started by Init inherit the
+	// component's profiling labels.
 	if i, ok := obj.(interface{ Init(context.Context) error }); ok {
-		if err := i.Init(ctx); err != nil {
+		var err error
+		pprof.Do(ctx, componentLabels(reg.Name), func(ctx context.Context) {
+			err = i.Init(ctx)
+		})
+		if err != nil {
 			return nil, fmt.Errorf("component %q initialization failed: %w", reg.Name, err)
 		}
 	}
 	return obj, nil
 }
 
+// componentLabels returns the profiling labels attached to the execution of
+// the component with the provided name. See GetProfileRequest in
+// runtime/protos/runtime.proto.
+func componentLabels(component string) pprof.LabelSet {
+	return pprof.Labels("component", component)
+}
+
 // getStub returns a component's client stub, initializing it if necessary.
 func (w *RemoteWeavelet) getStub(c *component) (codegen.Stub, error) {
 	c.stubInit.Do(func() {
-		c.stub, c.stubErr = w.makeStub(c.reg.Name, c.reg, c.resolver, c.balancer, true)
+		c.stub, c.stubErr = w.makeStub(c.reg.Name, c.reg, c.resolver, c.balancer, true, false, w.componentTracer(c))
+		if c.stubErr == nil {
+			c.stub = circuitBreakerStub{Stub: c.stub, component: c.reg.Name, breaker: &c.breaker, clock: w.opts.Clock}
+		}
 	})
 	return c.stub, c.stubErr
 }
 
-// makeStub makes a new stub with the provided resolver and balancer.
-func (w *RemoteWeavelet) makeStub(fullName string, reg *codegen.Registration, resolver call.Resolver, balancer call.Balancer, wait bool) (codegen.Stub, error) {
+// componentTracer returns the tracer used for the method calls of the provided
+// component, which adds the component's annotations, if any, to spans.
+func (w *RemoteWeavelet) componentTracer(c *component) trace.Tracer {
+	if annotations := c.annotations.Load(); annotations != nil {
+		return newAnnotatedTracer(w.tracer, *annotations)
+	}
+	return w.tracer
+}
+
+// makeStub makes a new stub with the provided resolver, balancer, and tracer.
+// If checksum is true, the stub's connection requests per-message checksums
+// (see call.ClientOptions.Checksum).
+func (w *RemoteWeavelet) makeStub(fullName string, reg *codegen.Registration, resolver call.Resolver, balancer call.Balancer, wait, checksum bool, tracer trace.Tracer) (codegen.Stub, error) {
 	// Create the client connection.
 	name := logging.ShortenComponent(fullName)
 	w.syslogger.Debug("Connecting to remote", "component", name)
 	opts := call.ClientOptions{
 		Balancer: balancer,
 		Logger:   w.syslogger,
+		Checksum: checksum,
 	}
 	conn, err := call.Connect(w.ctx, resolver, opts)
 	if err != nil {
@@ -538,7 +681,7 @@ func (w *RemoteWeavelet) makeStub(fullName string, reg *codegen.Registration, re
 		}
 	}
 	w.syslogger.Debug("Connected to remote", "component", name)
-	return call.NewStub(fullName, reg, conn, w.tracer, w.opts.InjectRetries), nil
+	return call.NewStub(fullName, reg, conn, tracer, w.opts.InjectRetries), nil
 }
 
 // GetLoad implements controller interface.
@@ -560,6 +703,20 @@ func (w *RemoteWeavelet) GetLoad(context.Context, *protos.GetLoadRequest) (*prot
 
 // UpdateComponents implements weaver.controller and conn.WeaverHandler interfaces.
 func (w *RemoteWeavelet) UpdateComponents(_ context.Context, req *protos.UpdateComponentsRequest) (*protos.UpdateComponentsReply, error) {
+	if req.DryRun {
+		reply := &protos.UpdateComponentsReply{}
+		for _, component := range req.Components {
+			c, ok := w.componentsByName[component]
+			switch {
+			case !ok:
+				reply.UnknownComponents = append(reply.UnknownComponents, component)
+			case c.disabled.Load():
+				reply.DisabledComponents = append(reply.DisabledComponents, component)
+			}
+		}
+		return reply, nil
+	}
+
 	var errs []error
 	var components []*component
 	var shortened []string
@@ -625,6 +782,23 @@ func (w *RemoteWeavelet) UpdateRoutingInfo(_ context.Context, req *protos.Update
 		return nil, err
 	}
 
+	// Reject stale updates. Note that we hold routingMu for the duration of
+	// the update, so that concurrent updates are applied in version order.
+	c.routingMu.Lock()
+	defer c.routingMu.Unlock()
+	if info.Version != 0 && info.Version < c.routingVersion {
+		return nil, fmt.Errorf("routing info for %q: version %d < applied version %d: %w", info.Component, info.Version, c.routingVersion, control.ErrStaleRoutingUpdate)
+	}
+	defer func() {
+		if err != nil {
+			return
+		}
+		if info.Version != 0 {
+			c.routingVersion = info.Version
+		}
+		c.routingInfo = protomsg.Clone(info)
+	}()
+
 	// Record whether the component is local or remote. Currently, a component
 	// must always be local or always be remote. It cannot change.
 	c.local.TryWrite(info.Local)
@@ -632,8 +806,8 @@ func (w *RemoteWeavelet) UpdateRoutingInfo(_ context.Context, req *protos.Update
 		return nil, fmt.Errorf("RoutingInfo.Local for %q: got %t, want %t", info.Component, got, want)
 	}
 
-	// If the component is local, we don't have to update anything. The routing
-	// info shouldn't contain any replicas or assignment.
+	// If the component is local, we only have to update the rate limit. The
+	// routing info shouldn't contain any replicas or assignment.
 	if info.Local {
 		if len(info.Replicas) > 0 {
 			w.syslogger.Error("Local routing info has replicas", "component", info.Component, "replicas", info.Replicas)
@@ -641,20 +815,32 @@ func (w *RemoteWeavelet) UpdateRoutingInfo(_ context.Context, req *protos.Update
 		if info.Assignment != nil {
 			w.syslogger.Error("Local routing info has assignment", "component", info.Component, "assignment", info.Assignment)
 		}
+		c.setRateLimit(info.MaxRequestsPerSecond, int(info.MaxBurst))
 		return
 	}
 
-	// Update resolver.
+	// Validate the routing info before applying any of it, so that an invalid
+	// update doesn't leave the component partially updated.
+	if err := validatePins(info); err != nil {
+		return nil, err
+	}
 	endpoints, err := parseEndpoints(info.Replicas, c.clientTLS)
 	if err != nil {
 		return nil, err
 	}
+
+	// Update rate limit and circuit breaker.
+	c.setRateLimit(info.MaxRequestsPerSecond, int(info.MaxBurst))
+	c.breaker.configure(info.CircuitBreaker)
+
+	// Update resolver.
 	c.resolver.update(endpoints)
 
 	// Update balancer.
 	if info.Assignment != nil {
 		c.balancer.update(info.Assignment)
 	}
+	c.balancer.updatePins(info.Pins)
 
 	// Update load collector.
 	if c.load != nil && info.Assignment != nil {
@@ -664,43 +850,133 @@ func (w *RemoteWeavelet) UpdateRoutingInfo(_ context.Context, req *protos.Update
 	return &protos.UpdateRoutingInfoReply{}, nil
 }
 
+// Ping implements controller.Ping.
+func (w *RemoteWeavelet) Ping(context.Context, *protos.PingRequest) (*protos.PingReply, error) {
+	return &protos.PingReply{}, nil
+}
+
+// GetTime implements controller.GetTime.
+func (w *RemoteWeavelet) GetTime(context.Context, *protos.GetTimeRequest) (*protos.GetTimeReply, error) {
+	return &protos.GetTimeReply{UnixNanos: time.Now().UnixNano()}, nil
+}
+
 // GetHealth implements controller.GetHealth.
-func (w *RemoteWeavelet) GetHealth(context.Context, *protos.GetHealthRequest) (*protos.GetHealthReply, error) {
-	// Get the health status for all components. For now, we consider a component
-	// healthy iff it has been successfully initialized. In the future, we will
-	// maintain a real-time health for each component.
+func (w *RemoteWeavelet) GetHealth(ctx context.Context, _ *protos.GetHealthRequest) (*protos.GetHealthReply, error) {
+	// Get the health status for all components. We consider a component
+	// healthy iff it has been successfully initialized and all of its health
+	// checks (see weaver.RegisterHealthCheck) pass.
 	reply := &protos.GetHealthReply{Status: protos.HealthStatus_HEALTHY}
 	for cname, c := range w.componentsByName {
-		if c.implReady.Load() {
-			reply.HealthyComponents = append(reply.HealthyComponents, cname)
+		switch {
+		case c.disabled.Load():
+			reply.DisabledComponents = append(reply.DisabledComponents, cname)
+		case c.implReady.Load():
+			if checkHealth(ctx, c, reply) {
+				reply.HealthyComponents = append(reply.HealthyComponents, cname)
+			} else {
+				reply.Status = protos.HealthStatus_UNHEALTHY
+			}
 		}
 	}
+	reply.Listeners = w.listenerReadiness()
 	return reply, nil
 }
 
+// checkHealth runs the health checks of the provided started component,
+// appends their results to the reply, and returns whether they all passed.
+func checkHealth(ctx context.Context, c *component, reply *protos.GetHealthReply) bool {
+	healthy := true
+	for _, check := range getHealthChecks(c.reg.Impl) {
+		result := &protos.HealthCheckResult{Component: c.reg.Name, Name: check.Name, Healthy: true}
+		if err := check.Check(ctx, c.impl); err != nil {
+			result.Healthy = false
+			result.Error = err.Error()
+			healthy = false
+		}
+		reply.Checks = append(reply.Checks, result)
+	}
+	return healthy
+}
+
 // GetMetrics implements controller.GetMetrics.
-func (w *RemoteWeavelet) GetMetrics(context.Context, *protos.GetMetricsRequest) (*protos.GetMetricsReply, error) {
+func (w *RemoteWeavelet) GetMetrics(_ context.Context, req *protos.GetMetricsRequest) (*protos.GetMetricsReply, error) {
 	// TODO(sanjay): The protocol is currently brittle; if we ever lose a set of
 	// updates, they will be lost forever. Fix by versioning the "last" map in
 	// metrics.Exporter. The reader echoes back the version of the last set of
 	// updates it read. If the echoed version does not match, send everything.
-	updates := w.metrics.Export()
+	if req.Aggregation != nil {
+		return w.getAggregatedMetrics(req)
+	}
+	// Hold the lock while exporting, so that concurrent requests (e.g., a
+	// full snapshot racing an incremental update) don't interleave their
+	// updates of the exporter's state.
+	w.metricsMu.Lock()
+	if req.FullSnapshot {
+		w.metrics.Reset()
+	}
+	updates := w.metrics.ExportPrefixes(req.NamePrefixes)
+	w.metricsMu.Unlock()
 
 	// Add weavelet labels to the metrics.
 	for _, def := range updates.Defs {
 		if def.Labels == nil {
 			def.Labels = map[string]string{}
 		}
-		def.Labels["serviceweaver_app"] = w.Info().App
-		def.Labels["serviceweaver_version"] = w.Info().DeploymentId
-		def.Labels["serviceweaver_node"] = w.Info().Id
+		w.addMetricLabels(def.Labels)
 	}
 
 	return &protos.GetMetricsReply{Update: updates}, nil
 }
 
+// getAggregatedMetrics replies to a GetMetricsRequest with an aggregation.
+// The metrics are aggregated after the weavelet labels are added, so that
+// weavelet labels can be dropped too. The reply is a full snapshot that
+// doesn't involve w.metrics.
+func (w *RemoteWeavelet) getAggregatedMetrics(req *protos.GetMetricsRequest) (*protos.GetMetricsReply, error) {
+	var snapshots []*metrics.MetricSnapshot
+	for _, s := range metrics.Snapshot() {
+		if !metrics.HasPrefix(s.Name, req.NamePrefixes) {
+			continue
+		}
+		if s.Labels == nil {
+			s.Labels = map[string]string{}
+		}
+		w.addMetricLabels(s.Labels)
+		snapshots = append(snapshots, s)
+	}
+	aggregated, err := metrics.Aggregate(snapshots, req.Aggregation)
+	if err != nil {
+		return nil, err
+	}
+	update := &protos.MetricUpdate{}
+	for _, s := range aggregated {
+		update.Defs = append(update.Defs, s.MetricDef())
+		update.Values = append(update.Values, s.MetricValue())
+	}
+	return &protos.GetMetricsReply{Update: update}, nil
+}
+
+// addMetricLabels adds the weavelet labels to the provided metric labels,
+// along with the annotations of the component the metric is about, if any.
+func (w *RemoteWeavelet) addMetricLabels(labels map[string]string) {
+	labels["serviceweaver_app"] = w.Info().App
+	labels["serviceweaver_version"] = w.Info().DeploymentId
+	labels["serviceweaver_node"] = w.Info().Id
+	if c, ok := w.componentsByName[labels["component"]]; ok {
+		if annotations := c.annotations.Load(); annotations != nil {
+			for k, v := range *annotations {
+				if _, ok := labels[k]; !ok {
+					labels[k] = v
+				}
+			}
+		}
+	}
+}
+
 // GetProfile implements controller.GetProfile.
 func (w *RemoteWeavelet) GetProfile(ctx context.Context, req *protos.GetProfileRequest) (*protos.GetProfileReply, error) {
+	ctx, done := w.profiles.start(ctx, req)
+	defer done()
 	data, err := getProfile(ctx, req)
 	if err != nil {
 		return nil, err
@@ -708,6 +984,494 @@ func (w *RemoteWeavelet) GetProfile(ctx context.Context, req *protos.GetProfileR
 	return &protos.GetProfileReply{Data: data}, nil
 }
 
+// GetProfileBundle implements controller.GetProfileBundle.
+func (w *RemoteWeavelet) GetProfileBundle(ctx context.Context, req *protos.GetProfileBundleRequest) (*protos.GetProfileBundleReply, error) {
+	seen := map[protos.ProfileType]bool{}
+	for _, typ := range req.ProfileTypes {
+		if seen[typ] {
+			return nil, fmt.Errorf("duplicate profile type %v", typ)
+		}
+		seen[typ] = true
+	}
+
+	// Collect the profiles concurrently. Each profile can be listed and
+	// cancelled on its own.
+	profiles := make([]*protos.ProfileData, len(req.ProfileTypes))
+	group, ctx := errgroup.WithContext(ctx)
+	for i, typ := range req.ProfileTypes {
+		group.Go(func() error {
+			preq := &protos.GetProfileRequest{ProfileType: typ, CpuDurationNs: req.CpuDurationNs}
+			ctx, done := w.profiles.start(ctx, preq)
+			defer done()
+			data, err := getProfile(ctx, preq)
+			if err != nil {
+				return fmt.Errorf("%v profile: %w", typ, err)
+			}
+			profiles[i] = &protos.ProfileData{ProfileType: typ, Data: data}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	return &protos.GetProfileBundleReply{Profiles: profiles}, nil
+}
+
+// ListProfiles implements controller.ListProfiles.
+func (w *RemoteWeavelet) ListProfiles(context.Context, *protos.ListProfilesRequest) (*protos.ListProfilesReply, error) {
+	return &protos.ListProfilesReply{Profiles: w.profiles.list()}, nil
+}
+
+// CancelProfile implements controller.CancelProfile.
+func (w *RemoteWeavelet) CancelProfile(_ context.Context, req *protos.CancelProfileRequest) (*protos.CancelProfileReply, error) {
+	if err := w.profiles.cancel(req.Id); err != nil {
+		return nil, err
+	}
+	return &protos.CancelProfileReply{}, nil
+}
+
+// SetProfilingRate implements controller.SetProfilingRate.
+func (w *RemoteWeavelet) SetProfilingRate(_ context.Context, req *protos.SetProfilingRateRequest) (*protos.SetProfilingRateReply, error) {
+	block, mutex := w.rates.set(int(req.BlockRate), int(req.MutexFraction))
+	w.syslogger.Info("Profiling rates set", "block", req.BlockRate, "mutex", req.MutexFraction)
+	return &protos.SetProfilingRateReply{
+		PrevBlockRate:     int64(block),
+		PrevMutexFraction: int64(mutex),
+	}, nil
+}
+
+// SetComponentEnabled implements controller.SetComponentEnabled.
+//
+// Note that only calls from other weavelets are affected. Calls from
+// colocated components are local method calls and are not rejected.
+func (w *RemoteWeavelet) SetComponentEnabled(ctx context.Context, req *protos.SetComponentEnabledRequest) (*protos.SetComponentEnabledReply, error) {
+	c, err := w.getComponent(req.Component)
+	if err != nil {
+		return nil, err
+	}
+	c.disabled.Store(!req.Enabled)
+	if req.Enabled {
+		w.syslogger.Info("Component enabled", "component", req.Component)
+		return &protos.SetComponentEnabledReply{}, nil
+	}
+
+	if err := drain(ctx, c); err != nil {
+		return nil, err
+	}
+	w.syslogger.Info("Component disabled", "component", req.Component)
+	return &protos.SetComponentEnabledReply{}, nil
+}
+
+// drain waits for the in-flight calls of the provided component to finish.
+func drain(ctx context.Context, c *component) error {
+	for r := retry.Begin(); c.inflight.Load() > 0; {
+		if !r.Continue(ctx) {
+			return fmt.Errorf("component %q: draining calls: %w", c.reg.Name, ctx.Err())
+		}
+	}
+	return nil
+}
+
+// ForwardSignal implements controller.ForwardSignal.
+func (w *RemoteWeavelet) ForwardSignal(_ context.Context, req *protos.ForwardSignalRequest) (*protos.ForwardSignalReply, error) {
+	if req.Signal <= 0 {
+		return nil, fmt.Errorf("invalid signal %d", req.Signal)
+	}
+	// Send the signal to ourselves, so that it is handled exactly like a
+	// signal received from the OS, including by signal handlers installed by
+	// the application.
+	p, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		return nil, err
+	}
+	if err := p.Signal(syscall.Signal(req.Signal)); err != nil {
+		return nil, fmt.Errorf("signal %v: %w", syscall.Signal(req.Signal), err)
+	}
+	return &protos.ForwardSignalReply{}, nil
+}
+
+// GetReadiness implements controller.GetReadiness.
+func (w *RemoteWeavelet) GetReadiness(ctx context.Context, _ *protos.GetReadinessRequest) (*protos.GetReadinessReply, error) {
+	ready, gates := evalReadinessGates(ctx)
+	return &protos.GetReadinessReply{Gates: gates, Ready: ready}, nil
+}
+
+// GetBuildFlags implements controller.GetBuildFlags.
+func (w *RemoteWeavelet) GetBuildFlags(context.Context, *protos.GetBuildFlagsRequest) (*protos.GetBuildFlagsReply, error) {
+	return &protos.GetBuildFlagsReply{Flags: getBuildFlags()}, nil
+}
+
+// SetGCPercent implements controller.SetGCPercent.
+func (w *RemoteWeavelet) SetGCPercent(_ context.Context, req *protos.SetGCPercentRequest) (*protos.SetGCPercentReply, error) {
+	previous := debug.SetGCPercent(int(req.Percent))
+	w.syslogger.Info("Set GC percent", "percent", req.Percent, "previous", previous)
+	return &protos.SetGCPercentReply{Previous: int32(previous)}, nil
+}
+
+// ForceGC implements controller.ForceGC.
+func (w *RemoteWeavelet) ForceGC(context.Context, *protos.ForceGCRequest) (*protos.ForceGCReply, error) {
+	goruntime.GC()
+	return &protos.ForceGCReply{}, nil
+}
+
+// FlushTelemetry implements controller.FlushTelemetry.
+func (w *RemoteWeavelet) FlushTelemetry(ctx context.Context, _ *protos.FlushTelemetryRequest) (*protos.FlushTelemetryReply, error) {
+	// Spans are exported synchronously, by calls to the deployer's
+	// HandleTraceSpans that return once the deployer has handled them.
+	// Flush traces first, so that errors logged while exporting spans are
+	// flushed too.
+	if err := w.traces.ForceFlush(ctx); err != nil {
+		return nil, fmt.Errorf("flush trace spans: %w", err)
+	}
+	if err := w.logDst.flush(ctx); err != nil {
+		return nil, fmt.Errorf("flush log entries: %w", err)
+	}
+	return &protos.FlushTelemetryReply{}, nil
+}
+
+// GetInFlight implements controller.GetInFlight.
+func (w *RemoteWeavelet) GetInFlight(context.Context, *protos.GetInFlightRequest) (*protos.GetInFlightReply, error) {
+	calls := map[string]int64{}
+	for name, c := range w.componentsByName {
+		if n := c.inflight.Load(); n > 0 || c.implReady.Load() {
+			calls[name] = n
+		}
+	}
+	return &protos.GetInFlightReply{Calls: calls}, nil
+}
+
+// configReloader is implemented by components that can reload their config
+// without a restart. See weaver.WithConfig.
+type configReloader interface {
+	ReloadConfig(context.Context, any) error
+}
+
+// ReloadConfig implements controller.ReloadConfig.
+func (w *RemoteWeavelet) ReloadConfig(ctx context.Context, req *protos.ReloadConfigRequest) (*protos.ReloadConfigReply, error) {
+	w.reloadMu.Lock()
+	defer w.reloadMu.Unlock()
+
+	// Parse the changed configs before reloading any of them, so that an
+	// invalid config section doesn't leave the weavelet half reloaded.
+	type reload struct {
+		c        *component
+		reloader configReloader
+		config   any
+	}
+	var reloads []reload
+	reply := &protos.ReloadConfigReply{}
+	w.configMu.Lock()
+	names := maps.Keys(w.componentsByName)
+	sort.Strings(names)
+	for _, name := range names {
+		c := w.componentsByName[name]
+		if c.config == nil || c.configSection == req.Sections[name] {
+			continue
+		}
+		cfg := reflect.New(reflect.TypeOf(c.config).Elem()).Interface()
+		if err := runtime.ParseConfigSection(name, "", req.Sections, cfg); err != nil {
+			w.configMu.Unlock()
+			return nil, err
+		}
+		// c.config is set before c.impl, so c.impl may still be being
+		// created. It is only safe to read once implReady is set.
+		if !c.implReady.Load() {
+			reply.RestartRequired = append(reply.RestartRequired, name)
+			continue
+		}
+		reloader, ok := c.impl.(configReloader)
+		if !ok {
+			reply.RestartRequired = append(reply.RestartRequired, name)
+			continue
+		}
+		reloads = append(reloads, reload{c, reloader, cfg})
+	}
+	w.sectionConfig = maps.Clone(req.Sections)
+	w.configMu.Unlock()
+
+	// Don't hold configMu while calling into the application: a reloader
+	// that gets a component that hasn't been created yet would deadlock.
+	var reloaded []reload
+	for _, r := range reloads {
+		name := r.c.reg.Name
+		if err := r.reloader.ReloadConfig(ctx, r.config); err != nil {
+			w.syslogger.Error("Failed to reload config", "component", logging.ShortenComponent(name), "err", err)
+			reply.RestartRequired = append(reply.RestartRequired, name)
+			continue
+		}
+		reloaded = append(reloaded, r)
+		reply.Reloaded = append(reply.Reloaded, name)
+	}
+
+	w.configMu.Lock()
+	defer w.configMu.Unlock()
+	for _, r := range reloaded {
+		r.c.config = r.config
+		r.c.configSection = req.Sections[r.c.reg.Name]
+	}
+	return reply, nil
+}
+
+// GetRecentErrors implements controller.GetRecentErrors.
+func (w *RemoteWeavelet) GetRecentErrors(_ context.Context, req *protos.GetRecentErrorsRequest) (*protos.GetRecentErrorsReply, error) {
+	return &protos.GetRecentErrorsReply{Entries: w.logDst.errors.recent(int(req.N))}, nil
+}
+
+// ClearRecentErrors implements controller.ClearRecentErrors.
+func (w *RemoteWeavelet) ClearRecentErrors(context.Context, *protos.ClearRecentErrorsRequest) (*protos.ClearRecentErrorsReply, error) {
+	w.logDst.errors.clear()
+	return &protos.ClearRecentErrorsReply{}, nil
+}
+
+// SetComponentLogLevel implements controller.SetComponentLogLevel.
+func (w *RemoteWeavelet) SetComponentLogLevel(_ context.Context, req *protos.SetComponentLogLevelRequest) (*protos.SetComponentLogLevelReply, error) {
+	if _, err := w.getComponent(req.Component); err != nil {
+		return nil, err
+	}
+	if req.Level == "" {
+		w.logLevels.clear(req.Component)
+		w.syslogger.Info("Component log level cleared", "component", req.Component)
+		return &protos.SetComponentLogLevelReply{}, nil
+	}
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(req.Level)); err != nil {
+		return nil, fmt.Errorf("component %q: invalid log level %q: %w", req.Component, req.Level, err)
+	}
+	w.logLevels.set(req.Component, level)
+	w.syslogger.Info("Component log level set", "component", req.Component, "level", level)
+	return &protos.SetComponentLogLevelReply{}, nil
+}
+
+// GetLogLevels implements controller.GetLogLevels.
+func (w *RemoteWeavelet) GetLogLevels(context.Context, *protos.GetLogLevelsRequest) (*protos.GetLogLevelsReply, error) {
+	levels := map[string]string{}
+	for component, level := range w.logLevels.overrides() {
+		levels[component] = level.String()
+	}
+	return &protos.GetLogLevelsReply{Levels: levels}, nil
+}
+
+// GetDependencies implements controller.GetDependencies.
+func (w *RemoteWeavelet) GetDependencies(context.Context, *protos.GetDependenciesRequest) (*protos.GetDependenciesReply, error) {
+	graph := &protos.CallGraph{}
+	for _, edge := range codegen.CallGraph() {
+		caller, ok := w.componentsByIntf[edge.Caller]
+		if !ok {
+			continue
+		}
+		callee, ok := w.componentsByIntf[edge.Callee]
+		if !ok {
+			continue
+		}
+		routing := protos.CallGraphEdge_UNKNOWN
+		if local, ok := callee.local.TryRead(); ok && local {
+			routing = protos.CallGraphEdge_LOCAL
+		} else if ok {
+			routing = protos.CallGraphEdge_REMOTE
+		}
+		graph.Edges = append(graph.Edges, &protos.CallGraphEdge{
+			Caller:  caller.reg.Name,
+			Callee:  callee.reg.Name,
+			Routing: routing,
+		})
+	}
+	return &protos.GetDependenciesReply{Graph: graph}, nil
+}
+
+// GetSnapshot implements controller.GetSnapshot.
+func (w *RemoteWeavelet) GetSnapshot(ctx context.Context, req *protos.GetSnapshotRequest) (*protos.GetSnapshotReply, error) {
+	snapshot := &protos.WeaveletSnapshot{TimeUnixMicros: time.Now().UnixMicro()}
+	reply := &protos.GetSnapshotReply{Snapshot: snapshot}
+	if req.Health {
+		health, err := w.GetHealth(ctx, &protos.GetHealthRequest{})
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Health = health
+	}
+	if req.Load {
+		load, err := w.GetLoad(ctx, &protos.GetLoadRequest{})
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Load = load.Load
+	}
+	if req.RuntimeStats {
+		snapshot.RuntimeStats = getRuntimeStats(w.started)
+	}
+	if req.Metrics != nil {
+		metrics, err := w.GetMetrics(ctx, req.Metrics)
+		if err != nil {
+			return nil, err
+		}
+		reply.Metrics = metrics.Update
+	}
+	return reply, nil
+}
+
+// GetFDCount implements controller.GetFDCount.
+func (w *RemoteWeavelet) GetFDCount(context.Context, *protos.GetFDCountRequest) (*protos.GetFDCountReply, error) {
+	return getFDCount()
+}
+
+// GetRoutingInfo implements controller.GetRoutingInfo.
+func (w *RemoteWeavelet) GetRoutingInfo(_ context.Context, req *protos.GetRoutingInfoRequest) (*protos.GetRoutingInfoReply, error) {
+	c, err := w.getComponent(req.Component)
+	if err != nil {
+		return nil, err
+	}
+	c.routingMu.Lock()
+	defer c.routingMu.Unlock()
+	return &protos.GetRoutingInfoReply{
+		RoutingInfo:    c.routingInfo,
+		CircuitBreaker: c.breaker.snapshot(w.opts.Clock.Now()),
+	}, nil
+}
+
+// GetFeatureFlags implements controller.GetFeatureFlags.
+func (w *RemoteWeavelet) GetFeatureFlags(context.Context, *protos.GetFeatureFlagsRequest) (*protos.GetFeatureFlagsReply, error) {
+	return &protos.GetFeatureFlagsReply{Flags: getFeatureFlags()}, nil
+}
+
+// SetFeatureFlag implements controller.SetFeatureFlag.
+func (w *RemoteWeavelet) SetFeatureFlag(_ context.Context, req *protos.SetFeatureFlagRequest) (*protos.SetFeatureFlagReply, error) {
+	if err := setFeatureFlag(req.Name, req.Value); err != nil {
+		return nil, err
+	}
+	w.syslogger.Info("Set feature flag", "name", req.Name, "value", req.Value)
+	return &protos.SetFeatureFlagReply{}, nil
+}
+
+// RawRPC implements controller.RawRPC.
+func (w *RemoteWeavelet) RawRPC(ctx context.Context, req *protos.RawRPCRequest) (*protos.RawRPCReply, error) {
+	payload, err := handleRawRPC(ctx, req.Handler, req.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return &protos.RawRPCReply{Payload: payload}, nil
+}
+
+// Checkpoint implements controller.Checkpoint.
+func (w *RemoteWeavelet) Checkpoint(ctx context.Context, req *protos.CheckpointRequest) (*protos.CheckpointReply, error) {
+	c, err := w.getComponent(req.Component)
+	if err != nil {
+		return nil, err
+	}
+	cp, ok := getCheckpointer(c.reg.Impl)
+	if !ok {
+		return nil, fmt.Errorf("checkpoint %q: %w", req.Component, control.ErrNotCheckpointable)
+	}
+	if !c.implReady.Load() {
+		return nil, fmt.Errorf("checkpoint %q: component not started", req.Component)
+	}
+	state, err := cp.Checkpoint(ctx, c.impl)
+	if err != nil {
+		return nil, fmt.Errorf("checkpoint %q: %w", req.Component, err)
+	}
+	return &protos.CheckpointReply{State: state}, nil
+}
+
+// QuiesceComponent implements controller.QuiesceComponent.
+//
+// As with SetComponentEnabled, calls from colocated components are not
+// rejected, so they may change the state of the component after it has been
+// checkpointed.
+func (w *RemoteWeavelet) QuiesceComponent(ctx context.Context, req *protos.QuiesceComponentRequest) (*protos.QuiesceComponentReply, error) {
+	c, err := w.getComponent(req.Component)
+	if err != nil {
+		return nil, err
+	}
+	cp, ok := getCheckpointer(c.reg.Impl)
+	if !ok {
+		return nil, fmt.Errorf("quiesce %q: %w", req.Component, control.ErrNotCheckpointable)
+	}
+	if !c.implReady.Load() {
+		return nil, fmt.Errorf("quiesce %q: component not started", req.Component)
+	}
+
+	// Disable the component, and re-enable it if it can't be checkpointed.
+	wasDisabled := c.disabled.Swap(true)
+	state, err := func() ([]byte, error) {
+		drainCtx := ctx
+		if req.TimeoutNs > 0 {
+			var cancel context.CancelFunc
+			drainCtx, cancel = context.WithTimeout(ctx, time.Duration(req.TimeoutNs))
+			defer cancel()
+		}
+		if err := drain(drainCtx, c); err != nil {
+			return nil, err
+		}
+		return cp.Checkpoint(ctx, c.impl)
+	}()
+	if err != nil {
+		c.disabled.Store(wasDisabled)
+		return nil, fmt.Errorf("quiesce %q: %w", req.Component, err)
+	}
+	w.syslogger.Info("Component quiesced", "component", req.Component)
+	return &protos.QuiesceComponentReply{State: state}, nil
+}
+
+// Drain implements controller.Drain.
+func (w *RemoteWeavelet) Drain(ctx context.Context, req *protos.DrainRequest) (*protos.DrainReply, error) {
+	// Validate all stages before disabling any component.
+	stages := make([][]*component, len(req.Stages))
+	seen := map[string]bool{}
+	for i, stage := range req.Stages {
+		for _, name := range stage.Components {
+			if seen[name] {
+				return nil, fmt.Errorf("drain: component %q in more than one stage", name)
+			}
+			seen[name] = true
+			c, err := w.getComponent(name)
+			if err != nil {
+				return nil, fmt.Errorf("drain: %w", err)
+			}
+			stages[i] = append(stages[i], c)
+		}
+	}
+
+	if req.TimeoutNs > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(req.TimeoutNs))
+		defer cancel()
+	}
+	for i, stage := range stages {
+		for _, c := range stage {
+			c.disabled.Store(true)
+		}
+		var group errgroup.Group
+		for _, c := range stage {
+			group.Go(func() error { return drain(ctx, c) })
+		}
+		if err := group.Wait(); err != nil {
+			return nil, fmt.Errorf("drain stage %d: %w", i, err)
+		}
+		w.syslogger.Info("Components drained", "stage", i, "components", req.Stages[i].Components)
+	}
+	return &protos.DrainReply{}, nil
+}
+
+// Restore implements controller.Restore.
+func (w *RemoteWeavelet) Restore(ctx context.Context, req *protos.RestoreRequest) (*protos.RestoreReply, error) {
+	c, err := w.getComponent(req.Component)
+	if err != nil {
+		return nil, err
+	}
+	cp, ok := getCheckpointer(c.reg.Impl)
+	if !ok {
+		return nil, fmt.Errorf("restore %q: %w", req.Component, control.ErrNotCheckpointable)
+	}
+	// Restoring into a component that hasn't started yet starts it.
+	impl, err := w.GetImpl(c.reg.Impl)
+	if err != nil {
+		return nil, err
+	}
+	if err := cp.Restore(ctx, impl, req.State); err != nil {
+		return nil, fmt.Errorf("restore %q: %w", req.Component, err)
+	}
+	return &protos.RestoreReply{}, nil
+}
+
 // Info returns the WeaveletArgs received from the envelope.
 func (w *RemoteWeavelet) Info() *protos.WeaveletArgs {
 	return w.args
@@ -730,9 +1494,21 @@ func (w *RemoteWeavelet) getComponent(name string) (*component, error) {
 // that (1) creates the local component if it hasn't been created yet and (2)
 // calls m.
 func (w *RemoteWeavelet) addHandlers(handlers *call.HandlerMap, c *component) {
+	labels := componentLabels(c.reg.Name)
 	for i, n := 0, c.reg.Iface.NumMethod(); i < n; i++ {
 		mname := c.reg.Iface.Method(i).Name
 		handler := func(ctx context.Context, args []byte) (res []byte, err error) {
+			// Note that we increment c.inflight before checking c.disabled,
+			// so that SetComponentEnabled doesn't miss any in-flight calls.
+			c.inflight.Add(1)
+			defer c.inflight.Add(-1)
+			if c.disabled.Load() {
+				return nil, fmt.Errorf("component %q is disabled", c.reg.Name)
+			}
+			if l := c.limiter.Load(); l != nil && !l.Take(w.opts.Clock.Now()) {
+				return nil, fmt.Errorf("component %q: %w (%v requests per second)", c.reg.Name, ErrRateLimited, l.Rate())
+			}
+
 			// This handler is supposed to invoke the method named mname on the
 			// local component. However, it is possible that the component has
 			// not yet been started. w.GetImpl will start the component if it
@@ -742,7 +1518,10 @@ func (w *RemoteWeavelet) addHandlers(handlers *call.HandlerMap, c *component) {
 				return nil, err
 			}
 			fn := c.serverStub.GetStubFn(mname)
-			return fn(ctx, args)
+			pprof.Do(ctx, labels, func(ctx context.Context) {
+				res, err = fn(ctx, args)
+			})
+			return res, err
 		}
 		handlers.Set(c.reg.Name, mname, handler)
 	}
@@ -751,7 +1530,7 @@ func (w *RemoteWeavelet) addHandlers(handlers *call.HandlerMap, c *component) {
 	// the clients to wait for the component to be ready before receiving traffic
 	// (see waitUntilReady).
 	handlers.Set(c.reg.Name, readyMethodName, func(context.Context, []byte) ([]byte, error) {
-		if c.implReady.Load() {
+		if c.implReady.Load() && !c.disabled.Load() {
 			return nil, nil
 		}
 		return nil, call.Unreachable
@@ -798,6 +1577,7 @@ func (w *RemoteWeavelet) logger(name string, attrs ...string) *slog.Logger {
 			Attrs:      attrs,
 		},
 		Write: w.logDst.log,
+		Level: w.logLevels.leveler(name),
 	})
 }
 
@@ -810,8 +1590,9 @@ func (w *RemoteWeavelet) sendTraceSpans(spans *protos.TraceSpans) error {
 	}
 }
 
-// listener returns the listener with the provided name.
-func (w *RemoteWeavelet) listener(ctx context.Context, name string) (*listener, error) {
+// listener returns the listener with the provided name. A new listener is
+// exported with the provided health check.
+func (w *RemoteWeavelet) listener(ctx context.Context, name string, health *protos.ListenerHealthCheck) (*listener, error) {
 	w.lismu.Lock()
 	defer w.lismu.Unlock()
 	if lis, ok := w.listeners[name]; ok {
@@ -830,7 +1611,7 @@ func (w *RemoteWeavelet) listener(ctx context.Context, name string) (*listener,
 	}
 
 	// Listen on the address.
-	lis, err := net.Listen("tcp", addr)
+	lis, err := listen(addr)
 	if err != nil {
 		return nil, fmt.Errorf("listener(%q): %w", name, err)
 	}
@@ -842,8 +1623,10 @@ func (w *RemoteWeavelet) listener(ctx context.Context, name string) (*listener,
 	if err := w.repeatedly(w.ctx, errMsg, func() error {
 		var err error
 		request := &protos.ExportListenerRequest{
-			Listener: name,
-			Address:  lis.Addr().String(),
+			Listener:    name,
+			Address:     listenerAddress(lis),
+			Token:       w.listenerToken(name),
+			HealthCheck: health,
 		}
 		reply, err = w.deployer.ExportListener(ctx, request)
 		return err
@@ -854,12 +1637,123 @@ func (w *RemoteWeavelet) listener(ctx context.Context, name string) (*listener,
 		return nil, fmt.Errorf("listener(%q): %s", name, reply.Error)
 	}
 
+	// Serve TLS if the deployer provided TLS material.
+	l := &listener{lis: lis, addr: listenerAddress(lis), proxyAddr: reply.ProxyAddress, health: health}
+	if reply.Tls != nil {
+		l.tls, err = newListenerTLS(reply.Tls)
+		if err != nil {
+			lis.Close()
+			return nil, fmt.Errorf("listener(%q): %w", name, err)
+		}
+		l.lis = l.tls.wrap(lis)
+	}
+	l.lis = readyListener{Listener: l.lis, ready: &l.ready}
+
 	// Store the listener.
-	l := &listener{lis, reply.ProxyAddress}
 	w.listeners[name] = l
 	return l, nil
 }
 
+// listenerToken returns the token of the listener with the provided name (see
+// ExportListenerRequest.token).
+func (w *RemoteWeavelet) listenerToken(name string) string {
+	return w.Info().Id + "/" + name
+}
+
+// listen listens on the provided address. Addresses of the form
+// "unix://<path>" are bound to a unix domain socket at the provided path, and
+// all other addresses are bound to a TCP socket. A stale unix domain socket
+// left at path by an earlier process is replaced. The socket file is removed
+// when the listener is closed.
+func listen(addr string) (net.Listener, error) {
+	path, ok := strings.CutPrefix(addr, "unix://")
+	if !ok {
+		return net.Listen("tcp", addr)
+	}
+	if info, err := os.Stat(path); err == nil && info.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+	return net.Listen("unix", path)
+}
+
+// listenerAddress returns the address of the provided listener, in the
+// format accepted by listen.
+func listenerAddress(lis net.Listener) string {
+	if lis.Addr().Network() == "unix" {
+		return "unix://" + lis.Addr().String()
+	}
+	return lis.Addr().String()
+}
+
+// listenerReadiness returns the readiness of all listeners, sorted by name.
+func (w *RemoteWeavelet) listenerReadiness() []*protos.ListenerReadiness {
+	w.lismu.Lock()
+	defer w.lismu.Unlock()
+	readiness := make([]*protos.ListenerReadiness, 0, len(w.listeners))
+	for name, l := range w.listeners {
+		readiness = append(readiness, &protos.ListenerReadiness{
+			Listener: name,
+			Address:  l.addr,
+			Ready:    l.ready.Load(),
+		})
+	}
+	sort.Slice(readiness, func(i, j int) bool {
+		return readiness[i].Listener < readiness[j].Listener
+	})
+	return readiness
+}
+
+// UpdateListenerTLS implements controller.UpdateListenerTLS.
+func (w *RemoteWeavelet) UpdateListenerTLS(_ context.Context, req *protos.UpdateListenerTLSRequest) (*protos.UpdateListenerTLSReply, error) {
+	w.lismu.Lock()
+	defer w.lismu.Unlock()
+	l, ok := w.listeners[req.Listener]
+	if !ok {
+		return nil, fmt.Errorf("listener %q not found", req.Listener)
+	}
+	if l.tls == nil {
+		return nil, fmt.Errorf("listener %q was not exported with TLS", req.Listener)
+	}
+	if err := l.tls.update(req.Tls); err != nil {
+		return nil, fmt.Errorf("listener %q: %w", req.Listener, err)
+	}
+	w.syslogger.Debug("Updated listener TLS", "listener", req.Listener)
+	return &protos.UpdateListenerTLSReply{}, nil
+}
+
+// ReexportListeners implements controller.ReexportListeners.
+func (w *RemoteWeavelet) ReexportListeners(ctx context.Context, _ *protos.ReexportListenersRequest) (*protos.ReexportListenersReply, error) {
+	w.lismu.Lock()
+	defer w.lismu.Unlock()
+	names := maps.Keys(w.listeners)
+	sort.Strings(names)
+	for _, name := range names {
+		l := w.listeners[name]
+		request := &protos.ExportListenerRequest{
+			Listener:    name,
+			Address:     l.addr,
+			Token:       w.listenerToken(name),
+			HealthCheck: l.health,
+		}
+		reply, err := w.deployer.ExportListener(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("listener %q: %w", name, err)
+		}
+		if reply.Error != "" {
+			return nil, fmt.Errorf("listener %q: %s", name, reply.Error)
+		}
+		if reply.Tls != nil && l.tls != nil {
+			if err := l.tls.update(reply.Tls); err != nil {
+				return nil, fmt.Errorf("listener %q: %w", name, err)
+			}
+		}
+	}
+	w.syslogger.Debug("Re-exported listeners", "listeners", names)
+	return &protos.ReexportListenersReply{Listeners: names}, nil
+}
+
 func (w *RemoteWeavelet) getListenerAddress(ctx context.Context, name string) (string, error) {
 	request := &protos.GetListenerAddressRequest{Name: name}
 	reply, err := w.deployer.GetListenerAddress(ctx, request)
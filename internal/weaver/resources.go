@@ -0,0 +1,191 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"bufio"
+	"bytes"
+	"io/fs"
+	"os"
+	"path"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// getResourceLimits returns the resources available to this process.
+func getResourceLimits() *protos.ResourceLimits {
+	limits := readResourceLimits(os.DirFS("/"))
+	limits.Gomaxprocs = int64(runtime.GOMAXPROCS(0))
+	limits.NumCpu = int64(runtime.NumCPU())
+	return limits
+}
+
+// getRuntimeStats returns the Go runtime stats of this process, which started
+// at the provided time.
+func getRuntimeStats(started time.Time) *protos.RuntimeStats {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return &protos.RuntimeStats{
+		UptimeMicros:   time.Since(started).Microseconds(),
+		Goroutines:     int64(runtime.NumGoroutine()),
+		HeapAllocBytes: m.HeapAlloc,
+		HeapSysBytes:   m.HeapSys,
+		NumGc:          m.NumGC,
+		GcPauseTotalNs: m.PauseTotalNs,
+	}
+}
+
+// readResourceLimits reads the cgroup CPU and memory limits and the available
+// memory of this process from the provided file system, which is rooted at
+// "/". Limits that can't be read (e.g., because the process doesn't run on
+// Linux) are left unset.
+func readResourceLimits(fsys fs.FS) *protos.ResourceLimits {
+	limits := &protos.ResourceLimits{}
+	var usage int64
+	if dir, ok := cgroupV2Dir(fsys); ok {
+		// See https://docs.kernel.org/admin-guide/cgroup-v2.html.
+		if fields := strings.Fields(readString(fsys, path.Join(dir, "cpu.max"))); len(fields) == 2 {
+			limits.CpuLimit = ratio(fields[0], fields[1])
+		}
+		limits.MemoryLimitBytes = readInt(fsys, path.Join(dir, "memory.max"))
+		usage = readInt(fsys, path.Join(dir, "memory.current"))
+	} else {
+		// See https://docs.kernel.org/admin-guide/cgroup-v1/index.html.
+		cpu := cgroupV1Dir(fsys, "cpu")
+		quota := readString(fsys, path.Join(cpu, "cpu.cfs_quota_us"))
+		period := readString(fsys, path.Join(cpu, "cpu.cfs_period_us"))
+		limits.CpuLimit = ratio(quota, period)
+		memory := cgroupV1Dir(fsys, "memory")
+		limits.MemoryLimitBytes = readInt(fsys, path.Join(memory, "memory.limit_in_bytes"))
+		usage = readInt(fsys, path.Join(memory, "memory.usage_in_bytes"))
+	}
+	if limits.MemoryLimitBytes >= 1<<62 {
+		// cgroup v1 reports a huge limit when memory is unlimited.
+		limits.MemoryLimitBytes = 0
+	}
+
+	limits.AvailableMemoryBytes = memAvailable(fsys)
+	if limit := limits.MemoryLimitBytes; limit > 0 && usage > 0 {
+		if avail := max(limit-usage, 0); limits.AvailableMemoryBytes == 0 || avail < limits.AvailableMemoryBytes {
+			limits.AvailableMemoryBytes = avail
+		}
+	}
+	return limits
+}
+
+// cgroupV2Dir returns the directory, relative to the root of fsys, of the
+// cgroup v2 of this process, if the process uses cgroups v2.
+func cgroupV2Dir(fsys fs.FS) (string, bool) {
+	if _, err := fs.Stat(fsys, "sys/fs/cgroup/cgroup.controllers"); err != nil {
+		return "", false
+	}
+	for _, line := range strings.Split(readString(fsys, "proc/self/cgroup"), "\n") {
+		if p, ok := strings.CutPrefix(line, "0::"); ok {
+			return cgroupDir(fsys, "sys/fs/cgroup", p), true
+		}
+	}
+	return "sys/fs/cgroup", true
+}
+
+// cgroupV1Dir returns the directory, relative to the root of fsys, of the
+// cgroup v1 of this process for the provided controller (e.g., "cpu").
+func cgroupV1Dir(fsys fs.FS, controller string) string {
+	mount := path.Join("sys/fs/cgroup", controller)
+	for _, line := range strings.Split(readString(fsys, "proc/self/cgroup"), "\n") {
+		// Lines have the form "<id>:<controllers>:<path>".
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		for _, c := range strings.Split(parts[1], ",") {
+			if c == controller {
+				return cgroupDir(fsys, mount, parts[2])
+			}
+		}
+	}
+	return mount
+}
+
+// cgroupDir returns the directory of the cgroup with the provided path under
+// the provided mount point. Inside a container, the cgroup path is typically
+// relative to a cgroup namespace mounted directly at the mount point, in which
+// case the mount point is returned.
+func cgroupDir(fsys fs.FS, mount, cgroup string) string {
+	dir := path.Join(mount, cgroup)
+	if _, err := fs.Stat(fsys, dir); err != nil {
+		return mount
+	}
+	return dir
+}
+
+// memAvailable returns the memory available on the machine, as reported by
+// /proc/meminfo, or 0 if unknown.
+func memAvailable(fsys fs.FS) int64 {
+	data, err := fs.ReadFile(fsys, "proc/meminfo")
+	if err != nil {
+		return 0
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		// The line has the form "MemAvailable:   12345 kB".
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 3 && fields[0] == "MemAvailable:" && fields[2] == "kB" {
+			kb, err := strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				return 0
+			}
+			return kb * 1024
+		}
+	}
+	return 0
+}
+
+// readString returns the trimmed contents of the provided file, or the empty
+// string if it can't be read.
+func readString(fsys fs.FS, name string) string {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// readInt returns the integer stored in the provided file, or 0 if the file
+// can't be read or doesn't contain a positive integer (e.g., "max").
+func readInt(fsys fs.FS, name string) int64 {
+	n, err := strconv.ParseInt(readString(fsys, name), 10, 64)
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+// ratio returns quota/period, or 0 if either isn't a positive integer (e.g.,
+// the quota is "max" or -1).
+func ratio(quota, period string) float64 {
+	q, err := strconv.ParseInt(quota, 10, 64)
+	if err != nil || q <= 0 {
+		return 0
+	}
+	p, err := strconv.ParseInt(period, 10, 64)
+	if err != nil || p <= 0 {
+		return 0
+	}
+	return float64(q) / float64(p)
+}
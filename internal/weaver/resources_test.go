@@ -0,0 +1,97 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestReadResourceLimits(t *testing.T) {
+	const meminfo = "MemTotal:       16000000 kB\nMemAvailable:    8000000 kB\n"
+	for _, test := range []struct {
+		name  string
+		files map[string]string
+		want  *protos.ResourceLimits
+	}{
+		{
+			name: "NoCgroup",
+			files: map[string]string{
+				"proc/meminfo": meminfo,
+			},
+			want: &protos.ResourceLimits{AvailableMemoryBytes: 8000000 * 1024},
+		},
+		{
+			name: "V2",
+			files: map[string]string{
+				"proc/meminfo":                       meminfo,
+				"proc/self/cgroup":                   "0::/app\n",
+				"sys/fs/cgroup/cgroup.controllers":   "cpu memory\n",
+				"sys/fs/cgroup/app/cpu.max":          "150000 100000\n",
+				"sys/fs/cgroup/app/memory.max":       "1073741824\n",
+				"sys/fs/cgroup/app/memory.current":   "73741824\n",
+				"sys/fs/cgroup/other/memory.max":     "1\n",
+				"sys/fs/cgroup/other/memory.current": "1\n",
+			},
+			want: &protos.ResourceLimits{
+				CpuLimit:             1.5,
+				MemoryLimitBytes:     1 << 30,
+				AvailableMemoryBytes: 1000000000,
+			},
+		},
+		{
+			name: "V2Namespace",
+			files: map[string]string{
+				// Inside a cgroup namespace, the cgroup path doesn't exist
+				// under the mount point.
+				"proc/self/cgroup":                 "0::/kubepods/pod1\n",
+				"sys/fs/cgroup/cgroup.controllers": "cpu memory\n",
+				"sys/fs/cgroup/cpu.max":            "max 100000\n",
+				"sys/fs/cgroup/memory.max":         "max\n",
+			},
+			want: &protos.ResourceLimits{},
+		},
+		{
+			name: "V1",
+			files: map[string]string{
+				"proc/meminfo":                                   meminfo,
+				"proc/self/cgroup":                               "4:memory:/app\n3:cpu,cpuacct:/app\n",
+				"sys/fs/cgroup/cpu/app/cpu.cfs_quota_us":         "200000\n",
+				"sys/fs/cgroup/cpu/app/cpu.cfs_period_us":        "100000\n",
+				"sys/fs/cgroup/memory/app/memory.limit_in_bytes": "9223372036854771712\n",
+				"sys/fs/cgroup/memory/app/memory.usage_in_bytes": "1000\n",
+			},
+			want: &protos.ResourceLimits{
+				CpuLimit:             2,
+				AvailableMemoryBytes: 8000000 * 1024,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			fsys := fstest.MapFS{}
+			for name, data := range test.files {
+				fsys[name] = &fstest.MapFile{Data: []byte(data)}
+			}
+			got := readResourceLimits(fsys)
+			if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("readResourceLimits (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
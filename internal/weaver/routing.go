@@ -17,7 +17,9 @@ package weaver
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"math/rand"
+	"slices"
 	"sync"
 
 	"github.com/ServiceWeaver/weaver/internal/cond"
@@ -34,6 +36,7 @@ type routingBalancer struct {
 	mu         sync.RWMutex
 	assignment *protos.Assignment
 	index      index
+	pins       []*protos.PinRule // see RoutingInfo.pins
 
 	// Map from address to connection. We currently allow just one
 	// connection per address.
@@ -81,6 +84,13 @@ func (rb *routingBalancer) update(assignment *protos.Assignment) {
 	rb.index = index
 }
 
+// updatePins replaces the balancer's pin rules with the provided rules.
+func (rb *routingBalancer) updatePins(pins []*protos.PinRule) {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	rb.pins = pins
+}
+
 // Pick implements the call.Balancer interface.
 func (rb *routingBalancer) Pick(opts call.CallOptions) (call.ReplicaConnection, bool) {
 	if opts.ShardKey == 0 {
@@ -89,6 +99,11 @@ func (rb *routingBalancer) Pick(opts call.CallOptions) (call.ReplicaConnection,
 		return rb.balancer.Pick(opts)
 	}
 
+	// Pin rules take precedence over the assignment.
+	if c, ok := rb.pinned(opts.ShardKey); ok {
+		return c, true
+	}
+
 	// Grab the current assignment. It's possible that the current assignment
 	// changes between when we release the lock and when we pick an endpoint,
 	// but using a slightly stale assignment is okay.
@@ -127,6 +142,34 @@ func (rb *routingBalancer) Pick(opts call.CallOptions) (call.ReplicaConnection,
 	return nil, false
 }
 
+// pinned returns the connection to the replica that the provided shard key is
+// pinned to, if the key is pinned and the replica is connected.
+func (rb *routingBalancer) pinned(key uint64) (call.ReplicaConnection, bool) {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+	for _, pin := range rb.pins {
+		if pin.Start <= key && key <= pin.End {
+			c, ok := rb.conns[pin.Replica]
+			return c, ok
+		}
+	}
+	return nil, false
+}
+
+// validatePins checks that the pin rules of the provided routing info are
+// well-formed and only pin keys to the routing info's replicas.
+func validatePins(info *protos.RoutingInfo) error {
+	for _, pin := range info.Pins {
+		if pin.Start > pin.End {
+			return fmt.Errorf("pin rule for %q: start %d > end %d", info.Component, pin.Start, pin.End)
+		}
+		if !slices.Contains(info.Replicas, pin.Replica) {
+			return fmt.Errorf("pin rule for %q: replica %q not in replicas %v", info.Component, pin.Replica, info.Replicas)
+		}
+	}
+	return nil
+}
+
 // routingResolver is a dummy resolver that returns whatever endpoints are
 // passed to the update method.
 type routingResolver struct {
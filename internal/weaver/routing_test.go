@@ -107,6 +107,74 @@ func TestRoutingBalancer(t *testing.T) {
 	}
 }
 
+// TestRoutingBalancerPins tests that a routingBalancer picks pinned replicas
+// ahead of its assignment, and falls back to its assignment for keys that are
+// not pinned or that are pinned to unavailable replicas.
+func TestRoutingBalancerPins(t *testing.T) {
+	b := call.BalancerFunc(func([]call.ReplicaConnection, call.CallOptions) (call.ReplicaConnection, bool) {
+		t.Fatal("default balancer called")
+		return nil, false
+	})
+	rb := routingBalancer{balancer: b, conns: map[string]call.ReplicaConnection{}}
+	rb.update(&protos.Assignment{
+		Slices: []*protos.Assignment_Slice{{Start: 0, Replicas: []string{"a"}}},
+	})
+	rb.updatePins([]*protos.PinRule{
+		{Start: 10, End: 20, Replica: "b"},
+		{Start: 15, End: 30, Replica: "c"},
+		{Start: 40, End: 40, Replica: "missing"},
+	})
+	rb.Add(fakeConn("a"))
+	rb.Add(fakeConn("b"))
+	rb.Add(fakeConn("c"))
+
+	for _, test := range []struct {
+		shardKey uint64
+		want     string
+	}{
+		{5, "a"},
+		{10, "b"},
+		{20, "b"}, // the first matching rule wins
+		{25, "c"},
+		{40, "a"}, // the pinned replica is not connected
+		{50, "a"},
+	} {
+		t.Run(fmt.Sprint(test.shardKey), func(t *testing.T) {
+			got, ok := rb.Pick(call.CallOptions{ShardKey: test.shardKey})
+			if !ok {
+				t.Fatal("did not find replica")
+			}
+			if got.Address() != test.want {
+				t.Fatalf("rb.Pick(%d): got %s, want %s", test.shardKey, got.Address(), test.want)
+			}
+		})
+	}
+}
+
+func TestValidatePins(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		pin   *protos.PinRule
+		valid bool
+	}{
+		{"Valid", &protos.PinRule{Start: 1, End: 2, Replica: "a"}, true},
+		{"SingleKey", &protos.PinRule{Start: 1, End: 1, Replica: "b"}, true},
+		{"EmptyRange", &protos.PinRule{Start: 2, End: 1, Replica: "a"}, false},
+		{"UnknownReplica", &protos.PinRule{Start: 1, End: 2, Replica: "c"}, false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			info := &protos.RoutingInfo{
+				Component: "component",
+				Replicas:  []string{"a", "b"},
+				Pins:      []*protos.PinRule{test.pin},
+			}
+			if err := validatePins(info); (err == nil) != test.valid {
+				t.Fatalf("validatePins(%v): got %v, want valid=%t", test.pin, err, test.valid)
+			}
+		})
+	}
+}
+
 // TestRoutingResolverInitialResolve tests that the first Resolve invocation on
 // a routingResolver returns a nil set of endpoints but a non-nil version.
 func TestRoutingResolverInitialResolve(t *testing.T) {
@@ -217,7 +217,8 @@ func singleTracer(ctx context.Context, app, deploymentId, id string) (trace.Trac
 	exporter := traceio.NewWriter(func(spans *protos.TraceSpans) error {
 		return traceDB.Store(ctx, app, deploymentId, spans)
 	})
-	return tracer(exporter, app, deploymentId, id), nil
+	tracer, _ := tracer(exporter, app, deploymentId, id)
+	return tracer, nil
 }
 
 // GetIntf implements the Weavelet interface.
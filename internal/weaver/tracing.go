@@ -15,10 +15,13 @@
 package weaver
 
 import (
+	"context"
 	"os"
+	"sort"
 
 	"github.com/ServiceWeaver/weaver/internal/traceio"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -27,7 +30,8 @@ import (
 )
 
 // tracer returns a tracer for the provided app, deploymentId, and weaveletId
-// that uses the provided exporter. The tracer is also set as the otel default.
+// that uses the provided exporter, along with its provider. The tracer is also
+// set as the otel default.
 //
 // Note that we set the ServiceNameKey attribute to the name of the app. Based on
 // the otel resource definition in [1], the ServiceNameKey should be unique across
@@ -36,7 +40,7 @@ import (
 // per application, it makes sense to be the application name.
 //
 // [1] https://github.com/open-telemetry/opentelemetry-go/blob/v1.20.0/semconv/v1.7.0/resource.go#L813
-func tracer(exporter sdktrace.SpanExporter, app, deploymentId, weaveletId string) trace.Tracer {
+func tracer(exporter sdktrace.SpanExporter, app, deploymentId, weaveletId string) (trace.Tracer, *sdktrace.TracerProvider) {
 	const instrumentationLibrary = "github.com/ServiceWeaver/weaver/serviceweaver"
 	const instrumentationVersion = "0.0.1"
 	tracerProvider := sdktrace.NewTracerProvider(
@@ -56,5 +60,32 @@ func tracer(exporter sdktrace.SpanExporter, app, deploymentId, weaveletId string
 	// Set global tracing defaults.
 	otel.SetTracerProvider(tracerProvider)
 	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
-	return tracer
+	return tracer, tracerProvider
+}
+
+// annotatedTracer is a trace.Tracer that adds the provided attributes to the
+// spans it starts. Attributes set on a span later take precedence.
+type annotatedTracer struct {
+	trace.Tracer
+	attrs []attribute.KeyValue
+}
+
+// newAnnotatedTracer returns a tracer that adds the provided annotations as
+// attributes to the spans started by the provided tracer.
+func newAnnotatedTracer(tracer trace.Tracer, annotations map[string]string) trace.Tracer {
+	if len(annotations) == 0 {
+		return tracer
+	}
+	attrs := make([]attribute.KeyValue, 0, len(annotations))
+	for k, v := range annotations {
+		attrs = append(attrs, attribute.String(k, v))
+	}
+	sort.Slice(attrs, func(i, j int) bool { return attrs[i].Key < attrs[j].Key })
+	return annotatedTracer{Tracer: tracer, attrs: attrs}
+}
+
+// Start implements the trace.Tracer interface.
+func (t annotatedTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	opts = append([]trace.SpanStartOption{trace.WithAttributes(t.attrs...)}, opts...)
+	return t.Tracer.Start(ctx, name, opts...)
 }
@@ -0,0 +1,48 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestAnnotatedTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := newAnnotatedTracer(provider.Tracer("test"), map[string]string{"color": "blue", "zone": "a"})
+
+	// Attributes set when starting the span take precedence over the
+	// annotations.
+	_, span := tracer.Start(context.Background(), "span", trace.WithAttributes(attribute.String("zone", "b")))
+	span.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	got := map[attribute.Key]string{}
+	for _, attr := range spans[0].Attributes() {
+		got[attr.Key] = attr.Value.AsString()
+	}
+	if got["color"] != "blue" || got["zone"] != "b" {
+		t.Fatalf("got attributes %v, want color=blue and zone=b", got)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"github.com/ServiceWeaver/weaver/internal/weaver"
+)
+
+// ListenerHealthCheck configures how a deployer checks the health of a
+// Listener, e.g., when registering it with a load balancer:
+//
+//   - Path is the URL path to request. Defaults to HealthzURL.
+//   - Status is the HTTP status code of a healthy reply. Defaults to 200.
+//   - Interval is the interval between checks. Defaults to 10 seconds.
+type ListenerHealthCheck = weaver.ListenerHealthCheck
+
+// ListenerHealthChecks configures the health checks of the listeners of a
+// component, by listener name. Embed it in the config of a component (see
+// WithConfig) to configure the health checks in the application config file.
+// For example:
+//
+//	type config struct {
+//	    weaver.ListenerHealthChecks `toml:"health_checks"`
+//	}
+//
+//	type server struct {
+//	    weaver.Implements[weaver.Main]
+//	    weaver.WithConfig[config]
+//	    api weaver.Listener
+//	}
+//
+// configures the health check of the api listener with:
+//
+//	["github.com/ServiceWeaver/weaver/Main".health_checks.api]
+//	path = "/ready"
+//	interval = "5s"
+//
+// The weavelet passes the health check of a listener to the deployer when it
+// exports the listener. Listeners without a configured health check use the
+// defaults.
+type ListenerHealthChecks = weaver.ListenerHealthChecks
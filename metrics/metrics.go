@@ -15,8 +15,11 @@
 package metrics
 
 import (
+	"context"
+
 	"github.com/ServiceWeaver/weaver/runtime/metrics"
 	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // A Counter is a float-valued, monotonically increasing metric. For example,
@@ -178,6 +181,18 @@ func (h *Histogram) Put(val float64) {
 	h.impl.Put(val)
 }
 
+// PutContext records a value in its bucket, like Put. If ctx carries a
+// sampled trace span, the value is also recorded as the exemplar of its
+// bucket, linking the bucket to an example trace.
+func (h *Histogram) PutContext(ctx context.Context, val float64) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsSampled() {
+		h.impl.Put(val)
+		return
+	}
+	h.impl.PutExemplar(val, sc.TraceID(), sc.SpanID())
+}
+
 // A HistogramMap is a collection of Histograms with the same name and label
 // schema but with different label values. See package documentation for a
 // description of L.
@@ -0,0 +1,38 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+
+	"github.com/ServiceWeaver/weaver/internal/weaver"
+)
+
+// RegisterRawHandler registers a named handler for raw RPCs issued by the
+// deployer (see envelope.Envelope.RawRPC). A raw RPC carries an opaque
+// payload to the handler and returns the handler's opaque result, which lets
+// deployers prototype new control messages without changing the runtime
+// protocol.
+//
+// Raw RPCs are unversioned: the deployer and the handler must agree on the
+// encoding of payloads themselves. They are intended for experimentation
+// only. Control messages that prove useful should become part of the runtime
+// protocol instead.
+//
+// RegisterRawHandler should be called from an init function, and at most once
+// per name. The handler may be called concurrently.
+func RegisterRawHandler(name string, handler func(context.Context, []byte) ([]byte, error)) {
+	weaver.RegisterRawHandler(name, handler)
+}
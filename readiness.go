@@ -0,0 +1,45 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+
+	"github.com/ServiceWeaver/weaver/internal/weaver"
+)
+
+// AddReadinessGate registers a named readiness gate. A weavelet is ready only
+// once all of its readiness gates report true. Readiness is distinct from
+// health: a healthy weavelet may not be ready yet because, for example, it is
+// still warming a cache or connecting to a dependency. Deployers can query
+// the readiness of a weavelet (see envelope.Envelope.GetReadiness) and should
+// wait for a weavelet to be ready before routing traffic to it.
+//
+// The ready function may be called concurrently and repeatedly, and it should
+// return quickly. AddReadinessGate should be called from an init function or
+// a component's Init method. Adding a gate with the name of an existing gate
+// replaces the existing gate, so an Init method that runs more than once in
+// the same process (e.g., in tests) leaves only the latest gate. For example:
+//
+//	func (c *cache) Init(ctx context.Context) error {
+//	    weaver.AddReadinessGate("cache-warm", func(context.Context) bool {
+//	        return c.warm.Load()
+//	    })
+//	    go c.warmUp()
+//	    return nil
+//	}
+func AddReadinessGate(name string, ready func(context.Context) bool) {
+	weaver.AddReadinessGate(name, ready)
+}
@@ -15,6 +15,9 @@
 package codegen
 
 import (
+	"math"
+	"math/rand/v2"
+	"sync/atomic"
 	"time"
 
 	imetrics "github.com/ServiceWeaver/weaver/internal/metrics"
@@ -46,6 +49,11 @@ var (
 		"Number of bytes in Service Weaver component method replies",
 		imetrics.GeneratedBuckets,
 	)
+	serializationLatencies = metrics.NewHistogramMap[SerializationLabels](
+		imetrics.SerializationLatenciesName,
+		"Duration, in microseconds, spent encoding or decoding the arguments or results of a sampled remote Service Weaver component method call",
+		imetrics.GeneratedBuckets,
+	)
 )
 
 type MethodLabels struct {
@@ -102,3 +110,75 @@ func (m *MethodMetrics) End(h MethodCallHandle, failed bool, requestBytes, reply
 		m.bytesReply.Put(float64(replyBytes))
 	}
 }
+
+// serializationSampling holds the fraction of remote method calls whose
+// serialization is timed, stored with math.Float64bits.
+var serializationSampling atomic.Uint64
+
+// SetSerializationSampling sets the fraction, between 0 and 1, of the remote
+// component method calls made or served by the process whose argument and
+// result encoding and decoding times are recorded by the generated stubs (see
+// BeginSerialization). It is zero (disabled) by default, and any value keeps
+// the overhead on calls that aren't sampled to a single atomic load.
+func SetSerializationSampling(rate float64) {
+	serializationSampling.Store(math.Float64bits(rate))
+}
+
+// SerializationOp identifies the encoding or decoding of the arguments or
+// results of a method call.
+type SerializationOp int
+
+const (
+	EncodeArgs    SerializationOp = iota // done by the client stub
+	DecodeArgs                           // done by the server stub
+	EncodeResults                        // done by the server stub
+	DecodeResults                        // done by the client stub
+)
+
+var serializationOpNames = []string{
+	EncodeArgs:    "encode_args",
+	DecodeArgs:    "decode_args",
+	EncodeResults: "encode_results",
+	DecodeResults: "decode_results",
+}
+
+// String returns the name of the operation, e.g., "encode_args".
+func (op SerializationOp) String() string {
+	if int(op) < len(serializationOpNames) {
+		return serializationOpNames[op]
+	}
+	return "unknown"
+}
+
+type SerializationLabels struct {
+	Component string // full callee component name
+	Method    string // callee component method's name
+	Op        string // serialization operation (see SerializationOp)
+	Generated bool   `weaver:"serviceweaver_generated"` // Is this an autogenerated metric?
+}
+
+// SerializationHandle holds information needed to record the time spent on a
+// serialization operation, if the operation is sampled.
+type SerializationHandle struct {
+	start time.Time // zero if not sampled
+}
+
+// BeginSerialization starts timing a serialization operation, if the
+// operation is sampled (see SetSerializationSampling).
+func BeginSerialization() SerializationHandle {
+	rate := math.Float64frombits(serializationSampling.Load())
+	if rate <= 0 || (rate < 1 && rand.Float64() >= rate) {
+		return SerializationHandle{}
+	}
+	return SerializationHandle{start: time.Now()}
+}
+
+// EndSerialization records the time spent on the provided serialization
+// operation of a call to the provided method, if the operation was sampled.
+func EndSerialization(h SerializationHandle, component, method string, op SerializationOp) {
+	if h.start.IsZero() {
+		return
+	}
+	labels := SerializationLabels{Component: component, Method: method, Op: op.String(), Generated: true}
+	serializationLatencies.Get(labels).Put(float64(time.Since(h.start).Microseconds()))
+}
@@ -15,10 +15,53 @@
 package codegen
 
 import (
+	"fmt"
 	"testing"
 	"time"
+
+	imetrics "github.com/ServiceWeaver/weaver/internal/metrics"
+	"github.com/ServiceWeaver/weaver/runtime/metrics"
 )
 
+func TestSerializationSampling(t *testing.T) {
+	defer SetSerializationSampling(0)
+	labels := SerializationLabels{
+		Component: "component",
+		Method:    "TestSerializationSampling",
+		Op:        DecodeArgs.String(),
+		Generated: true,
+	}
+	count := func() uint64 {
+		for _, m := range metrics.Snapshot() {
+			if m.Name == imetrics.SerializationLatenciesName && m.Labels["method"] == labels.Method {
+				var n uint64
+				for _, c := range m.Counts {
+					n += c
+				}
+				return n
+			}
+		}
+		return 0
+	}
+
+	for _, test := range []struct {
+		rate float64
+		want uint64
+	}{
+		{0, 0},
+		{1, 10},
+	} {
+		SetSerializationSampling(test.rate)
+		before := count()
+		for i := 0; i < 10; i++ {
+			EndSerialization(BeginSerialization(), labels.Component, labels.Method, DecodeArgs)
+		}
+		if got := count() - before; got != test.want {
+			t.Errorf("rate %v: got %d samples, want %d", test.rate, got, test.want)
+		}
+	}
+}
+
 func BenchmarkMetrics(b *testing.B) {
 	metrics := MethodMetricsFor(MethodLabels{
 		Caller:    "caller",
@@ -37,3 +80,15 @@ func BenchmarkMetrics(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkSerializationSampling(b *testing.B) {
+	for _, rate := range []float64{0, 0.01} {
+		b.Run(fmt.Sprint(rate), func(b *testing.B) {
+			SetSerializationSampling(rate)
+			defer SetSerializationSampling(0)
+			for i := 0; i < b.N; i++ {
+				EndSerialization(BeginSerialization(), "component", "method", EncodeArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conntrace records the bytes exchanged over connections and replays
+// them later. It can be used to turn an issue observed on the connections
+// between an envelope and a weavelet into a reproducible test:
+//
+//	recorder := conntrace.NewRecorder(w)
+//	env, err := envelope.NewEnvelope(ctx, args, config, envelope.Options{
+//	    WrapConn: recorder.WrapConn,
+//	})
+//	...
+//	records, err := conntrace.Replay(r)
+//
+// A trace is a sequence of records. Every record holds a chunk of bytes that
+// was either read from or written to one of the recorded connections, tagged
+// with the connection and its socket, in the order in which the reads and
+// writes completed.
+package conntrace
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+)
+
+// Record kinds.
+const (
+	kindConn  byte = 'c' // a new connection, whose data is its socket
+	kindRead  byte = 'r' // bytes read from a connection
+	kindWrite byte = 'w' // bytes written to a connection
+)
+
+// maxRecordSize is the maximum size of a single record. It guards against
+// allocating huge buffers when replaying a corrupted trace.
+const maxRecordSize = 64 << 20
+
+// A Record is a chunk of bytes read from or written to a recorded connection.
+type Record struct {
+	Socket string // socket of the connection, as passed to WrapConn
+	Conn   int    // connection number, starting at 1, in the order of the WrapConn calls
+	Read   bool   // whether Data was read from (or else written to) the connection
+	Data   []byte
+}
+
+// A Recorder records the bytes read from and written to connections. A
+// single trace can hold the records of many connections (e.g., the control
+// and deployer connections between an envelope and a weavelet).
+type Recorder struct {
+	mu    sync.Mutex // guards the following fields
+	w     io.Writer  // destination of the trace
+	err   error      // first error writing to w
+	conns int        // number of wrapped connections
+}
+
+// NewRecorder returns a recorder that writes its trace to w.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{w: w}
+}
+
+// WrapConn returns a connection that behaves like c and records all bytes
+// read from and written to c, tagged with the provided socket. Its signature
+// matches envelope.Options.WrapConn. Errors writing the trace don't affect
+// the returned connection (see Err).
+func (r *Recorder) WrapConn(socket string, c net.Conn) net.Conn {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.conns++
+	id := r.conns
+	r.write(kindConn, id, []byte(socket))
+	return &recordedConn{Conn: c, r: r, id: id}
+}
+
+// Err returns the first error writing the trace, if any. Writing stops at
+// the first error.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return fmt.Errorf("conntrace: error recording trace: %w", r.err)
+	}
+	return nil
+}
+
+// record appends a record about the provided connection to the trace.
+func (r *Recorder) record(kind byte, id int, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.write(kind, id, data)
+}
+
+// write writes a record to the trace.
+//
+// REQUIRES: r.mu is held.
+func (r *Recorder) write(kind byte, id int, data []byte) {
+	if r.err != nil {
+		return
+	}
+	// Write every record with a single call, so that a trace read while
+	// connections are still being recorded only ends with whole records.
+	rec := make([]byte, 1, 1+2*binary.MaxVarintLen64+len(data))
+	rec[0] = kind
+	rec = binary.AppendUvarint(rec, uint64(id))
+	rec = binary.AppendUvarint(rec, uint64(len(data)))
+	rec = append(rec, data...)
+	if _, err := r.w.Write(rec); err != nil {
+		r.err = err
+	}
+}
+
+// recordedConn is a net.Conn that records the bytes read and written.
+type recordedConn struct {
+	net.Conn
+	r  *Recorder
+	id int // connection number
+}
+
+// Read implements the net.Conn interface.
+func (c *recordedConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.r.record(kindRead, c.id, b[:n])
+	return n, err
+}
+
+// Write implements the net.Conn interface.
+func (c *recordedConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.r.record(kindWrite, c.id, b[:n])
+	return n, err
+}
+
+// Replay reads a trace produced by a Recorder and returns its records, in
+// order.
+func Replay(r io.Reader) ([]Record, error) {
+	var records []Record
+	sockets := map[int]string{} // sockets, by connection number
+	br := bufio.NewReader(r)
+	for {
+		kind, err := br.ReadByte()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("conntrace: %w", err)
+		}
+		id, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, fmt.Errorf("conntrace: truncated record: %w", err)
+		}
+		n, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, fmt.Errorf("conntrace: truncated record: %w", err)
+		}
+		if n > maxRecordSize {
+			return nil, fmt.Errorf("conntrace: record size %d exceeds limit %d", n, maxRecordSize)
+		}
+		var data bytes.Buffer
+		if _, err := io.CopyN(&data, br, int64(n)); err != nil {
+			return nil, fmt.Errorf("conntrace: truncated record: %w", err)
+		}
+
+		conn := int(id)
+		switch kind {
+		case kindConn:
+			sockets[conn] = data.String()
+		case kindRead, kindWrite:
+			socket, ok := sockets[conn]
+			if !ok {
+				return nil, fmt.Errorf("conntrace: record of unknown connection %d", conn)
+			}
+			records = append(records, Record{Socket: socket, Conn: conn, Read: kind == kindRead, Data: data.Bytes()})
+		default:
+			return nil, fmt.Errorf("conntrace: invalid record kind %q", kind)
+		}
+	}
+}
+
+// Play plays the peer's side of a recorded connection over c: it writes to c
+// the bytes that were read from the recorded connection, and reads from c
+// the bytes that were written to it, in the order of the records. It fails
+// if the bytes read from c differ from the recorded ones. The records must
+// belong to a single connection (see Record.Conn).
+//
+// For example, Play can replay the weavelet's side of a recorded connection
+// to an envelope, to check that the envelope handles it the same way.
+func Play(c net.Conn, records []Record) error {
+	for i, rec := range records {
+		if rec.Read {
+			if _, err := c.Write(rec.Data); err != nil {
+				return fmt.Errorf("conntrace: record %d: %w", i, err)
+			}
+			continue
+		}
+		got := make([]byte, len(rec.Data))
+		if _, err := io.ReadFull(c, got); err != nil {
+			return fmt.Errorf("conntrace: record %d: %w", i, err)
+		}
+		if !bytes.Equal(got, rec.Data) {
+			return fmt.Errorf("conntrace: record %d: got %q, want %q", i, got, rec.Data)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,304 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conntrace
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/internal/control"
+	"github.com/ServiceWeaver/weaver/runtime/envelope"
+	"github.com/ServiceWeaver/weaver/runtime/protomsg"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/ServiceWeaver/weaver/runtime/scriptedweavelet"
+	"github.com/google/go-cmp/cmp"
+)
+
+// echo serves server, echoing back what it reads, in upper case.
+func echo(server net.Conn) {
+	buf := make([]byte, 64)
+	for {
+		n, err := server.Read(buf)
+		if err != nil {
+			return
+		}
+		server.Write(bytes.ToUpper(buf[:n]))
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	var trace bytes.Buffer
+	recorder := NewRecorder(&trace)
+	var conns []net.Conn
+	for _, socket := range []string{"a", "b"} {
+		client, server := net.Pipe()
+		go echo(server)
+		conns = append(conns, recorder.WrapConn(socket, client))
+	}
+
+	// Interleave the messages on both connections.
+	for _, msg := range []struct {
+		conn int
+		data string
+	}{{0, "hello"}, {1, "world"}, {0, "bye"}} {
+		conn := conns[msg.conn]
+		if _, err := conn.Write([]byte(msg.data)); err != nil {
+			t.Fatal(err)
+		}
+		buf := make([]byte, len(msg.data))
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, conn := range conns {
+		if err := conn.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := recorder.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := Replay(&trace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Record{
+		{Socket: "a", Conn: 1, Data: []byte("hello")},
+		{Socket: "a", Conn: 1, Read: true, Data: []byte("HELLO")},
+		{Socket: "b", Conn: 2, Data: []byte("world")},
+		{Socket: "b", Conn: 2, Read: true, Data: []byte("WORLD")},
+		{Socket: "a", Conn: 1, Data: []byte("bye")},
+		{Socket: "a", Conn: 1, Read: true, Data: []byte("BYE")},
+	}
+	if diff := cmp.Diff(want, records); diff != "" {
+		t.Fatalf("records (-want +got):\n%s", diff)
+	}
+}
+
+func TestReplayCorrupted(t *testing.T) {
+	for _, trace := range []string{
+		"x\x01\x01a",            // invalid kind
+		"r\x01\x01a",            // unknown connection
+		"c\x01\x01ar\x01\x05ab", // truncated data
+		"w\x01\xff\xff",         // truncated size
+	} {
+		if _, err := Replay(bytes.NewBufferString(trace)); err == nil {
+			t.Errorf("Replay(%q): unexpected success", trace)
+		}
+	}
+}
+
+// lockedBuffer is a bytes.Buffer that can be written and read concurrently.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// Bytes returns a copy of the bytes written so far.
+func (b *lockedBuffer) Bytes() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return bytes.Clone(b.buf.Bytes())
+}
+
+// logHandler is an EnvelopeHandler that records the messages of the log
+// entries it receives.
+type logHandler struct {
+	envelope.EnvelopeHandler
+	mu   sync.Mutex
+	msgs []string
+}
+
+func (h *logHandler) LogBatch(_ context.Context, batch *protos.LogEntryBatch) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, entry := range batch.Entries {
+		h.msgs = append(h.msgs, entry.Msg)
+	}
+	return nil
+}
+
+func (h *logHandler) logged() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return slices.Clone(h.msgs)
+}
+
+// deployerSocket returns the path of the envelope's socket, which the
+// weavelet with the provided args dials.
+func deployerSocket(args *protos.WeaveletArgs) string {
+	for _, r := range args.Redirects {
+		if r.Component == control.DeployerPath {
+			return strings.TrimPrefix(r.Address, "unix://")
+		}
+	}
+	return ""
+}
+
+// argsChild is a scripted weavelet that remembers the args it is started
+// with.
+type argsChild struct {
+	*scriptedweavelet.Weavelet
+	args *protos.WeaveletArgs
+}
+
+// Start implements the envelope.Child interface.
+func (c *argsChild) Start(ctx context.Context, config *protos.AppConfig, args *protos.WeaveletArgs) error {
+	c.args = protomsg.Clone(args)
+	return c.Weavelet.Start(ctx, config, args)
+}
+
+// playChild is a scripted weavelet without a script that also dials the
+// envelope and plays the provided records over the connection.
+type playChild struct {
+	*scriptedweavelet.Weavelet
+	records []Record
+	played  chan error // receives the result of Play
+}
+
+// Start implements the envelope.Child interface.
+func (c *playChild) Start(ctx context.Context, config *protos.AppConfig, args *protos.WeaveletArgs) error {
+	if err := c.Weavelet.Start(ctx, config, args); err != nil {
+		return err
+	}
+	socket := deployerSocket(args)
+	go func() {
+		// The envelope listens on its socket once it serves.
+		var conn net.Conn
+		var err error
+		for start := time.Now(); ; time.Sleep(10 * time.Millisecond) {
+			if conn, err = net.Dial("unix", socket); err == nil || time.Since(start) > 10*time.Second {
+				break
+			}
+		}
+		if err != nil {
+			c.played <- err
+			return
+		}
+		context.AfterFunc(ctx, func() { conn.Close() })
+		c.played <- Play(conn, c.records)
+	}()
+	return nil
+}
+
+// serve creates an envelope for the provided child, with the provided
+// options, and serves it with the provided handler until the test ends.
+func serve(t *testing.T, child envelope.Child, h envelope.EnvelopeHandler, opts envelope.Options) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	args := &protos.WeaveletArgs{
+		App:             "conntrace_test.go",
+		DeploymentId:    "deployment",
+		Id:              "weavelet",
+		InternalAddress: "localhost:0",
+	}
+	opts.TmpDir = t.TempDir()
+	opts.Child = child
+	env, err := envelope.NewEnvelope(ctx, args, &protos.AppConfig{}, opts)
+	if err != nil {
+		cancel()
+		t.Fatal(err)
+	}
+	served := make(chan error, 1)
+	go func() { served <- env.Serve(h) }()
+	t.Cleanup(func() {
+		cancel()
+		<-served
+	})
+}
+
+// TestReplayIntoServe records the connections between an envelope and a
+// weavelet, and replays the weavelet's side of the connection it dials into
+// a new envelope.
+func TestReplayIntoServe(t *testing.T) {
+	// Record.
+	var trace lockedBuffer
+	recorder := NewRecorder(&trace)
+	child := &argsChild{Weavelet: scriptedweavelet.New(nil,
+		scriptedweavelet.Log(&protos.LogEntry{Msg: "hello"}),
+		scriptedweavelet.Log(&protos.LogEntry{Msg: "world"}),
+	)}
+	h := &logHandler{}
+	serve(t, child, h, envelope.Options{WrapConn: recorder.WrapConn})
+	select {
+	case <-child.Done():
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the script to end")
+	}
+	if err := child.Err(); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"hello", "world"}
+	if diff := cmp.Diff(want, h.logged()); diff != "" {
+		t.Fatalf("recorded logs (-want +got):\n%s", diff)
+	}
+	if err := recorder.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Both sockets are recorded, and every record of the deployer socket
+	// belongs to the connection dialed by the weavelet.
+	records, err := Replay(bytes.NewReader(trace.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var deployer []Record
+	sockets := map[string]bool{}
+	for _, rec := range records {
+		sockets[rec.Socket] = true
+		if rec.Socket == deployerSocket(child.args) {
+			deployer = append(deployer, rec)
+		}
+	}
+	if !sockets[child.args.ControlSocket] || len(deployer) == 0 {
+		t.Fatalf("records of sockets %v, want %q and %q", sockets, child.args.ControlSocket, deployerSocket(child.args))
+	}
+	for _, rec := range deployer {
+		if rec.Conn != deployer[0].Conn {
+			t.Fatalf("records of deployer connections %d and %d, want one connection", deployer[0].Conn, rec.Conn)
+		}
+	}
+
+	// Replay.
+	replayed := &logHandler{}
+	player := &playChild{
+		Weavelet: scriptedweavelet.New(nil),
+		records:  deployer,
+		played:   make(chan error, 1),
+	}
+	serve(t, player, replayed, envelope.Options{})
+	if err := <-player.played; err != nil {
+		t.Fatal(err)
+	}
+	for start := time.Now(); !slices.Equal(replayed.logged(), want); time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > 10*time.Second {
+			t.Fatalf("replayed logs: got %v, want %v", replayed.logged(), want)
+		}
+	}
+}
@@ -24,6 +24,8 @@ import (
 	"sync"
 
 	"github.com/ServiceWeaver/weaver/internal/net/call"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 // ServeComponents handles method calls made to the specified listener for the specified
@@ -31,7 +33,27 @@ import (
 //
 // Each components map entry has the full component name as the key, and the component
 // implementation as the value.
+//
+// Clients that request per-message checksums get them (see
+// call.ServerOptions.Checksum).
 func ServeComponents(ctx context.Context, listener net.Listener, logger *slog.Logger, components map[string]any) error {
+	return serveComponents(ctx, listener, call.ServerOptions{Logger: logger, Checksum: true}, components)
+}
+
+// ServeComponentsWithTracer is like ServeComponents, but the method calls
+// that carry the caller's trace context are served in server spans created by
+// the provided tracer, as children of the caller's span. If tracer is nil, no
+// spans are created, and the contexts passed to the components carry the
+// caller's span context instead. Either way, the components can start spans
+// that are correctly parented to the caller's span.
+func ServeComponentsWithTracer(ctx context.Context, listener net.Listener, logger *slog.Logger, tracer trace.Tracer, components map[string]any) error {
+	if tracer == nil {
+		tracer = noop.NewTracerProvider().Tracer("")
+	}
+	return serveComponents(ctx, listener, call.ServerOptions{Logger: logger, Tracer: tracer, Checksum: true}, components)
+}
+
+func serveComponents(ctx context.Context, listener net.Listener, opts call.ServerOptions, components map[string]any) error {
 	// Precompute the handler map.
 	handlers := call.NewHandlerMap()
 	for path, impl := range components {
@@ -40,7 +62,7 @@ func ServeComponents(ctx context.Context, listener net.Listener, logger *slog.Lo
 		}
 	}
 	f := &fixedListener{listener, handlers}
-	return call.Serve(ctx, f, call.ServerOptions{Logger: logger})
+	return call.Serve(ctx, f, opts)
 }
 
 type fixedListener struct {
@@ -0,0 +1,69 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Broadcast calls f on every envelope in envelopes, running at most
+// maxConcurrency calls in parallel. If maxConcurrency is not positive, all
+// calls run in parallel. Broadcast waits for all calls to finish and returns
+// the result of every call, keyed by envelope; a nil error indicates success.
+//
+// A failing call does not stop the other calls. If ctx is cancelled, calls
+// that have not started yet are skipped and their results are set to
+// ctx.Err().
+//
+// Broadcast is useful for fanning out an operation (e.g., updating routing
+// info) across many weavelets:
+//
+//	results := envelope.Broadcast(ctx, envelopes, func(ctx context.Context, e *envelope.Envelope) error {
+//	    return e.UpdateRoutingInfo(routing)
+//	}, 16)
+func Broadcast(ctx context.Context, envelopes []*Envelope, f func(context.Context, *Envelope) error, maxConcurrency int) map[*Envelope]error {
+	var mu sync.Mutex
+	results := make(map[*Envelope]error, len(envelopes))
+	record := func(e *Envelope, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		results[e] = err
+	}
+
+	var group errgroup.Group
+	if maxConcurrency > 0 {
+		group.SetLimit(maxConcurrency)
+	}
+	for _, e := range envelopes {
+		e := e
+		if err := ctx.Err(); err != nil {
+			record(e, err)
+			continue
+		}
+		group.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				record(e, err)
+				return nil
+			}
+			record(e, f(ctx, e))
+			return nil
+		})
+	}
+	group.Wait() // calls never return errors
+	return results
+}
@@ -0,0 +1,29 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import "github.com/ServiceWeaver/weaver/internal/net/call"
+
+// Clock is the source of time used by an envelope for all of its timing
+// related logic (e.g., timeouts and periodic polling), including the timing
+// of its connection to the weavelet. Tests can provide a fake Clock to
+// deterministically trigger timeouts without real sleeps.
+type Clock = call.Clock
+
+// Timer is a single event timer created by a Clock. It mirrors time.Timer.
+type Timer = call.Timer
+
+// realClock is a Clock implemented using the time package.
+type realClock = call.RealClock
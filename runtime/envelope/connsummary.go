@@ -0,0 +1,318 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/internal/net/call"
+	"github.com/ServiceWeaver/weaver/runtime/metrics"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// ConnSummary summarizes the connection between an envelope and its weavelet,
+// once the connection has terminated. See Options.OnClose.
+//
+// Messages are RPC requests and replies, in both directions: RPCs issued by
+// the envelope (e.g., [Envelope.GetHealth]) and RPCs issued by the weavelet
+// (e.g., [EnvelopeHandler.LogBatch]). Bytes are the bytes written to and read
+// from the sockets connecting the envelope and the weavelet, including
+// framing.
+type ConnSummary struct {
+	Start            time.Time        // when the envelope connected to the weavelet
+	Uptime           time.Duration    // how long the connection was up
+	Err              error            // why the connection terminated, as returned by Serve
+	MessagesSent     int64            // messages sent to the weavelet
+	MessagesReceived int64            // messages received from the weavelet
+	BytesSent        int64            // bytes sent to the weavelet
+	BytesReceived    int64            // bytes received from the weavelet
+	RPCs             map[string]int64 // number of RPCs, in either direction, by method
+	Retries          map[string]int64 // number of retries of RPCs to the weavelet, by method
+}
+
+// connStats accumulates the statistics of the connection between an envelope
+// and its weavelet.
+type connStats struct {
+	clock            Clock
+	start            time.Time
+	messagesSent     atomic.Int64
+	messagesReceived atomic.Int64
+	bytesSent        atomic.Int64
+	bytesReceived    atomic.Int64
+	lastRecv         atomic.Int64 // when bytes were last received, in Unix nanoseconds
+
+	onRetry func(method string, attempt int, err error) // see Options.OnRetry
+
+	mu      sync.Mutex
+	rpcs    map[string]int64
+	retries map[string]int64
+}
+
+func newConnStats(clock Clock) *connStats {
+	return &connStats{clock: clock, start: clock.Now(), rpcs: map[string]int64{}, retries: map[string]int64{}}
+}
+
+// lastActivity returns when bytes were last received from the weavelet, or
+// the zero time if none have been received.
+func (s *connStats) lastActivity() time.Time {
+	nanos := s.lastRecv.Load()
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
+}
+
+// rpc records an RPC of the provided method.
+func (s *connStats) rpc(method string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rpcs[method]++
+}
+
+// retry records a retry of an RPC of the provided method.
+func (s *connStats) retry(method string, attempt int, err error) {
+	s.mu.Lock()
+	s.retries[method]++
+	s.mu.Unlock()
+	if s.onRetry != nil {
+		s.onRetry(method, attempt, err)
+	}
+}
+
+// summary returns a summary of the connection, which terminated at the
+// provided time with the provided error.
+func (s *connStats) summary(end time.Time, err error) ConnSummary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return ConnSummary{
+		Start:            s.start,
+		Uptime:           end.Sub(s.start),
+		Err:              err,
+		MessagesSent:     s.messagesSent.Load(),
+		MessagesReceived: s.messagesReceived.Load(),
+		BytesSent:        s.bytesSent.Load(),
+		BytesReceived:    s.bytesReceived.Load(),
+		RPCs:             maps.Clone(s.rpcs),
+		Retries:          maps.Clone(s.retries),
+	}
+}
+
+// snapshots returns the statistics of the connection at the provided time as
+// metrics with the provided labels. RPC and retry counts are labeled by
+// method.
+func (s *connStats) snapshots(now time.Time, labels map[string]string) []*metrics.MetricSnapshot {
+	var snapshots []*metrics.MetricSnapshot
+	add := func(typ protos.MetricType, name, help string, value float64, extra map[string]string) {
+		l := map[string]string{}
+		maps.Copy(l, labels)
+		maps.Copy(l, extra)
+		snapshots = append(snapshots, &metrics.MetricSnapshot{
+			Id:     uint64(len(snapshots)),
+			Type:   typ,
+			Name:   name,
+			Labels: l,
+			Help:   help,
+			Value:  value,
+		})
+	}
+	summary := s.summary(now, nil)
+	add(protos.MetricType_GAUGE, "serviceweaver_envelope_uptime_seconds", "Seconds since the envelope connected to the weavelet", summary.Uptime.Seconds(), nil)
+	add(protos.MetricType_COUNTER, "serviceweaver_envelope_messages_sent", "Number of messages sent to the weavelet", float64(summary.MessagesSent), nil)
+	add(protos.MetricType_COUNTER, "serviceweaver_envelope_messages_received", "Number of messages received from the weavelet", float64(summary.MessagesReceived), nil)
+	add(protos.MetricType_COUNTER, "serviceweaver_envelope_bytes_sent", "Number of bytes sent to the weavelet", float64(summary.BytesSent), nil)
+	add(protos.MetricType_COUNTER, "serviceweaver_envelope_bytes_received", "Number of bytes received from the weavelet", float64(summary.BytesReceived), nil)
+	for _, method := range sortedKeys(summary.RPCs) {
+		add(protos.MetricType_COUNTER, "serviceweaver_envelope_rpcs", "Number of RPCs between the envelope and the weavelet, in either direction", float64(summary.RPCs[method]), map[string]string{"method": method})
+	}
+	for _, method := range sortedKeys(summary.Retries) {
+		add(protos.MetricType_COUNTER, "serviceweaver_envelope_rpc_retries", "Number of retries of RPCs to the weavelet that failed with communication errors", float64(summary.Retries[method]), map[string]string{"method": method})
+	}
+	return snapshots
+}
+
+// sortedKeys returns the keys of the provided map, sorted.
+func sortedKeys(m map[string]int64) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// statsConn is a net.Conn that counts the bytes read and written.
+type statsConn struct {
+	net.Conn
+	stats *connStats
+}
+
+// Read implements the net.Conn interface.
+func (c statsConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.stats.bytesReceived.Add(int64(n))
+		c.stats.lastRecv.Store(c.stats.clock.Now().UnixNano())
+	}
+	return n, err
+}
+
+// Write implements the net.Conn interface.
+func (c statsConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.stats.bytesSent.Add(int64(n))
+	return n, err
+}
+
+// statsEndpoint is a call.Endpoint whose connections count their bytes. If
+// wrap is not nil, the connections are wrapped by wrap (see Options.WrapConn).
+type statsEndpoint struct {
+	call.Endpoint
+	socket string                          // path of the socket of the endpoint
+	wrap   func(string, net.Conn) net.Conn // see Options.WrapConn
+	stats  *connStats
+}
+
+// Dial implements the call.Endpoint interface.
+func (e statsEndpoint) Dial(ctx context.Context) (net.Conn, error) {
+	c, err := e.Endpoint.Dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if e.wrap != nil {
+		c = e.wrap(e.socket, c)
+	}
+	return statsConn{Conn: c, stats: e.stats}, nil
+}
+
+// statsListener is a net.Listener whose connections count their bytes. If
+// wrap is not nil, the connections are wrapped by wrap (see Options.WrapConn).
+type statsListener struct {
+	net.Listener
+	socket string                          // path of the socket of the listener
+	wrap   func(string, net.Conn) net.Conn // see Options.WrapConn
+	stats  *connStats
+}
+
+// Accept implements the net.Listener interface.
+func (l statsListener) Accept() (net.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	if l.wrap != nil {
+		c = l.wrap(l.socket, c)
+	}
+	return statsConn{Conn: c, stats: l.stats}, nil
+}
+
+// statsConnection is a call.Connection that counts the RPCs issued to the
+// weavelet's controller.
+type statsConnection struct {
+	call.Connection
+	stats   *connStats
+	methods map[call.MethodKey]string // method names, by key
+}
+
+// Call implements the call.Connection interface.
+func (c statsConnection) Call(ctx context.Context, h call.MethodKey, arg []byte, opts call.CallOptions) ([]byte, error) {
+	c.stats.rpc(c.methods[h])
+	c.stats.messagesSent.Add(1)
+	reply, err := c.Connection.Call(ctx, h, arg, opts)
+	if !opts.NoReply && replied(ctx, err) {
+		c.stats.messagesReceived.Add(1)
+	}
+	return reply, err
+}
+
+// replied returns whether a call made with the provided context, which
+// returned the provided error, received a reply. Calls that fail with a
+// communication error, or whose context is done, don't receive a reply.
+func replied(ctx context.Context, err error) bool {
+	if err == nil {
+		return true
+	}
+	return ctx.Err() == nil && !errors.Is(err, call.CommunicationError) && !errors.Is(err, call.Unreachable)
+}
+
+// statsHandler is an EnvelopeHandler that counts the RPCs issued by the
+// weavelet.
+type statsHandler struct {
+	h     EnvelopeHandler
+	stats *connStats
+}
+
+var _ EnvelopeHandler = statsHandler{}
+
+// record records an RPC of the provided method, and its reply.
+func (s statsHandler) record(method string) {
+	s.stats.rpc(method)
+	s.stats.messagesReceived.Add(1)
+	s.stats.messagesSent.Add(1)
+}
+
+// ActivateComponent implements the EnvelopeHandler interface.
+func (s statsHandler) ActivateComponent(ctx context.Context, req *protos.ActivateComponentRequest) (*protos.ActivateComponentReply, error) {
+	s.record("ActivateComponent")
+	return s.h.ActivateComponent(ctx, req)
+}
+
+// GetListenerAddress implements the EnvelopeHandler interface.
+func (s statsHandler) GetListenerAddress(ctx context.Context, req *protos.GetListenerAddressRequest) (*protos.GetListenerAddressReply, error) {
+	s.record("GetListenerAddress")
+	return s.h.GetListenerAddress(ctx, req)
+}
+
+// ExportListener implements the EnvelopeHandler interface.
+func (s statsHandler) ExportListener(ctx context.Context, req *protos.ExportListenerRequest) (*protos.ExportListenerReply, error) {
+	s.record("ExportListener")
+	return s.h.ExportListener(ctx, req)
+}
+
+// GetSelfCertificate implements the EnvelopeHandler interface.
+func (s statsHandler) GetSelfCertificate(ctx context.Context, req *protos.GetSelfCertificateRequest) (*protos.GetSelfCertificateReply, error) {
+	s.record("GetSelfCertificate")
+	return s.h.GetSelfCertificate(ctx, req)
+}
+
+// VerifyClientCertificate implements the EnvelopeHandler interface.
+func (s statsHandler) VerifyClientCertificate(ctx context.Context, req *protos.VerifyClientCertificateRequest) (*protos.VerifyClientCertificateReply, error) {
+	s.record("VerifyClientCertificate")
+	return s.h.VerifyClientCertificate(ctx, req)
+}
+
+// VerifyServerCertificate implements the EnvelopeHandler interface.
+func (s statsHandler) VerifyServerCertificate(ctx context.Context, req *protos.VerifyServerCertificateRequest) (*protos.VerifyServerCertificateReply, error) {
+	s.record("VerifyServerCertificate")
+	return s.h.VerifyServerCertificate(ctx, req)
+}
+
+// LogBatch implements the EnvelopeHandler interface.
+func (s statsHandler) LogBatch(ctx context.Context, batch *protos.LogEntryBatch) error {
+	s.record("LogBatch")
+	return s.h.LogBatch(ctx, batch)
+}
+
+// HandleTraceSpans implements the EnvelopeHandler interface.
+func (s statsHandler) HandleTraceSpans(ctx context.Context, spans *protos.TraceSpans) error {
+	s.record("HandleTraceSpans")
+	return s.h.HandleTraceSpans(ctx, spans)
+}
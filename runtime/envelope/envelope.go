@@ -17,14 +17,19 @@
 package envelope
 
 import (
-	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"maps"
 	"net"
 	"os"
+	"slices"
 	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
 
 	"github.com/ServiceWeaver/weaver/internal/control"
 	"github.com/ServiceWeaver/weaver/internal/net/call"
@@ -32,11 +37,14 @@ import (
 	"github.com/ServiceWeaver/weaver/runtime/codegen"
 	"github.com/ServiceWeaver/weaver/runtime/deployers"
 	"github.com/ServiceWeaver/weaver/runtime/metrics"
+	"github.com/ServiceWeaver/weaver/runtime/prometheus"
 	"github.com/ServiceWeaver/weaver/runtime/protomsg"
 	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/ServiceWeaver/weaver/runtime/retry"
 	"github.com/ServiceWeaver/weaver/runtime/version"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/encoding/prototext"
 
 	// We rely on the weaver.controller component registrattion entry.
 	_ "github.com/ServiceWeaver/weaver"
@@ -44,6 +52,13 @@ import (
 
 // EnvelopeHandler handles messages from the weavelet. Values passed to the
 // handlers are only valid for the duration of the handler's execution.
+//
+// If the weavelet sends a message while tracing an operation, the context
+// passed to the handler carries the trace context of that operation, and the
+// spans the handler starts from the context are children of the weavelet's
+// span (see Options.Tracer). This is best-effort: most messages are sent by
+// the weavelet on its own behalf, outside of any traced operation, in which
+// case trace.SpanContextFromContext returns an invalid span context.
 type EnvelopeHandler interface {
 	// ActivateComponent ensures that the provided component is running
 	// somewhere. A call to ActivateComponent also implicitly signals that a
@@ -93,30 +108,133 @@ type EnvelopeHandler interface {
 	HandleTraceSpans(context.Context, *protos.TraceSpans) error
 }
 
+// ErrNotCheckpointable is returned by [Envelope.Checkpoint],
+// [Envelope.Restore], and [Envelope.QuiesceComponent] for components that do
+// not support checkpointing. See weaver.RegisterCheckpoint.
+var ErrNotCheckpointable = control.ErrNotCheckpointable
+
+// ErrRetryBudgetExceeded is returned by an RPC to a weavelet that failed with
+// a communication error and was not retried because the envelope's retry
+// budget was exhausted. See Options.RetryBudget.
+var ErrRetryBudgetExceeded = call.ErrRetryBudgetExceeded
+
+// Errors wrapped by RPCs to a weavelet that failed because of a communication
+// error, identifying the operation on the connection to the weavelet that
+// failed. Check for them via errors.Is.
+var (
+	ErrSendFailed      = call.ErrSendFailed
+	ErrRecvFailed      = call.ErrRecvFailed
+	ErrHandshakeFailed = call.ErrHandshakeFailed
+)
+
+// RetryBudget bounds the rate at which RPCs are retried. See NewRetryBudget.
+type RetryBudget = call.RetryBudget
+
+// NewRetryBudget returns a retry budget that allows up to max retries in a
+// burst, and is refilled at perSecond retries per second.
+func NewRetryBudget(max int, perSecond float64) *RetryBudget {
+	return call.NewRetryBudget(max, perSecond)
+}
+
+// Capability identifies an EnvelopeHandler method that a weavelet may invoke
+// (e.g., protos.Capability_CAPABILITY_EXPORT_LISTENER). See
+// [Envelope.SupportsCapability].
+type Capability = protos.Capability
+
+// ErrAlreadyServing is returned by [Envelope.Serve] if it is called more than
+// once on the same envelope.
+var ErrAlreadyServing = errors.New("envelope: Serve called more than once")
+
+// ErrStaleRoutingUpdate is returned by [Envelope.UpdateRoutingInfo] when the
+// provided routing info is older than the routing info the weavelet has
+// already applied. See RoutingInfo.version in runtime/protos/runtime.proto.
+var ErrStaleRoutingUpdate = control.ErrStaleRoutingUpdate
+
+// ErrUnknownFeatureFlag is returned by [Envelope.SetFeatureFlag] for feature
+// flags that have not been registered. See weaver.RegisterFeatureFlag.
+var ErrUnknownFeatureFlag = control.ErrUnknownFeatureFlag
+
+// ErrUnknownRawHandler is returned by [Envelope.RawRPC] for raw handlers that
+// have not been registered. See weaver.RegisterRawHandler.
+var ErrUnknownRawHandler = control.ErrUnknownRawHandler
+
 // Ensure that EnvelopeHandler implements all the DeployerControl methods.
 var _ control.DeployerControl = EnvelopeHandler(nil)
 
 // Envelope starts and manages a weavelet in a subprocess.
 //
+// An envelope and its weavelet communicate over two independent connections.
+// RPCs issued by the envelope (e.g., [Envelope.GetHealth]) and their replies
+// use the weavelet's control socket, while the weavelet sends logs, trace
+// spans, and other requests on the envelope's socket. As a result, a burst of
+// logs never delays the reply to a control RPC. Log batches are delivered to
+// [EnvelopeHandler.LogBatch] in the order the weavelet produced them, but
+// there is no ordering between log batches and control RPC replies.
+//
 // For more information, refer to runtime/protos/runtime.proto and
 // https://serviceweaver.dev/blog/deployers.html.
 type Envelope struct {
 	// Fields below are constant after construction.
 	ctx          context.Context
 	ctxCancel    context.CancelFunc
+	serveCtx     context.Context    // cancelled by StopServing
+	stopServing  context.CancelFunc // cancels serveCtx
 	logger       *slog.Logger
+	tracer       trace.Tracer // see Options.Tracer
 	tmpDir       string
 	tmpDirOwned  bool // Did Envelope create tmpDir?
 	myUds        string
 	weavelet     *protos.WeaveletArgs
 	weaveletAddr string
-	config       *protos.AppConfig
+	resources    *protos.ResourceLimits  // reported by the weavelet at handshake
+	capabilities []Capability            // reported by the weavelet at handshake
 	child        Child                   // weavelet process handle
+	streams      *stdStreams             // captures weavelet stdout and stderr
 	controller   control.WeaveletControl // Stub that talks to the weavelet controller
+	conn         call.Connection         // connection used by controller
+	limiter      *rpcLimiter             // bounds concurrent RPCs to the weavelet
+	maxRPCs      int                     // reported by the weavelet at handshake
+	clock        Clock                   // source of time for timeouts and polling
+	retryBudget  *RetryBudget            // limits RPC retries, or nil
+	newBackoff   func() retry.Backoff    // see Options.NewBackoff
+	rpcTimeout   time.Duration           // see Options.RPCTimeout
+	handshake    time.Duration           // see Options.HandshakeTimeout
+	msgSampling  float64                 // see Options.MessageStatsSampling
+	traceQueue   int                     // see Options.TraceQueueSize
+	spanResource bool                    // see Options.SpanResource
+	skewInterval time.Duration           // see Options.SkewInterval
+	correctTimes bool                    // see Options.CorrectTimestamps
+	skew         skewEstimate            // skew of the weavelet's clock
+	logSpool     *LogSpool               // see Options.LogSpool
+	stats        *connStats              // statistics of the connection
+	onClose      func(ConnSummary)       // see Options.OnClose
+	onViolation  func(error)             // see Options.OnOrderViolation
+
+	// Derives the contexts of handler calls (see Options.HandlerContext).
+	handlerCtx func(context.Context) context.Context
+
+	// Wraps the connections to the weavelet (see Options.WrapConn).
+	wrapConn func(string, net.Conn) net.Conn
 
 	// State needed to process metric updates.
 	metricsMu sync.Mutex
-	metrics   metrics.Importer
+	metrics   *metrics.Importer
+	labels    map[string]string // extra metric labels (see Options.Labels)
+
+	// The app config, replaced by ReloadConfig.
+	configMu sync.Mutex
+	config   *protos.AppConfig
+
+	logSubs logSubscribers // live log subscribers (see Subscribe)
+	state   atomic.Int32   // current State (see State)
+
+	// The handler of trace spans, if trace spans are handled asynchronously
+	// (see Options.TraceQueueSize). Set by Serve.
+	asyncTraces atomic.Pointer[asyncTraceHandler]
+
+	// The spooler of log entries, if log entries are spooled (see
+	// Options.LogSpool). Set by Serve.
+	spooler atomic.Pointer[logSpooler]
 }
 
 // Options contains optional arguments for the envelope.
@@ -127,11 +245,178 @@ type Options struct {
 	// Logger is used for logging internal messages. If nil, a default logger is used.
 	Logger *slog.Logger
 
-	// Tracer is used for tracing internal calls. If nil, internal calls are not traced.
+	// Tracer is used for tracing internal calls, including the handling of
+	// the weavelet's messages that carry a trace context. If nil, internal
+	// calls are not traced.
 	Tracer trace.Tracer
 
 	// Child is used to run the weavelet. If nil, a sub-process is created.
 	Child Child
+
+	// Clock is used for all timing related logic. If nil, the real clock is
+	// used. Tests may provide a fake clock to trigger timeouts deterministically.
+	Clock Clock
+
+	// Labels are added to the labels of every metric returned by the
+	// envelope (e.g., by GetMetrics), which lets operators label metrics with
+	// deployment-specific dimensions like region or zone. Labels set by the
+	// weavelet take precedence over these labels.
+	Labels map[string]string
+
+	// Importer, if not nil, is used to import the metric updates received
+	// from the weavelet. This lets callers pre-configure or inspect the
+	// importer. The envelope takes ownership of the importer, which must not
+	// be used by other envelopes. If nil, a new importer is used.
+	Importer *metrics.Importer
+
+	// RetryBudget, if not nil, limits the rate at which RPCs to the weavelet
+	// are retried after communication errors. A single budget can be shared
+	// by many envelopes to bound the total rate of retries. If nil, retries
+	// are unlimited.
+	RetryBudget *RetryBudget
+
+	// NewBackoff, if not nil, returns the backoff used by each of the loops
+	// that redial the weavelet and that retry RPCs to the weavelet. If nil,
+	// retry.Exponential(retry.DefaultOptions) is used.
+	NewBackoff func() retry.Backoff
+
+	// OnRetry, if not nil, is called every time an RPC to the weavelet that
+	// failed with a communication error is about to be retried, with the
+	// name of the RPC's method (e.g., "GetHealth"), the number of the retry
+	// (starting at 1), and the error. It is called synchronously and should
+	// not block. Retries are also counted in ConnSummary.Retries and in the
+	// serviceweaver_envelope_rpc_retries metric (see WriteOpenMetrics). A high
+	// rate of retries is an early sign of trouble with the weavelet.
+	OnRetry func(method string, attempt int, err error)
+
+	// RPCTimeout, if positive, bounds the duration of every RPC the envelope
+	// issues to the weavelet, including the initialization of the weavelet,
+	// unless the RPC is already bounded by a context deadline. The deadline of
+	// an RPC is propagated to the weavelet: the weavelet handles the RPC under
+	// a context with the same deadline, which it passes on to the component
+	// calls it makes on behalf of the RPC (e.g., calls made by a checkpoint
+	// function). If zero, RPCs are not bounded.
+	RPCTimeout time.Duration
+
+	// HandshakeTimeout, if positive, bounds the exchange of versions that
+	// starts every connection to the weavelet's control socket, as measured
+	// by Clock. A connection whose handshake takes longer (e.g., because the
+	// weavelet accepts the connection but never replies) is redialed. If
+	// zero, handshakes are only bounded by RPCTimeout.
+	HandshakeTimeout time.Duration
+
+	// MessageStatsSampling is the fraction, between 0 and 1, of the RPCs to
+	// the weavelet whose message sizes are recorded as metrics of the
+	// envelope process, labeled by method and message type. This is intended
+	// for performance tuning and is zero (disabled) by default. The time
+	// spent encoding and decoding messages is recorded by the stubs instead
+	// (see codegen.SetSerializationSampling).
+	MessageStatsSampling float64
+
+	// TraceQueueSize, if positive, makes the envelope pass trace spans to
+	// EnvelopeHandler.HandleTraceSpans on a separate goroutine, through a
+	// queue that holds up to TraceQueueSize batches of spans. This keeps a
+	// slow trace exporter from delaying logs and other messages. Batches of
+	// spans that don't fit in the queue are dropped, errors returned by
+	// HandleTraceSpans are logged instead of being returned to the weavelet,
+	// and spans are no longer ordered with respect to other messages (e.g.,
+	// log entries). If zero, trace spans are handled like other messages.
+	TraceQueueSize int
+
+	// SpanResource, if true, makes the envelope add resource attributes
+	// identifying the weavelet to every trace span it receives, before
+	// passing the span to EnvelopeHandler.HandleTraceSpans: the service name
+	// and serviceweaver.app (the app name), the service instance id and
+	// serviceweaver.weavelet_id (the weavelet id),
+	// serviceweaver.deployment_id, and the process id of the weavelet, if
+	// known. This way, exported spans are attributed to the right service
+	// and instance, even if the weavelet doesn't set these attributes itself.
+	// The added attributes replace the span's resource attributes with the
+	// same keys.
+	SpanResource bool
+
+	// LogSpool, if not nil, makes the envelope pass log entries to
+	// EnvelopeHandler.LogBatch on a separate goroutine, queueing them on disk
+	// in LogSpool.Dir until the handler handles them. This keeps a slow or
+	// unavailable log sink from stalling the weavelet. Log entries for which
+	// LogBatch returns an error are retried until LogBatch succeeds, so they
+	// are delivered at least once, and the log entries still queued when the
+	// envelope stops or crashes are delivered by the next envelope that uses
+	// the same LogSpool.Dir. Log entries that don't fit in the spool are
+	// dropped.
+	// Live subscribers (see [Envelope.Subscribe]) receive log entries
+	// without delay.
+	LogSpool *LogSpool
+
+	// OnInitFailure, if not nil, is called when the weavelet fails to
+	// initialize or replies to the initialization request with invalid
+	// weavelet info (e.g., because of a version mismatch). reply is the
+	// decoded reply received from the weavelet, or nil if none was received
+	// or it couldn't be decoded. raw holds the bytes of the reply as
+	// received, or nil if none was received, which helps debug replies that
+	// can't be decoded (e.g., because of a corrupted stream). err is the
+	// error returned by NewEnvelope. OnInitFailure is intended for
+	// diagnostics and must not retain reply or raw.
+	OnInitFailure func(reply *protos.InitWeaveletReply, raw []byte, err error)
+
+	// OnClose, if not nil, is called with a summary of the connection to the
+	// weavelet when Serve returns (e.g., because the weavelet exited or the
+	// envelope was cancelled). This gives deployers a single record of every
+	// connection, gathered without enabling MessageStatsSampling.
+	OnClose func(ConnSummary)
+
+	// OnOrderViolation, if not nil, makes the weavelet stamp the log batches
+	// it sends with sequence numbers, and makes the envelope check that it
+	// receives them in order. OnOrderViolation is called with an error
+	// wrapping ErrMessageOrder for every batch that is received out of order,
+	// or after a gap (e.g., because the batch before it was lost). This
+	// catches bugs in the transport or framing of messages that would
+	// silently reorder log entries. It is a debugging aid intended for tests
+	// (e.g., passing t.Error), and should be nil in production.
+	OnOrderViolation func(error)
+
+	// HandlerContext, if not nil, is called with the context of every call
+	// to a method of the EnvelopeHandler, and the method is called with the
+	// returned context instead, which must be derived from the provided one.
+	// It lets a deployer attach values identifying the connection (e.g., a
+	// tenant) to the contexts of all the calls, without building a handler
+	// per connection:
+	//
+	//	HandlerContext: func(ctx context.Context) context.Context {
+	//	    return context.WithValue(ctx, tenantKey{}, tenant)
+	//	}
+	//
+	// HandlerContext applies to every call, including the deliveries of
+	// weavelet stdout and stderr, and of spooled log entries.
+	HandlerContext func(context.Context) context.Context
+
+	// SkewInterval, if positive, makes the envelope measure the skew of the
+	// weavelet's clock (see [Envelope.GetTime]) every SkewInterval while it
+	// serves, so that the estimate returned by [Envelope.ClockSkew] follows
+	// the drift of the clock of a long-running weavelet.
+	SkewInterval time.Duration
+
+	// CorrectTimestamps, if true, makes the envelope subtract the estimated
+	// skew of the weavelet's clock (see [Envelope.ClockSkew]) from the
+	// timestamps of the log entries and trace spans sent by the weavelet,
+	// before passing them to the EnvelopeHandler, so that they are on the
+	// envelope's clock. Timestamps are left unchanged until the skew is
+	// measured, either by GetTime or periodically (see SkewInterval).
+	CorrectTimestamps bool
+
+	// Registry, if not nil, tracks the envelope while its connection to the
+	// weavelet is active (see ConnRegistry).
+	Registry *ConnRegistry
+
+	// WrapConn, if not nil, is called with every connection between the
+	// envelope and the weavelet, as soon as it is established, and the
+	// envelope uses the returned connection instead. socket is the path of
+	// the Unix socket of the connection: the weavelet's control socket for
+	// the connections the envelope dials, and the envelope's own socket for
+	// the connections it accepts from the weavelet. It lets tests observe or
+	// alter the bytes exchanged with the weavelet (e.g., to record them with
+	// conntrace.Recorder).
+	WrapConn func(socket string, c net.Conn) net.Conn
 }
 
 // NewEnvelope creates a new envelope, starting a weavelet subprocess (via child.Start) and
@@ -140,13 +425,28 @@ type Options struct {
 //
 // You can issue RPCs *to* the weavelet using the returned Envelope. To start
 // receiving messages *from* the weavelet, call [Serve].
+//
+// NewEnvelope checks that wlet and config are well-formed before starting the
+// weavelet, and returns a descriptive error if they aren't (e.g., if wlet is
+// missing a deployment id). The control socket and redirects of wlet are
+// filled in by NewEnvelope.
 func NewEnvelope(ctx context.Context, wlet *protos.WeaveletArgs, config *protos.AppConfig, options Options) (*Envelope, error) {
+	if err := checkWeaveletArgs(wlet, config); err != nil {
+		return nil, fmt.Errorf("NewEnvelope: %w", err)
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer func() { cancel() }() // cancel may be changed below if we want to delay it
 
 	if options.Logger == nil {
 		options.Logger = slog.Default()
 	}
+	if options.Clock == nil {
+		options.Clock = realClock{}
+	}
+	if options.Importer == nil {
+		options.Importer = &metrics.Importer{}
+	}
 
 	// Make a temporary directory for unix domain sockets.
 	var removeDir bool
@@ -182,20 +482,48 @@ func NewEnvelope(ctx context.Context, wlet *protos.WeaveletArgs, config *protos.
 			Address:   "unix://" + myUds,
 		},
 	}
-	controller, err := getWeaveletControlStub(ctx, wlet.ControlSocket, options)
+	wlet.CheckMessageOrder = options.OnOrderViolation != nil
+	limiter := &rpcLimiter{}
+	stats := newConnStats(options.Clock)
+	stats.onRetry = options.OnRetry
+	controller, conn, err := getWeaveletControlStub(ctx, wlet.ControlSocket, options, limiter, stats)
 	if err != nil {
 		return nil, err
 	}
+	serveCtx, stopServing := context.WithCancel(ctx)
 	e := &Envelope{
-		ctx:         ctx,
-		ctxCancel:   cancel,
-		logger:      options.Logger,
-		tmpDir:      tmpDir,
-		tmpDirOwned: tmpDirOwned,
-		myUds:       myUds,
-		weavelet:    wlet,
-		config:      config,
-		controller:  controller,
+		ctx:          ctx,
+		ctxCancel:    cancel,
+		serveCtx:     serveCtx,
+		stopServing:  stopServing,
+		logger:       options.Logger,
+		tracer:       options.Tracer,
+		tmpDir:       tmpDir,
+		tmpDirOwned:  tmpDirOwned,
+		myUds:        myUds,
+		weavelet:     wlet,
+		config:       config,
+		controller:   controller,
+		conn:         conn,
+		limiter:      limiter,
+		clock:        options.Clock,
+		retryBudget:  options.RetryBudget,
+		newBackoff:   options.NewBackoff,
+		rpcTimeout:   options.RPCTimeout,
+		handshake:    options.HandshakeTimeout,
+		msgSampling:  options.MessageStatsSampling,
+		traceQueue:   options.TraceQueueSize,
+		spanResource: options.SpanResource,
+		skewInterval: options.SkewInterval,
+		correctTimes: options.CorrectTimestamps,
+		logSpool:     options.LogSpool,
+		stats:        stats,
+		onClose:      options.OnClose,
+		onViolation:  options.OnOrderViolation,
+		wrapConn:     options.WrapConn,
+		metrics:      options.Importer,
+		labels:       maps.Clone(options.Labels),
+		handlerCtx:   options.HandlerContext,
 	}
 
 	child := options.Child
@@ -206,19 +534,57 @@ func NewEnvelope(ctx context.Context, wlet *protos.WeaveletArgs, config *protos.
 		return nil, fmt.Errorf("NewEnvelope: %w", err)
 	}
 
-	reply, err := controller.InitWeavelet(e.ctx, &protos.InitWeaveletRequest{
+	// Capture the weavelet's stdout and stderr right away, so that output
+	// written before the handshake completes (e.g., an early panic) is not
+	// lost. If the handshake fails, the captured output is logged.
+	streams := captureStdStreams(e.weavelet, child, e.logger)
+	abandon := true // Cleared on a successful return
+	defer func() {
+		if abandon {
+			cancel() // Kill the weavelet so that its stdout and stderr are closed
+			streams.abandon(child, e.clock, drainTimeout)
+		}
+	}()
+
+	var raw rawReply
+	reply, err := controller.InitWeavelet(withRawReply(e.ctx, &raw), &protos.InitWeaveletRequest{
 		Sections: config.Sections,
 	})
 	if err != nil {
+		if raw.received {
+			// The weavelet replied, with an error or a reply that couldn't be
+			// decoded. Include the (truncated) raw reply in the error to help
+			// debug corrupted streams and version mismatches.
+			err = fmt.Errorf("%w (raw reply: %s)", err, formatRawReply(raw.bytes))
+		}
+		if options.OnInitFailure != nil {
+			options.OnInitFailure(nil, raw.bytes, err)
+		}
 		return nil, err
 	}
 	if err := verifyWeaveletInfo(reply); err != nil {
+		// Include the (truncated) reply in the error to help debug version
+		// mismatches and misbehaving weavelets.
+		err = fmt.Errorf("%w (weavelet info: %s)", err, formatInitReply(reply))
+		if options.OnInitFailure != nil {
+			options.OnInitFailure(reply, raw.bytes, err)
+		}
 		return nil, err
 	}
 	e.weaveletAddr = reply.DialAddr
+	e.resources = reply.Resources
+	e.capabilities = reply.Capabilities
+	e.maxRPCs = int(reply.MaxConcurrentRpcs)
+	e.limiter.setLimit(e.maxRPCs)
 
 	e.child = child
+	e.streams = streams
 
+	e.transition(StateHandshaking, StateReady)
+	if options.Registry != nil {
+		options.Registry.register(e.ctx, e)
+	}
+	abandon = false    // Serve() is now responsible for the streams
 	removeDir = false  // Serve() is now responsible for deletion
 	cancel = func() {} // Delay real context cancellation
 	return e, nil
@@ -227,66 +593,189 @@ func NewEnvelope(ctx context.Context, wlet *protos.WeaveletArgs, config *protos.
 // WeaveletControl returns the controller component for the weavelet managed by this envelope.
 func (e *Envelope) WeaveletControl() control.WeaveletControl { return e.controller }
 
+// OpenControlChannel opens a new connection to the weavelet's control socket
+// and returns a controller that issues RPCs over it. RPCs issued on the
+// returned controller are independent of RPCs issued by the envelope, so,
+// for example, a large profile being returned on one connection does not
+// delay an administrative RPC on the other. The connection is closed when
+// ctx is cancelled.
+//
+// The returned controller has its own bound on concurrent RPCs (see
+// InitWeaveletReply.max_concurrent_rpcs), so RPCs queued on the envelope's
+// connection don't block it either.
+func (e *Envelope) OpenControlChannel(ctx context.Context) (control.WeaveletControl, error) {
+	options := Options{
+		Logger:               e.logger,
+		Tracer:               e.tracer,
+		Clock:                e.clock,
+		RetryBudget:          e.retryBudget,
+		NewBackoff:           e.newBackoff,
+		RPCTimeout:           e.rpcTimeout,
+		HandshakeTimeout:     e.handshake,
+		MessageStatsSampling: e.msgSampling,
+	}
+	limiter := &rpcLimiter{}
+	limiter.setLimit(e.maxRPCs)
+	controller, _, err := getWeaveletControlStub(ctx, e.weavelet.ControlSocket, options, limiter, e.stats)
+	return controller, err
+}
+
 // Serve accepts incoming messages from the weavelet. RPC requests are handled
 // serially in the order they are received. Serve blocks until the connection
 // terminates, returning the error that caused it to terminate. You can cancel
-// the connection by cancelling the context passed to [NewEnvelope]. This
+// the connection by cancelling the context passed to [NewEnvelope] or by
+// calling [Envelope.Close], and you can stop handling messages without
+// stopping the weavelet by calling [Envelope.StopServing]. This
 // method never returns a non-nil error.
-func (e *Envelope) Serve(h EnvelopeHandler) error {
+//
+// An error returned by a method of h that handles an RPC from the weavelet
+// (e.g., ActivateComponent, or LogBatch for log entries sent by the weavelet)
+// only fails that RPC: it is returned to the weavelet, and the connection
+// stays up. Only an error returned by LogBatch for lines of weavelet stdout or
+// stderr, which the envelope captures itself, terminates the connection.
+//
+// A panic in a method of h doesn't crash the process. It is logged and
+// converted into an error wrapping ErrHandlerPanicked, which includes the
+// stack of the panic, and which is returned to the weavelet as the result of
+// the message. Lines of weavelet stdout or stderr that cause LogBatch to
+// panic are dropped.
+//
+// A deployer that doesn't care about some of the messages can implement
+// only some of the parts of an EnvelopeHandler, and complete its handler
+// with [CompleteHandler].
+//
+// Serve must be called at most once. Later calls return ErrAlreadyServing
+// immediately. See [State] for the states the envelope goes through while
+// serving. When Serve returns, it passes a summary of the connection to
+// Options.OnClose, if set.
+func (e *Envelope) Serve(h EnvelopeHandler) (err error) {
+	// Serving messages from more than one goroutine would break the ordering
+	// of messages sent by the weavelet.
+	if !e.transition(StateReady, StateServing) {
+		return ErrAlreadyServing
+	}
+	defer e.state.Store(int32(StateStopped))
+	if e.onClose != nil {
+		defer func() { e.onClose(e.stats.summary(e.clock.Now(), err)) }()
+	}
+
+	// Pass the contexts derived by Options.HandlerContext, if set.
+	if e.handlerCtx != nil {
+		h = contextHandler{h: h, context: e.handlerCtx}
+	}
+
+	// Recover from panics in the handler.
+	h = recoverHandler{h: h, logger: e.logger}
+
+	// Spool log entries, if requested.
+	var spooler *logSpooler
+	if e.logSpool != nil {
+		spooler, err = newLogSpooler(h, e.logger, *e.logSpool)
+		if err != nil {
+			return err
+		}
+		e.spooler.Store(spooler)
+		h = spooler
+	}
+
+	// Deliver log entries to live subscribers as well.
+	h = teeHandler{EnvelopeHandler: h, subs: &e.logSubs}
+
 	// Cleanup when we are done with the envelope.
 	if e.tmpDirOwned {
 		defer os.RemoveAll(e.tmpDir)
 	}
 
-	uds, err := net.Listen("unix", e.myUds)
+	listener, err := net.Listen("unix", e.myUds)
 	if err != nil {
+		if spooler != nil {
+			spooler.close()
+		}
 		return err
 	}
+	uds := statsListener{Listener: listener, socket: e.myUds, wrap: e.wrapConn, stats: e.stats}
 
 	var running errgroup.Group
 
+	if spooler != nil {
+		running.Go(func() error {
+			spooler.run(e.ctx)
+			return nil
+		})
+	}
+
+	// Add resource attributes to trace spans, if requested.
+	if e.spanResource {
+		pid, _ := e.child.Pid()
+		h = newResourceHandler(h, e.weavelet, pid)
+	}
+
+	// Hand trace spans off to a separate goroutine, if requested.
+	if e.traceQueue > 0 {
+		async := newAsyncTraceHandler(h, e.logger, e.traceQueue)
+		e.asyncTraces.Store(async)
+		h = async
+		running.Go(func() error {
+			async.run(e.ctx)
+			return nil
+		})
+	}
+
 	var stopErr error
 	var once sync.Once
 	stop := func(err error) {
 		once.Do(func() {
 			stopErr = err
+			e.transition(StateServing, StateDraining)
 		})
 		e.ctxCancel()
 	}
 
-	// Capture stdout and stderr from the weavelet.
-	if stdout := e.child.Stdout(); stdout != nil {
+	// Deliver stdout and stderr from the weavelet, including the output
+	// captured before Serve was called. A single goroutine waits for the
+	// capture of all the streams to end.
+	if err := e.streams.install(e.ctx, h); err != nil {
+		stop(err)
+	}
+	if e.streams.n > 0 {
 		running.Go(func() error {
-			err := e.logLines("stdout", stdout, h)
-			stop(err)
-			return err
+			for i := 0; i < e.streams.n; i++ {
+				stop(<-e.streams.done)
+			}
+			return nil
 		})
 	}
-	if stderr := e.child.Stderr(); stderr != nil {
+
+	// Track the skew of the weavelet's clock, if requested.
+	if e.skewInterval > 0 {
 		running.Go(func() error {
-			err := e.logLines("stderr", stderr, h)
-			stop(err)
-			return err
+			e.trackSkew(e.skewInterval)
+			return nil
 		})
 	}
 
-	// Start the goroutine watching the context for cancelation.
-	running.Go(func() error {
-		<-e.ctx.Done()
-		err := e.ctx.Err()
-		stop(err)
-		return err
-	})
+	// Correct the timestamps of the messages sent by the weavelet, if
+	// requested. Lines of weavelet stdout and stderr are timestamped by the
+	// envelope, so they are not corrected.
+	dh := e.checkOrder(h)
+	if e.correctTimes {
+		dh = skewCorrector{EnvelopeHandler: dh, skew: &e.skew}
+	}
 
-	// Start the goroutine to handle deployer control calls.
-	running.Go(func() error {
-		err := deployers.ServeComponents(e.ctx, uds, e.logger, map[string]any{
-			control.DeployerPath: h,
-		})
-		stop(err)
-		return err
+	// Handle deployer control calls on the calling goroutine, rather than on
+	// a goroutine of its own, as envelopes may be embedded by the thousands.
+	err = deployers.ServeComponentsWithTracer(e.serveCtx, uds, e.logger, e.tracer, map[string]any{
+		control.DeployerPath: statsHandler{h: dh, stats: e.stats},
 	})
-
+	if e.ctx.Err() == nil && e.serveCtx.Err() != nil {
+		// StopServing was called. Keep the weavelet running until the
+		// context is cancelled.
+		<-e.ctx.Done()
+	}
+	if e.ctx.Err() != nil {
+		err = e.ctx.Err()
+	}
+	stop(err)
 	running.Wait()
 
 	// Wait for the weavelet command to finish. This needs to be done after
@@ -297,18 +786,79 @@ func (e *Envelope) Serve(h EnvelopeHandler) error {
 	return stopErr
 }
 
+// checkOrder returns a handler that checks the order of the log batches sent
+// by the weavelet before passing them to h, if requested (see
+// Options.OnOrderViolation), or h otherwise.
+func (e *Envelope) checkOrder(h EnvelopeHandler) EnvelopeHandler {
+	if e.onViolation == nil {
+		return h
+	}
+	return &orderChecker{EnvelopeHandler: h, report: e.onViolation}
+}
+
+// StopServing stops handling the messages sent by the weavelet (e.g., log
+// entries and component activations), which fail from then on, while leaving
+// the weavelet running, so that the envelope can still issue RPCs to it
+// (e.g., a final GetMetrics) during a controlled teardown. Lines of weavelet
+// stdout and stderr are still delivered to the handler passed to Serve, and
+// Serve keeps running until the weavelet exits or the envelope is closed (see
+// [Envelope.Close]). StopServing may be called more than once.
+func (e *Envelope) StopServing() {
+	e.stopServing()
+}
+
+// Close stops the weavelet, like cancelling the context passed to
+// [NewEnvelope] does. RPCs issued to the weavelet fail from then on. Close
+// doesn't wait for the weavelet to stop; Serve returns once it has.
+func (e *Envelope) Close() {
+	e.ctxCancel()
+}
+
+// LastActivity returns when the envelope last received anything from the
+// weavelet over its connections to the weavelet, be it a reply to an RPC
+// issued by the envelope or a message sent by the weavelet (e.g., log
+// entries). A deployer's watchdog can use it to detect weavelets that have
+// gone quiet for too long, even when no RPC is outstanding. Lines of weavelet
+// stdout and stderr don't count as activity.
+func (e *Envelope) LastActivity() time.Time {
+	return e.stats.lastActivity()
+}
+
 // Pid returns the process id of the weavelet, if it is running in a separate process.
 func (e *Envelope) Pid() (int, bool) {
 	return e.child.Pid()
 }
 
+// WeaveletID returns the unique id of the weavelet managed by the envelope.
+func (e *Envelope) WeaveletID() string {
+	return e.weavelet.Id
+}
+
 // WeaveletAddress returns the address that other components should dial to communicate with the
 // weavelet.
 func (e *Envelope) WeaveletAddress() string {
 	return e.weaveletAddr
 }
 
-// GetHealth returns the health status of the weavelet.
+// ResourceLimits returns the resources available to the weavelet (e.g.,
+// GOMAXPROCS and cgroup CPU and memory limits), as reported by the weavelet
+// during the handshake. It returns nil if the weavelet didn't report them.
+// The returned value must not be modified.
+func (e *Envelope) ResourceLimits() *protos.ResourceLimits {
+	return e.resources
+}
+
+// SupportsCapability returns whether the weavelet reported, during the
+// handshake, that it may invoke the EnvelopeHandler method identified by c.
+// Deployers can use it to warn about handler methods they don't implement
+// but the weavelet needs. Weavelets that predate capabilities report none.
+func (e *Envelope) SupportsCapability(c Capability) bool {
+	return slices.Contains(e.capabilities, c)
+}
+
+// GetHealth returns the health status of the weavelet. The reply includes the
+// results of the application-defined health checks of the weavelet's
+// components, which deployers can surface to operators.
 func (e *Envelope) GetHealth() *protos.GetHealthReply {
 	reply, err := e.controller.GetHealth(context.TODO(), &protos.GetHealthRequest{})
 	if err != nil {
@@ -317,7 +867,56 @@ func (e *Envelope) GetHealth() *protos.GetHealthReply {
 	return reply
 }
 
-// GetProfile gets a profile from the weavelet.
+// MeasureRTT measures the round-trip latency of the envelope's connection to
+// the weavelet, by timing an RPC that the weavelet replies to without doing
+// any work. Deployers can use it to detect high-latency connections (e.g.,
+// ones tunneled across regions) and avoid placing latency-sensitive
+// components behind them. A single measurement is noisy; callers should
+// aggregate several.
+func (e *Envelope) MeasureRTT() (time.Duration, error) {
+	start := e.clock.Now()
+	if _, err := e.controller.Ping(context.TODO(), &protos.PingRequest{}); err != nil {
+		return 0, err
+	}
+	return e.clock.Now().Sub(start), nil
+}
+
+// GetTime returns the time of the weavelet's clock, and the round-trip time of
+// the RPC used to read it. The difference between the weavelet's time and the
+// envelope's time halfway through the round trip measures the skew of the
+// weavelet's clock, which the envelope adds to its skew estimate (see
+// [Envelope.ClockSkew]).
+func (e *Envelope) GetTime() (weaveletTime time.Time, rtt time.Duration, err error) {
+	return e.getTime(context.TODO())
+}
+
+// getTime is like GetTime, but issues the RPC with the provided context.
+func (e *Envelope) getTime(ctx context.Context) (time.Time, time.Duration, error) {
+	start := e.clock.Now()
+	reply, err := e.controller.GetTime(ctx, &protos.GetTimeRequest{})
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	rtt := e.clock.Now().Sub(start)
+	weaveletTime := time.Unix(0, reply.UnixNanos)
+	// Assume the weavelet read its clock halfway through the round trip.
+	e.skew.update(weaveletTime.Sub(start.Add(rtt / 2)))
+	return weaveletTime, rtt, nil
+}
+
+// ClockSkew returns an estimate of how far ahead of the envelope's clock the
+// weavelet's clock is (negative if it is behind). The estimate is an
+// exponentially weighted moving average of the skews measured by GetTime,
+// whether called directly or periodically (see Options.SkewInterval). It is
+// zero until the skew is first measured.
+func (e *Envelope) ClockSkew() time.Duration {
+	return e.skew.get()
+}
+
+// GetProfile gets a profile from the weavelet. CPU profile samples are
+// labeled with the component that was running (see GetProfileRequest in
+// runtime/protos/runtime.proto), so that profiles can be sliced by component
+// (e.g., with pprof -tagfocus=component=...).
 func (e *Envelope) GetProfile(req *protos.GetProfileRequest) ([]byte, error) {
 	reply, err := e.controller.GetProfile(context.TODO(), req)
 	if err != nil {
@@ -326,17 +925,334 @@ func (e *Envelope) GetProfile(req *protos.GetProfileRequest) ([]byte, error) {
 	return reply.Data, nil
 }
 
+// SetComponentEnabled enables or disables the provided component. A disabled
+// component rejects calls from other weavelets until it is re-enabled.
+// Disabling a component blocks until its in-flight calls finish.
+func (e *Envelope) SetComponentEnabled(component string, enabled bool) error {
+	req := &protos.SetComponentEnabledRequest{Component: component, Enabled: enabled}
+	_, err := e.controller.SetComponentEnabled(context.TODO(), req)
+	return err
+}
+
+// ForwardSignal delivers the provided signal to the weavelet. The weavelet
+// handles the signal as if it was sent to the weavelet process directly. This
+// is useful to propagate signals received by the envelope (e.g., SIGTERM) to
+// weavelets that don't share a signal namespace with the envelope.
+func (e *Envelope) ForwardSignal(sig os.Signal) error {
+	s, ok := sig.(syscall.Signal)
+	if !ok {
+		return fmt.Errorf("unsupported signal %v", sig)
+	}
+	_, err := e.controller.ForwardSignal(context.TODO(), &protos.ForwardSignalRequest{Signal: int32(s)})
+	return err
+}
+
+// SetGCPercent sets the garbage collection target percentage of the weavelet
+// (see runtime/debug.SetGCPercent) and returns the previous percentage. A
+// negative percentage disables garbage collection.
+func (e *Envelope) SetGCPercent(percent int) (int, error) {
+	reply, err := e.controller.SetGCPercent(context.TODO(), &protos.SetGCPercentRequest{Percent: int32(percent)})
+	if err != nil {
+		return 0, err
+	}
+	return int(reply.Previous), nil
+}
+
+// ForceGC runs a garbage collection in the weavelet.
+func (e *Envelope) ForceGC() error {
+	_, err := e.controller.ForceGC(context.TODO(), &protos.ForceGCRequest{})
+	return err
+}
+
+// ListProfiles returns the profiles the weavelet is currently collecting.
+func (e *Envelope) ListProfiles() ([]*protos.ProfileInfo, error) {
+	reply, err := e.controller.ListProfiles(context.TODO(), &protos.ListProfilesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Profiles, nil
+}
+
+// CancelProfile cancels the profile with the provided id (see
+// [ListProfiles]). The corresponding call to [GetProfile] returns an error.
+func (e *Envelope) CancelProfile(id string) error {
+	_, err := e.controller.CancelProfile(context.TODO(), &protos.CancelProfileRequest{Id: id})
+	return err
+}
+
+// SetProfilingRate sets the rates at which the weavelet samples blocking and
+// mutex contention events, as with runtime.SetBlockProfileRate and
+// runtime.SetMutexProfileFraction, and returns the previous rates. A rate of
+// zero disables the corresponding profiling, and a negative rate leaves it
+// unchanged. Note that Block and Mutex profiles are empty unless the
+// corresponding profiling is enabled.
+func (e *Envelope) SetProfilingRate(block, mutex int) (prevBlock, prevMutex int, err error) {
+	req := &protos.SetProfilingRateRequest{BlockRate: int64(block), MutexFraction: int64(mutex)}
+	reply, err := e.controller.SetProfilingRate(context.TODO(), req)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(reply.PrevBlockRate), int(reply.PrevMutexFraction), nil
+}
+
 // GetMetrics returns a weavelet's metrics.
 func (e *Envelope) GetMetrics() ([]*metrics.MetricSnapshot, error) {
+	// Hold the lock during the RPC, so that incremental updates are imported
+	// in the order the weavelet exported them (see ResyncMetrics).
+	e.metricsMu.Lock()
+	defer e.metricsMu.Unlock()
 	req := &protos.GetMetricsRequest{}
 	reply, err := e.controller.GetMetrics(context.TODO(), req)
 	if err != nil {
 		return nil, err
 	}
+	return e.importMetrics(reply.Update)
+}
 
+// ResyncMetrics is like GetMetrics, but it discards the metrics previously
+// received from the weavelet and asks the weavelet for a full snapshot of its
+// metrics. This is useful to rebuild a consistent state if an earlier reply
+// was lost. If the RPC fails, or the snapshot can't be imported, the metrics
+// previously received are kept.
+func (e *Envelope) ResyncMetrics() ([]*metrics.MetricSnapshot, error) {
+	// Hold the lock during the RPC, so that a concurrent call to GetMetrics
+	// doesn't import a partial update into the discarded snapshot.
 	e.metricsMu.Lock()
 	defer e.metricsMu.Unlock()
-	return e.metrics.Import(reply.Update)
+	req := &protos.GetMetricsRequest{FullSnapshot: true}
+	reply, err := e.controller.GetMetrics(context.TODO(), req)
+	if err != nil {
+		return nil, err
+	}
+	// Restore the discarded snapshot if the full snapshot can't be imported.
+	old := *e.metrics
+	e.metrics.Forget()
+	snapshots, err := e.importMetrics(reply.Update)
+	if err != nil {
+		*e.metrics = old
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+// importMetrics adds the envelope's labels to the provided update and imports
+// it.
+//
+// REQUIRES: e.metricsMu is held.
+func (e *Envelope) importMetrics(update *protos.MetricUpdate) ([]*metrics.MetricSnapshot, error) {
+	if len(e.labels) > 0 {
+		for _, def := range update.Defs {
+			if def.Labels == nil {
+				def.Labels = map[string]string{}
+			}
+			for k, v := range e.labels {
+				if _, ok := def.Labels[k]; !ok {
+					def.Labels[k] = v
+				}
+			}
+		}
+	}
+	return e.metrics.Import(update)
+}
+
+// GetMetricsWithPrefixes is like GetMetrics, but it only returns the metrics
+// whose names start with one of the provided prefixes. Only the matching
+// metrics are sent by the weavelet, which makes it cheaper than GetMetrics
+// when only a few metrics are needed.
+func (e *Envelope) GetMetricsWithPrefixes(prefixes ...string) ([]*metrics.MetricSnapshot, error) {
+	// Hold the lock during the RPC (see GetMetrics).
+	e.metricsMu.Lock()
+	defer e.metricsMu.Unlock()
+	req := &protos.GetMetricsRequest{NamePrefixes: prefixes}
+	reply, err := e.controller.GetMetrics(context.TODO(), req)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := e.importMetrics(reply.Update)
+	if err != nil {
+		return nil, err
+	}
+	// The importer also returns previously imported metrics that may not
+	// match the prefixes.
+	matching := snapshots[:0]
+	for _, s := range snapshots {
+		if metrics.HasPrefix(s.Name, prefixes) {
+			matching = append(matching, s)
+		}
+	}
+	return matching, nil
+}
+
+// GetAggregatedMetrics is like GetMetricsWithPrefixes, but the weavelet
+// aggregates the matching metrics as specified by agg before sending them
+// (see protos.MetricAggregation), which reduces the cost of scraping metrics
+// with many label combinations. The envelope's labels named in
+// agg.DropLabels are dropped too.
+//
+// Dropped labels are irrecoverable: the returned snapshots only contain the
+// aggregated metrics, and an aggregated scrape doesn't affect the metrics
+// returned by GetMetrics. agg must not be nil.
+func (e *Envelope) GetAggregatedMetrics(agg *protos.MetricAggregation, prefixes ...string) ([]*metrics.MetricSnapshot, error) {
+	if agg == nil {
+		return nil, fmt.Errorf("GetAggregatedMetrics: nil aggregation")
+	}
+	req := &protos.GetMetricsRequest{NamePrefixes: prefixes, Aggregation: agg}
+	reply, err := e.controller.GetMetrics(context.TODO(), req)
+	if err != nil {
+		return nil, err
+	}
+	if reply.Update == nil {
+		// No metrics matched.
+		return nil, nil
+	}
+
+	dropped := map[string]bool{}
+	for _, label := range agg.DropLabels {
+		dropped[label] = true
+	}
+	for _, def := range reply.Update.Defs {
+		if def.Labels == nil {
+			def.Labels = map[string]string{}
+		}
+		for k, v := range e.labels {
+			if _, ok := def.Labels[k]; !ok && !dropped[k] {
+				def.Labels[k] = v
+			}
+		}
+	}
+	// Aggregated replies are full snapshots with fresh ids, so they are
+	// imported independently of e.metrics.
+	var importer metrics.Importer
+	return importer.Import(reply.Update)
+}
+
+// WriteOpenMetrics writes the weavelet's metrics, as most recently received
+// by the envelope (e.g., by [Envelope.GetMetrics]), to w in the OpenMetrics
+// text format, ending with the "# EOF" marker. It doesn't issue an RPC to the
+// weavelet: call GetMetrics first to write up-to-date metrics. The metrics of
+// the connection to the weavelet (see [ConnSummary]) are written too, named
+// serviceweaver_envelope_*, with the same serviceweaver_app,
+// serviceweaver_version, and serviceweaver_node labels as the weavelet's
+// metrics, and the envelope's labels (see Options.Labels).
+//
+// WriteOpenMetrics is intended to be used by the HTTP handlers that
+// Prometheus-compatible monitoring systems scrape. Such handlers should set
+// the Content-Type header to prometheus.OpenMetricsContentType (see
+// runtime/prometheus).
+func (e *Envelope) WriteOpenMetrics(w io.Writer) error {
+	e.metricsMu.Lock()
+	snapshots := e.metrics.Snapshot()
+	e.metricsMu.Unlock()
+
+	labels := map[string]string{
+		"serviceweaver_app":     e.weavelet.App,
+		"serviceweaver_version": e.weavelet.DeploymentId,
+		"serviceweaver_node":    e.weavelet.Id,
+	}
+	for k, v := range e.labels {
+		if _, ok := labels[k]; !ok {
+			labels[k] = v
+		}
+	}
+	snapshots = append(snapshots, e.stats.snapshots(e.clock.Now(), labels)...)
+	return prometheus.WriteOpenMetrics(w, snapshots)
+}
+
+// FlushTelemetry asks the weavelet to send the log entries and trace spans it
+// has buffered, and blocks until they have been handled by the envelope's
+// handler (see [Envelope.Serve]), including trace spans queued for
+// asynchronous handling (see Options.TraceQueueSize) and spooled log entries
+// (see Options.LogSpool). Call FlushTelemetry before stopping a weavelet to
+// avoid losing its latest logs and traces.
+func (e *Envelope) FlushTelemetry() error {
+	if _, err := e.controller.FlushTelemetry(context.TODO(), &protos.FlushTelemetryRequest{}); err != nil {
+		return err
+	}
+	if spooler := e.spooler.Load(); spooler != nil {
+		if err := spooler.flush(e.ctx); err != nil {
+			return err
+		}
+	}
+	if async := e.asyncTraces.Load(); async != nil {
+		return async.flush(e.ctx)
+	}
+	return nil
+}
+
+// GetInFlight returns the number of component method calls currently
+// executing in the weavelet, keyed by the full name of every component
+// started in the weavelet. Unlike the load reported by GetLoad, which is a
+// rate, the counts are instantaneous. Only calls received from other
+// weavelets are counted; calls between components in the same weavelet are
+// not.
+func (e *Envelope) GetInFlight() (map[string]int, error) {
+	reply, err := e.controller.GetInFlight(context.TODO(), &protos.GetInFlightRequest{})
+	if err != nil {
+		return nil, err
+	}
+	calls := make(map[string]int, len(reply.Calls))
+	for component, n := range reply.Calls {
+		calls[component] = int(n)
+	}
+	return calls, nil
+}
+
+// GetRecentErrors returns the n most recent error log entries of the
+// weavelet, oldest first, or all of the recent error log entries the
+// weavelet keeps if n is not positive. The weavelet keeps a bounded number of
+// entries, so this is a quick view of what has been going wrong in the
+// weavelet, not a replacement for the log stream.
+func (e *Envelope) GetRecentErrors(n int) ([]*protos.LogEntry, error) {
+	reply, err := e.controller.GetRecentErrors(context.TODO(), &protos.GetRecentErrorsRequest{N: int64(n)})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Entries, nil
+}
+
+// ClearRecentErrors makes the weavelet forget its recent error log entries
+// (see GetRecentErrors), e.g., once an incident has been triaged.
+func (e *Envelope) ClearRecentErrors() error {
+	_, err := e.controller.ClearRecentErrors(context.TODO(), &protos.ClearRecentErrorsRequest{})
+	return err
+}
+
+// SetComponentLogLevel makes the logger of the provided component, hosted by
+// the weavelet, write only log entries at or above the provided level. The
+// loggers of the other components are unaffected, so a single component can
+// be made more verbose, e.g., during an incident. Call ClearComponentLogLevel
+// to restore the default.
+func (e *Envelope) SetComponentLogLevel(component string, level slog.Level) error {
+	req := &protos.SetComponentLogLevelRequest{Component: component, Level: level.String()}
+	_, err := e.controller.SetComponentLogLevel(context.TODO(), req)
+	return err
+}
+
+// ClearComponentLogLevel clears the level set by SetComponentLogLevel for the
+// provided component, whose logger writes entries of all levels again.
+func (e *Envelope) ClearComponentLogLevel(component string) error {
+	req := &protos.SetComponentLogLevelRequest{Component: component}
+	_, err := e.controller.SetComponentLogLevel(context.TODO(), req)
+	return err
+}
+
+// GetLogLevels returns the component log levels set by SetComponentLogLevel,
+// by component name. Components without a level set write log entries of all
+// levels and are absent from the returned map.
+func (e *Envelope) GetLogLevels() (map[string]slog.Level, error) {
+	reply, err := e.controller.GetLogLevels(context.TODO(), &protos.GetLogLevelsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	levels := make(map[string]slog.Level, len(reply.Levels))
+	for component, text := range reply.Levels {
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(text)); err != nil {
+			return nil, fmt.Errorf("component %q: invalid log level %q: %w", component, text, err)
+		}
+		levels[component] = level
+	}
+	return levels, nil
 }
 
 // GetLoad gets a load report from the weavelet.
@@ -349,6 +1265,59 @@ func (e *Envelope) GetLoad() (*protos.LoadReport, error) {
 	return reply.Load, nil
 }
 
+// SnapshotOptions specifies the sections of a weavelet snapshot. See
+// [Envelope.GetSnapshot].
+type SnapshotOptions struct {
+	Health       bool // include health (see GetHealth)?
+	Load         bool // include load (see GetLoad)?
+	RuntimeStats bool // include Go runtime stats?
+	Metrics      bool // include metrics (see GetMetrics)?
+
+	// If non-empty, only the metrics whose names start with one of the
+	// provided prefixes are included (see GetMetricsWithPrefixes).
+	MetricPrefixes []string
+}
+
+// GetSnapshot returns a snapshot of the weavelet's health, load, runtime
+// stats, and metrics, in a single RPC. Only the sections requested by opts
+// are included. All the sections are taken at the same moment, which gives
+// dashboards a coherent view of the weavelet. Like GetLoad, including the
+// load resets it.
+func (e *Envelope) GetSnapshot(opts SnapshotOptions) (*protos.WeaveletSnapshot, error) {
+	req := &protos.GetSnapshotRequest{
+		Health:       opts.Health,
+		Load:         opts.Load,
+		RuntimeStats: opts.RuntimeStats,
+	}
+	if opts.Metrics {
+		req.Metrics = &protos.GetMetricsRequest{NamePrefixes: opts.MetricPrefixes}
+
+		// Hold the lock during the RPC (see GetMetrics).
+		e.metricsMu.Lock()
+		defer e.metricsMu.Unlock()
+	}
+	reply, err := e.controller.GetSnapshot(context.TODO(), req)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := reply.Snapshot
+	if !opts.Metrics || reply.Metrics == nil {
+		return snapshot, nil
+	}
+	snapshots, err := e.importMetrics(reply.Metrics)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range snapshots {
+		// The importer also returns previously imported metrics that may not
+		// match the prefixes.
+		if metrics.HasPrefix(s.Name, opts.MetricPrefixes) {
+			snapshot.Metrics = append(snapshot.Metrics, s.ToProto())
+		}
+	}
+	return snapshot, nil
+}
+
 // UpdateComponents updates the weavelet with the latest set of components it
 // should be running.
 func (e *Envelope) UpdateComponents(components []string) error {
@@ -359,8 +1328,26 @@ func (e *Envelope) UpdateComponents(components []string) error {
 	return err
 }
 
+// CheckComponents checks whether the weavelet can run the provided components
+// without starting any of them, which is useful to validate a rollout before
+// calling UpdateComponents. It returns the components that are not part of
+// the weavelet's binary and the components that are disabled (see
+// SetComponentEnabled).
+func (e *Envelope) CheckComponents(components []string) (unknown, disabled []string, err error) {
+	req := &protos.UpdateComponentsRequest{
+		Components: components,
+		DryRun:     true,
+	}
+	reply, err := e.controller.UpdateComponents(context.TODO(), req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return reply.UnknownComponents, reply.DisabledComponents, nil
+}
+
 // UpdateRoutingInfo updates the weavelet with a component's most recent
-// routing info.
+// routing info. It returns an error wrapping ErrStaleRoutingUpdate if
+// routing.Version is lower than the version the weavelet last applied.
 func (e *Envelope) UpdateRoutingInfo(routing *protos.RoutingInfo) error {
 	req := &protos.UpdateRoutingInfoRequest{
 		RoutingInfo: routing,
@@ -369,62 +1356,395 @@ func (e *Envelope) UpdateRoutingInfo(routing *protos.RoutingInfo) error {
 	return err
 }
 
-func (e *Envelope) logLines(component string, src io.Reader, h EnvelopeHandler) error {
-	// Fill partial log entry.
-	entry := &protos.LogEntry{
-		App:       e.weavelet.App,
-		Version:   e.weavelet.DeploymentId,
-		Component: component,
-		Node:      e.weavelet.Id,
-		Level:     component, // Either "stdout" or "stderr"
-		File:      "",
-		Line:      -1,
-	}
-	batch := &protos.LogEntryBatch{}
-	batch.Entries = append(batch.Entries, entry)
-
-	rdr := bufio.NewReader(src)
-	for {
-		line, err := rdr.ReadBytes('\n')
-		// Note: both line and err may be present.
-		if len(line) > 0 {
-			entry.Msg = string(dropNewline(line))
-			entry.TimeMicros = 0 // In case previous LogBatch mutated it
-			if err := h.LogBatch(e.ctx, batch); err != nil {
-				return err
-			}
+// UpdateRoutingInfoNoAck is like UpdateRoutingInfo, but it returns as soon as
+// the update has been sent to the weavelet, without waiting for the weavelet
+// to apply it. This cuts the latency of frequent routing updates (e.g.,
+// during a fast rollout) at the cost of weaker guarantees: the update is sent
+// at most once, the returned error only reflects whether the update was sent,
+// not whether the weavelet accepted it, and concurrent updates may be applied
+// in any order. Set routing.Version so that the weavelet rejects updates that
+// arrive after newer ones. Note that, unlike UpdateRoutingInfo, such a stale
+// update is dropped by the weavelet without any signal to the caller: the
+// ErrStaleRoutingUpdate error is discarded along with the reply.
+func (e *Envelope) UpdateRoutingInfoNoAck(routing *protos.RoutingInfo) error {
+	req := &protos.UpdateRoutingInfoRequest{
+		RoutingInfo: routing,
+	}
+	_, err := e.controller.UpdateRoutingInfo(call.WithNoReply(context.TODO()), req)
+	return err
+}
+
+// GetReadiness returns the readiness of every readiness gate registered by
+// the weavelet (see weaver.AddReadinessGate), by gate name. The weavelet is
+// ready iff all gates are ready. Deployers should wait for a weavelet to be
+// ready before routing traffic to it.
+func (e *Envelope) GetReadiness() (map[string]bool, error) {
+	reply, err := e.controller.GetReadiness(context.TODO(), &protos.GetReadinessRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Gates, nil
+}
+
+// GetBuildFlags returns the build flags registered by the weavelet's
+// application (see weaver.RegisterBuildFlag), by flag name.
+func (e *Envelope) GetBuildFlags() (map[string]string, error) {
+	reply, err := e.controller.GetBuildFlags(context.TODO(), &protos.GetBuildFlagsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Flags, nil
+}
+
+// ReloadConfig reloads the config of the weavelet's components from the
+// provided app config, typically re-read from the app config file (e.g., with
+// runtime.ParseConfig) after it has been edited. Components that support it
+// (see weaver.WithConfig) reload their config without a restart, and
+// components started later use the new config.
+//
+// ReloadConfig returns the config now in effect, along with the changes that
+// require the weavelet to be restarted to take effect: the names of the
+// changed AppConfig fields other than sections (e.g., "binary" or "args"),
+// and the names of the started components whose config changed but couldn't
+// be reloaded. The returned config must not be modified.
+func (e *Envelope) ReloadConfig(config *protos.AppConfig) (*protos.AppConfig, []string, error) {
+	e.configMu.Lock()
+	defer e.configMu.Unlock()
+	reply, err := e.controller.ReloadConfig(context.TODO(), &protos.ReloadConfigRequest{
+		Sections: config.Sections,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Changes to fields other than sections require a restart.
+	var restart []string
+	before, after := e.config.ProtoReflect(), config.ProtoReflect()
+	fields := before.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.Get(i)
+		if f.Name() != "sections" && !before.Get(f).Equal(after.Get(f)) {
+			restart = append(restart, string(f.Name()))
 		}
-		if err != nil {
-			return fmt.Errorf("capture %s: %w", component, err)
+	}
+
+	// Components that weren't reloaded keep running with their old config.
+	effective := protomsg.Clone(e.config)
+	effective.Sections = maps.Clone(config.Sections)
+	if effective.Sections == nil {
+		effective.Sections = map[string]string{}
+	}
+	for _, component := range reply.RestartRequired {
+		if section, ok := e.config.Sections[component]; ok {
+			effective.Sections[component] = section
+		} else {
+			delete(effective.Sections, component)
 		}
 	}
+	e.config = effective
+	return effective, append(restart, reply.RestartRequired...), nil
+}
+
+// GetDependencies returns the call graph of the weavelet's components,
+// including whether every call is routed locally or remotely.
+func (e *Envelope) GetDependencies() (*protos.CallGraph, error) {
+	reply, err := e.controller.GetDependencies(context.TODO(), &protos.GetDependenciesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Graph, nil
+}
+
+// GetFDCount returns the number of file descriptors opened by the weavelet,
+// along with the weavelet's soft and hard limits on open file descriptors
+// (RLIMIT_NOFILE). This lets deployers detect file descriptor leaks before
+// the limit is reached.
+func (e *Envelope) GetFDCount() (*protos.GetFDCountReply, error) {
+	return e.controller.GetFDCount(context.TODO(), &protos.GetFDCountRequest{})
 }
 
-func dropNewline(line []byte) []byte {
-	if len(line) > 0 && line[len(line)-1] == '\n' {
-		line = line[:len(line)-1]
+// GetRoutingInfo returns the routing info that the weavelet has applied for
+// the provided component, or nil if the weavelet hasn't received routing info
+// for the component. This lets deployers confirm that a routing update (see
+// [Envelope.UpdateRoutingInfo]) took effect.
+func (e *Envelope) GetRoutingInfo(component string) (*protos.RoutingInfo, error) {
+	req := &protos.GetRoutingInfoRequest{Component: component}
+	reply, err := e.controller.GetRoutingInfo(context.TODO(), req)
+	if err != nil {
+		return nil, err
 	}
-	return line
+	return reply.RoutingInfo, nil
+}
+
+// GetCircuitBreakerState returns the state of the circuit breaker that the
+// weavelet applies to calls to the provided component, or nil if the routing
+// info applied for the component doesn't configure a circuit breaker. See
+// RoutingInfo.circuit_breaker in runtime/protos/runtime.proto.
+func (e *Envelope) GetCircuitBreakerState(component string) (*protos.CircuitBreakerState, error) {
+	req := &protos.GetRoutingInfoRequest{Component: component}
+	reply, err := e.controller.GetRoutingInfo(context.TODO(), req)
+	if err != nil {
+		return nil, err
+	}
+	return reply.CircuitBreaker, nil
+}
+
+// GetFeatureFlags returns the current values of the feature flags registered
+// by the application (see weaver.RegisterFeatureFlag), by flag name.
+func (e *Envelope) GetFeatureFlags() (map[string]string, error) {
+	reply, err := e.controller.GetFeatureFlags(context.TODO(), &protos.GetFeatureFlagsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Flags, nil
+}
+
+// SetFeatureFlag overrides the value of the provided feature flag in the
+// weavelet. It returns an error wrapping ErrUnknownFeatureFlag if the
+// application has not registered the flag.
+func (e *Envelope) SetFeatureFlag(name, value string) error {
+	req := &protos.SetFeatureFlagRequest{Name: name, Value: value}
+	_, err := e.controller.SetFeatureFlag(context.TODO(), req)
+	return err
+}
+
+// RawRPC passes the provided opaque payload to the raw handler with the
+// provided name registered by the application (see weaver.RegisterRawHandler)
+// and returns the handler's result. It returns an error wrapping
+// ErrUnknownRawHandler if the handler has not been registered. Raw RPCs are
+// unversioned and intended for experimentation only.
+func (e *Envelope) RawRPC(ctx context.Context, handler string, payload []byte) ([]byte, error) {
+	req := &protos.RawRPCRequest{Handler: handler, Payload: payload}
+	reply, err := e.controller.RawRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply.Payload, nil
+}
+
+// Checkpoint returns the serialized state of the provided component. It
+// returns an error wrapping ErrNotCheckpointable if the component does not
+// support checkpointing.
+func (e *Envelope) Checkpoint(component string) ([]byte, error) {
+	req := &protos.CheckpointRequest{Component: component}
+	reply, err := e.controller.Checkpoint(context.TODO(), req)
+	if err != nil {
+		return nil, err
+	}
+	return reply.State, nil
+}
+
+// QuiesceComponent prepares the provided component to be migrated to another
+// weavelet: it disables the component (see SetComponentEnabled), waits up to
+// timeout for its in-flight calls to finish, and returns its serialized state
+// (see Checkpoint), which can be restored elsewhere with Restore. If timeout
+// is not positive, QuiesceComponent waits for as long as it takes. If any
+// step fails, the component is left as it was. QuiesceComponent
+// returns an error wrapping ErrNotCheckpointable if the component does not
+// support checkpointing.
+func (e *Envelope) QuiesceComponent(component string, timeout time.Duration) ([]byte, error) {
+	req := &protos.QuiesceComponentRequest{Component: component, TimeoutNs: int64(timeout)}
+	reply, err := e.controller.QuiesceComponent(context.TODO(), req)
+	if err != nil {
+		return nil, err
+	}
+	return reply.State, nil
+}
+
+// Drain drains the components hosted by the weavelet in the provided order,
+// e.g., before the weavelet is shut down. Every element of order is a stage:
+// the components of a stage are disabled (see SetComponentEnabled) and their
+// in-flight calls are waited for, and the next stage starts only once all of
+// them have finished. Draining frontends before the backends they call avoids
+// cascading errors while dependent components shut down.
+//
+// Drain waits up to timeout for all stages, or for as long as it takes if
+// timeout is not positive. If a stage fails to drain, the components of the
+// earlier stages and of the failed stage stay disabled, and those of the
+// later stages are left enabled. No component is disabled if order names an
+// unknown component, or names a component more than once.
+func (e *Envelope) Drain(order [][]string, timeout time.Duration) error {
+	req := &protos.DrainRequest{TimeoutNs: int64(timeout)}
+	for _, stage := range order {
+		req.Stages = append(req.Stages, &protos.DrainStage{Components: stage})
+	}
+	_, err := e.controller.Drain(context.TODO(), req)
+	return err
+}
+
+// Restore restores the state of the provided component from a state previously
+// returned by [Checkpoint]. It returns an error wrapping ErrNotCheckpointable
+// if the component does not support checkpointing.
+func (e *Envelope) Restore(component string, state []byte) error {
+	req := &protos.RestoreRequest{Component: component, State: state}
+	_, err := e.controller.Restore(context.TODO(), req)
+	return err
+}
+
+// UpdateListenerTLS rotates the TLS material of a listener that was exported
+// with TLS (see protos.ExportListenerReply). New connections to the listener
+// use the new material.
+func (e *Envelope) UpdateListenerTLS(listener string, material *protos.ListenerTLS) error {
+	req := &protos.UpdateListenerTLSRequest{Listener: listener, Tls: material}
+	_, err := e.controller.UpdateListenerTLS(context.TODO(), req)
+	return err
+}
+
+// ReexportListeners asks the weavelet to export all of its listeners again, so
+// that the handler's ExportListener method is called for every listener, with
+// the same address and token as when the listener was first exported. This
+// lets a deployer that lost its record of the listeners (e.g., because it
+// restarted) rebuild it. It returns the names of the re-exported listeners,
+// sorted.
+func (e *Envelope) ReexportListeners() ([]string, error) {
+	reply, err := e.controller.ReexportListeners(context.TODO(), &protos.ReexportListenersRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Listeners, nil
 }
 
 // getWeaveletControlStub returns a control.WeaveletControl that forwards calls to the controller
 // component in the weavelet at the specified socket.
-func getWeaveletControlStub(ctx context.Context, socket string, options Options) (control.WeaveletControl, error) {
+func getWeaveletControlStub(ctx context.Context, socket string, options Options, limiter *rpcLimiter, stats *connStats) (control.WeaveletControl, call.Connection, error) {
 	controllerReg, ok := codegen.Find(control.WeaveletPath)
 	if !ok {
-		return nil, fmt.Errorf("controller component (%s) not found", control.WeaveletPath)
+		return nil, nil, fmt.Errorf("controller component (%s) not found", control.WeaveletPath)
+	}
+	methods := map[call.MethodKey]string{}
+	for i := 0; i < controllerReg.Iface.NumMethod(); i++ {
+		name := controllerReg.Iface.Method(i).Name
+		methods[call.MakeMethodKey(control.WeaveletPath, name)] = name
 	}
-	controlEndpoint := call.Unix(socket)
+	controlEndpoint := statsEndpoint{Endpoint: call.Unix(socket), socket: socket, wrap: options.WrapConn, stats: stats}
 	resolver := call.NewConstantResolver(controlEndpoint)
-	opts := call.ClientOptions{Logger: options.Logger}
+	opts := call.ClientOptions{
+		Logger:               options.Logger,
+		RetryBudget:          options.RetryBudget,
+		MessageStatsSampling: options.MessageStatsSampling,
+		NewBackoff:           options.NewBackoff,
+		Clock:                options.Clock,
+		HandshakeTimeout:     options.HandshakeTimeout,
+		Checksum:             true,
+		OnRetry: func(h call.MethodKey, attempt int, err error) {
+			stats.retry(methods[h], attempt, err)
+		},
+	}
 	conn, err := call.Connect(ctx, resolver, opts)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	conn = statsConnection{Connection: conn, stats: stats, methods: methods}
+	conn = rawReplyConnection{Connection: conn}
+	conn = limitedConnection{Connection: conn, limiter: limiter}
+	if options.RPCTimeout > 0 {
+		// Time spent waiting for the limiter counts against the timeout.
+		conn = timeoutConnection{Connection: conn, clock: options.Clock, timeout: options.RPCTimeout}
 	}
 	// We skip waitUntilReady() and rely on automatic retries of methods
 	stub := call.NewStub(control.WeaveletPath, controllerReg, conn, options.Tracer, 0)
 	obj := controllerReg.ClientStubFn(stub, "envelope")
-	return obj.(control.WeaveletControl), nil
+	return obj.(control.WeaveletControl), conn, nil
+}
+
+// rpcLimiter bounds the number of concurrent RPCs to a weavelet, as
+// advertised by the weavelet during the handshake (see
+// InitWeaveletReply.max_concurrent_rpcs). Every connection to the weavelet's
+// control socket has its own limiter.
+type rpcLimiter struct {
+	sem atomic.Pointer[chan struct{}] // nil if unlimited
+}
+
+// setLimit bounds the number of concurrent RPCs to n, if n is positive.
+func (l *rpcLimiter) setLimit(n int) {
+	if n > 0 {
+		sem := make(chan struct{}, n)
+		l.sem.Store(&sem)
+	}
+}
+
+// acquire blocks until an RPC can be issued, or until ctx is done. It returns
+// a function to call once the RPC finishes.
+func (l *rpcLimiter) acquire(ctx context.Context) (func(), error) {
+	sem := l.sem.Load()
+	if sem == nil {
+		return func() {}, nil
+	}
+	select {
+	case *sem <- struct{}{}:
+		return func() { <-*sem }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// limitedConnection is a call.Connection that bounds the number of concurrent
+// calls with a limiter. Calls made without waiting for a reply (see
+// call.CallOptions.NoReply) only count until they have been sent.
+type limitedConnection struct {
+	call.Connection
+	limiter *rpcLimiter
+}
+
+// Call implements the call.Connection interface.
+func (c limitedConnection) Call(ctx context.Context, h call.MethodKey, arg []byte, opts call.CallOptions) ([]byte, error) {
+	release, err := c.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return c.Connection.Call(ctx, h, arg, opts)
+}
+
+// timeoutConnection is a call.Connection that bounds the duration of calls
+// that don't have a deadline. The duration is measured by clock.
+type timeoutConnection struct {
+	call.Connection
+	clock   Clock
+	timeout time.Duration
+}
+
+// Call implements the call.Connection interface.
+func (c timeoutConnection) Call(ctx context.Context, h call.MethodKey, arg []byte, opts call.CallOptions) ([]byte, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = call.WithTimeout(ctx, c.clock, c.timeout)
+		defer cancel()
+	}
+	return c.Connection.Call(ctx, h, arg, opts)
+}
+
+// checkWeaveletArgs checks that the arguments passed to NewEnvelope are
+// well-formed. Unlike runtime.CheckWeaveletArgs, it doesn't check the fields
+// of wlet that NewEnvelope fills in.
+func checkWeaveletArgs(wlet *protos.WeaveletArgs, config *protos.AppConfig) error {
+	if wlet == nil {
+		return fmt.Errorf("WeaveletArgs: nil")
+	}
+	if wlet.App == "" {
+		return fmt.Errorf("WeaveletArgs: missing app name")
+	}
+	if wlet.DeploymentId == "" {
+		return fmt.Errorf("WeaveletArgs: missing deployment id")
+	}
+	if wlet.Id == "" {
+		return fmt.Errorf("WeaveletArgs: missing weavelet id")
+	}
+	if addr := wlet.InternalAddress; addr != "" {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return fmt.Errorf("WeaveletArgs: invalid internal address %q: %w", addr, err)
+		}
+	}
+	if wlet.MaxConcurrentRpcs < 0 {
+		return fmt.Errorf("WeaveletArgs: negative max concurrent RPCs %d", wlet.MaxConcurrentRpcs)
+	}
+	if config == nil {
+		return fmt.Errorf("AppConfig: nil")
+	}
+	if config.Name != "" && config.Name != wlet.App {
+		return fmt.Errorf("WeaveletArgs: app name %q doesn't match app config name %q", wlet.App, config.Name)
+	}
+	return nil
 }
 
 // verifyWeaveletInfo verifies the information sent by the weavelet.
@@ -442,6 +1762,67 @@ func verifyWeaveletInfo(wlet *protos.InitWeaveletReply) error {
 	return nil
 }
 
+// maxInitReplyLen is the maximum length of a weavelet info message included in
+// an error.
+const maxInitReplyLen = 512
+
+// formatInitReply returns a compact, truncated, textual representation of the
+// provided reply.
+func formatInitReply(reply *protos.InitWeaveletReply) string {
+	if reply == nil {
+		return "<nil>"
+	}
+	text := prototext.MarshalOptions{}.Format(reply)
+	if text == "" {
+		return "<empty>"
+	}
+	if len(text) > maxInitReplyLen {
+		text = text[:maxInitReplyLen] + "...(truncated)"
+	}
+	return text
+}
+
+// formatRawReply returns a compact, truncated, quoted representation of the
+// provided raw reply bytes.
+func formatRawReply(raw []byte) string {
+	if len(raw) > maxInitReplyLen {
+		return fmt.Sprintf("%q...(truncated, %d bytes)", raw[:maxInitReplyLen], len(raw))
+	}
+	return fmt.Sprintf("%q", raw)
+}
+
+// rawReply holds the raw bytes of the reply to an RPC (see withRawReply).
+type rawReply struct {
+	received bool
+	bytes    []byte
+}
+
+// rawReplyKey is the context key of the rawReply of an RPC.
+type rawReplyKey struct{}
+
+// withRawReply returns a context that makes the RPCs issued with it over a
+// rawReplyConnection record the raw bytes of their replies in raw, even if
+// the replies can't be decoded.
+func withRawReply(ctx context.Context, raw *rawReply) context.Context {
+	return context.WithValue(ctx, rawReplyKey{}, raw)
+}
+
+// rawReplyConnection is a call.Connection that records the raw bytes of the
+// replies to the RPCs issued with a context returned by withRawReply.
+type rawReplyConnection struct {
+	call.Connection
+}
+
+// Call implements the call.Connection interface.
+func (c rawReplyConnection) Call(ctx context.Context, h call.MethodKey, arg []byte, opts call.CallOptions) ([]byte, error) {
+	reply, err := c.Connection.Call(ctx, h, arg, opts)
+	if raw, ok := ctx.Value(rawReplyKey{}).(*rawReply); ok && err == nil {
+		raw.received = true
+		raw.bytes = reply
+	}
+	return reply, err
+}
+
 // checkVersion checks that the deployer API version the deployer was built
 // with is compatible with the deployer API version the app was built with,
 // erroring out if they are not compatible.
@@ -0,0 +1,71 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// contextHandler is an EnvelopeHandler that passes the contexts returned by
+// a function (see Options.HandlerContext) to the methods of the wrapped
+// handler.
+type contextHandler struct {
+	h       EnvelopeHandler
+	context func(context.Context) context.Context
+}
+
+var _ EnvelopeHandler = contextHandler{}
+
+// ActivateComponent implements the EnvelopeHandler interface.
+func (c contextHandler) ActivateComponent(ctx context.Context, req *protos.ActivateComponentRequest) (*protos.ActivateComponentReply, error) {
+	return c.h.ActivateComponent(c.context(ctx), req)
+}
+
+// GetListenerAddress implements the EnvelopeHandler interface.
+func (c contextHandler) GetListenerAddress(ctx context.Context, req *protos.GetListenerAddressRequest) (*protos.GetListenerAddressReply, error) {
+	return c.h.GetListenerAddress(c.context(ctx), req)
+}
+
+// ExportListener implements the EnvelopeHandler interface.
+func (c contextHandler) ExportListener(ctx context.Context, req *protos.ExportListenerRequest) (*protos.ExportListenerReply, error) {
+	return c.h.ExportListener(c.context(ctx), req)
+}
+
+// GetSelfCertificate implements the EnvelopeHandler interface.
+func (c contextHandler) GetSelfCertificate(ctx context.Context, req *protos.GetSelfCertificateRequest) (*protos.GetSelfCertificateReply, error) {
+	return c.h.GetSelfCertificate(c.context(ctx), req)
+}
+
+// VerifyClientCertificate implements the EnvelopeHandler interface.
+func (c contextHandler) VerifyClientCertificate(ctx context.Context, req *protos.VerifyClientCertificateRequest) (*protos.VerifyClientCertificateReply, error) {
+	return c.h.VerifyClientCertificate(c.context(ctx), req)
+}
+
+// VerifyServerCertificate implements the EnvelopeHandler interface.
+func (c contextHandler) VerifyServerCertificate(ctx context.Context, req *protos.VerifyServerCertificateRequest) (*protos.VerifyServerCertificateReply, error) {
+	return c.h.VerifyServerCertificate(c.context(ctx), req)
+}
+
+// LogBatch implements the EnvelopeHandler interface.
+func (c contextHandler) LogBatch(ctx context.Context, batch *protos.LogEntryBatch) error {
+	return c.h.LogBatch(c.context(ctx), batch)
+}
+
+// HandleTraceSpans implements the EnvelopeHandler interface.
+func (c contextHandler) HandleTraceSpans(ctx context.Context, spans *protos.TraceSpans) error {
+	return c.h.HandleTraceSpans(c.context(ctx), spans)
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// WatchHealth polls the health of the weavelet every interval and returns a
+// channel that receives the weavelet's health status whenever it changes,
+// starting with the initial status. If the weavelet's health can't be
+// fetched, the status is UNKNOWN.
+//
+// When the envelope stops, the channel receives a final TERMINATED status
+// and is closed. When ctx is cancelled, the channel is closed without a
+// final status.
+func (e *Envelope) WatchHealth(ctx context.Context, interval time.Duration) <-chan protos.HealthStatus {
+	return e.watchHealth(ctx, interval, func(reply *protos.GetHealthReply) protos.HealthStatus {
+		return reply.Status
+	})
+}
+
+// WatchComponentHealth is like WatchHealth, but it watches the health of the
+// provided component. A component is HEALTHY once it has been successfully
+// started and while it is enabled (see SetComponentEnabled), and UNHEALTHY
+// otherwise.
+func (e *Envelope) WatchComponentHealth(ctx context.Context, component string, interval time.Duration) <-chan protos.HealthStatus {
+	return e.watchHealth(ctx, interval, func(reply *protos.GetHealthReply) protos.HealthStatus {
+		if reply.Status != protos.HealthStatus_HEALTHY {
+			return reply.Status
+		}
+		if slices.Contains(reply.HealthyComponents, component) {
+			return protos.HealthStatus_HEALTHY
+		}
+		return protos.HealthStatus_UNHEALTHY
+	})
+}
+
+// watchHealth polls the weavelet's health every interval and emits the status
+// computed by the provided function whenever it changes.
+func (e *Envelope) watchHealth(ctx context.Context, interval time.Duration, status func(*protos.GetHealthReply) protos.HealthStatus) <-chan protos.HealthStatus {
+	statuses := make(chan protos.HealthStatus, 1)
+	go func() {
+		defer close(statuses)
+		emit := func(s protos.HealthStatus) bool {
+			select {
+			case statuses <- s:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		var last protos.HealthStatus
+		for first := true; ; first = false {
+			current := protos.HealthStatus_UNKNOWN
+			if reply, err := e.controller.GetHealth(ctx, &protos.GetHealthRequest{}); err == nil {
+				current = status(reply)
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			if e.ctx.Err() != nil {
+				emit(protos.HealthStatus_TERMINATED)
+				return
+			}
+			if first || current != last {
+				if !emit(current) {
+					return
+				}
+				last = current
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-e.ctx.Done():
+				emit(protos.HealthStatus_TERMINATED)
+				return
+			case <-e.clock.After(interval):
+			}
+		}
+	}()
+	return statuses
+}
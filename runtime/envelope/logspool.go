@@ -0,0 +1,270 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ServiceWeaver/weaver/internal/cond"
+	"github.com/ServiceWeaver/weaver/runtime/protomsg"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/ServiceWeaver/weaver/runtime/retry"
+)
+
+// defaultLogSpoolBytes is the default value of LogSpool.MaxBytes.
+const defaultLogSpoolBytes = 64 << 20
+
+// LogSpool configures the spooling of log entries to disk. See
+// Options.LogSpool.
+type LogSpool struct {
+	// Dir is the directory that holds the spool. It is created if it doesn't
+	// exist, and it must not be used by other envelopes at the same time.
+	// Log entries left in Dir by an earlier envelope (e.g., one that crashed
+	// or was stopped while the spool was not empty) are delivered before
+	// the log entries of the new weavelet.
+	Dir string
+
+	// MaxBytes bounds the size of the spool file. Log entries that don't fit
+	// are dropped. If zero, the spool holds up to 64 MiB.
+	MaxBytes int64
+}
+
+// logSpooler is an EnvelopeHandler that decouples the handling of log entries
+// from their receipt. Received batches of log entries are appended to a
+// spool file before LogBatch returns, and they are passed to the wrapped
+// handler, in order, on a separate goroutine. Batches that the wrapped
+// handler fails to handle are retried until they succeed.
+//
+// The spool file holds a sequence of length-prefixed LogEntryBatch protos.
+// The offset of the first batch that hasn't been delivered is stored in a
+// separate offset file after every delivery, so that a new logSpooler
+// resumes where the previous one stopped, even if the previous one crashed.
+// Batches are delivered at least once: a batch that was being delivered when
+// the envelope stopped is delivered again.
+type logSpooler struct {
+	EnvelopeHandler
+	logger     *slog.Logger
+	maxBytes   int64
+	file       *os.File // the spool file
+	offsetFile *os.File // holds the offset of the next batch to deliver
+
+	mu        sync.Mutex
+	ready     *cond.Cond // signalled when a batch is queued
+	delivered *cond.Cond // signalled when a batch is delivered
+	size      int64      // size of file
+	offset    int64      // offset in file of the next batch to deliver
+	truncated uint64     // number of times file was emptied
+	full      bool       // is the spool file full?
+}
+
+// newLogSpooler returns a logSpooler that passes log entries to h, recovering
+// the batches left in the spool by an earlier logSpooler, if any.
+func newLogSpooler(h EnvelopeHandler, logger *slog.Logger, spool LogSpool) (*logSpooler, error) {
+	if spool.MaxBytes <= 0 {
+		spool.MaxBytes = defaultLogSpoolBytes
+	}
+	if err := os.MkdirAll(spool.Dir, 0o700); err != nil {
+		return nil, fmt.Errorf("log spool: %w", err)
+	}
+	file, err := os.OpenFile(filepath.Join(spool.Dir, "spool"), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("log spool: %w", err)
+	}
+	offsetFile, err := os.OpenFile(filepath.Join(spool.Dir, "offset"), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("log spool: %w", err)
+	}
+	s := &logSpooler{
+		EnvelopeHandler: h,
+		logger:          logger,
+		maxBytes:        spool.MaxBytes,
+		file:            file,
+		offsetFile:      offsetFile,
+	}
+	s.ready = cond.NewCond(&s.mu)
+	s.delivered = cond.NewCond(&s.mu)
+
+	// Recover the state of the spool.
+	info, err := file.Stat()
+	if err != nil {
+		s.close()
+		return nil, fmt.Errorf("log spool: %w", err)
+	}
+	s.size = info.Size()
+	var buf [8]byte
+	if _, err := offsetFile.ReadAt(buf[:], 0); err == nil {
+		s.offset = int64(binary.LittleEndian.Uint64(buf[:]))
+	}
+	if s.offset < 0 || s.offset > s.size {
+		logger.Error("Discarding log spool with invalid offset", "dir", spool.Dir, "offset", s.offset, "size", s.size)
+		s.offset = s.size
+	}
+	if s.offset < s.size {
+		logger.Info("Recovering spooled log entries", "dir", spool.Dir, "bytes", s.size-s.offset)
+	}
+	return s, nil
+}
+
+// close closes the spool files.
+func (s *logSpooler) close() {
+	s.file.Close()
+	s.offsetFile.Close()
+}
+
+// LogBatch implements the EnvelopeHandler interface.
+func (s *logSpooler) LogBatch(_ context.Context, batch *protos.LogEntryBatch) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.spool(batch); err != nil {
+		return err
+	}
+	s.ready.Signal()
+	return nil
+}
+
+// spool appends the provided batch to the spool file, unless the file would
+// grow beyond s.maxBytes.
+//
+// REQUIRES: s.mu is held.
+func (s *logSpooler) spool(batch *protos.LogEntryBatch) error {
+	var buf bytes.Buffer
+	if err := protomsg.Write(&buf, batch); err != nil {
+		return fmt.Errorf("log spool: %w", err)
+	}
+	if s.size+int64(buf.Len()) > s.maxBytes {
+		if !s.full {
+			s.logger.Warn("Dropping log entries: log spool is full", "bytes", s.size)
+			s.full = true
+		}
+		return nil
+	}
+	if _, err := s.file.WriteAt(buf.Bytes(), s.size); err != nil {
+		return fmt.Errorf("log spool: %w", err)
+	}
+	s.size += int64(buf.Len())
+	return nil
+}
+
+// run delivers queued batches to the wrapped handler until ctx is done. The
+// batches that are still in the spool file when ctx is done are delivered by
+// a later logSpooler.
+func (s *logSpooler) run(ctx context.Context) {
+	defer s.close()
+	for {
+		batch, next, ok := s.next(ctx)
+		if !ok || (batch != nil && !s.deliver(ctx, batch)) {
+			// ctx is done. Undelivered batches, including batch, will be
+			// delivered by a later logSpooler.
+			return
+		}
+
+		s.mu.Lock()
+		s.advance(next)
+		s.delivered.Broadcast()
+		s.mu.Unlock()
+	}
+}
+
+// next waits for the next batch to deliver. It returns the batch, along with
+// the offset of the following batch in the spool file. It returns false if
+// ctx is done first. A nil batch means the spool file was corrupted, and the
+// rest of it is skipped.
+func (s *logSpooler) next(ctx context.Context) (*protos.LogEntryBatch, int64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for s.offset == s.size {
+		if err := s.ready.Wait(ctx); err != nil {
+			return nil, 0, false
+		}
+	}
+
+	r := io.NewSectionReader(s.file, s.offset, s.size-s.offset)
+	batch := &protos.LogEntryBatch{}
+	if err := protomsg.Read(r, batch); err != nil {
+		// The envelope may have crashed while writing the batch.
+		s.logger.Error("Skipping corrupted log spool", "offset", s.offset, "err", err)
+		return nil, s.size, true
+	}
+	n, _ := r.Seek(0, io.SeekCurrent)
+	return batch, s.offset + n, true
+}
+
+// advance records that the batches of the spool file before the provided
+// offset have been delivered, emptying the file when it has been fully
+// delivered.
+//
+// REQUIRES: s.mu is held.
+func (s *logSpooler) advance(offset int64) {
+	s.offset = offset
+	if s.offset == s.size {
+		if err := s.file.Truncate(0); err != nil {
+			s.logger.Error("Failed to truncate log spool", "err", err)
+		} else {
+			s.offset, s.size = 0, 0
+			s.truncated++
+			if s.full {
+				s.logger.Info("Log spool is no longer full")
+				s.full = false
+			}
+		}
+	}
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(s.offset))
+	if _, err := s.offsetFile.WriteAt(buf[:], 0); err != nil {
+		s.logger.Error("Failed to record log spool offset", "err", err)
+	}
+}
+
+// deliver passes the provided batch to the wrapped handler, retrying until
+// the handler succeeds or ctx is done. It returns false if ctx is done first.
+func (s *logSpooler) deliver(ctx context.Context, batch *protos.LogEntryBatch) bool {
+	for r := retry.Begin(); r.Continue(ctx); {
+		err := s.EnvelopeHandler.LogBatch(ctx, batch)
+		if err == nil || errors.Is(err, ErrHandlerPanicked) {
+			// Panics have already been logged by recoverHandler, and
+			// retrying would likely panic again.
+			return true
+		}
+	}
+	return false
+}
+
+// flush blocks until all batches in the spool file when flush is called have
+// been delivered or skipped, or until ctx is done. Batches dropped because
+// the spool was full are not waited for.
+func (s *logSpooler) flush(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The file is only emptied once all of its batches have been delivered,
+	// so the watermark is reached if the file is emptied or if the offset
+	// reaches the current end of the file.
+	watermark, truncated := s.size, s.truncated
+	for s.offset < watermark && s.truncated == truncated {
+		if err := s.delivered.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// ErrMessageOrder is wrapped by the errors passed to Options.OnOrderViolation.
+var ErrMessageOrder = errors.New("log batches received out of order")
+
+// orderChecker is an EnvelopeHandler that checks that the weavelet's log
+// batches are received in the order the weavelet sent them, as indicated by
+// their sequence numbers (see LogEntryBatch.seq), and reports the batches
+// that are not. A batch received twice in a row is a retry, not a violation.
+// Batches are passed to the wrapped handler regardless.
+type orderChecker struct {
+	EnvelopeHandler
+	report func(error) // see Options.OnOrderViolation
+
+	mu   sync.Mutex
+	last uint64 // sequence number of the last batch received
+}
+
+// LogBatch implements the EnvelopeHandler interface.
+func (c *orderChecker) LogBatch(ctx context.Context, batch *protos.LogEntryBatch) error {
+	if err := c.check(batch.Seq); err != nil {
+		c.report(err)
+	}
+	return c.EnvelopeHandler.LogBatch(ctx, batch)
+}
+
+// check records the receipt of the batch with the provided sequence number,
+// and returns an error if the batch is out of order.
+func (c *orderChecker) check(seq uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	last := c.last
+	if seq > last {
+		c.last = seq
+	}
+	switch {
+	case seq == 0:
+		return fmt.Errorf("%w: batch without a sequence number", ErrMessageOrder)
+	case seq == last:
+		// The weavelet retried the delivery of the batch. Retries happen
+		// before the next batch is sent.
+		return nil
+	case seq < last:
+		return fmt.Errorf("%w: batch %d received after batch %d", ErrMessageOrder, seq, last)
+	case seq > last+1:
+		return fmt.Errorf("%w: batch %d received after batch %d; batches %d to %d are missing", ErrMessageOrder, seq, last, last+1, seq-1)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/ServiceWeaver/weaver/runtime/retry"
+	"github.com/ServiceWeaver/weaver/runtime/traces"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OTLPOptions configures an OTLPTraceHandler.
+type OTLPOptions struct {
+	// Exporter exports spans to an OTLP endpoint. It is typically created
+	// using otlptracehttp.New or otlptracegrpc.New, which is also where the
+	// endpoint is configured. Required.
+	Exporter sdktrace.SpanExporter
+
+	// Resource attributes (e.g., service name, weavelet id) added to every
+	// exported span. They take precedence over the span's own resource
+	// attributes.
+	ResourceAttributes []attribute.KeyValue
+
+	// The maximum number of spans exported in a single batch, and the maximum
+	// delay before a partial batch is exported. If zero, the defaults of the
+	// OpenTelemetry SDK batch span processor are used.
+	MaxBatchSize int
+	BatchTimeout time.Duration
+
+	// The maximum number of attempts to export a batch of spans. If zero, a
+	// batch is attempted 3 times before it is dropped.
+	MaxAttempts int
+}
+
+// OTLPTraceHandler implements the HandleTraceSpans method of an
+// EnvelopeHandler by exporting spans to an OTLP endpoint, with batching and
+// retries. Embed it in an EnvelopeHandler to export traces out of the box:
+//
+//	type handler struct {
+//	    *envelope.OTLPTraceHandler
+//	    ...
+//	}
+type OTLPTraceHandler struct {
+	processor sdktrace.SpanProcessor
+	resource  *resource.Resource // nil if there are no resource attributes
+}
+
+// NewOTLPTraceHandler returns a new OTLPTraceHandler. Call Shutdown to flush
+// buffered spans and release the exporter.
+func NewOTLPTraceHandler(opts OTLPOptions) (*OTLPTraceHandler, error) {
+	if opts.Exporter == nil {
+		return nil, fmt.Errorf("NewOTLPTraceHandler: nil exporter")
+	}
+	if opts.MaxAttempts == 0 {
+		opts.MaxAttempts = 3
+	}
+	var batchOpts []sdktrace.BatchSpanProcessorOption
+	if opts.MaxBatchSize > 0 {
+		batchOpts = append(batchOpts, sdktrace.WithMaxExportBatchSize(opts.MaxBatchSize))
+	}
+	if opts.BatchTimeout > 0 {
+		batchOpts = append(batchOpts, sdktrace.WithBatchTimeout(opts.BatchTimeout))
+	}
+	exporter := retryingExporter{SpanExporter: opts.Exporter, attempts: opts.MaxAttempts}
+	h := &OTLPTraceHandler{processor: sdktrace.NewBatchSpanProcessor(exporter, batchOpts...)}
+	if len(opts.ResourceAttributes) > 0 {
+		h.resource = resource.NewSchemaless(opts.ResourceAttributes...)
+	}
+	return h, nil
+}
+
+// HandleTraceSpans implements the EnvelopeHandler interface.
+func (h *OTLPTraceHandler) HandleTraceSpans(_ context.Context, spans *protos.TraceSpans) error {
+	for _, span := range spans.Span {
+		h.processor.OnEnd(exportSpan{
+			ReadOnlySpan: &traces.ReadSpan{Span: span},
+			resource:     h.resource,
+		})
+	}
+	return nil
+}
+
+// ForceFlush exports all buffered spans.
+func (h *OTLPTraceHandler) ForceFlush(ctx context.Context) error {
+	return h.processor.ForceFlush(ctx)
+}
+
+// Shutdown exports all buffered spans and shuts down the exporter.
+func (h *OTLPTraceHandler) Shutdown(ctx context.Context) error {
+	return h.processor.Shutdown(ctx)
+}
+
+// exportSpan is a span received from a weavelet, prepared for export.
+type exportSpan struct {
+	sdktrace.ReadOnlySpan
+	resource *resource.Resource // additional resource attributes, or nil
+}
+
+// SpanContext implements the sdktrace.ReadOnlySpan interface.
+func (s exportSpan) SpanContext() trace.SpanContext {
+	// Weavelets only send spans that were sampled, but the trace flags are
+	// not preserved. Note that the batch span processor drops spans that are
+	// not marked as sampled.
+	return s.ReadOnlySpan.SpanContext().WithTraceFlags(trace.FlagsSampled)
+}
+
+// Resource implements the sdktrace.ReadOnlySpan interface.
+func (s exportSpan) Resource() *resource.Resource {
+	if s.resource == nil {
+		return s.ReadOnlySpan.Resource()
+	}
+	r, err := resource.Merge(s.ReadOnlySpan.Resource(), s.resource)
+	if err != nil {
+		// The schema URLs conflict. Prefer the configured attributes.
+		return s.resource
+	}
+	return r
+}
+
+// retryingExporter is a span exporter that retries failed exports.
+type retryingExporter struct {
+	sdktrace.SpanExporter
+	attempts int
+}
+
+// ExportSpans implements the sdktrace.SpanExporter interface.
+func (e retryingExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	var err error
+	attempt := 0
+	for r := retry.Begin(); r.Continue(ctx); {
+		if err = e.SpanExporter.ExportSpans(ctx, spans); err == nil {
+			return nil
+		}
+		attempt++
+		if attempt >= e.attempts {
+			return err
+		}
+	}
+	if err == nil {
+		err = ctx.Err()
+	}
+	return err
+}
@@ -0,0 +1,197 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// The following interfaces are the parts of an EnvelopeHandler. A handler
+// that implements any subset of them, instead of the whole EnvelopeHandler,
+// can be completed with [CompleteHandler] before it is passed to
+// [Envelope.Serve].
+
+// ComponentHandler handles component activations. See EnvelopeHandler.
+type ComponentHandler interface {
+	ActivateComponent(context.Context, *protos.ActivateComponentRequest) (*protos.ActivateComponentReply, error)
+}
+
+// ListenerHandler handles listeners. See EnvelopeHandler.
+type ListenerHandler interface {
+	GetListenerAddress(context.Context, *protos.GetListenerAddressRequest) (*protos.GetListenerAddressReply, error)
+	ExportListener(context.Context, *protos.ExportListenerRequest) (*protos.ExportListenerReply, error)
+}
+
+// SecurityHandler handles the certificates used for mTLS. See
+// EnvelopeHandler.
+type SecurityHandler interface {
+	GetSelfCertificate(context.Context, *protos.GetSelfCertificateRequest) (*protos.GetSelfCertificateReply, error)
+	VerifyClientCertificate(context.Context, *protos.VerifyClientCertificateRequest) (*protos.VerifyClientCertificateReply, error)
+	VerifyServerCertificate(context.Context, *protos.VerifyServerCertificateRequest) (*protos.VerifyServerCertificateReply, error)
+}
+
+// LogHandler handles log entries. See EnvelopeHandler.
+type LogHandler interface {
+	LogBatch(context.Context, *protos.LogEntryBatch) error
+}
+
+// TraceHandler handles trace spans. See EnvelopeHandler.
+type TraceHandler interface {
+	HandleTraceSpans(context.Context, *protos.TraceSpans) error
+}
+
+// partialHandler is an EnvelopeHandler that passes messages to the parts of
+// an EnvelopeHandler implemented by a handler, and handles the other
+// messages with defaults. A nil part is not implemented by the handler.
+type partialHandler struct {
+	env        *Envelope
+	name       string // type of the handler, for errors
+	components ComponentHandler
+	listeners  ListenerHandler
+	security   SecurityHandler
+	logs       LogHandler
+	traces     TraceHandler
+
+	mu        sync.Mutex
+	activated []string // components activated by default
+}
+
+var _ EnvelopeHandler = &partialHandler{}
+
+// CompleteHandler returns an EnvelopeHandler for e that handles messages
+// with h, which must implement EnvelopeHandler or at least one of its parts:
+// ComponentHandler, ListenerHandler, SecurityHandler, LogHandler, and
+// TraceHandler. If h implements EnvelopeHandler, it is returned as is.
+// Otherwise, the messages of the parts that h doesn't implement are handled
+// by default as follows:
+//
+//   - Components are run by the weavelet, and routed locally.
+//   - Listeners listen on an arbitrary port of localhost, and are not
+//     proxied.
+//   - Certificate requests fail, so mTLS can't be used.
+//   - Log entries and trace spans are dropped.
+//
+// CompleteHandler returns an error if h implements none of the parts.
+func CompleteHandler(e *Envelope, h any) (EnvelopeHandler, error) {
+	if full, ok := h.(EnvelopeHandler); ok {
+		return full, nil
+	}
+	p := &partialHandler{env: e, name: fmt.Sprintf("%T", h)}
+	p.components, _ = h.(ComponentHandler)
+	p.listeners, _ = h.(ListenerHandler)
+	p.security, _ = h.(SecurityHandler)
+	p.logs, _ = h.(LogHandler)
+	p.traces, _ = h.(TraceHandler)
+	if p.components == nil && p.listeners == nil && p.security == nil && p.logs == nil && p.traces == nil {
+		return nil, fmt.Errorf("envelope: handler %s implements no part of EnvelopeHandler", p.name)
+	}
+	return p, nil
+}
+
+// ActivateComponent implements the EnvelopeHandler interface. By default, the
+// component is run by the weavelet and routed locally.
+func (p *partialHandler) ActivateComponent(ctx context.Context, req *protos.ActivateComponentRequest) (*protos.ActivateComponentReply, error) {
+	if p.components != nil {
+		return p.components.ActivateComponent(ctx, req)
+	}
+	p.mu.Lock()
+	if !slices.Contains(p.activated, req.Component) {
+		p.activated = append(p.activated, req.Component)
+	}
+	components := slices.Clone(p.activated)
+	p.mu.Unlock()
+	if err := p.env.UpdateComponents(components); err != nil {
+		return nil, err
+	}
+	routing := &protos.RoutingInfo{Component: req.Component, Local: true}
+	if err := p.env.UpdateRoutingInfo(routing); err != nil {
+		return nil, err
+	}
+	return &protos.ActivateComponentReply{}, nil
+}
+
+// GetListenerAddress implements the EnvelopeHandler interface. By default,
+// the weavelet listens on an arbitrary port of localhost.
+func (p *partialHandler) GetListenerAddress(ctx context.Context, req *protos.GetListenerAddressRequest) (*protos.GetListenerAddressReply, error) {
+	if p.listeners != nil {
+		return p.listeners.GetListenerAddress(ctx, req)
+	}
+	return &protos.GetListenerAddressReply{Address: "localhost:0"}, nil
+}
+
+// ExportListener implements the EnvelopeHandler interface. By default, the
+// listener is not proxied, and should be contacted directly.
+func (p *partialHandler) ExportListener(ctx context.Context, req *protos.ExportListenerRequest) (*protos.ExportListenerReply, error) {
+	if p.listeners != nil {
+		return p.listeners.ExportListener(ctx, req)
+	}
+	return &protos.ExportListenerReply{}, nil
+}
+
+// GetSelfCertificate implements the EnvelopeHandler interface. By default, it
+// fails, so mTLS can't be used.
+func (p *partialHandler) GetSelfCertificate(ctx context.Context, req *protos.GetSelfCertificateRequest) (*protos.GetSelfCertificateReply, error) {
+	if p.security != nil {
+		return p.security.GetSelfCertificate(ctx, req)
+	}
+	return nil, p.noSecurity()
+}
+
+// VerifyClientCertificate implements the EnvelopeHandler interface. By
+// default, it fails, so no client is authorized.
+func (p *partialHandler) VerifyClientCertificate(ctx context.Context, req *protos.VerifyClientCertificateRequest) (*protos.VerifyClientCertificateReply, error) {
+	if p.security != nil {
+		return p.security.VerifyClientCertificate(ctx, req)
+	}
+	return nil, p.noSecurity()
+}
+
+// VerifyServerCertificate implements the EnvelopeHandler interface. By
+// default, it fails, so no server is trusted.
+func (p *partialHandler) VerifyServerCertificate(ctx context.Context, req *protos.VerifyServerCertificateRequest) (*protos.VerifyServerCertificateReply, error) {
+	if p.security != nil {
+		return p.security.VerifyServerCertificate(ctx, req)
+	}
+	return nil, p.noSecurity()
+}
+
+// noSecurity returns the error of the certificate methods of a handler that
+// doesn't implement SecurityHandler.
+func (p *partialHandler) noSecurity() error {
+	return fmt.Errorf("envelope: handler %s doesn't implement SecurityHandler", p.name)
+}
+
+// LogBatch implements the EnvelopeHandler interface. By default, log entries
+// are dropped.
+func (p *partialHandler) LogBatch(ctx context.Context, batch *protos.LogEntryBatch) error {
+	if p.logs != nil {
+		return p.logs.LogBatch(ctx, batch)
+	}
+	return nil
+}
+
+// HandleTraceSpans implements the EnvelopeHandler interface. By default,
+// trace spans are dropped.
+func (p *partialHandler) HandleTraceSpans(ctx context.Context, spans *protos.TraceSpans) error {
+	if p.traces != nil {
+		return p.traces.HandleTraceSpans(ctx, spans)
+	}
+	return nil
+}
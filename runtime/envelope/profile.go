@@ -0,0 +1,98 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// ProfileType is a type of profile collected by GetTypedProfile and
+// GetProfileBundle.
+type ProfileType = protos.ProfileType
+
+// Profile types supported by GetTypedProfile and GetProfileBundle.
+const (
+	ProfileCPU       ProfileType = protos.ProfileType_CPU
+	ProfileHeap      ProfileType = protos.ProfileType_Heap
+	ProfileBlock     ProfileType = protos.ProfileType_Block
+	ProfileMutex     ProfileType = protos.ProfileType_Mutex
+	ProfileGoroutine ProfileType = protos.ProfileType_Goroutine
+	ProfileAllocs    ProfileType = protos.ProfileType_Allocs
+)
+
+// GetTypedProfile returns a profile of the provided type, encoded in the
+// pprof format. duration is the duration of CPU profiles and must be positive
+// for them. It is ignored for other profile types, which capture the current
+// state of the weavelet.
+func (e *Envelope) GetTypedProfile(ctx context.Context, typ ProfileType, duration time.Duration) ([]byte, error) {
+	req := &protos.GetProfileRequest{ProfileType: typ}
+	switch typ {
+	case ProfileCPU:
+		if duration <= 0 {
+			return nil, fmt.Errorf("GetTypedProfile: invalid CPU profile duration %v", duration)
+		}
+		req.CpuDurationNs = duration.Nanoseconds()
+	case ProfileHeap, ProfileBlock, ProfileMutex, ProfileGoroutine, ProfileAllocs:
+	default:
+		return nil, fmt.Errorf("GetTypedProfile: unsupported profile type %v", typ)
+	}
+	reply, err := e.controller.GetProfile(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply.Data, nil
+}
+
+// GetProfileBundle returns a profile of every one of the provided types,
+// keyed by type and encoded in the pprof format. The profiles are collected
+// concurrently, so that they capture the weavelet at about the same time,
+// which helps correlate them when diagnosing an incident. duration is the
+// duration of the CPU profile, if requested, and it dominates the duration of
+// the call. types must not contain duplicates.
+func (e *Envelope) GetProfileBundle(ctx context.Context, types []ProfileType, duration time.Duration) (map[ProfileType][]byte, error) {
+	seen := map[ProfileType]bool{}
+	for _, typ := range types {
+		switch typ {
+		case ProfileCPU:
+			if duration <= 0 {
+				return nil, fmt.Errorf("GetProfileBundle: invalid CPU profile duration %v", duration)
+			}
+		case ProfileHeap, ProfileBlock, ProfileMutex, ProfileGoroutine, ProfileAllocs:
+		default:
+			return nil, fmt.Errorf("GetProfileBundle: unsupported profile type %v", typ)
+		}
+		if seen[typ] {
+			return nil, fmt.Errorf("GetProfileBundle: duplicate profile type %v", typ)
+		}
+		seen[typ] = true
+	}
+	req := &protos.GetProfileBundleRequest{ProfileTypes: types}
+	if seen[ProfileCPU] {
+		req.CpuDurationNs = duration.Nanoseconds()
+	}
+	reply, err := e.controller.GetProfileBundle(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	profiles := make(map[ProfileType][]byte, len(reply.Profiles))
+	for _, p := range reply.Profiles {
+		profiles[p.ProfileType] = p.Data
+	}
+	return profiles, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// ErrHandlerPanicked is wrapped by the errors that replace panics in
+// EnvelopeHandler methods. See [Envelope.Serve].
+var ErrHandlerPanicked = errors.New("envelope handler panicked")
+
+// recoverHandler is an EnvelopeHandler that recovers from panics in the
+// methods of the wrapped handler. A panic is logged and converted into an
+// error, which includes the stack of the panicking goroutine, so that a
+// single bad message doesn't crash the deployer.
+type recoverHandler struct {
+	h      EnvelopeHandler
+	logger *slog.Logger
+}
+
+var _ EnvelopeHandler = recoverHandler{}
+
+// catch recovers from a panic in the provided method, if any, and stores the
+// corresponding error in err. It must be called directly by a defer
+// statement.
+func (r recoverHandler) catch(method string, err *error) {
+	x := recover()
+	if x == nil {
+		return
+	}
+	*err = fmt.Errorf("%w: %s: %v\n%s", ErrHandlerPanicked, method, x, debug.Stack())
+	r.logger.Error("EnvelopeHandler panicked", "method", method, "err", *err)
+}
+
+// ActivateComponent implements the EnvelopeHandler interface.
+func (r recoverHandler) ActivateComponent(ctx context.Context, req *protos.ActivateComponentRequest) (reply *protos.ActivateComponentReply, err error) {
+	defer r.catch("ActivateComponent", &err)
+	return r.h.ActivateComponent(ctx, req)
+}
+
+// GetListenerAddress implements the EnvelopeHandler interface.
+func (r recoverHandler) GetListenerAddress(ctx context.Context, req *protos.GetListenerAddressRequest) (reply *protos.GetListenerAddressReply, err error) {
+	defer r.catch("GetListenerAddress", &err)
+	return r.h.GetListenerAddress(ctx, req)
+}
+
+// ExportListener implements the EnvelopeHandler interface.
+func (r recoverHandler) ExportListener(ctx context.Context, req *protos.ExportListenerRequest) (reply *protos.ExportListenerReply, err error) {
+	defer r.catch("ExportListener", &err)
+	return r.h.ExportListener(ctx, req)
+}
+
+// GetSelfCertificate implements the EnvelopeHandler interface.
+func (r recoverHandler) GetSelfCertificate(ctx context.Context, req *protos.GetSelfCertificateRequest) (reply *protos.GetSelfCertificateReply, err error) {
+	defer r.catch("GetSelfCertificate", &err)
+	return r.h.GetSelfCertificate(ctx, req)
+}
+
+// VerifyClientCertificate implements the EnvelopeHandler interface.
+func (r recoverHandler) VerifyClientCertificate(ctx context.Context, req *protos.VerifyClientCertificateRequest) (reply *protos.VerifyClientCertificateReply, err error) {
+	defer r.catch("VerifyClientCertificate", &err)
+	return r.h.VerifyClientCertificate(ctx, req)
+}
+
+// VerifyServerCertificate implements the EnvelopeHandler interface.
+func (r recoverHandler) VerifyServerCertificate(ctx context.Context, req *protos.VerifyServerCertificateRequest) (reply *protos.VerifyServerCertificateReply, err error) {
+	defer r.catch("VerifyServerCertificate", &err)
+	return r.h.VerifyServerCertificate(ctx, req)
+}
+
+// LogBatch implements the EnvelopeHandler interface.
+func (r recoverHandler) LogBatch(ctx context.Context, batch *protos.LogEntryBatch) (err error) {
+	defer r.catch("LogBatch", &err)
+	return r.h.LogBatch(ctx, batch)
+}
+
+// HandleTraceSpans implements the EnvelopeHandler interface.
+func (r recoverHandler) HandleTraceSpans(ctx context.Context, spans *protos.TraceSpans) (err error) {
+	defer r.catch("HandleTraceSpans", &err)
+	return r.h.HandleTraceSpans(ctx, spans)
+}
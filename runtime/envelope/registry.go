@@ -0,0 +1,76 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// ConnRegistry tracks the active connections of a set of envelopes to their
+// weavelets, e.g., to list all the weavelets managed by a deployer in an
+// admin view. An envelope created with Options.Registry set is added to the
+// registry once NewEnvelope succeeds, and is removed from it once the
+// envelope's connection to its weavelet is closed (i.e., once Serve returns,
+// the envelope is closed, or the context passed to NewEnvelope is
+// cancelled). A ConnRegistry can be shared by any number of envelopes.
+//
+// The zero value is an empty registry, ready to use.
+type ConnRegistry struct {
+	mu        sync.Mutex
+	envelopes map[*Envelope]struct{}
+}
+
+// List returns the envelopes with an active connection to their weavelets,
+// ordered by weavelet id (see [Envelope.WeaveletID]).
+func (r *ConnRegistry) List() []*Envelope {
+	r.mu.Lock()
+	envelopes := make([]*Envelope, 0, len(r.envelopes))
+	for e := range r.envelopes {
+		envelopes = append(envelopes, e)
+	}
+	r.mu.Unlock()
+	sort.Slice(envelopes, func(i, j int) bool {
+		return envelopes[i].WeaveletID() < envelopes[j].WeaveletID()
+	})
+	return envelopes
+}
+
+// Len returns the number of envelopes with an active connection to their
+// weavelets.
+func (r *ConnRegistry) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.envelopes)
+}
+
+// register adds the provided envelope to the registry, until the provided
+// context, which is cancelled when the envelope's connection is closed, is
+// done.
+func (r *ConnRegistry) register(ctx context.Context, e *Envelope) {
+	r.mu.Lock()
+	if r.envelopes == nil {
+		r.envelopes = map[*Envelope]struct{}{}
+	}
+	r.envelopes[e] = struct{}{}
+	r.mu.Unlock()
+
+	context.AfterFunc(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.envelopes, e)
+	})
+}
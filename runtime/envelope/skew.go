@@ -0,0 +1,101 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// skewWeight is the weight of a new measurement in a skewEstimate.
+const skewWeight = 0.2
+
+// skewEstimate is an exponentially weighted moving average of the measured
+// skews of a weavelet's clock (see Envelope.ClockSkew).
+type skewEstimate struct {
+	mu       sync.Mutex
+	measured bool          // has the skew been measured?
+	skew     time.Duration // estimated skew
+}
+
+// update adds a measured skew to the estimate.
+func (s *skewEstimate) update(measured time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.measured {
+		s.skew = measured
+		s.measured = true
+		return
+	}
+	s.skew += time.Duration(skewWeight * float64(measured-s.skew))
+}
+
+// get returns the estimated skew, or zero if it hasn't been measured.
+func (s *skewEstimate) get() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.skew
+}
+
+// skewCorrector is an EnvelopeHandler that subtracts the estimated skew of the
+// weavelet's clock from the timestamps of the log entries and trace spans it
+// receives (see Options.CorrectTimestamps), before passing them to the
+// wrapped handler.
+type skewCorrector struct {
+	EnvelopeHandler
+	skew *skewEstimate
+}
+
+// LogBatch implements the EnvelopeHandler interface.
+func (c skewCorrector) LogBatch(ctx context.Context, batch *protos.LogEntryBatch) error {
+	if skew := c.skew.get().Microseconds(); skew != 0 {
+		for _, entry := range batch.Entries {
+			entry.TimeMicros -= skew
+		}
+	}
+	return c.EnvelopeHandler.LogBatch(ctx, batch)
+}
+
+// HandleTraceSpans implements the EnvelopeHandler interface.
+func (c skewCorrector) HandleTraceSpans(ctx context.Context, spans *protos.TraceSpans) error {
+	if skew := c.skew.get().Microseconds(); skew != 0 {
+		for _, span := range spans.Span {
+			span.StartMicros -= skew
+			span.EndMicros -= skew
+			for _, event := range span.Events {
+				event.TimeMicros -= skew
+			}
+		}
+	}
+	return c.EnvelopeHandler.HandleTraceSpans(ctx, spans)
+}
+
+// trackSkew measures the skew of the weavelet's clock every interval, until
+// the envelope is closed.
+func (e *Envelope) trackSkew(interval time.Duration) {
+	for {
+		select {
+		case <-e.ctx.Done():
+			return
+		case <-e.clock.After(interval):
+		}
+		if _, _, err := e.getTime(e.ctx); err != nil && e.ctx.Err() == nil {
+			e.logger.Debug("Failed to measure weavelet clock skew", "err", err)
+		}
+	}
+}
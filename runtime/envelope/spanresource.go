@@ -0,0 +1,99 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+
+	"github.com/ServiceWeaver/weaver/internal/traceio"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+// resourceHandler is an EnvelopeHandler that adds resource attributes
+// identifying the weavelet to the trace spans it receives (see
+// Options.SpanResource), before passing them to the wrapped handler.
+type resourceHandler struct {
+	EnvelopeHandler
+	attrs []*protos.Span_Attribute
+}
+
+// newResourceHandler returns a resourceHandler that adds the resource
+// attributes of the provided weavelet to the spans passed to h. pid is the
+// process id of the weavelet, or zero if it is unknown.
+func newResourceHandler(h EnvelopeHandler, wlet *protos.WeaveletArgs, pid int) *resourceHandler {
+	attrs := []*protos.Span_Attribute{
+		stringAttr(string(semconv.ServiceNameKey), wlet.App),
+		stringAttr(string(semconv.ServiceInstanceIDKey), wlet.Id),
+		stringAttr(string(traceio.AppTraceKey), wlet.App),
+		stringAttr(string(traceio.DeploymentIdTraceKey), wlet.DeploymentId),
+		stringAttr(string(traceio.WeaveletIdTraceKey), wlet.Id),
+	}
+	if pid > 0 {
+		attrs = append(attrs, &protos.Span_Attribute{
+			Key: string(semconv.ProcessPIDKey),
+			Value: &protos.Span_Attribute_Value{
+				Type:  protos.Span_Attribute_Value_INT64,
+				Value: &protos.Span_Attribute_Value_Num{Num: uint64(pid)},
+			},
+		})
+	}
+	return &resourceHandler{EnvelopeHandler: h, attrs: attrs}
+}
+
+// HandleTraceSpans implements the EnvelopeHandler interface.
+func (r *resourceHandler) HandleTraceSpans(ctx context.Context, spans *protos.TraceSpans) error {
+	for _, span := range spans.Span {
+		r.stamp(span)
+	}
+	return r.EnvelopeHandler.HandleTraceSpans(ctx, spans)
+}
+
+// stamp adds the handler's resource attributes to the resource of the
+// provided span, replacing the span's own attributes with the same keys.
+func (r *resourceHandler) stamp(span *protos.Span) {
+	if span.Resource == nil {
+		span.Resource = &protos.Span_Resource{}
+	}
+	attrs := make([]*protos.Span_Attribute, 0, len(span.Resource.Attributes)+len(r.attrs))
+	for _, attr := range span.Resource.Attributes {
+		if !r.has(attr.Key) {
+			attrs = append(attrs, attr)
+		}
+	}
+	span.Resource.Attributes = append(attrs, r.attrs...)
+}
+
+// has returns whether the handler adds a resource attribute with the
+// provided key.
+func (r *resourceHandler) has(key string) bool {
+	for _, attr := range r.attrs {
+		if attr.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// stringAttr returns a string valued span attribute.
+func stringAttr(key, value string) *protos.Span_Attribute {
+	return &protos.Span_Attribute{
+		Key: key,
+		Value: &protos.Span_Attribute_Value{
+			Type:  protos.Span_Attribute_Value_STRING,
+			Value: &protos.Span_Attribute_Value_Str{Str: value},
+		},
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import "fmt"
+
+// State is the lifecycle state of an envelope. An envelope goes through the
+// following states, in order, never going back to an earlier state:
+//
+//	StateHandshaking -> StateReady -> StateServing -> StateDraining -> StateStopped
+//
+// An envelope returned by [NewEnvelope] has completed the handshake with its
+// weavelet, so it is at least in StateReady. An envelope stays in StateReady
+// until [Envelope.Serve] is called, even if its context is cancelled.
+type State int32
+
+const (
+	// StateHandshaking is the state of an envelope while NewEnvelope starts
+	// the weavelet and performs the handshake with it.
+	StateHandshaking State = iota
+
+	// StateReady is the state of an envelope after the handshake, before
+	// Serve is called. The envelope can issue RPCs to the weavelet, but it
+	// doesn't handle messages from the weavelet yet.
+	StateReady
+
+	// StateServing is the state of an envelope while Serve handles messages
+	// from the weavelet.
+	StateServing
+
+	// StateDraining is the state of an envelope whose connection to the
+	// weavelet is terminating (e.g., because its context was cancelled or
+	// the weavelet exited), while Serve waits for in-progress work and for
+	// the weavelet to exit. Deployers should avoid issuing RPCs that mutate
+	// the weavelet in this state.
+	StateDraining
+
+	// StateStopped is the state of an envelope after Serve returns.
+	StateStopped
+)
+
+var stateNames = []string{
+	StateHandshaking: "handshaking",
+	StateReady:       "ready",
+	StateServing:     "serving",
+	StateDraining:    "draining",
+	StateStopped:     "stopped",
+}
+
+// String implements the fmt.Stringer interface.
+func (s State) String() string {
+	if s >= 0 && int(s) < len(stateNames) {
+		return stateNames[s]
+	}
+	return fmt.Sprintf("State(%d)", int32(s))
+}
+
+// State returns the current lifecycle state of the envelope.
+func (e *Envelope) State() State {
+	return State(e.state.Load())
+}
+
+// transition moves the envelope from state from to state to, returning false
+// if the envelope is not in state from.
+func (e *Envelope) transition(from, to State) bool {
+	return e.state.CompareAndSwap(int32(from), int32(to))
+}
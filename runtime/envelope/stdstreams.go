@@ -0,0 +1,224 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+const (
+	// maxBufferedLines bounds the number of lines of weavelet stdout and
+	// stderr buffered before the envelope starts serving. Older lines are
+	// dropped first, so that the end of the output (e.g., the stack trace of
+	// a panic) is kept.
+	maxBufferedLines = 1000
+
+	// drainTimeout bounds how long a failed NewEnvelope waits for the
+	// weavelet's stdout and stderr to be closed before closing them itself.
+	drainTimeout = time.Second
+)
+
+// CloseWeaveletStdStreams closes the stdout and stderr of the provided child,
+// if any. The envelope captures weavelet stdout and stderr until they are
+// closed, which typically happens when the weavelet exits. Implementations of
+// Child whose streams may outlive the weavelet (e.g., because they are
+// shared with other processes) can use CloseWeaveletStdStreams to stop the
+// capture when they abandon the weavelet.
+func CloseWeaveletStdStreams(child Child) error {
+	var errs []error
+	if stdout := child.Stdout(); stdout != nil {
+		errs = append(errs, stdout.Close())
+	}
+	if stderr := child.Stderr(); stderr != nil {
+		errs = append(errs, stderr.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// stdStreams captures the stdout and stderr of a weavelet as log entries.
+// Capture starts as soon as the weavelet is started, so that output written
+// before the handshake with the weavelet completes (e.g., a panic during
+// initialization) isn't lost. The last maxBufferedLines lines captured before
+// a handler is installed are buffered, and delivered when a handler is
+// installed.
+type stdStreams struct {
+	weavelet *protos.WeaveletArgs
+	logger   *slog.Logger
+	done     chan error // receives the error that ends the capture of a stream
+	n        int        // number of captured streams
+
+	mu       sync.Mutex
+	ctx      context.Context    // passed to h
+	h        EnvelopeHandler    // nil until installed
+	buffered []*protos.LogEntry // ring of lines captured before h was installed
+	oldest   int                // index of the oldest line in a full buffered
+	dropped  int                // oldest lines dropped because buffered was full
+}
+
+// captureStdStreams starts capturing the stdout and stderr of the provided
+// child.
+func captureStdStreams(wlet *protos.WeaveletArgs, child Child, logger *slog.Logger) *stdStreams {
+	s := &stdStreams{weavelet: wlet, logger: logger, done: make(chan error, 2)}
+	streams := []struct {
+		component string
+		src       io.Reader
+	}{
+		{"stdout", child.Stdout()},
+		{"stderr", child.Stderr()},
+	}
+	for _, stream := range streams {
+		if stream.src == nil {
+			continue
+		}
+		s.n++
+		go func() { s.done <- s.capture(stream.component, stream.src) }()
+	}
+	return s
+}
+
+// capture delivers the lines read from src until src is exhausted or the
+// handler fails, returning the error that ended the capture.
+func (s *stdStreams) capture(component string, src io.Reader) error {
+	rdr := bufio.NewReader(src)
+	for {
+		line, err := rdr.ReadBytes('\n')
+		// Note: both line and err may be present.
+		if len(line) > 0 {
+			if err := s.deliver(component, string(dropNewline(line))); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("capture %s: %w", component, err)
+		}
+	}
+}
+
+func dropNewline(line []byte) []byte {
+	if len(line) > 0 && line[len(line)-1] == '\n' {
+		line = line[:len(line)-1]
+	}
+	return line
+}
+
+// deliver delivers a line read from the provided stream, or buffers it if no
+// handler has been installed yet.
+func (s *stdStreams) deliver(component, msg string) error {
+	entry := &protos.LogEntry{
+		App:       s.weavelet.App,
+		Version:   s.weavelet.DeploymentId,
+		Component: component,
+		Node:      s.weavelet.Id,
+		Level:     component, // Either "stdout" or "stderr"
+		File:      "",
+		Line:      -1,
+		Msg:       msg,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.h == nil {
+		// Record the time the line was captured, rather than the time it
+		// is delivered.
+		entry.TimeMicros = time.Now().UnixMicro()
+		if len(s.buffered) < maxBufferedLines {
+			s.buffered = append(s.buffered, entry)
+			return nil
+		}
+		// Replace the oldest line.
+		s.buffered[s.oldest] = entry
+		s.oldest = (s.oldest + 1) % maxBufferedLines
+		s.dropped++
+		return nil
+	}
+	return s.logEntry(entry)
+}
+
+// takeBuffered returns the buffered lines, oldest first, and empties the
+// buffer.
+//
+// REQUIRES: s.mu is held.
+func (s *stdStreams) takeBuffered() []*protos.LogEntry {
+	buffered := slices.Concat(s.buffered[s.oldest:], s.buffered[:s.oldest])
+	s.buffered, s.oldest = nil, 0
+	return buffered
+}
+
+// logEntry passes the provided entry to the installed handler.
+//
+// REQUIRES: s.mu is held and a handler has been installed.
+func (s *stdStreams) logEntry(entry *protos.LogEntry) error {
+	batch := &protos.LogEntryBatch{Entries: []*protos.LogEntry{entry}}
+	if err := s.h.LogBatch(s.ctx, batch); err != nil && !errors.Is(err, ErrHandlerPanicked) {
+		// Panics have already been logged by recoverHandler.
+		return err
+	}
+	return nil
+}
+
+// install installs the provided handler, delivering any buffered lines to
+// it first.
+func (s *stdStreams) install(ctx context.Context, h EnvelopeHandler) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx, s.h = ctx, h
+	if s.dropped > 0 {
+		s.logger.Warn("Dropped the oldest weavelet output written before serving", "lines", s.dropped)
+	}
+	for _, entry := range s.takeBuffered() {
+		if err := s.logEntry(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// abandon logs the lines captured from a weavelet that failed to start. It
+// waits for the capture of every stream to end, closing the streams of the
+// child if they are not closed within the provided timeout.
+func (s *stdStreams) abandon(child Child, clock Clock, timeout time.Duration) {
+	timer := clock.NewTimer(timeout)
+	defer timer.Stop()
+wait:
+	for i := 0; i < s.n; i++ {
+		select {
+		case <-s.done:
+		case <-timer.C():
+			if err := CloseWeaveletStdStreams(child); err != nil {
+				s.logger.Error("Failed to close weavelet stdout and stderr", "err", err)
+			}
+			break wait
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dropped > 0 {
+		s.logger.Warn("Dropped the oldest weavelet output", "lines", s.dropped)
+	}
+	for _, entry := range s.takeBuffered() {
+		s.logger.Error("Weavelet output", "stream", entry.Component, "line", entry.Msg)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// LogFilter selects the log entries delivered to a log subscriber.
+type LogFilter struct {
+	// If non-empty, only entries logged by one of these components are
+	// delivered.
+	Components []string
+
+	// If non-empty, only entries with one of these levels (e.g., "info",
+	// "error") are delivered. Levels are matched case-insensitively.
+	Levels []string
+}
+
+// matches returns whether the provided entry passes the filter.
+func (f LogFilter) matches(entry *protos.LogEntry) bool {
+	if len(f.Components) > 0 && !contains(f.Components, entry.Component, strings.EqualFold) {
+		return false
+	}
+	if len(f.Levels) > 0 && !contains(f.Levels, entry.Level, strings.EqualFold) {
+		return false
+	}
+	return true
+}
+
+func contains(xs []string, x string, eq func(a, b string) bool) bool {
+	for _, y := range xs {
+		if eq(x, y) {
+			return true
+		}
+	}
+	return false
+}
+
+// logSubscriber is a live subscriber to the log entries of a weavelet.
+type logSubscriber struct {
+	filter LogFilter
+	f      func(*protos.LogEntry)
+}
+
+// logSubscribers is the set of live log subscribers of an envelope.
+type logSubscribers struct {
+	mu   sync.Mutex
+	next int
+	subs map[int]*logSubscriber
+}
+
+// Subscribe registers f to be called on every log entry produced by the
+// weavelet, including the lines the weavelet writes to stdout and stderr, that
+// passes the provided filter. Entries are delivered to f in addition to, and
+// before, the EnvelopeHandler passed to [Serve]. f is called synchronously
+// and must not block or modify the entry. Call the returned function to
+// unsubscribe, possibly from f itself. f may still be called with entries
+// that were being delivered when the returned function was called.
+func (e *Envelope) Subscribe(filter LogFilter, f func(*protos.LogEntry)) (unsubscribe func()) {
+	e.logSubs.mu.Lock()
+	defer e.logSubs.mu.Unlock()
+	if e.logSubs.subs == nil {
+		e.logSubs.subs = map[int]*logSubscriber{}
+	}
+	id := e.logSubs.next
+	e.logSubs.next++
+	e.logSubs.subs[id] = &logSubscriber{filter: filter, f: f}
+	return func() {
+		e.logSubs.mu.Lock()
+		defer e.logSubs.mu.Unlock()
+		delete(e.logSubs.subs, id)
+	}
+}
+
+// publish delivers the provided log entries to all matching subscribers.
+// Subscribers are called without holding s.mu, so that they can unsubscribe.
+func (s *logSubscribers) publish(entries []*protos.LogEntry) {
+	s.mu.Lock()
+	subs := make([]*logSubscriber, 0, len(s.subs))
+	for _, sub := range s.subs {
+		subs = append(subs, sub)
+	}
+	s.mu.Unlock()
+	for _, sub := range subs {
+		for _, entry := range entries {
+			if sub.filter.matches(entry) {
+				sub.f(entry)
+			}
+		}
+	}
+}
+
+// teeHandler is an EnvelopeHandler that delivers log entries to the live log
+// subscribers of an envelope before forwarding them to the wrapped handler.
+type teeHandler struct {
+	EnvelopeHandler
+	subs *logSubscribers
+}
+
+// LogBatch implements the EnvelopeHandler interface.
+func (t teeHandler) LogBatch(ctx context.Context, batch *protos.LogEntryBatch) error {
+	t.subs.publish(batch.Entries)
+	return t.EnvelopeHandler.LogBatch(ctx, batch)
+}
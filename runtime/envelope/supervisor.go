@@ -0,0 +1,229 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/ServiceWeaver/weaver/runtime/retry"
+)
+
+// SupervisorEventType is the type of a SupervisorEvent.
+type SupervisorEventType int
+
+const (
+	// WeaveletStarted is emitted after a weavelet is started.
+	WeaveletStarted SupervisorEventType = iota
+
+	// WeaveletReady is emitted once a started weavelet reports itself as
+	// healthy.
+	WeaveletReady
+
+	// WeaveletExited is emitted when a weavelet exits or fails to start. The
+	// weavelet is restarted, unless the supervisor is stopped.
+	WeaveletExited
+
+	// SupervisorStopped is emitted when the supervisor stops.
+	SupervisorStopped
+)
+
+// String implements the fmt.Stringer interface.
+func (t SupervisorEventType) String() string {
+	switch t {
+	case WeaveletStarted:
+		return "WeaveletStarted"
+	case WeaveletReady:
+		return "WeaveletReady"
+	case WeaveletExited:
+		return "WeaveletExited"
+	case SupervisorStopped:
+		return "SupervisorStopped"
+	default:
+		return fmt.Sprintf("SupervisorEventType(%d)", int(t))
+	}
+}
+
+// SupervisorEvent is a lifecycle event of a weavelet managed by a Supervisor.
+type SupervisorEvent struct {
+	Type     SupervisorEventType
+	Envelope *Envelope // the envelope of the weavelet, if any
+	Err      error     // for WeaveletExited, the reason the weavelet exited
+}
+
+// SupervisorOptions configures a Supervisor.
+type SupervisorOptions struct {
+	// Options used to create the envelope of every weavelet. Options.Child is
+	// ignored in favor of NewChild.
+	Options Options
+
+	// NewChild returns the Child used to run a new weavelet. If nil, every
+	// weavelet runs in a new subprocess.
+	NewChild func() Child
+
+	// OnStart, if non-nil, is called with the envelope of every started
+	// weavelet, before the weavelet is reported as ready. It is typically
+	// used to push the weavelet's components and routing info. If OnStart
+	// returns an error, the weavelet is restarted.
+	OnStart func(*Envelope) error
+
+	// OnEvent, if non-nil, is called on every lifecycle event. It is called
+	// synchronously and should not block.
+	OnEvent func(SupervisorEvent)
+
+	// Backoff between restarts. If zero, retry.DefaultOptions is used. The
+	// backoff is reset every time a weavelet becomes ready.
+	Backoff retry.Options
+
+	// NewBackoff, if not nil, returns the backoff between restarts, overriding
+	// Backoff. A new backoff is used every time Run is called.
+	NewBackoff func() retry.Backoff
+}
+
+// Supervisor manages the lifecycle of a weavelet. It starts the weavelet,
+// serves its requests using an EnvelopeHandler, waits for it to become
+// healthy, and restarts it with exponential backoff when it exits, until the
+// supervisor is stopped.
+//
+//	s := envelope.NewSupervisor(wlet, config, handler, envelope.SupervisorOptions{})
+//	go s.Run(ctx)
+//	...
+//	if e := s.Envelope(); e != nil {
+//	    e.UpdateRoutingInfo(routing)
+//	}
+type Supervisor struct {
+	wlet    *protos.WeaveletArgs
+	config  *protos.AppConfig
+	handler EnvelopeHandler
+	opts    SupervisorOptions
+
+	mu      sync.Mutex
+	current *Envelope // envelope of the running weavelet, or nil
+}
+
+// NewSupervisor returns a new supervisor for the weavelet with the provided
+// arguments and config. Weavelet requests are handled by h. Call Run to
+// start the weavelet.
+func NewSupervisor(wlet *protos.WeaveletArgs, config *protos.AppConfig, h EnvelopeHandler, opts SupervisorOptions) *Supervisor {
+	if opts.Backoff == (retry.Options{}) {
+		opts.Backoff = retry.DefaultOptions
+	}
+	return &Supervisor{wlet: wlet, config: config, handler: h, opts: opts}
+}
+
+// Envelope returns the envelope of the running weavelet, or nil if no
+// weavelet is running.
+func (s *Supervisor) Envelope() *Envelope {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.current
+}
+
+// Run runs the weavelet, restarting it whenever it exits, until ctx is
+// cancelled. Cancelling ctx shuts down the running weavelet. Run returns
+// ctx.Err() once the weavelet has shut down.
+func (s *Supervisor) Run(ctx context.Context) error {
+	backoff := retry.Exponential(s.opts.Backoff)
+	if s.opts.NewBackoff != nil {
+		backoff = s.opts.NewBackoff()
+	}
+	for r := retry.BeginWithBackoff(backoff); r.Continue(ctx); {
+		ready, err := s.runOnce(ctx)
+		if ctx.Err() != nil {
+			break
+		}
+		if err == nil {
+			err = fmt.Errorf("weavelet exited")
+		}
+		s.emit(SupervisorEvent{Type: WeaveletExited, Err: err})
+		if ready {
+			r.Reset()
+		}
+	}
+	s.emit(SupervisorEvent{Type: SupervisorStopped})
+	return ctx.Err()
+}
+
+// runOnce starts a weavelet and waits for it to exit. It returns whether the
+// weavelet became ready, and the reason the weavelet exited.
+func (s *Supervisor) runOnce(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	opts := s.opts.Options
+	opts.Child = nil
+	if s.opts.NewChild != nil {
+		opts.Child = s.opts.NewChild()
+	}
+	e, err := NewEnvelope(ctx, s.wlet, s.config, opts)
+	if err != nil {
+		return false, err
+	}
+
+	// Note that Serve is responsible for cleaning up the envelope, so we
+	// start serving before doing anything else.
+	served := make(chan error, 1)
+	go func() { served <- e.Serve(s.handler) }()
+	s.setCurrent(e)
+	defer s.setCurrent(nil)
+	s.emit(SupervisorEvent{Type: WeaveletStarted, Envelope: e})
+
+	ready, err := s.awaitReady(ctx, e, served)
+	if err != nil {
+		cancel()
+		<-served
+		return false, err
+	}
+	if ready {
+		s.emit(SupervisorEvent{Type: WeaveletReady, Envelope: e})
+	}
+	return ready, <-served
+}
+
+// awaitReady calls OnStart and waits for the weavelet to become healthy. It
+// returns false if the envelope stops serving or ctx is cancelled first.
+func (s *Supervisor) awaitReady(ctx context.Context, e *Envelope, served chan error) (bool, error) {
+	if s.opts.OnStart != nil {
+		if err := s.opts.OnStart(e); err != nil {
+			return false, fmt.Errorf("OnStart: %w", err)
+		}
+	}
+	for r := retry.Begin(); r.Continue(ctx); {
+		select {
+		case err := <-served:
+			served <- err // let the caller observe err as well
+			return false, nil
+		default:
+		}
+		if e.GetHealth().Status == protos.HealthStatus_HEALTHY {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *Supervisor) setCurrent(e *Envelope) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.current = e
+}
+
+func (s *Supervisor) emit(event SupervisorEvent) {
+	if s.opts.OnEvent != nil {
+		s.opts.OnEvent(event)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/ServiceWeaver/weaver/internal/cond"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// asyncTraceHandler is an EnvelopeHandler that hands trace spans to the
+// wrapped handler on a separate goroutine, through a bounded queue, so that a
+// slow HandleTraceSpans doesn't delay the handling of other messages. Spans
+// that don't fit in the queue are dropped.
+type asyncTraceHandler struct {
+	EnvelopeHandler
+	logger *slog.Logger
+	queue  chan *protos.TraceSpans
+
+	mu      sync.Mutex
+	queued  uint64     // number of batches added to queue
+	handled uint64     // number of batches removed from queue and handled
+	done    *cond.Cond // signalled when handled increases
+}
+
+func newAsyncTraceHandler(h EnvelopeHandler, logger *slog.Logger, size int) *asyncTraceHandler {
+	a := &asyncTraceHandler{
+		EnvelopeHandler: h,
+		logger:          logger,
+		queue:           make(chan *protos.TraceSpans, size),
+	}
+	a.done = cond.NewCond(&a.mu)
+	return a
+}
+
+// HandleTraceSpans implements the EnvelopeHandler interface.
+func (a *asyncTraceHandler) HandleTraceSpans(_ context.Context, spans *protos.TraceSpans) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	select {
+	case a.queue <- spans:
+		a.queued++
+	default:
+		a.logger.Warn("Dropping trace spans: trace queue is full", "spans", len(spans.Span))
+	}
+	return nil
+}
+
+// run delivers queued trace spans to the wrapped handler until ctx is done.
+func (a *asyncTraceHandler) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case spans := <-a.queue:
+			if err := a.EnvelopeHandler.HandleTraceSpans(ctx, spans); err != nil {
+				a.logger.Error("Failed to handle trace spans", "err", err)
+			}
+			a.mu.Lock()
+			a.handled++
+			a.mu.Unlock()
+			a.done.Broadcast()
+		}
+	}
+}
+
+// flush blocks until all trace spans queued before the call to flush have
+// been handled, or until ctx is done.
+func (a *asyncTraceHandler) flush(ctx context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	watermark := a.queued
+	for a.handled < watermark {
+		if err := a.done.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
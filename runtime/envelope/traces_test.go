@@ -0,0 +1,136 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// spanRecorder is an EnvelopeHandler that records the names of the spans
+// passed to HandleTraceSpans. If block is not nil, HandleTraceSpans sends on
+// started and then blocks until block is closed.
+type spanRecorder struct {
+	EnvelopeHandler
+	started chan struct{}
+	block   chan struct{}
+	err     error // returned by HandleTraceSpans
+
+	mu    sync.Mutex
+	names []string
+}
+
+// HandleTraceSpans implements the EnvelopeHandler interface.
+func (r *spanRecorder) HandleTraceSpans(_ context.Context, spans *protos.TraceSpans) error {
+	if r.block != nil {
+		r.started <- struct{}{}
+		<-r.block
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, span := range spans.Span {
+		r.names = append(r.names, span.Name)
+	}
+	return r.err
+}
+
+// recorded returns the names of the spans recorded so far.
+func (r *spanRecorder) recorded() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.names)
+}
+
+// batch returns a batch with a single span with the provided name.
+func batch(name string) *protos.TraceSpans {
+	return &protos.TraceSpans{Span: []*protos.Span{{Name: name}}}
+}
+
+func TestAsyncTraceHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	recorder := &spanRecorder{err: fmt.Errorf("simulated failure")}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	a := newAsyncTraceHandler(recorder, logger, 10)
+	go a.run(ctx)
+
+	// Batches are delivered in order, and errors don't stop delivery.
+	var want []string
+	for i := 0; i < 5; i++ {
+		name := fmt.Sprint(i)
+		if err := a.HandleTraceSpans(ctx, batch(name)); err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, name)
+	}
+	if err := a.flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := recorder.recorded(); !slices.Equal(got, want) {
+		t.Fatalf("delivered spans: got %v, want %v", got, want)
+	}
+}
+
+func TestAsyncTraceHandlerOverflow(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	recorder := &spanRecorder{started: make(chan struct{}), block: make(chan struct{})}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	a := newAsyncTraceHandler(recorder, logger, 2)
+	go a.run(ctx)
+
+	// Block the handler on the first batch, and fill the queue behind it.
+	if err := a.HandleTraceSpans(ctx, batch("handled")); err != nil {
+		t.Fatal(err)
+	}
+	<-recorder.started
+	for _, name := range []string{"queued1", "queued2", "dropped"} {
+		// Overflowing batches are dropped without failing.
+		if err := a.HandleTraceSpans(ctx, batch(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// flush waits for the queued batches.
+	short, cancelShort := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancelShort()
+	if err := a.flush(short); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("flush: got %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// Unblock the handler.
+	go func() {
+		for range recorder.started {
+		}
+	}()
+	close(recorder.block)
+	if err := a.flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	close(recorder.started)
+	want := []string{"handled", "queued1", "queued2"}
+	if got := recorder.recorded(); !slices.Equal(got, want) {
+		t.Fatalf("delivered spans: got %v, want %v", got, want)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"math"
 	"os"
 	"runtime"
 	"sync"
@@ -59,6 +60,14 @@ type Options struct {
 type LogHandler struct {
 	Opts  Options                      // configures the log entries
 	Write func(entry *protos.LogEntry) // called on every log entry
+
+	// If non-nil, the minimum level of the log entries written by the
+	// handler. Otherwise, all levels are written.
+	Level slog.Leveler
+
+	// Typed versions of the attributes added through WithAttrs. These are
+	// always the trailing attributes in Opts.Attrs.
+	typed []*protos.LogAttr
 }
 
 var _ slog.Handler = &LogHandler{}
@@ -70,9 +79,12 @@ func (h *LogHandler) Handle(ctx context.Context, rec slog.Record) error {
 }
 
 // Enabled implements the slog.Handler interface.
-func (h *LogHandler) Enabled(context.Context, slog.Level) bool {
-	// Support all logging levels.
-	return true
+func (h *LogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	if h.Level == nil {
+		// Support all logging levels.
+		return true
+	}
+	return level >= h.Level.Level()
 }
 
 // WithAttrs implements the slog.Handler interface.
@@ -85,8 +97,10 @@ func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
 	rh := &LogHandler{
 		Opts:  h.Opts,
 		Write: h.Write,
+		Level: h.Level,
 	}
 	rh.Opts.Attrs = appendAttrs(rh.Opts.Attrs, attrs)
+	rh.typed = appendTypedAttrs(h.typed, attrs)
 	return rh
 }
 
@@ -120,6 +134,7 @@ func (h *LogHandler) makeEntry(rec slog.Record) *protos.LogEntry {
 		Line:       -1,
 		Msg:        rec.Message,
 		Attrs:      appendAttrs(h.Opts.Attrs, attrs),
+		TypedAttrs: h.typedAttrs(attrs),
 	}
 
 	// Get the file and line information.
@@ -132,6 +147,60 @@ func (h *LogHandler) makeEntry(rec slog.Record) *protos.LogEntry {
 	return &entry
 }
 
+// typedAttrs returns the typed versions of the attributes in h.Opts.Attrs,
+// followed by the typed versions of attrs.
+func (h *LogHandler) typedAttrs(attrs []slog.Attr) []*protos.LogAttr {
+	// The leading attributes in h.Opts.Attrs were provided as strings. The
+	// trailing ones were added through WithAttrs and have typed versions in
+	// h.typed.
+	n := len(h.Opts.Attrs)/2 - len(h.typed)
+	if n < 0 {
+		n = 0
+	}
+	if n == 0 && len(h.typed) == 0 && len(attrs) == 0 {
+		return nil
+	}
+	dst := make([]*protos.LogAttr, 0, n+len(h.typed)+len(attrs))
+	for i := 0; i < n; i++ {
+		dst = append(dst, &protos.LogAttr{
+			Key:   h.Opts.Attrs[2*i],
+			Value: &protos.LogAttr_Str{Str: h.Opts.Attrs[2*i+1]},
+		})
+	}
+	dst = append(dst, h.typed...)
+	return appendTypedAttrs(dst, attrs)
+}
+
+// EntryAttrs returns the attributes of the provided log entry as slog
+// attributes, in the order they were logged. Attribute types are preserved if
+// the entry carries typed attributes; otherwise, all values are strings.
+func EntryAttrs(entry *protos.LogEntry) []slog.Attr {
+	if len(entry.TypedAttrs) == 0 {
+		attrs := make([]slog.Attr, 0, len(entry.Attrs)/2)
+		for i := 0; i+1 < len(entry.Attrs); i += 2 {
+			attrs = append(attrs, slog.String(entry.Attrs[i], entry.Attrs[i+1]))
+		}
+		return attrs
+	}
+
+	attrs := make([]slog.Attr, 0, len(entry.TypedAttrs))
+	for _, a := range entry.TypedAttrs {
+		switch v := a.Value.(type) {
+		case *protos.LogAttr_Int:
+			attrs = append(attrs, slog.Int64(a.Key, v.Int))
+		case *protos.LogAttr_Float:
+			attrs = append(attrs, slog.Float64(a.Key, v.Float))
+		case *protos.LogAttr_Bool:
+			attrs = append(attrs, slog.Bool(a.Key, v.Bool))
+		case *protos.LogAttr_TimeMicros:
+			attrs = append(attrs, slog.Time(a.Key, time.UnixMicro(v.TimeMicros)))
+		default:
+			attrs = append(attrs, slog.String(a.Key, a.GetStr()))
+		}
+	}
+	return attrs
+}
+
 // StderrLogger returns a logger that pretty prints log entries to stderr.
 func StderrLogger(opts Options) *slog.Logger {
 	pp := NewPrettyPrinter(colors.Enabled())
@@ -228,3 +297,45 @@ func appendAttrs(prefix []string, attrs []slog.Attr) []string {
 	}
 	return dst
 }
+
+// appendTypedAttrs appends the typed versions of attrs to prefix and returns
+// the resulting slice. It never appends in place.
+func appendTypedAttrs(prefix []*protos.LogAttr, attrs []slog.Attr) []*protos.LogAttr {
+	if len(attrs) == 0 {
+		return prefix
+	}
+
+	// NOTE: Copy prefix for the same reason as in appendAttrs.
+	dst := make([]*protos.LogAttr, 0, len(prefix)+len(attrs))
+	dst = append(dst, prefix...)
+	for _, attr := range attrs {
+		dst = append(dst, typedAttr(attr))
+	}
+	return dst
+}
+
+// typedAttr returns the typed version of the provided attribute. Values of
+// kinds that don't have a typed representation are stored as strings.
+func typedAttr(attr slog.Attr) *protos.LogAttr {
+	a := &protos.LogAttr{Key: attr.Key}
+	v := attr.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindInt64:
+		a.Value = &protos.LogAttr_Int{Int: v.Int64()}
+	case slog.KindUint64:
+		if u := v.Uint64(); u <= math.MaxInt64 {
+			a.Value = &protos.LogAttr_Int{Int: int64(u)}
+		} else {
+			a.Value = &protos.LogAttr_Str{Str: v.String()}
+		}
+	case slog.KindFloat64:
+		a.Value = &protos.LogAttr_Float{Float: v.Float64()}
+	case slog.KindBool:
+		a.Value = &protos.LogAttr_Bool{Bool: v.Bool()}
+	case slog.KindTime:
+		a.Value = &protos.LogAttr_TimeMicros{TimeMicros: v.Time().UnixMicro()}
+	default:
+		a.Value = &protos.LogAttr_Str{Str: v.String()}
+	}
+	return a
+}
@@ -73,6 +73,68 @@ func TestWithAttributes(t *testing.T) {
 	}
 }
 
+func TestLevel(t *testing.T) {
+	var got []string
+	var level slog.LevelVar
+	level.Set(slog.LevelInfo)
+	logger := slog.New(&LogHandler{
+		Write: func(e *protos.LogEntry) { got = append(got, e.Msg) },
+		Level: &level,
+	}).With("foo", "bar")
+	logger.Debug("debug")
+	logger.Info("info")
+	level.Set(slog.LevelDebug)
+	logger.Debug("debug")
+	want := []string{"info", "debug"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected messages (-want +got):\n%s", diff)
+	}
+}
+
+func TestTypedAttributes(t *testing.T) {
+	var got *protos.LogEntry
+	logSaver := func(e *protos.LogEntry) {
+		got = e
+	}
+	now := time.UnixMicro(time.Now().UnixMicro())
+	logger := slog.New(&LogHandler{
+		Opts:  Options{Attrs: []string{"pre", "assigned"}},
+		Write: logSaver,
+	}).With("with", 42)
+	logger.Info("", "str", "s", "int", -1, "uint", uint(2), "float", 1.5, "bool", true, "time", now)
+
+	want := []slog.Attr{
+		slog.String("pre", "assigned"),
+		slog.Int64("with", 42),
+		slog.String("str", "s"),
+		slog.Int64("int", -1),
+		slog.Int64("uint", 2),
+		slog.Float64("float", 1.5),
+		slog.Bool("bool", true),
+		slog.Time("time", now),
+	}
+	equal := func(x, y slog.Attr) bool { return x.Equal(y) }
+	if diff := cmp.Diff(want, EntryAttrs(got), cmp.Comparer(equal)); diff != "" {
+		t.Fatalf("unexpected attributes (-want +got):\n%s", diff)
+	}
+
+	// Entries without typed attributes fall back to string attributes.
+	got.TypedAttrs = nil
+	want = []slog.Attr{
+		slog.String("pre", "assigned"),
+		slog.String("with", "42"),
+		slog.String("str", "s"),
+		slog.String("int", "-1"),
+		slog.String("uint", "2"),
+		slog.String("float", "1.5"),
+		slog.String("bool", "true"),
+		slog.String("time", now.String()),
+	}
+	if diff := cmp.Diff(want, EntryAttrs(got), cmp.Comparer(equal)); diff != "" {
+		t.Fatalf("unexpected attributes (-want +got):\n%s", diff)
+	}
+}
+
 func TestConcurrentAttributes(t *testing.T) {
 	// Test plan: start a number of goroutines that emit attributes with sequential
 	// values. Confirm that attributes are saved in the same sequential order
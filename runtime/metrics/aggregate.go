@@ -0,0 +1,122 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/google/uuid"
+	"golang.org/x/exp/maps"
+)
+
+// Aggregate combines the metric snapshots that have the same name and the
+// same labels after dropping the labels in agg.DropLabels, returning one
+// snapshot per group, in the order in which the groups first appear. Counter
+// and gauge values are combined using agg.Function, while histogram counts
+// and sums are always added. Every returned snapshot is assigned a new id.
+//
+// Aggregate doesn't modify the provided snapshots.
+func Aggregate(snapshots []*MetricSnapshot, agg *protos.MetricAggregation) ([]*MetricSnapshot, error) {
+	var combine func(x, y float64) float64
+	switch agg.GetFunction() {
+	case protos.AggregationFunction_AGGREGATE_SUM:
+		combine = func(x, y float64) float64 { return x + y }
+	case protos.AggregationFunction_AGGREGATE_MAX:
+		combine = math.Max
+	case protos.AggregationFunction_AGGREGATE_MIN:
+		combine = math.Min
+	default:
+		return nil, fmt.Errorf("metrics.Aggregate: unknown aggregation function %v", agg.GetFunction())
+	}
+
+	drop := map[string]bool{}
+	for _, label := range agg.GetDropLabels() {
+		drop[label] = true
+	}
+
+	var aggregated []*MetricSnapshot
+	groups := map[string]*MetricSnapshot{}
+	for _, s := range snapshots {
+		labels := map[string]string{}
+		for k, v := range s.Labels {
+			if !drop[k] {
+				labels[k] = v
+			}
+		}
+		key := groupKey(s.Name, labels)
+		g, ok := groups[key]
+		if !ok {
+			g = s.Clone()
+			var id [16]byte = uuid.New()
+			g.Id = binary.LittleEndian.Uint64(id[:8])
+			g.Labels = labels
+			groups[key] = g
+			aggregated = append(aggregated, g)
+			continue
+		}
+
+		if g.Type != s.Type {
+			return nil, fmt.Errorf("metrics.Aggregate: metric %q has types %v and %v", s.Name, g.Type, s.Type)
+		}
+		if g.Type != protos.MetricType_HISTOGRAM {
+			g.Value = combine(g.Value, s.Value)
+			continue
+		}
+		if !slices.Equal(g.Bounds, s.Bounds) || len(g.Counts) != len(s.Counts) {
+			return nil, fmt.Errorf("metrics.Aggregate: histogram %q has different bounds %v and %v", s.Name, g.Bounds, s.Bounds)
+		}
+		g.Value += s.Value
+		for i, count := range s.Counts {
+			g.Counts[i] += count
+		}
+		g.Exemplars = mergeExemplars(g.Exemplars, s.Exemplars)
+	}
+	return aggregated, nil
+}
+
+// mergeExemplars returns the latest of the provided exemplars of each bucket,
+// in bucket order.
+func mergeExemplars(x, y []Exemplar) []Exemplar {
+	if len(y) == 0 {
+		return x
+	}
+	latest := map[int]Exemplar{}
+	for _, e := range slices.Concat(x, y) {
+		if l, ok := latest[e.Bucket]; !ok || e.Time.After(l.Time) {
+			latest[e.Bucket] = e
+		}
+	}
+	merged := maps.Values(latest)
+	slices.SortFunc(merged, func(a, b Exemplar) int { return a.Bucket - b.Bucket })
+	return merged
+}
+
+// groupKey returns a key that uniquely identifies the provided metric name
+// and labels.
+func groupKey(name string, labels map[string]string) string {
+	var b strings.Builder
+	b.WriteString(name)
+	keys := maps.Keys(labels)
+	slices.Sort(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&b, "\x00%s\x00%s", k, labels[k])
+	}
+	return b.String()
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestAggregate(t *testing.T) {
+	snapshots := []*MetricSnapshot{
+		{Id: 1, Type: counterType, Name: "counter", Labels: map[string]string{"a": "1", "b": "x"}, Value: 1},
+		{Id: 2, Type: counterType, Name: "counter", Labels: map[string]string{"a": "2", "b": "x"}, Value: 2},
+		{Id: 3, Type: counterType, Name: "counter", Labels: map[string]string{"a": "3", "b": "y"}, Value: 4},
+		{Id: 4, Type: histogramType, Name: "histogram", Labels: map[string]string{"a": "1"}, Value: 3, Bounds: []float64{1, 2}, Counts: []uint64{1, 0, 1}},
+		{Id: 5, Type: histogramType, Name: "histogram", Labels: map[string]string{"a": "2"}, Value: 5, Bounds: []float64{1, 2}, Counts: []uint64{0, 2, 1}},
+	}
+	agg := &protos.MetricAggregation{
+		DropLabels: []string{"a"},
+		Function:   protos.AggregationFunction_AGGREGATE_MAX,
+	}
+	got, err := Aggregate(snapshots, agg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*MetricSnapshot{
+		{Type: counterType, Name: "counter", Labels: map[string]string{"b": "x"}, Value: 2},
+		{Type: counterType, Name: "counter", Labels: map[string]string{"b": "y"}, Value: 4},
+		{Type: histogramType, Name: "histogram", Labels: map[string]string{}, Value: 8, Bounds: []float64{1, 2}, Counts: []uint64{1, 2, 2}},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(MetricSnapshot{}, "Id")); diff != "" {
+		t.Fatalf("Aggregate (-want +got):\n%s", diff)
+	}
+
+	// The provided snapshots are not modified.
+	if got, want := snapshots[3].Counts, []uint64{1, 0, 1}; !cmp.Equal(got, want) {
+		t.Fatalf("snapshot counts: got %v, want %v", got, want)
+	}
+}
+
+func TestAggregateExemplars(t *testing.T) {
+	t1, t2 := time.UnixMicro(1), time.UnixMicro(2)
+	snapshots := []*MetricSnapshot{
+		{Id: 1, Type: histogramType, Name: "histogram", Labels: map[string]string{"a": "1"}, Bounds: []float64{1}, Counts: []uint64{1, 1},
+			Exemplars: []Exemplar{{Bucket: 0, Value: 0.5, Time: t2}, {Bucket: 1, Value: 5, Time: t1}}},
+		{Id: 2, Type: histogramType, Name: "histogram", Labels: map[string]string{"a": "2"}, Bounds: []float64{1}, Counts: []uint64{1, 1},
+			Exemplars: []Exemplar{{Bucket: 0, Value: 0.2, Time: t1}, {Bucket: 1, Value: 2, Time: t2}}},
+	}
+	got, err := Aggregate(snapshots, &protos.MetricAggregation{DropLabels: []string{"a"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The latest exemplar of every bucket is kept.
+	want := []Exemplar{{Bucket: 0, Value: 0.5, Time: t2}, {Bucket: 1, Value: 2, Time: t2}}
+	if diff := cmp.Diff(want, got[0].Exemplars); diff != "" {
+		t.Fatalf("Aggregate (-want +got):\n%s", diff)
+	}
+}
+
+func TestAggregateMismatchedBounds(t *testing.T) {
+	snapshots := []*MetricSnapshot{
+		{Id: 1, Type: histogramType, Name: "histogram", Labels: map[string]string{"a": "1"}, Bounds: []float64{1}, Counts: []uint64{0, 0}},
+		{Id: 2, Type: histogramType, Name: "histogram", Labels: map[string]string{"a": "2"}, Bounds: []float64{2}, Counts: []uint64{0, 0}},
+	}
+	agg := &protos.MetricAggregation{DropLabels: []string{"a"}}
+	if _, err := Aggregate(snapshots, agg); err == nil {
+		t.Fatal("Aggregate: unexpected success")
+	}
+}
@@ -17,6 +17,7 @@ package metrics
 import (
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/ServiceWeaver/weaver/runtime/protos"
 	"golang.org/x/exp/maps"
@@ -33,6 +34,21 @@ type Exporter struct {
 // Export produces a MetricUpdate that summarizes the changes to all metrics
 // since the last call to MetricUpdate.
 func (e *Exporter) Export() *protos.MetricUpdate {
+	return e.ExportPrefixes(nil)
+}
+
+// Reset forgets all previously exported metrics, so that the next export
+// includes the definitions and values of all considered metrics, as if the
+// Exporter was new.
+func (e *Exporter) Reset() {
+	e.last = nil
+}
+
+// ExportPrefixes is like Export, but it only considers metrics whose names
+// start with one of the provided prefixes. If prefixes is empty, all metrics
+// are considered. Changes to metrics that are not considered are reported by
+// later exports that do consider them.
+func (e *Exporter) ExportPrefixes(prefixes []string) *protos.MetricUpdate {
 	if e.last == nil {
 		e.last = map[uint64]uint64{}
 	}
@@ -40,8 +56,13 @@ func (e *Exporter) Export() *protos.MetricUpdate {
 	metricsMu.RLock()
 	defer metricsMu.RUnlock()
 
-	update := &protos.MetricUpdate{}
+	// The update defines all metrics if none were exported before and all
+	// are considered.
+	update := &protos.MetricUpdate{Full: len(e.last) == 0 && len(prefixes) == 0}
 	for _, metric := range metrics {
+		if !HasPrefix(metric.name, prefixes) {
+			continue
+		}
 		metric.initIdAndLabels()
 
 		// Send metric definition first time we are exporting metric.
@@ -77,15 +98,55 @@ type Importer struct {
 }
 
 // Import updates the Importer's snapshot with the latest metric changes.
+// Updates are applied atomically: if Import returns an error (e.g., because
+// the update is inconsistent with the snapshot), the snapshot is unchanged.
+//
+// The set of metrics may change between updates, e.g., when the weavelet that
+// exports them restarts with different components. A newly defined metric
+// with the name and labels of a known metric replaces it. The known metrics
+// that a full update (see MetricUpdate.full) doesn't define are kept, with
+// their last values, but marked as stale (see MetricSnapshot.Stale).
 func (i *Importer) Import(update *protos.MetricUpdate) ([]*MetricSnapshot, error) {
 	if i.metrics == nil {
 		i.metrics = map[uint64]*MetricSnapshot{}
 	}
 
+	// Validate the update before applying any of it.
+	defs := map[uint64]bool{}
 	for _, def := range update.Defs {
-		if _, ok := i.metrics[def.Id]; ok {
+		if _, ok := i.metrics[def.Id]; ok || defs[def.Id] {
 			return nil, fmt.Errorf("metrics.Importer: duplicate MetricDef %d", def.Id)
 		}
+		defs[def.Id] = true
+	}
+	for _, val := range update.Values {
+		if _, ok := i.metrics[val.Id]; !ok && !defs[val.Id] {
+			return nil, fmt.Errorf("metrics.Importer: unknown metric %d", val.Id)
+		}
+	}
+
+	// Replace the known metrics that are redefined under new ids.
+	if len(update.Defs) > 0 {
+		known := map[string]uint64{}
+		for id, m := range i.metrics {
+			known[groupKey(m.Name, m.Labels)] = id
+		}
+		for _, def := range update.Defs {
+			if id, ok := known[groupKey(def.Name, def.Labels)]; ok {
+				delete(i.metrics, id)
+			}
+		}
+	}
+
+	// The remaining known metrics are not defined by a full update, so they
+	// are stale.
+	if update.Full {
+		for _, m := range i.metrics {
+			m.Stale = true
+		}
+	}
+
+	for _, def := range update.Defs {
 		i.metrics[def.Id] = &MetricSnapshot{
 			Id:     def.Id,
 			Name:   def.Name,
@@ -97,13 +158,43 @@ func (i *Importer) Import(update *protos.MetricUpdate) ([]*MetricSnapshot, error
 	}
 
 	for _, val := range update.Values {
-		metric, ok := i.metrics[val.Id]
-		if !ok {
-			return nil, fmt.Errorf("metrics.Importer: unknown metric %d", val.Id)
-		}
+		metric := i.metrics[val.Id]
 		metric.Value = val.Value
 		metric.Counts = val.Counts
+		metric.Exemplars = exemplarsFromProto(val.Exemplars)
 	}
 
 	return maps.Values(i.metrics), nil
 }
+
+// Snapshot returns a copy of the Importer's snapshot, without importing an
+// update.
+func (i *Importer) Snapshot() []*MetricSnapshot {
+	snapshots := make([]*MetricSnapshot, 0, len(i.metrics))
+	for _, m := range i.metrics {
+		snapshots = append(snapshots, m.Clone())
+	}
+	return snapshots
+}
+
+// Forget discards the Importer's snapshot, as if the Importer was new. The
+// next update imported must be produced by a new or reset Exporter (see
+// Exporter.Reset), since updates only contain the changes relative to the
+// previous update.
+func (i *Importer) Forget() {
+	i.metrics = nil
+}
+
+// HasPrefix returns whether the provided metric name starts with one of the
+// provided prefixes. It returns true if prefixes is empty.
+func HasPrefix(name string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
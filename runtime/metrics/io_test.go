@@ -50,6 +50,210 @@ func TestIncExport(t *testing.T) {
 	}
 }
 
+func TestResetAndForget(t *testing.T) {
+	clear()
+
+	var exporter Exporter
+	var importer Importer
+	foo := Register(counterType, "TestResetAndForget/foo", "", nil)
+	foo.Inc()
+	if _, err := importer.Import(exporter.Export()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nothing changed, so a delta export is empty.
+	if update := exporter.Export(); len(update.Defs) != 0 || len(update.Values) != 0 {
+		t.Fatalf("unexpected non-empty update %v", update)
+	}
+
+	// After a reset, the export is a full snapshot, which can be imported
+	// after the importer forgets its state.
+	exporter.Reset()
+	update := exporter.Export()
+	if got, want := len(update.Defs), 1; got != want {
+		t.Fatalf("got %d defs, want %d", got, want)
+	}
+	if _, err := importer.Import(update); err == nil {
+		t.Fatal("unexpected success importing duplicate definitions")
+	}
+	importer.Forget()
+	snapshots, err := importer.Import(update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 1 || snapshots[0].Value != 1 {
+		t.Fatalf("unexpected snapshots %v", snapshots)
+	}
+}
+
+func TestImportChangedMetrics(t *testing.T) {
+	// Export foo and bar, as a weavelet would.
+	clear()
+	var importer Importer
+	foo := Register(gaugeType, "TestImportChangedMetrics/foo", "", nil)
+	bar := Register(gaugeType, "TestImportChangedMetrics/bar", "", nil)
+	foo.Set(1)
+	bar.Set(2)
+	if _, err := importer.Import((&Exporter{}).Export()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Export foo and baz, as the weavelet would after restarting with a
+	// different set of metrics. The metrics have new ids.
+	clear()
+	foo = Register(gaugeType, "TestImportChangedMetrics/foo", "", nil)
+	baz := Register(gaugeType, "TestImportChangedMetrics/baz", "", nil)
+	foo.Set(10)
+	baz.Set(30)
+	var exporter Exporter
+	snapshots, err := importer.Import(exporter.Export())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type metric struct {
+		Name  string
+		Value float64
+		Stale bool
+	}
+	var got []metric
+	for _, s := range snapshots {
+		got = append(got, metric{s.Name, s.Value, s.Stale})
+	}
+	want := []metric{
+		{"TestImportChangedMetrics/bar", 2, true},
+		{"TestImportChangedMetrics/baz", 30, false},
+		{"TestImportChangedMetrics/foo", 10, false},
+	}
+	less := func(x, y metric) bool { return x.Name < y.Name }
+	if diff := cmp.Diff(want, got, cmpopts.SortSlices(less)); diff != "" {
+		t.Fatalf("Import (-want +got):\n%s", diff)
+	}
+
+	// A delta update doesn't mark any more metrics as stale.
+	baz.Set(31)
+	snapshots, err = importer.Import(exporter.Export())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range snapshots {
+		if got, want := s.Stale, s.Name == "TestImportChangedMetrics/bar"; got != want {
+			t.Errorf("%s: got stale %t, want %t", s.Name, got, want)
+		}
+	}
+}
+
+func TestImportIsAtomic(t *testing.T) {
+	var importer Importer
+	if _, err := importer.Import(&protos.MetricUpdate{
+		Defs:   []*protos.MetricDef{{Id: 1, Name: "foo", Typ: counterType}},
+		Values: []*protos.MetricValue{{Id: 1, Value: 1}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	want := importer.Snapshot()
+
+	// Every update below fails partway through, after changes that would be
+	// valid on their own.
+	for _, test := range []struct {
+		name   string
+		update *protos.MetricUpdate
+	}{
+		{
+			name: "DuplicateDef",
+			update: &protos.MetricUpdate{
+				Defs: []*protos.MetricDef{
+					{Id: 2, Name: "bar", Typ: counterType},
+					{Id: 1, Name: "foo", Typ: counterType},
+				},
+				Values: []*protos.MetricValue{{Id: 1, Value: 2}},
+			},
+		},
+		{
+			name: "DuplicateDefInUpdate",
+			update: &protos.MetricUpdate{
+				Defs: []*protos.MetricDef{
+					{Id: 2, Name: "bar", Typ: counterType},
+					{Id: 2, Name: "bar", Typ: counterType},
+				},
+			},
+		},
+		{
+			name: "UnknownValue",
+			update: &protos.MetricUpdate{
+				Defs:   []*protos.MetricDef{{Id: 2, Name: "bar", Typ: counterType}},
+				Values: []*protos.MetricValue{{Id: 1, Value: 2}, {Id: 2, Value: 3}, {Id: 3, Value: 4}},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := importer.Import(test.update); err == nil {
+				t.Fatal("Import: unexpected success")
+			}
+			if diff := cmp.Diff(want, importer.Snapshot()); diff != "" {
+				t.Fatalf("Import partially applied a failed update (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	// Since none of bar's definitions were applied, bar can still be defined.
+	snapshots, err := importer.Import(&protos.MetricUpdate{
+		Defs:   []*protos.MetricDef{{Id: 2, Name: "bar", Typ: counterType}},
+		Values: []*protos.MetricValue{{Id: 1, Value: 2}, {Id: 2, Value: 3}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(snapshots), 2; got != want {
+		t.Fatalf("Import: got %d snapshots, want %d", got, want)
+	}
+}
+
+func TestExportPrefixes(t *testing.T) {
+	clear()
+
+	var exporter Exporter
+	var importer Importer
+	names := func(update *protos.MetricUpdate) []string {
+		snapshots, err := importer.Import(update)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, s := range snapshots {
+			names = append(names, s.Name)
+		}
+		return names
+	}
+
+	foo := Register(counterType, "TestExportPrefixes/foo", "", nil)
+	bar := Register(counterType, "TestExportPrefixes/bar", "", nil)
+	foo.Inc()
+	bar.Inc()
+
+	// Only foo is exported.
+	update := exporter.ExportPrefixes([]string{"TestExportPrefixes/f"})
+	if got, want := len(update.Values), 1; got != want {
+		t.Fatalf("got %d values, want %d", got, want)
+	}
+	want := []string{"TestExportPrefixes/foo"}
+	if diff := cmp.Diff(want, names(update)); diff != "" {
+		t.Fatalf("unexpected metrics (-want +got):\n%s", diff)
+	}
+
+	// A full export includes bar, which was not previously exported, but not
+	// foo, which didn't change.
+	bar.Inc()
+	update = exporter.Export()
+	if got, want := len(update.Values), 1; got != want {
+		t.Fatalf("got %d values, want %d", got, want)
+	}
+	names(update)
+	if v := importer.metrics[bar.id].Value; v != 2 {
+		t.Fatalf("expecting counter %v, got %v", 2, v)
+	}
+}
+
 func TestExportImport(t *testing.T) {
 	clear()
 	var exporter Exporter
@@ -192,6 +396,53 @@ func TestExportImport(t *testing.T) {
 	}
 }
 
+func TestExportImportExemplars(t *testing.T) {
+	clear()
+	var exporter Exporter
+	var importer Importer
+	io := func() *MetricSnapshot {
+		t.Helper()
+		snapshots, err := importer.Import(exporter.Export())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(snapshots), 1; got != want {
+			t.Fatalf("Import: got %d snapshots, want %d", got, want)
+		}
+		return snapshots[0]
+	}
+
+	histogram := Register(histogramType, "TestExportImportExemplars/histogram", "", []float64{10, 100})
+	histogram.Put(1)
+	if got := io().Exemplars; len(got) != 0 {
+		t.Fatalf("Put: got exemplars %v, want none", got)
+	}
+
+	trace1, span1 := [16]byte{1}, [8]byte{1}
+	trace2, span2 := [16]byte{2}, [8]byte{2}
+	histogram.PutExemplar(5, trace1, span1)
+	histogram.PutExemplar(50, trace1, span1)
+	histogram.PutExemplar(500, trace2, span2)
+	histogram.PutExemplar(60, trace2, span2) // replaces the exemplar of 50
+	snapshot := io()
+	want := []Exemplar{
+		{Bucket: 0, Value: 5, TraceID: trace1, SpanID: span1},
+		{Bucket: 1, Value: 60, TraceID: trace2, SpanID: span2},
+		{Bucket: 2, Value: 500, TraceID: trace2, SpanID: span2},
+	}
+	if diff := cmp.Diff(want, snapshot.Exemplars, cmpopts.IgnoreFields(Exemplar{}, "Time")); diff != "" {
+		t.Fatalf("Import (-want +got):\n%s", diff)
+	}
+	if got, want := snapshot.Counts, []uint64{2, 2, 1}; !cmp.Equal(got, want) {
+		t.Fatalf("Import: got counts %v, want %v", got, want)
+	}
+
+	// Exemplars survive the conversion to and from protos.
+	if diff := cmp.Diff(snapshot, UnProto(snapshot.ToProto())); diff != "" {
+		t.Fatalf("UnProto(ToProto) (-want +got):\n%s", diff)
+	}
+}
+
 func TestConcurrentExportImport(t *testing.T) {
 	clear()
 	var exporter Exporter
@@ -23,6 +23,7 @@ import (
 	"sort"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/ServiceWeaver/weaver/runtime/protos"
 	"github.com/google/uuid"
@@ -72,9 +73,10 @@ type Metric struct {
 	ivalue atomic.Uint64 // integer increments for Counter (separated for speed)
 
 	// For histograms only:
-	putCount atomic.Uint64   // incremented on every Put, for change detection
-	bounds   []float64       // histogram bounds
-	counts   []atomic.Uint64 // histogram counts
+	putCount  atomic.Uint64              // incremented on every Put, for change detection
+	bounds    []float64                  // histogram bounds
+	counts    []atomic.Uint64            // histogram counts
+	exemplars []atomic.Pointer[Exemplar] // latest exemplar per bucket
 }
 
 // A MetricSnapshot is a snapshot of a metric.
@@ -85,9 +87,68 @@ type MetricSnapshot struct {
 	Labels map[string]string
 	Help   string
 
-	Value  float64
-	Bounds []float64
-	Counts []uint64
+	Value     float64
+	Bounds    []float64
+	Counts    []uint64
+	Exemplars []Exemplar // latest exemplars, at most one per bucket
+
+	// Stale is set by an Importer for a metric that is no longer exported,
+	// e.g., because the weavelet that exported it restarted without it. Value
+	// and Counts are the last ones received.
+	Stale bool
+}
+
+// An Exemplar is a value put in a histogram, linked to the trace span of the
+// operation that produced it (see Metric.PutExemplar). Exemplars let a
+// latency histogram bucket be correlated with an example trace.
+type Exemplar struct {
+	Bucket  int       // index of the value's bucket in MetricSnapshot.Counts
+	Value   float64   // value put in the histogram
+	TraceID [16]byte  // trace id of the span
+	SpanID  [8]byte   // span id of the span
+	Time    time.Time // when the value was put
+}
+
+// ToProto converts an Exemplar to its proto equivalent.
+func (e Exemplar) ToProto() *protos.Exemplar {
+	return &protos.Exemplar{
+		Bucket:     int32(e.Bucket),
+		Value:      e.Value,
+		TraceId:    slices.Clone(e.TraceID[:]),
+		SpanId:     slices.Clone(e.SpanID[:]),
+		TimeMicros: e.Time.UnixMicro(),
+	}
+}
+
+// exemplarsToProto converts the provided exemplars to their proto
+// equivalents.
+func exemplarsToProto(exemplars []Exemplar) []*protos.Exemplar {
+	if len(exemplars) == 0 {
+		return nil
+	}
+	ps := make([]*protos.Exemplar, len(exemplars))
+	for i, e := range exemplars {
+		ps[i] = e.ToProto()
+	}
+	return ps
+}
+
+// exemplarsFromProto converts the provided proto exemplars to Exemplars.
+func exemplarsFromProto(ps []*protos.Exemplar) []Exemplar {
+	if len(ps) == 0 {
+		return nil
+	}
+	exemplars := make([]Exemplar, len(ps))
+	for i, p := range ps {
+		exemplars[i] = Exemplar{
+			Bucket: int(p.Bucket),
+			Value:  p.Value,
+			Time:   time.UnixMicro(p.TimeMicros),
+		}
+		copy(exemplars[i].TraceID[:], p.TraceId)
+		copy(exemplars[i].SpanID[:], p.SpanId)
+	}
+	return exemplars
 }
 
 // MetricDef returns a MetricDef derived from the metric.
@@ -105,37 +166,40 @@ func (m *MetricSnapshot) MetricDef() *protos.MetricDef {
 // MetricValue returns a MetricValue derived from the metric.
 func (m *MetricSnapshot) MetricValue() *protos.MetricValue {
 	return &protos.MetricValue{
-		Id:     m.Id,
-		Value:  m.Value,
-		Counts: m.Counts,
+		Id:        m.Id,
+		Value:     m.Value,
+		Counts:    m.Counts,
+		Exemplars: exemplarsToProto(m.Exemplars),
 	}
 }
 
 // ToProto converts a MetricSnapshot to its proto equivalent.
 func (m *MetricSnapshot) ToProto() *protos.MetricSnapshot {
 	return &protos.MetricSnapshot{
-		Id:     m.Id,
-		Name:   m.Name,
-		Typ:    m.Type,
-		Help:   m.Help,
-		Labels: m.Labels,
-		Bounds: m.Bounds,
-		Value:  m.Value,
-		Counts: m.Counts,
+		Id:        m.Id,
+		Name:      m.Name,
+		Typ:       m.Type,
+		Help:      m.Help,
+		Labels:    m.Labels,
+		Bounds:    m.Bounds,
+		Value:     m.Value,
+		Counts:    m.Counts,
+		Exemplars: exemplarsToProto(m.Exemplars),
 	}
 }
 
 // UnProto converts a protos.MetricSnapshot into a metrics.MetricSnapshot.
 func UnProto(m *protos.MetricSnapshot) *MetricSnapshot {
 	return &MetricSnapshot{
-		Id:     m.Id,
-		Type:   m.Typ,
-		Name:   m.Name,
-		Labels: m.Labels,
-		Help:   m.Help,
-		Value:  m.Value,
-		Bounds: m.Bounds,
-		Counts: m.Counts,
+		Id:        m.Id,
+		Type:      m.Typ,
+		Name:      m.Name,
+		Labels:    m.Labels,
+		Help:      m.Help,
+		Value:     m.Value,
+		Bounds:    m.Bounds,
+		Counts:    m.Counts,
+		Exemplars: exemplarsFromProto(m.Exemplars),
 	}
 }
 
@@ -145,6 +209,7 @@ func (m *MetricSnapshot) Clone() *MetricSnapshot {
 	c.Labels = maps.Clone(m.Labels)
 	c.Bounds = slices.Clone(m.Bounds)
 	c.Counts = slices.Clone(m.Counts)
+	c.Exemplars = slices.Clone(m.Exemplars)
 	return &c
 }
 
@@ -177,6 +242,7 @@ func newMetric(config config) *Metric {
 	}
 	if config.Type == protos.MetricType_HISTOGRAM {
 		metric.counts = make([]atomic.Uint64, len(config.Bounds)+1)
+		metric.exemplars = make([]atomic.Pointer[Exemplar], len(config.Bounds)+1)
 	}
 	metrics = append(metrics, metric)
 	return metric
@@ -209,16 +275,43 @@ func (m *Metric) Set(val float64) {
 
 // Put adds the provided value to the metric's histogram.
 func (m *Metric) Put(val float64) {
-	var idx int
+	m.put(m.bucket(val), val)
+}
+
+// PutExemplar is like Put, but also records the value as the exemplar of its
+// bucket, linked to the trace span with the provided ids. The exemplar
+// replaces any earlier exemplar of the bucket.
+func (m *Metric) PutExemplar(val float64, traceID [16]byte, spanID [8]byte) {
+	idx := m.bucket(val)
+	m.exemplars[idx].Store(&Exemplar{
+		Bucket:  idx,
+		Value:   val,
+		TraceID: traceID,
+		SpanID:  spanID,
+		Time:    time.Now(),
+	})
+	// Store the exemplar before put, so that an exporter that observes the
+	// put also observes the exemplar.
+	m.put(idx, val)
+}
+
+// bucket returns the index of the histogram bucket of the provided value.
+func (m *Metric) bucket(val float64) int {
 	if len(m.bounds) == 0 || val < m.bounds[0] {
 		// Skip binary search for values that fall in the first bucket
 		// (often true for short latency operations).
-	} else {
-		idx = sort.SearchFloat64s(m.bounds, val)
-		if idx < len(m.bounds) && val == m.bounds[idx] {
-			idx++
-		}
+		return 0
+	}
+	idx := sort.SearchFloat64s(m.bounds, val)
+	if idx < len(m.bounds) && val == m.bounds[idx] {
+		idx++
 	}
+	return idx
+}
+
+// put adds the provided value to the histogram bucket with the provided
+// index.
+func (m *Metric) put(idx int, val float64) {
 	m.counts[idx].Add(1)
 
 	// Microsecond latencies are often zero for very fast functions.
@@ -241,6 +334,17 @@ func (m *Metric) initIdAndLabels() {
 	})
 }
 
+// loadExemplars returns the latest exemplars of a histogram, in bucket order.
+func (m *Metric) loadExemplars() []Exemplar {
+	var exemplars []Exemplar
+	for i := range m.exemplars {
+		if e := m.exemplars[i].Load(); e != nil {
+			exemplars = append(exemplars, *e)
+		}
+	}
+	return exemplars
+}
+
 // get returns the current value (sum of all added values for histograms).
 func (m *Metric) get() float64 {
 	return m.fvalue.get() + float64(m.ivalue.Load())
@@ -257,14 +361,15 @@ func (m *Metric) Snapshot() *MetricSnapshot {
 		}
 	}
 	return &MetricSnapshot{
-		Id:     m.id,
-		Name:   m.name,
-		Type:   m.typ,
-		Help:   m.help,
-		Labels: maps.Clone(m.labels),
-		Value:  m.get(),
-		Bounds: slices.Clone(m.bounds),
-		Counts: counts,
+		Id:        m.id,
+		Name:      m.name,
+		Type:      m.typ,
+		Help:      m.help,
+		Labels:    maps.Clone(m.labels),
+		Value:     m.get(),
+		Bounds:    slices.Clone(m.bounds),
+		Counts:    counts,
+		Exemplars: m.loadExemplars(),
 	}
 }
 
@@ -291,9 +396,10 @@ func (m *Metric) MetricValue() *protos.MetricValue {
 		}
 	}
 	return &protos.MetricValue{
-		Id:     m.id,
-		Value:  m.get(),
-		Counts: counts,
+		Id:        m.id,
+		Value:     m.get(),
+		Counts:    counts,
+		Exemplars: exemplarsToProto(m.loadExemplars()),
 	}
 }
 
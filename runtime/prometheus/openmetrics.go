@@ -0,0 +1,145 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ServiceWeaver/weaver/runtime/metrics"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// OpenMetricsContentType is the HTTP content type of the OpenMetrics text
+// format written by WriteOpenMetrics.
+const OpenMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+// WriteOpenMetrics writes the provided metrics to w in the OpenMetrics text
+// format [1], which can be scraped by Prometheus and other monitoring systems.
+//
+// Metrics are grouped into metric families by name, and the families are
+// sorted by name. As required by the format, the samples of a counter named
+// x are named x_total; a counter whose name already ends in _total keeps its
+// name. Unlike TranslateMetricsToPrometheusTextFormat, WriteOpenMetrics
+// writes all labels of the metrics unchanged. Histogram exemplars (see
+// metrics.Exemplar) are written as the exemplars of their buckets.
+//
+// [1] https://github.com/OpenObservability/OpenMetrics/blob/main/specification/OpenMetrics.md
+func WriteOpenMetrics(w io.Writer, ms []*metrics.MetricSnapshot) error {
+	// Group by family, sorting by id within a family.
+	families := map[string][]*metrics.MetricSnapshot{}
+	for _, m := range ms {
+		name := m.Name
+		if m.Type == protos.MetricType_COUNTER {
+			name = strings.TrimSuffix(name, "_total")
+		}
+		families[name] = append(families[name], m)
+	}
+	names := make([]string, 0, len(families))
+	for name, family := range families {
+		names = append(names, name)
+		sort.SliceStable(family, func(i, j int) bool { return family[i].Id < family[j].Id })
+	}
+	sort.Strings(names)
+
+	var b bytes.Buffer
+	for _, name := range names {
+		writeFamily(&b, name, families[name])
+	}
+	b.WriteString("# EOF\n")
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// writeFamily writes a metric family with the provided name. The type and
+// help of the family are those of its first metric.
+func writeFamily(w *bytes.Buffer, name string, family []*metrics.MetricSnapshot) {
+	first := family[0]
+	w.WriteString("# TYPE " + name)
+	switch first.Type {
+	case protos.MetricType_COUNTER:
+		w.WriteString(" counter\n")
+	case protos.MetricType_GAUGE:
+		w.WriteString(" gauge\n")
+	case protos.MetricType_HISTOGRAM:
+		w.WriteString(" histogram\n")
+	default:
+		w.WriteString(" unknown\n")
+	}
+	if first.Help != "" {
+		w.WriteString("# HELP " + name + " ")
+		escaper.WriteString(w, first.Help)
+		w.WriteByte('\n')
+	}
+
+	for _, m := range family {
+		switch first.Type {
+		case protos.MetricType_COUNTER:
+			writeEntry(w, name, m.Value, "_total", m.Labels, "", 0)
+		case protos.MetricType_HISTOGRAM:
+			exemplars := map[int]metrics.Exemplar{}
+			for _, e := range m.Exemplars {
+				exemplars[e.Bucket] = e
+			}
+			// The exemplar of a bucket is written on the first line that
+			// counts the bucket.
+			var count uint64
+			var pending *metrics.Exemplar
+			for i, bound := range m.Bounds {
+				if i < len(m.Counts) {
+					count += m.Counts[i]
+				}
+				if e, ok := exemplars[i]; ok {
+					pending = &e
+				}
+				if !math.IsInf(bound, +1) {
+					writeBucket(w, name, count, m.Labels, bound, pending)
+					pending = nil
+				}
+			}
+			if len(m.Counts) > len(m.Bounds) {
+				count += m.Counts[len(m.Bounds)]
+			}
+			if e, ok := exemplars[len(m.Bounds)]; ok {
+				pending = &e
+			}
+			writeBucket(w, name, count, m.Labels, math.Inf(+1), pending)
+			writeEntry(w, name, float64(count), "_count", m.Labels, "", 0)
+			writeEntry(w, name, m.Value, "_sum", m.Labels, "", 0)
+		default:
+			writeEntry(w, name, m.Value, "", m.Labels, "", 0)
+		}
+	}
+}
+
+// writeBucket writes a histogram bucket line of the metric family with the
+// provided name, followed by the provided exemplar, if any.
+func writeBucket(w *bytes.Buffer, name string, count uint64, labels map[string]string, bound float64, e *metrics.Exemplar) {
+	if e == nil {
+		writeEntry(w, name, float64(count), "_bucket", labels, "le", bound)
+		return
+	}
+	w.WriteString(name + "_bucket")
+	writeLabels(w, labels, "le", bound)
+	w.WriteString(" " + strconv.FormatUint(count, 10))
+	w.WriteString(` # {trace_id="` + hex.EncodeToString(e.TraceID[:]) + `",span_id="` + hex.EncodeToString(e.SpanID[:]) + `"} `)
+	w.WriteString(strconv.FormatFloat(e.Value, 'f', -1, 64))
+	w.WriteString(" " + strconv.FormatFloat(float64(e.Time.UnixMicro())/1e6, 'f', -1, 64) + "\n")
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/metrics"
+	imetrics "github.com/ServiceWeaver/weaver/runtime/prometheus"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWriteOpenMetrics(t *testing.T) {
+	ms := []*metrics.MetricSnapshot{
+		{
+			Id:     2,
+			Name:   "requests",
+			Type:   protos.MetricType_COUNTER,
+			Help:   "Number of requests",
+			Labels: map[string]string{"path": "/b"},
+			Value:  20,
+		},
+		{
+			Id:     1,
+			Name:   "requests",
+			Type:   protos.MetricType_COUNTER,
+			Help:   "Number of requests",
+			Labels: map[string]string{"path": `/"a"`},
+			Value:  10,
+		},
+		{
+			Id:    3,
+			Name:  "errors_total",
+			Type:  protos.MetricType_COUNTER,
+			Value: 1,
+		},
+		{
+			Id:    4,
+			Name:  "temperature",
+			Type:  protos.MetricType_GAUGE,
+			Help:  "Temperature\nin Celsius",
+			Value: -1.5,
+		},
+		{
+			Id:     5,
+			Name:   "latency",
+			Type:   protos.MetricType_HISTOGRAM,
+			Labels: map[string]string{"method": "Get"},
+			Value:  42.5,
+			Bounds: []float64{1, 10},
+			Counts: []uint64{1, 2, 3},
+		},
+		{
+			Id:     6,
+			Name:   "sizes",
+			Type:   protos.MetricType_HISTOGRAM,
+			Value:  3,
+			Bounds: []float64{1, math.Inf(+1)},
+			Counts: []uint64{1, 1, 0},
+		},
+	}
+
+	var b strings.Builder
+	if err := imetrics.WriteOpenMetrics(&b, ms); err != nil {
+		t.Fatal(err)
+	}
+	want := `# TYPE errors counter
+errors_total 1
+# TYPE latency histogram
+latency_bucket{method="Get",le="1"} 1
+latency_bucket{method="Get",le="10"} 3
+latency_bucket{method="Get",le="+Inf"} 6
+latency_count{method="Get"} 6
+latency_sum{method="Get"} 42.5
+# TYPE requests counter
+# HELP requests Number of requests
+requests_total{path="/\"a\""} 10
+requests_total{path="/b"} 20
+# TYPE sizes histogram
+sizes_bucket{le="1"} 1
+sizes_bucket{le="+Inf"} 2
+sizes_count 2
+sizes_sum 3
+# TYPE temperature gauge
+# HELP temperature Temperature\nin Celsius
+temperature -1.5
+# EOF
+`
+	if diff := cmp.Diff(want, b.String()); diff != "" {
+		t.Fatalf("WriteOpenMetrics (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteOpenMetricsExemplars(t *testing.T) {
+	ms := []*metrics.MetricSnapshot{{
+		Id:     1,
+		Name:   "latency",
+		Type:   protos.MetricType_HISTOGRAM,
+		Value:  107,
+		Bounds: []float64{10, math.Inf(+1)},
+		Counts: []uint64{1, 1, 0},
+		Exemplars: []metrics.Exemplar{
+			{Bucket: 0, Value: 7, TraceID: [16]byte{0xab}, SpanID: [8]byte{0xcd}, Time: time.UnixMilli(1500)},
+			{Bucket: 1, Value: 100, TraceID: [16]byte{0x01}, SpanID: [8]byte{0x02}, Time: time.Unix(2, 0)},
+		},
+	}}
+
+	var b strings.Builder
+	if err := imetrics.WriteOpenMetrics(&b, ms); err != nil {
+		t.Fatal(err)
+	}
+	want := `# TYPE latency histogram
+latency_bucket{le="10"} 1 # {trace_id="ab000000000000000000000000000000",span_id="cd00000000000000"} 7 1.5
+latency_bucket{le="+Inf"} 2 # {trace_id="01000000000000000000000000000000",span_id="0200000000000000"} 100 2
+latency_count 2
+latency_sum 107
+# EOF
+`
+	if diff := cmp.Diff(want, b.String()); diff != "" {
+		t.Fatalf("WriteOpenMetrics (-want +got):\n%s", diff)
+	}
+}
@@ -34,6 +34,75 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Capability identifies a DeployerControl method that a weavelet may invoke
+// on its envelope.
+type Capability int32
+
+const (
+	Capability_CAPABILITY_UNKNOWN                   Capability = 0
+	Capability_CAPABILITY_ACTIVATE_COMPONENT        Capability = 1
+	Capability_CAPABILITY_GET_LISTENER_ADDRESS      Capability = 2
+	Capability_CAPABILITY_EXPORT_LISTENER           Capability = 3
+	Capability_CAPABILITY_GET_SELF_CERTIFICATE      Capability = 4
+	Capability_CAPABILITY_VERIFY_CLIENT_CERTIFICATE Capability = 5
+	Capability_CAPABILITY_VERIFY_SERVER_CERTIFICATE Capability = 6
+	Capability_CAPABILITY_LOG_BATCH                 Capability = 7
+	Capability_CAPABILITY_HANDLE_TRACE_SPANS        Capability = 8
+)
+
+// Enum value maps for Capability.
+var (
+	Capability_name = map[int32]string{
+		0: "CAPABILITY_UNKNOWN",
+		1: "CAPABILITY_ACTIVATE_COMPONENT",
+		2: "CAPABILITY_GET_LISTENER_ADDRESS",
+		3: "CAPABILITY_EXPORT_LISTENER",
+		4: "CAPABILITY_GET_SELF_CERTIFICATE",
+		5: "CAPABILITY_VERIFY_CLIENT_CERTIFICATE",
+		6: "CAPABILITY_VERIFY_SERVER_CERTIFICATE",
+		7: "CAPABILITY_LOG_BATCH",
+		8: "CAPABILITY_HANDLE_TRACE_SPANS",
+	}
+	Capability_value = map[string]int32{
+		"CAPABILITY_UNKNOWN":                   0,
+		"CAPABILITY_ACTIVATE_COMPONENT":        1,
+		"CAPABILITY_GET_LISTENER_ADDRESS":      2,
+		"CAPABILITY_EXPORT_LISTENER":           3,
+		"CAPABILITY_GET_SELF_CERTIFICATE":      4,
+		"CAPABILITY_VERIFY_CLIENT_CERTIFICATE": 5,
+		"CAPABILITY_VERIFY_SERVER_CERTIFICATE": 6,
+		"CAPABILITY_LOG_BATCH":                 7,
+		"CAPABILITY_HANDLE_TRACE_SPANS":        8,
+	}
+)
+
+func (x Capability) Enum() *Capability {
+	p := new(Capability)
+	*p = x
+	return p
+}
+
+func (x Capability) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Capability) Descriptor() protoreflect.EnumDescriptor {
+	return file_runtime_protos_runtime_proto_enumTypes[0].Descriptor()
+}
+
+func (Capability) Type() protoreflect.EnumType {
+	return &file_runtime_protos_runtime_proto_enumTypes[0]
+}
+
+func (x Capability) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Capability.Descriptor instead.
+func (Capability) EnumDescriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{0}
+}
+
 // HealthStatus specifies the health of a weavelet.
 type HealthStatus int32
 
@@ -71,11 +140,11 @@ func (x HealthStatus) String() string {
 }
 
 func (HealthStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_runtime_protos_runtime_proto_enumTypes[0].Descriptor()
+	return file_runtime_protos_runtime_proto_enumTypes[1].Descriptor()
 }
 
 func (HealthStatus) Type() protoreflect.EnumType {
-	return &file_runtime_protos_runtime_proto_enumTypes[0]
+	return &file_runtime_protos_runtime_proto_enumTypes[1]
 }
 
 func (x HealthStatus) Number() protoreflect.EnumNumber {
@@ -84,7 +153,57 @@ func (x HealthStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HealthStatus.Descriptor instead.
 func (HealthStatus) EnumDescriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{0}
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{1}
+}
+
+// AggregationFunction is a function that combines metric values.
+type AggregationFunction int32
+
+const (
+	AggregationFunction_AGGREGATE_SUM AggregationFunction = 0
+	AggregationFunction_AGGREGATE_MAX AggregationFunction = 1
+	AggregationFunction_AGGREGATE_MIN AggregationFunction = 2
+)
+
+// Enum value maps for AggregationFunction.
+var (
+	AggregationFunction_name = map[int32]string{
+		0: "AGGREGATE_SUM",
+		1: "AGGREGATE_MAX",
+		2: "AGGREGATE_MIN",
+	}
+	AggregationFunction_value = map[string]int32{
+		"AGGREGATE_SUM": 0,
+		"AGGREGATE_MAX": 1,
+		"AGGREGATE_MIN": 2,
+	}
+)
+
+func (x AggregationFunction) Enum() *AggregationFunction {
+	p := new(AggregationFunction)
+	*p = x
+	return p
+}
+
+func (x AggregationFunction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregationFunction) Descriptor() protoreflect.EnumDescriptor {
+	return file_runtime_protos_runtime_proto_enumTypes[2].Descriptor()
+}
+
+func (AggregationFunction) Type() protoreflect.EnumType {
+	return &file_runtime_protos_runtime_proto_enumTypes[2]
+}
+
+func (x AggregationFunction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregationFunction.Descriptor instead.
+func (AggregationFunction) EnumDescriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{2}
 }
 
 // MetricType specifies a particular kind of metric.
@@ -124,11 +243,11 @@ func (x MetricType) String() string {
 }
 
 func (MetricType) Descriptor() protoreflect.EnumDescriptor {
-	return file_runtime_protos_runtime_proto_enumTypes[1].Descriptor()
+	return file_runtime_protos_runtime_proto_enumTypes[3].Descriptor()
 }
 
 func (MetricType) Type() protoreflect.EnumType {
-	return &file_runtime_protos_runtime_proto_enumTypes[1]
+	return &file_runtime_protos_runtime_proto_enumTypes[3]
 }
 
 func (x MetricType) Number() protoreflect.EnumNumber {
@@ -137,7 +256,7 @@ func (x MetricType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MetricType.Descriptor instead.
 func (MetricType) EnumDescriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{1}
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{3}
 }
 
 // ProfileType specifies a type of profile.
@@ -147,6 +266,10 @@ const (
 	ProfileType_Unspecified ProfileType = 0
 	ProfileType_Heap        ProfileType = 1
 	ProfileType_CPU         ProfileType = 2
+	ProfileType_Block       ProfileType = 3
+	ProfileType_Mutex       ProfileType = 4
+	ProfileType_Goroutine   ProfileType = 5
+	ProfileType_Allocs      ProfileType = 6
 )
 
 // Enum value maps for ProfileType.
@@ -155,11 +278,19 @@ var (
 		0: "Unspecified",
 		1: "Heap",
 		2: "CPU",
+		3: "Block",
+		4: "Mutex",
+		5: "Goroutine",
+		6: "Allocs",
 	}
 	ProfileType_value = map[string]int32{
 		"Unspecified": 0,
 		"Heap":        1,
 		"CPU":         2,
+		"Block":       3,
+		"Mutex":       4,
+		"Goroutine":   5,
+		"Allocs":      6,
 	}
 )
 
@@ -174,11 +305,11 @@ func (x ProfileType) String() string {
 }
 
 func (ProfileType) Descriptor() protoreflect.EnumDescriptor {
-	return file_runtime_protos_runtime_proto_enumTypes[2].Descriptor()
+	return file_runtime_protos_runtime_proto_enumTypes[4].Descriptor()
 }
 
 func (ProfileType) Type() protoreflect.EnumType {
-	return &file_runtime_protos_runtime_proto_enumTypes[2]
+	return &file_runtime_protos_runtime_proto_enumTypes[4]
 }
 
 func (x ProfileType) Number() protoreflect.EnumNumber {
@@ -187,7 +318,106 @@ func (x ProfileType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProfileType.Descriptor instead.
 func (ProfileType) EnumDescriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{2}
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{4}
+}
+
+// Routing describes how calls from the caller to the callee are routed.
+type CallGraphEdge_Routing int32
+
+const (
+	CallGraphEdge_UNKNOWN CallGraphEdge_Routing = 0 // no routing info has been received for the callee yet
+	CallGraphEdge_LOCAL   CallGraphEdge_Routing = 1 // calls are local
+	CallGraphEdge_REMOTE  CallGraphEdge_Routing = 2 // calls are remote
+)
+
+// Enum value maps for CallGraphEdge_Routing.
+var (
+	CallGraphEdge_Routing_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "LOCAL",
+		2: "REMOTE",
+	}
+	CallGraphEdge_Routing_value = map[string]int32{
+		"UNKNOWN": 0,
+		"LOCAL":   1,
+		"REMOTE":  2,
+	}
+)
+
+func (x CallGraphEdge_Routing) Enum() *CallGraphEdge_Routing {
+	p := new(CallGraphEdge_Routing)
+	*p = x
+	return p
+}
+
+func (x CallGraphEdge_Routing) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CallGraphEdge_Routing) Descriptor() protoreflect.EnumDescriptor {
+	return file_runtime_protos_runtime_proto_enumTypes[5].Descriptor()
+}
+
+func (CallGraphEdge_Routing) Type() protoreflect.EnumType {
+	return &file_runtime_protos_runtime_proto_enumTypes[5]
+}
+
+func (x CallGraphEdge_Routing) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CallGraphEdge_Routing.Descriptor instead.
+func (CallGraphEdge_Routing) EnumDescriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{51, 0}
+}
+
+type CircuitBreakerState_State int32
+
+const (
+	CircuitBreakerState_CLOSED    CircuitBreakerState_State = 0 // calls are issued
+	CircuitBreakerState_OPEN      CircuitBreakerState_State = 1 // calls fail immediately
+	CircuitBreakerState_HALF_OPEN CircuitBreakerState_State = 2 // a single probe call is issued
+)
+
+// Enum value maps for CircuitBreakerState_State.
+var (
+	CircuitBreakerState_State_name = map[int32]string{
+		0: "CLOSED",
+		1: "OPEN",
+		2: "HALF_OPEN",
+	}
+	CircuitBreakerState_State_value = map[string]int32{
+		"CLOSED":    0,
+		"OPEN":      1,
+		"HALF_OPEN": 2,
+	}
+)
+
+func (x CircuitBreakerState_State) Enum() *CircuitBreakerState_State {
+	p := new(CircuitBreakerState_State)
+	*p = x
+	return p
+}
+
+func (x CircuitBreakerState_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CircuitBreakerState_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_runtime_protos_runtime_proto_enumTypes[6].Descriptor()
+}
+
+func (CircuitBreakerState_State) Type() protoreflect.EnumType {
+	return &file_runtime_protos_runtime_proto_enumTypes[6]
+}
+
+func (x CircuitBreakerState_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CircuitBreakerState_State.Descriptor instead.
+func (CircuitBreakerState_State) EnumDescriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{79, 0}
 }
 
 // Span's role in the trace.
@@ -233,11 +463,11 @@ func (x Span_Kind) String() string {
 }
 
 func (Span_Kind) Descriptor() protoreflect.EnumDescriptor {
-	return file_runtime_protos_runtime_proto_enumTypes[3].Descriptor()
+	return file_runtime_protos_runtime_proto_enumTypes[7].Descriptor()
 }
 
 func (Span_Kind) Type() protoreflect.EnumType {
-	return &file_runtime_protos_runtime_proto_enumTypes[3]
+	return &file_runtime_protos_runtime_proto_enumTypes[7]
 }
 
 func (x Span_Kind) Number() protoreflect.EnumNumber {
@@ -246,7 +476,7 @@ func (x Span_Kind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Span_Kind.Descriptor instead.
 func (Span_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{38, 0}
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{120, 0}
 }
 
 // Type describes the type of the value.
@@ -301,11 +531,11 @@ func (x Span_Attribute_Value_Type) String() string {
 }
 
 func (Span_Attribute_Value_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_runtime_protos_runtime_proto_enumTypes[4].Descriptor()
+	return file_runtime_protos_runtime_proto_enumTypes[8].Descriptor()
 }
 
 func (Span_Attribute_Value_Type) Type() protoreflect.EnumType {
-	return &file_runtime_protos_runtime_proto_enumTypes[4]
+	return &file_runtime_protos_runtime_proto_enumTypes[8]
 }
 
 func (x Span_Attribute_Value_Type) Number() protoreflect.EnumNumber {
@@ -314,7 +544,7 @@ func (x Span_Attribute_Value_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Span_Attribute_Value_Type.Descriptor instead.
 func (Span_Attribute_Value_Type) EnumDescriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{38, 0, 0, 0}
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{120, 0, 0, 0}
 }
 
 type Span_Status_Code int32
@@ -350,11 +580,11 @@ func (x Span_Status_Code) String() string {
 }
 
 func (Span_Status_Code) Descriptor() protoreflect.EnumDescriptor {
-	return file_runtime_protos_runtime_proto_enumTypes[5].Descriptor()
+	return file_runtime_protos_runtime_proto_enumTypes[9].Descriptor()
 }
 
 func (Span_Status_Code) Type() protoreflect.EnumType {
-	return &file_runtime_protos_runtime_proto_enumTypes[5]
+	return &file_runtime_protos_runtime_proto_enumTypes[9]
 }
 
 func (x Span_Status_Code) Number() protoreflect.EnumNumber {
@@ -363,7 +593,7 @@ func (x Span_Status_Code) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Span_Status_Code.Descriptor instead.
 func (Span_Status_Code) EnumDescriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{38, 3, 0}
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{120, 3, 0}
 }
 
 // WeaveletArgs is the information provided by an envelope to a weavelet when
@@ -392,6 +622,15 @@ type WeaveletArgs struct {
 	// method calls.
 	ControlSocket string                   `protobuf:"bytes,13,opt,name=control_socket,json=controlSocket,proto3" json:"control_socket,omitempty"`
 	Redirects     []*WeaveletArgs_Redirect `protobuf:"bytes,12,rep,name=redirects,proto3" json:"redirects,omitempty"`
+	// If true, the weavelet stamps every LogEntryBatch it sends with a
+	// sequence number, so that the envelope can check that it receives the
+	// batches in order. This is a debugging aid for tests, and it is disabled
+	// in production.
+	CheckMessageOrder bool `protobuf:"varint,14,opt,name=check_message_order,json=checkMessageOrder,proto3" json:"check_message_order,omitempty"`
+	// If positive, the maximum number of concurrent RPCs the envelope may issue
+	// to the weavelet. The weavelet advertises it back to the envelope in
+	// InitWeaveletReply.max_concurrent_rpcs.
+	MaxConcurrentRpcs int64 `protobuf:"varint,15,opt,name=max_concurrent_rpcs,json=maxConcurrentRpcs,proto3" json:"max_concurrent_rpcs,omitempty"`
 }
 
 func (x *WeaveletArgs) Reset() {
@@ -482,6 +721,20 @@ func (x *WeaveletArgs) GetRedirects() []*WeaveletArgs_Redirect {
 	return nil
 }
 
+func (x *WeaveletArgs) GetCheckMessageOrder() bool {
+	if x != nil {
+		return x.CheckMessageOrder
+	}
+	return false
+}
+
+func (x *WeaveletArgs) GetMaxConcurrentRpcs() int64 {
+	if x != nil {
+		return x.MaxConcurrentRpcs
+	}
+	return 0
+}
+
 // InitWeaveletRequest holds the initialization info passed to the weavelet by the envelope.
 type InitWeaveletRequest struct {
 	state         protoimpl.MessageState
@@ -542,6 +795,15 @@ type InitWeaveletReply struct {
 	DialAddr string `protobuf:"bytes,1,opt,name=dial_addr,json=dialAddr,proto3" json:"dial_addr,omitempty"`
 	// The deployer API version of the weavelet.
 	Version *SemVer `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// The resources available to the weavelet.
+	Resources *ResourceLimits `protobuf:"bytes,4,opt,name=resources,proto3" json:"resources,omitempty"`
+	// The envelope handler methods the weavelet may invoke (see
+	// DeployerControl). A deployer can use them to detect missing handlers.
+	Capabilities []Capability `protobuf:"varint,5,rep,packed,name=capabilities,proto3,enum=runtime.Capability" json:"capabilities,omitempty"`
+	// If positive, the maximum number of RPCs the envelope may issue to the
+	// weavelet concurrently. The envelope queues excess RPCs until earlier
+	// RPCs finish. If zero, the number of concurrent RPCs is unbounded.
+	MaxConcurrentRpcs int64 `protobuf:"varint,6,opt,name=max_concurrent_rpcs,json=maxConcurrentRpcs,proto3" json:"max_concurrent_rpcs,omitempty"`
 }
 
 func (x *InitWeaveletReply) Reset() {
@@ -590,6 +852,110 @@ func (x *InitWeaveletReply) GetVersion() *SemVer {
 	return nil
 }
 
+func (x *InitWeaveletReply) GetResources() *ResourceLimits {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *InitWeaveletReply) GetCapabilities() []Capability {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *InitWeaveletReply) GetMaxConcurrentRpcs() int64 {
+	if x != nil {
+		return x.MaxConcurrentRpcs
+	}
+	return 0
+}
+
+// ResourceLimits describes the resources available to a weavelet, as
+// detected by the weavelet when it starts. CPU and memory limits are read
+// from the weavelet's cgroup, if any (cgroup v2 or v1). A zero limit means
+// that no limit was detected.
+type ResourceLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gomaxprocs           int64   `protobuf:"varint,1,opt,name=gomaxprocs,proto3" json:"gomaxprocs,omitempty"`                                                   // runtime.GOMAXPROCS of the weavelet
+	NumCpu               int64   `protobuf:"varint,2,opt,name=num_cpu,json=numCpu,proto3" json:"num_cpu,omitempty"`                                             // logical CPUs usable by the weavelet
+	CpuLimit             float64 `protobuf:"fixed64,3,opt,name=cpu_limit,json=cpuLimit,proto3" json:"cpu_limit,omitempty"`                                      // cgroup CPU quota, in CPUs
+	MemoryLimitBytes     int64   `protobuf:"varint,4,opt,name=memory_limit_bytes,json=memoryLimitBytes,proto3" json:"memory_limit_bytes,omitempty"`             // cgroup memory limit
+	AvailableMemoryBytes int64   `protobuf:"varint,5,opt,name=available_memory_bytes,json=availableMemoryBytes,proto3" json:"available_memory_bytes,omitempty"` // memory available when starting
+}
+
+func (x *ResourceLimits) Reset() {
+	*x = ResourceLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceLimits) ProtoMessage() {}
+
+func (x *ResourceLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceLimits.ProtoReflect.Descriptor instead.
+func (*ResourceLimits) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResourceLimits) GetGomaxprocs() int64 {
+	if x != nil {
+		return x.Gomaxprocs
+	}
+	return 0
+}
+
+func (x *ResourceLimits) GetNumCpu() int64 {
+	if x != nil {
+		return x.NumCpu
+	}
+	return 0
+}
+
+func (x *ResourceLimits) GetCpuLimit() float64 {
+	if x != nil {
+		return x.CpuLimit
+	}
+	return 0
+}
+
+func (x *ResourceLimits) GetMemoryLimitBytes() int64 {
+	if x != nil {
+		return x.MemoryLimitBytes
+	}
+	return 0
+}
+
+func (x *ResourceLimits) GetAvailableMemoryBytes() int64 {
+	if x != nil {
+		return x.AvailableMemoryBytes
+	}
+	return 0
+}
+
 // SemVer represents a [semantic version][1] of the form
 // "<major>.<minor>.<patch>". For example, the semver "0.12.3" has major=0,
 // minor=12, and patch=3.
@@ -608,7 +974,7 @@ type SemVer struct {
 func (x *SemVer) Reset() {
 	*x = SemVer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[3]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -621,7 +987,7 @@ func (x *SemVer) String() string {
 func (*SemVer) ProtoMessage() {}
 
 func (x *SemVer) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[3]
+	mi := &file_runtime_protos_runtime_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,7 +1000,7 @@ func (x *SemVer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SemVer.ProtoReflect.Descriptor instead.
 func (*SemVer) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{3}
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SemVer) GetMajor() int64 {
@@ -658,30 +1024,32 @@ func (x *SemVer) GetPatch() int64 {
 	return 0
 }
 
-// GetHealthRequest is a request from an envelope for a weavelet's health.
-type GetHealthRequest struct {
+// PingRequest is a request from an envelope for a weavelet to reply right
+// away, without doing any work. The envelope uses it to measure the round-trip
+// latency of its connection to the weavelet.
+type PingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetHealthRequest) Reset() {
-	*x = GetHealthRequest{}
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[4]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetHealthRequest) String() string {
+func (x *PingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHealthRequest) ProtoMessage() {}
+func (*PingRequest) ProtoMessage() {}
 
-func (x *GetHealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[4]
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,38 +1060,35 @@ func (x *GetHealthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHealthRequest.ProtoReflect.Descriptor instead.
-func (*GetHealthRequest) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{5}
 }
 
-// GetHealthReply is a reply to a GetHealthRequest.
-type GetHealthReply struct {
+// PingReply is a reply to a PingRequest.
+type PingReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Status            HealthStatus `protobuf:"varint,1,opt,name=status,proto3,enum=runtime.HealthStatus" json:"status,omitempty"`
-	HealthyComponents []string     `protobuf:"bytes,2,rep,name=healthy_components,json=healthyComponents,proto3" json:"healthy_components,omitempty"`
 }
 
-func (x *GetHealthReply) Reset() {
-	*x = GetHealthReply{}
+func (x *PingReply) Reset() {
+	*x = PingReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[5]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetHealthReply) String() string {
+func (x *PingReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHealthReply) ProtoMessage() {}
+func (*PingReply) ProtoMessage() {}
 
-func (x *GetHealthReply) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[5]
+func (x *PingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,50 +1099,36 @@ func (x *GetHealthReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHealthReply.ProtoReflect.Descriptor instead.
-func (*GetHealthReply) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PingReply.ProtoReflect.Descriptor instead.
+func (*PingReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetHealthReply) GetStatus() HealthStatus {
-	if x != nil {
-		return x.Status
-	}
-	return HealthStatus_UNKNOWN
-}
-
-func (x *GetHealthReply) GetHealthyComponents() []string {
-	if x != nil {
-		return x.HealthyComponents
-	}
-	return nil
-}
-
-// GetMetricsRequest is a request from an envelope for a weavelet's metrics.
-// There can only be one outstanding GetMetricsRequest at a time.
-type GetMetricsRequest struct {
+// GetTimeRequest is a request from an envelope for the time of a weavelet's
+// clock. The envelope uses it to measure the skew of the weavelet's clock.
+type GetTimeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetMetricsRequest) Reset() {
-	*x = GetMetricsRequest{}
+func (x *GetTimeRequest) Reset() {
+	*x = GetTimeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[6]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMetricsRequest) String() string {
+func (x *GetTimeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMetricsRequest) ProtoMessage() {}
+func (*GetTimeRequest) ProtoMessage() {}
 
-func (x *GetMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[6]
+func (x *GetTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,39 +1139,37 @@ func (x *GetMetricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMetricsRequest.ProtoReflect.Descriptor instead.
-func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetTimeRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{7}
 }
 
-// GetMetricsReply is a reply to a GetMetricsRequest. It only contains
-// information about the metrics that have changed since the prior
-// GetMetricsRequest.
-type GetMetricsReply struct {
+// GetTimeReply is a reply to a GetTimeRequest.
+type GetTimeReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Update *MetricUpdate `protobuf:"bytes,1,opt,name=update,proto3" json:"update,omitempty"`
+	UnixNanos int64 `protobuf:"varint,1,opt,name=unix_nanos,json=unixNanos,proto3" json:"unix_nanos,omitempty"` // time when the request was handled
 }
 
-func (x *GetMetricsReply) Reset() {
-	*x = GetMetricsReply{}
+func (x *GetTimeReply) Reset() {
+	*x = GetTimeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[7]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMetricsReply) String() string {
+func (x *GetTimeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMetricsReply) ProtoMessage() {}
+func (*GetTimeReply) ProtoMessage() {}
 
-func (x *GetMetricsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[7]
+func (x *GetTimeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,51 +1180,42 @@ func (x *GetMetricsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMetricsReply.ProtoReflect.Descriptor instead.
-func (*GetMetricsReply) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetTimeReply.ProtoReflect.Descriptor instead.
+func (*GetTimeReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetMetricsReply) GetUpdate() *MetricUpdate {
+func (x *GetTimeReply) GetUnixNanos() int64 {
 	if x != nil {
-		return x.Update
+		return x.UnixNanos
 	}
-	return nil
+	return 0
 }
 
-// MetricUpdate contains metric updates.
-type MetricUpdate struct {
+// GetHealthRequest is a request from an envelope for a weavelet's health.
+type GetHealthRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Metric definitions and updated values.
-	//
-	// All metrics are identified by their globally unique id. Two endpoints
-	// exchanging metric updates must ensure that a received metric value has been
-	// preceded by a metric definition with the same id, either in the current
-	// update or an earlier one.
-	Defs   []*MetricDef   `protobuf:"bytes,1,rep,name=defs,proto3" json:"defs,omitempty"`     // metric definitions
-	Values []*MetricValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"` // metric values
 }
 
-func (x *MetricUpdate) Reset() {
-	*x = MetricUpdate{}
+func (x *GetHealthRequest) Reset() {
+	*x = GetHealthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[8]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetricUpdate) String() string {
+func (x *GetHealthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricUpdate) ProtoMessage() {}
+func (*GetHealthRequest) ProtoMessage() {}
 
-func (x *MetricUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[8]
+func (x *GetHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,56 +1226,48 @@ func (x *MetricUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricUpdate.ProtoReflect.Descriptor instead.
-func (*MetricUpdate) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *MetricUpdate) GetDefs() []*MetricDef {
-	if x != nil {
-		return x.Defs
-	}
-	return nil
-}
-
-func (x *MetricUpdate) GetValues() []*MetricValue {
-	if x != nil {
-		return x.Values
-	}
-	return nil
+// Deprecated: Use GetHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetHealthRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{9}
 }
 
-// MetricDef is the definition of a new metric.
-type MetricDef struct {
+// GetHealthReply is a reply to a GetHealthRequest.
+type GetHealthReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                                // metric's unique id
-	Name   string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                                                             // name of the metric
-	Typ    MetricType        `protobuf:"varint,3,opt,name=typ,proto3,enum=runtime.MetricType" json:"typ,omitempty"`                                                                      // type of metric
-	Help   string            `protobuf:"bytes,4,opt,name=help,proto3" json:"help,omitempty"`                                                                                             // metric's help message
-	Labels map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // metric labels
-	Bounds []float64         `protobuf:"fixed64,6,rep,packed,name=bounds,proto3" json:"bounds,omitempty"`                                                                                // histogram bucket bounds
+	Status            HealthStatus `protobuf:"varint,1,opt,name=status,proto3,enum=runtime.HealthStatus" json:"status,omitempty"`
+	HealthyComponents []string     `protobuf:"bytes,2,rep,name=healthy_components,json=healthyComponents,proto3" json:"healthy_components,omitempty"`
+	// The readiness of the weavelet's listeners, independent of the health of
+	// the weavelet's components.
+	Listeners []*ListenerReadiness `protobuf:"bytes,3,rep,name=listeners,proto3" json:"listeners,omitempty"`
+	// Components that have been disabled (see SetComponentEnabledRequest).
+	// Disabled components are never reported as healthy.
+	DisabledComponents []string `protobuf:"bytes,4,rep,name=disabled_components,json=disabledComponents,proto3" json:"disabled_components,omitempty"`
+	// The results of the application-defined health checks of the started
+	// components (see weaver.RegisterHealthCheck). If any check failed, status
+	// is UNHEALTHY and the component isn't reported as healthy.
+	Checks []*HealthCheckResult `protobuf:"bytes,5,rep,name=checks,proto3" json:"checks,omitempty"`
 }
 
-func (x *MetricDef) Reset() {
-	*x = MetricDef{}
+func (x *GetHealthReply) Reset() {
+	*x = GetHealthReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[9]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetricDef) String() string {
+func (x *GetHealthReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricDef) ProtoMessage() {}
+func (*GetHealthReply) ProtoMessage() {}
 
-func (x *MetricDef) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[9]
+func (x *GetHealthReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -946,81 +1278,75 @@ func (x *MetricDef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricDef.ProtoReflect.Descriptor instead.
-func (*MetricDef) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *MetricDef) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use GetHealthReply.ProtoReflect.Descriptor instead.
+func (*GetHealthReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MetricDef) GetName() string {
+func (x *GetHealthReply) GetStatus() HealthStatus {
 	if x != nil {
-		return x.Name
+		return x.Status
 	}
-	return ""
+	return HealthStatus_UNKNOWN
 }
 
-func (x *MetricDef) GetTyp() MetricType {
+func (x *GetHealthReply) GetHealthyComponents() []string {
 	if x != nil {
-		return x.Typ
+		return x.HealthyComponents
 	}
-	return MetricType_INVALID
+	return nil
 }
 
-func (x *MetricDef) GetHelp() string {
+func (x *GetHealthReply) GetListeners() []*ListenerReadiness {
 	if x != nil {
-		return x.Help
+		return x.Listeners
 	}
-	return ""
+	return nil
 }
 
-func (x *MetricDef) GetLabels() map[string]string {
+func (x *GetHealthReply) GetDisabledComponents() []string {
 	if x != nil {
-		return x.Labels
+		return x.DisabledComponents
 	}
 	return nil
 }
 
-func (x *MetricDef) GetBounds() []float64 {
+func (x *GetHealthReply) GetChecks() []*HealthCheckResult {
 	if x != nil {
-		return x.Bounds
+		return x.Checks
 	}
 	return nil
 }
 
-// MetricValue is the value associated with a metric.
-type MetricValue struct {
+// HealthCheckResult is the result of an application-defined health check.
+type HealthCheckResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                // metric's unique id.
-	Value  float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`         // value for counter and gauge, sum for histogram
-	Counts []uint64 `protobuf:"varint,3,rep,packed,name=counts,proto3" json:"counts,omitempty"` // histogram counts
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"` // component name
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`           // name of the check
+	Healthy   bool   `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`    // did the check pass?
+	Error     string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`         // if the check failed, the error it returned
 }
 
-func (x *MetricValue) Reset() {
-	*x = MetricValue{}
+func (x *HealthCheckResult) Reset() {
+	*x = HealthCheckResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[10]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetricValue) String() string {
+func (x *HealthCheckResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricValue) ProtoMessage() {}
+func (*HealthCheckResult) ProtoMessage() {}
 
-func (x *MetricValue) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[10]
+func (x *HealthCheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,68 +1357,70 @@ func (x *MetricValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricValue.ProtoReflect.Descriptor instead.
-func (*MetricValue) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use HealthCheckResult.ProtoReflect.Descriptor instead.
+func (*HealthCheckResult) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *MetricValue) GetId() uint64 {
+func (x *HealthCheckResult) GetComponent() string {
 	if x != nil {
-		return x.Id
+		return x.Component
 	}
-	return 0
+	return ""
 }
 
-func (x *MetricValue) GetValue() float64 {
+func (x *HealthCheckResult) GetName() string {
 	if x != nil {
-		return x.Value
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *MetricValue) GetCounts() []uint64 {
+func (x *HealthCheckResult) GetHealthy() bool {
 	if x != nil {
-		return x.Counts
+		return x.Healthy
 	}
-	return nil
+	return false
 }
 
-// MetricSnapshot is a snapshot of a metric. It is the union of a MetricDef and
-// a MetricValue.
-//
-// TODO(mwhittaker): Move out of runtime.proto. It's not used by the pipe.
-type MetricSnapshot struct {
+func (x *HealthCheckResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ListenerReadiness specifies whether a listener is ready to accept
+// connections.
+type ListenerReadiness struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                                // metric's unique id
-	Name   string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                                                             // name of the metric
-	Typ    MetricType        `protobuf:"varint,3,opt,name=typ,proto3,enum=runtime.MetricType" json:"typ,omitempty"`                                                                      // type of metric
-	Help   string            `protobuf:"bytes,4,opt,name=help,proto3" json:"help,omitempty"`                                                                                             // metric's help message
-	Labels map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // metric labels
-	Bounds []float64         `protobuf:"fixed64,6,rep,packed,name=bounds,proto3" json:"bounds,omitempty"`                                                                                // histogram bucket bounds
-	Value  float64           `protobuf:"fixed64,7,opt,name=value,proto3" json:"value,omitempty"`                                                                                         // value for counter and gauge, sum for histogram
-	Counts []uint64          `protobuf:"varint,8,rep,packed,name=counts,proto3" json:"counts,omitempty"`                                                                                 // histogram counts
+	Listener string `protobuf:"bytes,1,opt,name=listener,proto3" json:"listener,omitempty"` // listener name
+	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`   // address the listener is bound to
+	// Is the listener ready to accept connections? A listener is ready once the
+	// application starts accepting connections on it.
+	Ready bool `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
 }
 
-func (x *MetricSnapshot) Reset() {
-	*x = MetricSnapshot{}
+func (x *ListenerReadiness) Reset() {
+	*x = ListenerReadiness{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[11]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetricSnapshot) String() string {
+func (x *ListenerReadiness) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricSnapshot) ProtoMessage() {}
+func (*ListenerReadiness) ProtoMessage() {}
 
-func (x *MetricSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[11]
+func (x *ListenerReadiness) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1103,91 +1431,75 @@ func (x *MetricSnapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricSnapshot.ProtoReflect.Descriptor instead.
-func (*MetricSnapshot) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListenerReadiness.ProtoReflect.Descriptor instead.
+func (*ListenerReadiness) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MetricSnapshot) GetId() uint64 {
+func (x *ListenerReadiness) GetListener() string {
 	if x != nil {
-		return x.Id
+		return x.Listener
 	}
-	return 0
+	return ""
 }
 
-func (x *MetricSnapshot) GetName() string {
+func (x *ListenerReadiness) GetAddress() string {
 	if x != nil {
-		return x.Name
+		return x.Address
 	}
 	return ""
 }
 
-func (x *MetricSnapshot) GetTyp() MetricType {
+func (x *ListenerReadiness) GetReady() bool {
 	if x != nil {
-		return x.Typ
+		return x.Ready
 	}
-	return MetricType_INVALID
+	return false
 }
 
-func (x *MetricSnapshot) GetHelp() string {
-	if x != nil {
-		return x.Help
-	}
-	return ""
-}
+// GetMetricsRequest is a request from an envelope for a weavelet's metrics.
+// There can only be one outstanding GetMetricsRequest at a time.
+type GetMetricsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *MetricSnapshot) GetLabels() map[string]string {
-	if x != nil {
-		return x.Labels
-	}
-	return nil
+	// If non-empty, only metrics whose names start with one of the provided
+	// prefixes are returned.
+	NamePrefixes []string `protobuf:"bytes,1,rep,name=name_prefixes,json=namePrefixes,proto3" json:"name_prefixes,omitempty"`
+	// If true, the weavelet forgets which metrics it previously reported and
+	// replies with the definitions and values of all (matching) metrics,
+	// rather than with only the metrics that changed. Metrics that don't match
+	// name_prefixes are reported in full by later requests that match them.
+	// A deployer should request a full snapshot after discarding the metrics
+	// it imported (e.g., after calling metrics.Importer.Forget), since
+	// subsequent replies only contain changes relative to the full snapshot.
+	FullSnapshot bool `protobuf:"varint,2,opt,name=full_snapshot,json=fullSnapshot,proto3" json:"full_snapshot,omitempty"`
+	// If present, the weavelet aggregates the (matching) metrics as specified
+	// before replying. The reply to an aggregated request is a full snapshot
+	// of the aggregated metrics, with freshly assigned ids, and it doesn't
+	// affect which metrics later non-aggregated requests report. full_snapshot
+	// is ignored.
+	Aggregation *MetricAggregation `protobuf:"bytes,3,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
 }
 
-func (x *MetricSnapshot) GetBounds() []float64 {
-	if x != nil {
-		return x.Bounds
+func (x *GetMetricsRequest) Reset() {
+	*x = GetMetricsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *MetricSnapshot) GetValue() float64 {
-	if x != nil {
-		return x.Value
-	}
-	return 0
-}
-
-func (x *MetricSnapshot) GetCounts() []uint64 {
-	if x != nil {
-		return x.Counts
-	}
-	return nil
-}
-
-// GetLoadRequest is a request from an envelope for a weavelet's load report.
-type GetLoadRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *GetLoadRequest) Reset() {
-	*x = GetLoadRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetLoadRequest) String() string {
+func (x *GetMetricsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoadRequest) ProtoMessage() {}
+func (*GetMetricsRequest) ProtoMessage() {}
 
-func (x *GetLoadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[12]
+func (x *GetMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1198,71 +1510,51 @@ func (x *GetLoadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoadRequest.ProtoReflect.Descriptor instead.
-func (*GetLoadRequest) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{12}
-}
-
-// GetLoadReply is a reply to a GetLoadRequest.
-type GetLoadReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Load *LoadReport `protobuf:"bytes,1,opt,name=load,proto3" json:"load,omitempty"`
+// Deprecated: Use GetMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetLoadReply) Reset() {
-	*x = GetLoadReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetMetricsRequest) GetNamePrefixes() []string {
+	if x != nil {
+		return x.NamePrefixes
 	}
+	return nil
 }
 
-func (x *GetLoadReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetLoadReply) ProtoMessage() {}
-
-func (x *GetLoadReply) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetMetricsRequest) GetFullSnapshot() bool {
+	if x != nil {
+		return x.FullSnapshot
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetLoadReply.ProtoReflect.Descriptor instead.
-func (*GetLoadReply) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{13}
+	return false
 }
 
-func (x *GetLoadReply) GetLoad() *LoadReport {
+func (x *GetMetricsRequest) GetAggregation() *MetricAggregation {
 	if x != nil {
-		return x.Load
+		return x.Aggregation
 	}
 	return nil
 }
 
-// LoadReport contains load information for all routed components hosted by a
-// particular weavelet.
-type LoadReport struct {
+// MetricAggregation specifies how a weavelet should aggregate its metrics
+// before reporting them. Metrics with the same name whose labels are equal
+// after dropping drop_labels are combined into a single metric using the
+// provided function. Dropped labels are irrecoverable: the reply only
+// contains the aggregated metrics.
+type MetricAggregation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Loads map[string]*LoadReport_ComponentLoad `protobuf:"bytes,1,rep,name=loads,proto3" json:"loads,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // load for routed components
+	// The labels to drop. Labels that a metric doesn't have are ignored.
+	DropLabels []string `protobuf:"bytes,1,rep,name=drop_labels,json=dropLabels,proto3" json:"drop_labels,omitempty"`
+	// The function used to combine the values of counters and gauges.
+	// Histogram counts and sums are always added.
+	Function AggregationFunction `protobuf:"varint,2,opt,name=function,proto3,enum=runtime.AggregationFunction" json:"function,omitempty"`
 }
 
-func (x *LoadReport) Reset() {
-	*x = LoadReport{}
+func (x *MetricAggregation) Reset() {
+	*x = MetricAggregation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1270,13 +1562,13 @@ func (x *LoadReport) Reset() {
 	}
 }
 
-func (x *LoadReport) String() string {
+func (x *MetricAggregation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadReport) ProtoMessage() {}
+func (*MetricAggregation) ProtoMessage() {}
 
-func (x *LoadReport) ProtoReflect() protoreflect.Message {
+func (x *MetricAggregation) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1288,34 +1580,38 @@ func (x *LoadReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadReport.ProtoReflect.Descriptor instead.
-func (*LoadReport) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricAggregation.ProtoReflect.Descriptor instead.
+func (*MetricAggregation) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *LoadReport) GetLoads() map[string]*LoadReport_ComponentLoad {
+func (x *MetricAggregation) GetDropLabels() []string {
 	if x != nil {
-		return x.Loads
+		return x.DropLabels
 	}
 	return nil
 }
 
-// GetProfileRequest is a request from an envelope for a weavelet to collect and
-// return a profile. Some profile types only support a single profile request at a
-// time and an error will be returned if another profile request is active.
-type GetProfileRequest struct {
+func (x *MetricAggregation) GetFunction() AggregationFunction {
+	if x != nil {
+		return x.Function
+	}
+	return AggregationFunction_AGGREGATE_SUM
+}
+
+// GetMetricsReply is a reply to a GetMetricsRequest. It only contains
+// information about the metrics that have changed since the prior
+// GetMetricsRequest.
+type GetMetricsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Type of the profile (e.g., heap, cpu).
-	ProfileType ProfileType `protobuf:"varint,1,opt,name=profile_type,json=profileType,proto3,enum=runtime.ProfileType" json:"profile_type,omitempty"`
-	// Duration of CPU profiles, in nanoseconds.
-	CpuDurationNs int64 `protobuf:"varint,2,opt,name=cpu_duration_ns,json=cpuDurationNs,proto3" json:"cpu_duration_ns,omitempty"`
+	Update *MetricUpdate `protobuf:"bytes,1,opt,name=update,proto3" json:"update,omitempty"`
 }
 
-func (x *GetProfileRequest) Reset() {
-	*x = GetProfileRequest{}
+func (x *GetMetricsReply) Reset() {
+	*x = GetMetricsReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1323,13 +1619,13 @@ func (x *GetProfileRequest) Reset() {
 	}
 }
 
-func (x *GetProfileRequest) String() string {
+func (x *GetMetricsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileRequest) ProtoMessage() {}
+func (*GetMetricsReply) ProtoMessage() {}
 
-func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMetricsReply) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1341,36 +1637,41 @@ func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMetricsReply.ProtoReflect.Descriptor instead.
+func (*GetMetricsReply) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetProfileRequest) GetProfileType() ProfileType {
-	if x != nil {
-		return x.ProfileType
-	}
-	return ProfileType_Unspecified
-}
-
-func (x *GetProfileRequest) GetCpuDurationNs() int64 {
+func (x *GetMetricsReply) GetUpdate() *MetricUpdate {
 	if x != nil {
-		return x.CpuDurationNs
+		return x.Update
 	}
-	return 0
+	return nil
 }
 
-// GetProfileReply is a reply to a GetProfileRequest.
-type GetProfileReply struct {
+// MetricUpdate contains metric updates.
+type MetricUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // encoded profile data
+	// Metric definitions and updated values.
+	//
+	// All metrics are identified by their globally unique id. Two endpoints
+	// exchanging metric updates must ensure that a received metric value has been
+	// preceded by a metric definition with the same id, either in the current
+	// update or an earlier one.
+	Defs   []*MetricDef   `protobuf:"bytes,1,rep,name=defs,proto3" json:"defs,omitempty"`     // metric definitions
+	Values []*MetricValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"` // metric values
+	// Does the update define all of the exporter's metrics? This is the case
+	// for the first update of an exporter (e.g., of a newly started weavelet),
+	// and for the first one after it is reset. An importer can treat the
+	// metrics it knows of that aren't defined by a full update as stale.
+	Full bool `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`
 }
 
-func (x *GetProfileReply) Reset() {
-	*x = GetProfileReply{}
+func (x *MetricUpdate) Reset() {
+	*x = MetricUpdate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1378,13 +1679,13 @@ func (x *GetProfileReply) Reset() {
 	}
 }
 
-func (x *GetProfileReply) String() string {
+func (x *MetricUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileReply) ProtoMessage() {}
+func (*MetricUpdate) ProtoMessage() {}
 
-func (x *GetProfileReply) ProtoReflect() protoreflect.Message {
+func (x *MetricUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1396,30 +1697,48 @@ func (x *GetProfileReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileReply.ProtoReflect.Descriptor instead.
-func (*GetProfileReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricUpdate.ProtoReflect.Descriptor instead.
+func (*MetricUpdate) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetProfileReply) GetData() []byte {
+func (x *MetricUpdate) GetDefs() []*MetricDef {
 	if x != nil {
-		return x.Data
+		return x.Defs
 	}
 	return nil
 }
 
-// UpdateRoutingInfoRequest is a request from an envelope to the weavelet to
-// update its routing information for a particular component.
-type UpdateRoutingInfoRequest struct {
+func (x *MetricUpdate) GetValues() []*MetricValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *MetricUpdate) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+// MetricDef is the definition of a new metric.
+type MetricDef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoutingInfo *RoutingInfo `protobuf:"bytes,1,opt,name=routing_info,json=routingInfo,proto3" json:"routing_info,omitempty"`
+	Id     uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                                // metric's unique id
+	Name   string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                                                             // name of the metric
+	Typ    MetricType        `protobuf:"varint,3,opt,name=typ,proto3,enum=runtime.MetricType" json:"typ,omitempty"`                                                                      // type of metric
+	Help   string            `protobuf:"bytes,4,opt,name=help,proto3" json:"help,omitempty"`                                                                                             // metric's help message
+	Labels map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // metric labels
+	Bounds []float64         `protobuf:"fixed64,6,rep,packed,name=bounds,proto3" json:"bounds,omitempty"`                                                                                // histogram bucket bounds
 }
 
-func (x *UpdateRoutingInfoRequest) Reset() {
-	*x = UpdateRoutingInfoRequest{}
+func (x *MetricDef) Reset() {
+	*x = MetricDef{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1427,13 +1746,13 @@ func (x *UpdateRoutingInfoRequest) Reset() {
 	}
 }
 
-func (x *UpdateRoutingInfoRequest) String() string {
+func (x *MetricDef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoutingInfoRequest) ProtoMessage() {}
+func (*MetricDef) ProtoMessage() {}
 
-func (x *UpdateRoutingInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *MetricDef) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1445,95 +1764,83 @@ func (x *UpdateRoutingInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoutingInfoRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRoutingInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricDef.ProtoReflect.Descriptor instead.
+func (*MetricDef) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdateRoutingInfoRequest) GetRoutingInfo() *RoutingInfo {
+func (x *MetricDef) GetId() uint64 {
 	if x != nil {
-		return x.RoutingInfo
+		return x.Id
 	}
-	return nil
-}
-
-// UpdateRoutingInfoReply is a reply to an UpdateRoutingInfoRequest.
-type UpdateRoutingInfoReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return 0
 }
 
-func (x *UpdateRoutingInfoReply) Reset() {
-	*x = UpdateRoutingInfoReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MetricDef) GetName() string {
+	if x != nil {
+		return x.Name
 	}
+	return ""
 }
 
-func (x *UpdateRoutingInfoReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MetricDef) GetTyp() MetricType {
+	if x != nil {
+		return x.Typ
+	}
+	return MetricType_INVALID
 }
 
-func (*UpdateRoutingInfoReply) ProtoMessage() {}
-
-func (x *UpdateRoutingInfoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *MetricDef) GetHelp() string {
+	if x != nil {
+		return x.Help
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateRoutingInfoReply.ProtoReflect.Descriptor instead.
-func (*UpdateRoutingInfoReply) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{18}
+func (x *MetricDef) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
 }
 
-// RoutingInfo contains routing information for a component. A weavelet uses a
-// RoutingInfo to know where to send a remote method call.
-type RoutingInfo struct {
+func (x *MetricDef) GetBounds() []float64 {
+	if x != nil {
+		return x.Bounds
+	}
+	return nil
+}
+
+// MetricValue is the value associated with a metric.
+type MetricValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
-	// If true, perform method calls on the component locally. Replicas and
-	// assignment are ignored. Currently, local must always be true or always be
-	// false for a given component.
-	Local bool `protobuf:"varint,2,opt,name=local,proto3" json:"local,omitempty"`
-	// The addresses of the weavelets hosting a replica of the component. Every
-	// address is of the form "<net>://<addr>" (e.g., "tcp://host:1234",
-	// "unix:///tmp/unix.sock"). For non-local components, a weavelet will execute
-	// method calls on one of these replicas.
-	Replicas []string `protobuf:"bytes,3,rep,name=replicas,proto3" json:"replicas,omitempty"`
-	// Routing assignment, if the component is routed.
-	Assignment *Assignment `protobuf:"bytes,4,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	Id     uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                // metric's unique id.
+	Value  float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`         // value for counter and gauge, sum for histogram
+	Counts []uint64 `protobuf:"varint,3,rep,packed,name=counts,proto3" json:"counts,omitempty"` // histogram counts
+	// The latest histogram exemplars, at most one per bucket.
+	Exemplars []*Exemplar `protobuf:"bytes,4,rep,name=exemplars,proto3" json:"exemplars,omitempty"`
 }
 
-func (x *RoutingInfo) Reset() {
-	*x = RoutingInfo{}
+func (x *MetricValue) Reset() {
+	*x = MetricValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[19]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RoutingInfo) String() string {
+func (x *MetricValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoutingInfo) ProtoMessage() {}
+func (*MetricValue) ProtoMessage() {}
 
-func (x *RoutingInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[19]
+func (x *MetricValue) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1544,70 +1851,70 @@ func (x *RoutingInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoutingInfo.ProtoReflect.Descriptor instead.
-func (*RoutingInfo) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use MetricValue.ProtoReflect.Descriptor instead.
+func (*MetricValue) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *RoutingInfo) GetComponent() string {
+func (x *MetricValue) GetId() uint64 {
 	if x != nil {
-		return x.Component
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *RoutingInfo) GetLocal() bool {
+func (x *MetricValue) GetValue() float64 {
 	if x != nil {
-		return x.Local
+		return x.Value
 	}
-	return false
+	return 0
 }
 
-func (x *RoutingInfo) GetReplicas() []string {
+func (x *MetricValue) GetCounts() []uint64 {
 	if x != nil {
-		return x.Replicas
+		return x.Counts
 	}
 	return nil
 }
 
-func (x *RoutingInfo) GetAssignment() *Assignment {
+func (x *MetricValue) GetExemplars() []*Exemplar {
 	if x != nil {
-		return x.Assignment
+		return x.Exemplars
 	}
 	return nil
 }
 
-// Assignment partitions a key space (e.g., the hash space [0, 2^64)) into a set
-// of subregions, called slices, and assigns each slice to a set of replicas.
-type Assignment struct {
+// Exemplar is a value put in a histogram, linked to the trace span of the
+// operation that produced it.
+type Exemplar struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Slices []*Assignment_Slice `protobuf:"bytes,1,rep,name=slices,proto3" json:"slices,omitempty"`
-	// The assignment for a component can change over time. Every one of these
-	// assignments is given a monotonically increasing version. Versions are
-	// unique for a given component, but not unique across components.
-	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Bucket     int32   `protobuf:"varint,1,opt,name=bucket,proto3" json:"bucket,omitempty"`                           // index of the value's bucket in the counts
+	Value      float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`                            // value put in the histogram
+	TraceId    []byte  `protobuf:"bytes,3,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`           // 16 bytes
+	SpanId     []byte  `protobuf:"bytes,4,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`              // 8 bytes
+	TimeMicros int64   `protobuf:"varint,5,opt,name=time_micros,json=timeMicros,proto3" json:"time_micros,omitempty"` // when the value was put
 }
 
-func (x *Assignment) Reset() {
-	*x = Assignment{}
+func (x *Exemplar) Reset() {
+	*x = Exemplar{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[20]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Assignment) String() string {
+func (x *Exemplar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Assignment) ProtoMessage() {}
+func (*Exemplar) ProtoMessage() {}
 
-func (x *Assignment) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[20]
+func (x *Exemplar) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1618,53 +1925,83 @@ func (x *Assignment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
-func (*Assignment) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Exemplar.ProtoReflect.Descriptor instead.
+func (*Exemplar) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Assignment) GetSlices() []*Assignment_Slice {
+func (x *Exemplar) GetBucket() int32 {
 	if x != nil {
-		return x.Slices
+		return x.Bucket
+	}
+	return 0
+}
+
+func (x *Exemplar) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Exemplar) GetTraceId() []byte {
+	if x != nil {
+		return x.TraceId
 	}
 	return nil
 }
 
-func (x *Assignment) GetVersion() uint64 {
+func (x *Exemplar) GetSpanId() []byte {
 	if x != nil {
-		return x.Version
+		return x.SpanId
+	}
+	return nil
+}
+
+func (x *Exemplar) GetTimeMicros() int64 {
+	if x != nil {
+		return x.TimeMicros
 	}
 	return 0
 }
 
-// UpdateComponentsRequest is a request from an envelope to the weavelet to
-// update the set of components it should be running. Currently, the set of
-// components must only ever increase over time.
-type UpdateComponentsRequest struct {
+// MetricSnapshot is a snapshot of a metric. It is the union of a MetricDef and
+// a MetricValue.
+//
+// TODO(mwhittaker): Move out of runtime.proto. It's not used by the pipe.
+type MetricSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Components []string `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	Id        uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                                // metric's unique id
+	Name      string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                                                             // name of the metric
+	Typ       MetricType        `protobuf:"varint,3,opt,name=typ,proto3,enum=runtime.MetricType" json:"typ,omitempty"`                                                                      // type of metric
+	Help      string            `protobuf:"bytes,4,opt,name=help,proto3" json:"help,omitempty"`                                                                                             // metric's help message
+	Labels    map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // metric labels
+	Bounds    []float64         `protobuf:"fixed64,6,rep,packed,name=bounds,proto3" json:"bounds,omitempty"`                                                                                // histogram bucket bounds
+	Value     float64           `protobuf:"fixed64,7,opt,name=value,proto3" json:"value,omitempty"`                                                                                         // value for counter and gauge, sum for histogram
+	Counts    []uint64          `protobuf:"varint,8,rep,packed,name=counts,proto3" json:"counts,omitempty"`                                                                                 // histogram counts
+	Exemplars []*Exemplar       `protobuf:"bytes,9,rep,name=exemplars,proto3" json:"exemplars,omitempty"`                                                                                   // histogram exemplars
 }
 
-func (x *UpdateComponentsRequest) Reset() {
-	*x = UpdateComponentsRequest{}
+func (x *MetricSnapshot) Reset() {
+	*x = MetricSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[21]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateComponentsRequest) String() string {
+func (x *MetricSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateComponentsRequest) ProtoMessage() {}
+func (*MetricSnapshot) ProtoMessage() {}
 
-func (x *UpdateComponentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[21]
+func (x *MetricSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1675,42 +2012,98 @@ func (x *UpdateComponentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateComponentsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateComponentsRequest) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use MetricSnapshot.ProtoReflect.Descriptor instead.
+func (*MetricSnapshot) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UpdateComponentsRequest) GetComponents() []string {
+func (x *MetricSnapshot) GetId() uint64 {
 	if x != nil {
-		return x.Components
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MetricSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MetricSnapshot) GetTyp() MetricType {
+	if x != nil {
+		return x.Typ
+	}
+	return MetricType_INVALID
+}
+
+func (x *MetricSnapshot) GetHelp() string {
+	if x != nil {
+		return x.Help
+	}
+	return ""
+}
+
+func (x *MetricSnapshot) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
 	}
 	return nil
 }
 
-// UpdateComponentsReply is a reply to an UpdateComponentsRequest.
-type UpdateComponentsReply struct {
+func (x *MetricSnapshot) GetBounds() []float64 {
+	if x != nil {
+		return x.Bounds
+	}
+	return nil
+}
+
+func (x *MetricSnapshot) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *MetricSnapshot) GetCounts() []uint64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *MetricSnapshot) GetExemplars() []*Exemplar {
+	if x != nil {
+		return x.Exemplars
+	}
+	return nil
+}
+
+// GetLoadRequest is a request from an envelope for a weavelet's load report.
+type GetLoadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UpdateComponentsReply) Reset() {
-	*x = UpdateComponentsReply{}
+func (x *GetLoadRequest) Reset() {
+	*x = GetLoadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[22]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateComponentsReply) String() string {
+func (x *GetLoadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateComponentsReply) ProtoMessage() {}
+func (*GetLoadRequest) ProtoMessage() {}
 
-func (x *UpdateComponentsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[22]
+func (x *GetLoadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1721,41 +2114,37 @@ func (x *UpdateComponentsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateComponentsReply.ProtoReflect.Descriptor instead.
-func (*UpdateComponentsReply) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetLoadRequest.ProtoReflect.Descriptor instead.
+func (*GetLoadRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{21}
 }
 
-// ActivateComponentRequest is a request from a weavelet to ensure that the
-// provided component is running somewhere. An ActivateComponentRequest also
-// implicitly signals that a weavelet is interested in receiving routing info
-// for the component via UpdateRoutingInfoRequest.
-type ActivateComponentRequest struct {
+// GetLoadReply is a reply to a GetLoadRequest.
+type GetLoadReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"` // component name
-	Routed    bool   `protobuf:"varint,2,opt,name=routed,proto3" json:"routed,omitempty"`      // is the component routed?
+	Load *LoadReport `protobuf:"bytes,1,opt,name=load,proto3" json:"load,omitempty"`
 }
 
-func (x *ActivateComponentRequest) Reset() {
-	*x = ActivateComponentRequest{}
+func (x *GetLoadReply) Reset() {
+	*x = GetLoadReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[23]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActivateComponentRequest) String() string {
+func (x *GetLoadReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateComponentRequest) ProtoMessage() {}
+func (*GetLoadReply) ProtoMessage() {}
 
-func (x *ActivateComponentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[23]
+func (x *GetLoadReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1766,34 +2155,90 @@ func (x *ActivateComponentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateComponentRequest.ProtoReflect.Descriptor instead.
-func (*ActivateComponentRequest) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetLoadReply.ProtoReflect.Descriptor instead.
+func (*GetLoadReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ActivateComponentRequest) GetComponent() string {
+func (x *GetLoadReply) GetLoad() *LoadReport {
 	if x != nil {
-		return x.Component
+		return x.Load
 	}
-	return ""
+	return nil
 }
 
-func (x *ActivateComponentRequest) GetRouted() bool {
+// LoadReport contains load information for all routed components hosted by a
+// particular weavelet.
+type LoadReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loads map[string]*LoadReport_ComponentLoad `protobuf:"bytes,1,rep,name=loads,proto3" json:"loads,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // load for routed components
+}
+
+func (x *LoadReport) Reset() {
+	*x = LoadReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadReport) ProtoMessage() {}
+
+func (x *LoadReport) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadReport.ProtoReflect.Descriptor instead.
+func (*LoadReport) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LoadReport) GetLoads() map[string]*LoadReport_ComponentLoad {
 	if x != nil {
-		return x.Routed
+		return x.Loads
 	}
-	return false
+	return nil
 }
 
-// ActivateComponentReply is a reply to an ActivateComponentRequest.
-type ActivateComponentReply struct {
+// GetProfileRequest is a request from an envelope for a weavelet to collect and
+// return a profile. Some profile types only support a single profile request at a
+// time and an error will be returned if another profile request is active.
+//
+// The samples of CPU profiles carry a "component" label with the full name of
+// the component whose code was running, for code run by a component's Init
+// method, by a method call received from another weavelet, or by goroutines
+// started from them. Calls between components in the same weavelet run in the
+// caller's goroutine and keep the caller's label. Labeling costs a context
+// allocation and two goroutine label updates per remote method call.
+type GetProfileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Type of the profile (e.g., heap, cpu).
+	ProfileType ProfileType `protobuf:"varint,1,opt,name=profile_type,json=profileType,proto3,enum=runtime.ProfileType" json:"profile_type,omitempty"`
+	// Duration of CPU profiles, in nanoseconds.
+	CpuDurationNs int64 `protobuf:"varint,2,opt,name=cpu_duration_ns,json=cpuDurationNs,proto3" json:"cpu_duration_ns,omitempty"`
 }
 
-func (x *ActivateComponentReply) Reset() {
-	*x = ActivateComponentReply{}
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1801,13 +2246,13 @@ func (x *ActivateComponentReply) Reset() {
 	}
 }
 
-func (x *ActivateComponentReply) String() string {
+func (x *GetProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateComponentReply) ProtoMessage() {}
+func (*GetProfileRequest) ProtoMessage() {}
 
-func (x *ActivateComponentReply) ProtoReflect() protoreflect.Message {
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1819,34 +2264,36 @@ func (x *ActivateComponentReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateComponentReply.ProtoReflect.Descriptor instead.
-func (*ActivateComponentReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{24}
 }
 
-// GetListenerAddressRequest is a request from a weavelet for the address the
-// weavelet should listen on for a particular listener.
-//
-// Imagine a weavelet calls GetListener with LocalAdress localhost:9000. Here
-// are some examples of how different deployers may handle a
-// GetListenerAddressRequest.
-//
-//   - The singleprocess deployer may instruct the weavelet to listen directly
-//     on localhost:9000.
-//   - The multiprocess deployer may instruct the weavelet to listen on
-//     localhost:0. It will separately start a proxy on localhost:9000.
-//   - The SSH deployer may instruct the weavelet to listen on
-//     $HOSTNAME:0. It will separately start a proxy on localhost:9000.
-type GetListenerAddressRequest struct {
+func (x *GetProfileRequest) GetProfileType() ProfileType {
+	if x != nil {
+		return x.ProfileType
+	}
+	return ProfileType_Unspecified
+}
+
+func (x *GetProfileRequest) GetCpuDurationNs() int64 {
+	if x != nil {
+		return x.CpuDurationNs
+	}
+	return 0
+}
+
+// GetProfileReply is a reply to a GetProfileRequest.
+type GetProfileReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // listener name
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // encoded profile data
 }
 
-func (x *GetListenerAddressRequest) Reset() {
-	*x = GetListenerAddressRequest{}
+func (x *GetProfileReply) Reset() {
+	*x = GetProfileReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1854,13 +2301,13 @@ func (x *GetListenerAddressRequest) Reset() {
 	}
 }
 
-func (x *GetListenerAddressRequest) String() string {
+func (x *GetProfileReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetListenerAddressRequest) ProtoMessage() {}
+func (*GetProfileReply) ProtoMessage() {}
 
-func (x *GetListenerAddressRequest) ProtoReflect() protoreflect.Message {
+func (x *GetProfileReply) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1872,29 +2319,35 @@ func (x *GetListenerAddressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetListenerAddressRequest.ProtoReflect.Descriptor instead.
-func (*GetListenerAddressRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProfileReply.ProtoReflect.Descriptor instead.
+func (*GetProfileReply) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetListenerAddressRequest) GetName() string {
+func (x *GetProfileReply) GetData() []byte {
 	if x != nil {
-		return x.Name
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-// GetListenerAddressReply is a reply to a GetListenerAddressRequest.
-type GetListenerAddressReply struct {
+// GetProfileBundleRequest is a request from an envelope for profiles of
+// different types, collected as close together in time as possible. The
+// profiles are collected concurrently, so the duration of the request is
+// dominated by the duration of the CPU profile, if one is requested.
+type GetProfileBundleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // address to listen on
+	// The distinct types of the profiles (e.g., heap, cpu).
+	ProfileTypes []ProfileType `protobuf:"varint,1,rep,packed,name=profile_types,json=profileTypes,proto3,enum=runtime.ProfileType" json:"profile_types,omitempty"`
+	// Duration of the CPU profile, in nanoseconds.
+	CpuDurationNs int64 `protobuf:"varint,2,opt,name=cpu_duration_ns,json=cpuDurationNs,proto3" json:"cpu_duration_ns,omitempty"`
 }
 
-func (x *GetListenerAddressReply) Reset() {
-	*x = GetListenerAddressReply{}
+func (x *GetProfileBundleRequest) Reset() {
+	*x = GetProfileBundleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1902,13 +2355,13 @@ func (x *GetListenerAddressReply) Reset() {
 	}
 }
 
-func (x *GetListenerAddressReply) String() string {
+func (x *GetProfileBundleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetListenerAddressReply) ProtoMessage() {}
+func (*GetProfileBundleRequest) ProtoMessage() {}
 
-func (x *GetListenerAddressReply) ProtoReflect() protoreflect.Message {
+func (x *GetProfileBundleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1920,32 +2373,36 @@ func (x *GetListenerAddressReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetListenerAddressReply.ProtoReflect.Descriptor instead.
-func (*GetListenerAddressReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProfileBundleRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileBundleRequest) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetListenerAddressReply) GetAddress() string {
+func (x *GetProfileBundleRequest) GetProfileTypes() []ProfileType {
 	if x != nil {
-		return x.Address
+		return x.ProfileTypes
 	}
-	return ""
+	return nil
 }
 
-// ExportListenerRequest is a request from a weavelet to export the provided
-// listener. Exporting a listener typically, but not always, involves running a
-// proxy that forwards traffic to the provided address.
-type ExportListenerRequest struct {
+func (x *GetProfileBundleRequest) GetCpuDurationNs() int64 {
+	if x != nil {
+		return x.CpuDurationNs
+	}
+	return 0
+}
+
+// GetProfileBundleReply is a reply to a GetProfileBundleRequest.
+type GetProfileBundleReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Listener string `protobuf:"bytes,1,opt,name=listener,proto3" json:"listener,omitempty"`
-	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Profiles []*ProfileData `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"` // one per requested profile type
 }
 
-func (x *ExportListenerRequest) Reset() {
-	*x = ExportListenerRequest{}
+func (x *GetProfileBundleReply) Reset() {
+	*x = GetProfileBundleReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1953,13 +2410,13 @@ func (x *ExportListenerRequest) Reset() {
 	}
 }
 
-func (x *ExportListenerRequest) String() string {
+func (x *GetProfileBundleReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportListenerRequest) ProtoMessage() {}
+func (*GetProfileBundleReply) ProtoMessage() {}
 
-func (x *ExportListenerRequest) ProtoReflect() protoreflect.Message {
+func (x *GetProfileBundleReply) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1971,44 +2428,30 @@ func (x *ExportListenerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportListenerRequest.ProtoReflect.Descriptor instead.
-func (*ExportListenerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProfileBundleReply.ProtoReflect.Descriptor instead.
+func (*GetProfileBundleReply) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ExportListenerRequest) GetListener() string {
-	if x != nil {
-		return x.Listener
-	}
-	return ""
-}
-
-func (x *ExportListenerRequest) GetAddress() string {
+func (x *GetProfileBundleReply) GetProfiles() []*ProfileData {
 	if x != nil {
-		return x.Address
+		return x.Profiles
 	}
-	return ""
+	return nil
 }
 
-// ExportListenerReply is a reply to an ExportListenerRequest.
-type ExportListenerReply struct {
+// ProfileData is a profile of a given type.
+type ProfileData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The address of the proxy that forwards traffic to the listener, or empty if
-	// there is no proxy.
-	ProxyAddress string `protobuf:"bytes,1,opt,name=proxy_address,json=proxyAddress,proto3" json:"proxy_address,omitempty"`
-	// If non-empty, a non-retriable error.
-	//
-	// TODO(mwhittaker): This field exists as a hack to avoid a weavelet retrying
-	// this error. In the future, we should have a more robust way to avoid
-	// retrying certain errors.
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ProfileType ProfileType `protobuf:"varint,1,opt,name=profile_type,json=profileType,proto3,enum=runtime.ProfileType" json:"profile_type,omitempty"`
+	Data        []byte      `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // encoded profile data
 }
 
-func (x *ExportListenerReply) Reset() {
-	*x = ExportListenerReply{}
+func (x *ProfileData) Reset() {
+	*x = ProfileData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2016,13 +2459,13 @@ func (x *ExportListenerReply) Reset() {
 	}
 }
 
-func (x *ExportListenerReply) String() string {
+func (x *ProfileData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportListenerReply) ProtoMessage() {}
+func (*ProfileData) ProtoMessage() {}
 
-func (x *ExportListenerReply) ProtoReflect() protoreflect.Message {
+func (x *ProfileData) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2034,35 +2477,35 @@ func (x *ExportListenerReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportListenerReply.ProtoReflect.Descriptor instead.
-func (*ExportListenerReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProfileData.ProtoReflect.Descriptor instead.
+func (*ProfileData) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ExportListenerReply) GetProxyAddress() string {
+func (x *ProfileData) GetProfileType() ProfileType {
 	if x != nil {
-		return x.ProxyAddress
+		return x.ProfileType
 	}
-	return ""
+	return ProfileType_Unspecified
 }
 
-func (x *ExportListenerReply) GetError() string {
+func (x *ProfileData) GetData() []byte {
 	if x != nil {
-		return x.Error
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-// GetSelfCertificateRequest is a request from a weavelet for its certificate
-// and the corresponding private key.
-type GetSelfCertificateRequest struct {
+// ListProfilesRequest is a request from an envelope for the profiles a
+// weavelet is currently collecting.
+type ListProfilesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetSelfCertificateRequest) Reset() {
-	*x = GetSelfCertificateRequest{}
+func (x *ListProfilesRequest) Reset() {
+	*x = ListProfilesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2070,13 +2513,13 @@ func (x *GetSelfCertificateRequest) Reset() {
 	}
 }
 
-func (x *GetSelfCertificateRequest) String() string {
+func (x *ListProfilesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSelfCertificateRequest) ProtoMessage() {}
+func (*ListProfilesRequest) ProtoMessage() {}
 
-func (x *GetSelfCertificateRequest) ProtoReflect() protoreflect.Message {
+func (x *ListProfilesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2088,26 +2531,22 @@ func (x *GetSelfCertificateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSelfCertificateRequest.ProtoReflect.Descriptor instead.
-func (*GetSelfCertificateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListProfilesRequest.ProtoReflect.Descriptor instead.
+func (*ListProfilesRequest) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{29}
 }
 
-// GetSelfCertificateReply is a reply to a GetSelfCertificateRequest.
-type GetSelfCertificateReply struct {
+// ListProfilesReply is a reply to a ListProfilesRequest.
+type ListProfilesReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// PEM-encoded blocks containing (1) the certificate identifying the
-	// weavelet and (2) the corresponding private key. Used for enforcing mTLS
-	// security across components.
-	Cert []byte `protobuf:"bytes,1,opt,name=cert,proto3" json:"cert,omitempty"` // certificate identifying the weavelet
-	Key  []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`   // private key corresponding to cert
+	Profiles []*ProfileInfo `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
 }
 
-func (x *GetSelfCertificateReply) Reset() {
-	*x = GetSelfCertificateReply{}
+func (x *ListProfilesReply) Reset() {
+	*x = ListProfilesReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2115,13 +2554,13 @@ func (x *GetSelfCertificateReply) Reset() {
 	}
 }
 
-func (x *GetSelfCertificateReply) String() string {
+func (x *ListProfilesReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSelfCertificateReply) ProtoMessage() {}
+func (*ListProfilesReply) ProtoMessage() {}
 
-func (x *GetSelfCertificateReply) ProtoReflect() protoreflect.Message {
+func (x *ListProfilesReply) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2133,39 +2572,32 @@ func (x *GetSelfCertificateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSelfCertificateReply.ProtoReflect.Descriptor instead.
-func (*GetSelfCertificateReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListProfilesReply.ProtoReflect.Descriptor instead.
+func (*ListProfilesReply) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetSelfCertificateReply) GetCert() []byte {
-	if x != nil {
-		return x.Cert
-	}
-	return nil
-}
-
-func (x *GetSelfCertificateReply) GetKey() []byte {
+func (x *ListProfilesReply) GetProfiles() []*ProfileInfo {
 	if x != nil {
-		return x.Key
+		return x.Profiles
 	}
 	return nil
 }
 
-// VerifyClientCertificateRequest is a request from a weavelet to verify
-// the identity of a client that is attempting to connect to the weavelet.
-type VerifyClientCertificateRequest struct {
+// ProfileInfo describes a profile that is being collected by a weavelet.
+type ProfileInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Client's certificate chain. Each certificate in the chain is represented as
-	// a DER-encoded ASN.1 structure.
-	CertChain [][]byte `protobuf:"bytes,1,rep,name=cert_chain,json=certChain,proto3" json:"cert_chain,omitempty"`
+	Id            string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                // unique profile id
+	ProfileType   ProfileType `protobuf:"varint,2,opt,name=profile_type,json=profileType,proto3,enum=runtime.ProfileType" json:"profile_type,omitempty"` // type of the profile
+	StartMicros   int64       `protobuf:"fixed64,3,opt,name=start_micros,json=startMicros,proto3" json:"start_micros,omitempty"`                         // start time (microseconds since epoch)
+	CpuDurationNs int64       `protobuf:"varint,4,opt,name=cpu_duration_ns,json=cpuDurationNs,proto3" json:"cpu_duration_ns,omitempty"`                  // requested duration of CPU profiles
 }
 
-func (x *VerifyClientCertificateRequest) Reset() {
-	*x = VerifyClientCertificateRequest{}
+func (x *ProfileInfo) Reset() {
+	*x = ProfileInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2173,13 +2605,13 @@ func (x *VerifyClientCertificateRequest) Reset() {
 	}
 }
 
-func (x *VerifyClientCertificateRequest) String() string {
+func (x *ProfileInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyClientCertificateRequest) ProtoMessage() {}
+func (*ProfileInfo) ProtoMessage() {}
 
-func (x *VerifyClientCertificateRequest) ProtoReflect() protoreflect.Message {
+func (x *ProfileInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2191,33 +2623,51 @@ func (x *VerifyClientCertificateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyClientCertificateRequest.ProtoReflect.Descriptor instead.
-func (*VerifyClientCertificateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProfileInfo.ProtoReflect.Descriptor instead.
+func (*ProfileInfo) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *VerifyClientCertificateRequest) GetCertChain() [][]byte {
+func (x *ProfileInfo) GetId() string {
 	if x != nil {
-		return x.CertChain
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// VerifyClientCertificateReply is a reply to a VerifyClientCertificateRequest.
-// If the client certificate cannot be verified, an error should be returned
-// rather than an empty reply.
-type VerifyClientCertificateReply struct {
+func (x *ProfileInfo) GetProfileType() ProfileType {
+	if x != nil {
+		return x.ProfileType
+	}
+	return ProfileType_Unspecified
+}
+
+func (x *ProfileInfo) GetStartMicros() int64 {
+	if x != nil {
+		return x.StartMicros
+	}
+	return 0
+}
+
+func (x *ProfileInfo) GetCpuDurationNs() int64 {
+	if x != nil {
+		return x.CpuDurationNs
+	}
+	return 0
+}
+
+// CancelProfileRequest is a request from an envelope for a weavelet to cancel
+// a profile that is being collected. The corresponding GetProfileRequest fails.
+type CancelProfileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The set of components hosted by the weavelet that the client is allowed to
-	// invoke methods on.
-	Components []string `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // see ProfileInfo.id
 }
 
-func (x *VerifyClientCertificateReply) Reset() {
-	*x = VerifyClientCertificateReply{}
+func (x *CancelProfileRequest) Reset() {
+	*x = CancelProfileRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2225,13 +2675,13 @@ func (x *VerifyClientCertificateReply) Reset() {
 	}
 }
 
-func (x *VerifyClientCertificateReply) String() string {
+func (x *CancelProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyClientCertificateReply) ProtoMessage() {}
+func (*CancelProfileRequest) ProtoMessage() {}
 
-func (x *VerifyClientCertificateReply) ProtoReflect() protoreflect.Message {
+func (x *CancelProfileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2243,34 +2693,27 @@ func (x *VerifyClientCertificateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyClientCertificateReply.ProtoReflect.Descriptor instead.
-func (*VerifyClientCertificateReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelProfileRequest.ProtoReflect.Descriptor instead.
+func (*CancelProfileRequest) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *VerifyClientCertificateReply) GetComponents() []string {
+func (x *CancelProfileRequest) GetId() string {
 	if x != nil {
-		return x.Components
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// VerifyServerCertificateRequest is a request from a weavelet to verify
-// the identity of the server it is attempting to connect to.
-type VerifyServerCertificateRequest struct {
+// CancelProfileReply is a reply to a CancelProfileRequest.
+type CancelProfileReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Server's certificate chain. Each certificate in the chain is represented as
-	// a DER-encoded ASN.1 structure.
-	CertChain [][]byte `protobuf:"bytes,1,rep,name=cert_chain,json=certChain,proto3" json:"cert_chain,omitempty"`
-	// Name of the component the server is meant to be hosting.
-	TargetComponent string `protobuf:"bytes,2,opt,name=target_component,json=targetComponent,proto3" json:"target_component,omitempty"`
 }
 
-func (x *VerifyServerCertificateRequest) Reset() {
-	*x = VerifyServerCertificateRequest{}
+func (x *CancelProfileReply) Reset() {
+	*x = CancelProfileReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2278,13 +2721,13 @@ func (x *VerifyServerCertificateRequest) Reset() {
 	}
 }
 
-func (x *VerifyServerCertificateRequest) String() string {
+func (x *CancelProfileReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyServerCertificateRequest) ProtoMessage() {}
+func (*CancelProfileReply) ProtoMessage() {}
 
-func (x *VerifyServerCertificateRequest) ProtoReflect() protoreflect.Message {
+func (x *CancelProfileReply) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2296,34 +2739,27 @@ func (x *VerifyServerCertificateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyServerCertificateRequest.ProtoReflect.Descriptor instead.
-func (*VerifyServerCertificateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelProfileReply.ProtoReflect.Descriptor instead.
+func (*CancelProfileReply) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *VerifyServerCertificateRequest) GetCertChain() [][]byte {
-	if x != nil {
-		return x.CertChain
-	}
-	return nil
-}
-
-func (x *VerifyServerCertificateRequest) GetTargetComponent() string {
-	if x != nil {
-		return x.TargetComponent
-	}
-	return ""
-}
-
-// VerifyServerCertificateReply is a reply to a VerifyServerCertificateRequest.
-type VerifyServerCertificateReply struct {
+// SetProfilingRateRequest is a request from an envelope for a weavelet to
+// change the rates at which it samples blocking and mutex contention events,
+// which are reported in Block and Mutex profiles. See
+// runtime.SetBlockProfileRate and runtime.SetMutexProfileFraction. A rate of
+// zero disables the profiling, and a negative rate leaves it unchanged.
+type SetProfilingRateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	BlockRate     int64 `protobuf:"varint,1,opt,name=block_rate,json=blockRate,proto3" json:"block_rate,omitempty"`             // nanoseconds spent blocked per sampled event
+	MutexFraction int64 `protobuf:"varint,2,opt,name=mutex_fraction,json=mutexFraction,proto3" json:"mutex_fraction,omitempty"` // 1/n of the contention events are sampled
 }
 
-func (x *VerifyServerCertificateReply) Reset() {
-	*x = VerifyServerCertificateReply{}
+func (x *SetProfilingRateRequest) Reset() {
+	*x = SetProfilingRateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2331,13 +2767,13 @@ func (x *VerifyServerCertificateReply) Reset() {
 	}
 }
 
-func (x *VerifyServerCertificateReply) String() string {
+func (x *SetProfilingRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyServerCertificateReply) ProtoMessage() {}
+func (*SetProfilingRateRequest) ProtoMessage() {}
 
-func (x *VerifyServerCertificateReply) ProtoReflect() protoreflect.Message {
+func (x *SetProfilingRateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2349,36 +2785,37 @@ func (x *VerifyServerCertificateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyServerCertificateReply.ProtoReflect.Descriptor instead.
-func (*VerifyServerCertificateReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetProfilingRateRequest.ProtoReflect.Descriptor instead.
+func (*SetProfilingRateRequest) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{34}
 }
 
-// LogEntry is a log entry. Every log entry consists of a message (the thing the
-// user logged) and a set of metadata describing the message.
-type LogEntry struct {
+func (x *SetProfilingRateRequest) GetBlockRate() int64 {
+	if x != nil {
+		return x.BlockRate
+	}
+	return 0
+}
+
+func (x *SetProfilingRateRequest) GetMutexFraction() int64 {
+	if x != nil {
+		return x.MutexFraction
+	}
+	return 0
+}
+
+// SetProfilingRateReply is a reply to a SetProfilingRateRequest.
+type SetProfilingRateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	App        string `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`                                   // application name
-	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                           // deployment id
-	Component  string `protobuf:"bytes,3,opt,name=component,proto3" json:"component,omitempty"`                       // component name
-	Node       string `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`                                 // weavelet id
-	TimeMicros int64  `protobuf:"fixed64,5,opt,name=time_micros,json=timeMicros,proto3" json:"time_micros,omitempty"` // time when logged (microseconds since epoch)
-	Level      string `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"`                               // log level (e.g., debug, info, error)
-	File       string `protobuf:"bytes,7,opt,name=file,proto3" json:"file,omitempty"`                                 // source file
-	Line       int32  `protobuf:"varint,8,opt,name=line,proto3" json:"line,omitempty"`                                // source line
-	Msg        string `protobuf:"bytes,9,opt,name=msg,proto3" json:"msg,omitempty"`                                   // user provided message
-	// User-provided attributes and their associated values. The storage format
-	// is a name followed by its value, i.e.:
-	//
-	//	name1, value1, name2, value2, name3, value3, ...
-	Attrs []string `protobuf:"bytes,10,rep,name=attrs,proto3" json:"attrs,omitempty"`
+	PrevBlockRate     int64 `protobuf:"varint,1,opt,name=prev_block_rate,json=prevBlockRate,proto3" json:"prev_block_rate,omitempty"`             // block rate before the request
+	PrevMutexFraction int64 `protobuf:"varint,2,opt,name=prev_mutex_fraction,json=prevMutexFraction,proto3" json:"prev_mutex_fraction,omitempty"` // mutex fraction before the request
 }
 
-func (x *LogEntry) Reset() {
-	*x = LogEntry{}
+func (x *SetProfilingRateReply) Reset() {
+	*x = SetProfilingRateReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2386,13 +2823,13 @@ func (x *LogEntry) Reset() {
 	}
 }
 
-func (x *LogEntry) String() string {
+func (x *SetProfilingRateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogEntry) ProtoMessage() {}
+func (*SetProfilingRateReply) ProtoMessage() {}
 
-func (x *LogEntry) ProtoReflect() protoreflect.Message {
+func (x *SetProfilingRateReply) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2404,92 +2841,40 @@ func (x *LogEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
-func (*LogEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetProfilingRateReply.ProtoReflect.Descriptor instead.
+func (*SetProfilingRateReply) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *LogEntry) GetApp() string {
-	if x != nil {
-		return x.App
-	}
-	return ""
-}
-
-func (x *LogEntry) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *LogEntry) GetComponent() string {
-	if x != nil {
-		return x.Component
-	}
-	return ""
-}
-
-func (x *LogEntry) GetNode() string {
-	if x != nil {
-		return x.Node
-	}
-	return ""
-}
-
-func (x *LogEntry) GetTimeMicros() int64 {
+func (x *SetProfilingRateReply) GetPrevBlockRate() int64 {
 	if x != nil {
-		return x.TimeMicros
+		return x.PrevBlockRate
 	}
 	return 0
 }
 
-func (x *LogEntry) GetLevel() string {
-	if x != nil {
-		return x.Level
-	}
-	return ""
-}
-
-func (x *LogEntry) GetFile() string {
-	if x != nil {
-		return x.File
-	}
-	return ""
-}
-
-func (x *LogEntry) GetLine() int32 {
+func (x *SetProfilingRateReply) GetPrevMutexFraction() int64 {
 	if x != nil {
-		return x.Line
+		return x.PrevMutexFraction
 	}
 	return 0
 }
 
-func (x *LogEntry) GetMsg() string {
-	if x != nil {
-		return x.Msg
-	}
-	return ""
-}
-
-func (x *LogEntry) GetAttrs() []string {
-	if x != nil {
-		return x.Attrs
-	}
-	return nil
-}
-
-// LogEntryBatch is a list of log entries.
-type LogEntryBatch struct {
+// SetComponentEnabledRequest is a request from an envelope for a weavelet to
+// enable or disable one of its components. A disabled component rejects calls
+// from other weavelets, but remains in the set of components hosted by the
+// weavelet and can be re-enabled at any time.
+type SetComponentEnabledRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*LogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Enabled   bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (x *LogEntryBatch) Reset() {
-	*x = LogEntryBatch{}
+func (x *SetComponentEnabledRequest) Reset() {
+	*x = SetComponentEnabledRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2497,13 +2882,13 @@ func (x *LogEntryBatch) Reset() {
 	}
 }
 
-func (x *LogEntryBatch) String() string {
+func (x *SetComponentEnabledRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogEntryBatch) ProtoMessage() {}
+func (*SetComponentEnabledRequest) ProtoMessage() {}
 
-func (x *LogEntryBatch) ProtoReflect() protoreflect.Message {
+func (x *SetComponentEnabledRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2515,29 +2900,34 @@ func (x *LogEntryBatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogEntryBatch.ProtoReflect.Descriptor instead.
-func (*LogEntryBatch) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetComponentEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetComponentEnabledRequest) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *LogEntryBatch) GetEntries() []*LogEntry {
+func (x *SetComponentEnabledRequest) GetComponent() string {
 	if x != nil {
-		return x.Entries
+		return x.Component
 	}
-	return nil
+	return ""
 }
 
-// TraceSpans is a list of Span messages.
-type TraceSpans struct {
+func (x *SetComponentEnabledRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// SetComponentEnabledReply is a reply to a SetComponentEnabledRequest.
+type SetComponentEnabledReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Span []*Span `protobuf:"bytes,1,rep,name=span,proto3" json:"span,omitempty"`
 }
 
-func (x *TraceSpans) Reset() {
-	*x = TraceSpans{}
+func (x *SetComponentEnabledReply) Reset() {
+	*x = SetComponentEnabledReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2545,13 +2935,13 @@ func (x *TraceSpans) Reset() {
 	}
 }
 
-func (x *TraceSpans) String() string {
+func (x *SetComponentEnabledReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TraceSpans) ProtoMessage() {}
+func (*SetComponentEnabledReply) ProtoMessage() {}
 
-func (x *TraceSpans) ProtoReflect() protoreflect.Message {
+func (x *SetComponentEnabledReply) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2563,53 +2953,24 @@ func (x *TraceSpans) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TraceSpans.ProtoReflect.Descriptor instead.
-func (*TraceSpans) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetComponentEnabledReply.ProtoReflect.Descriptor instead.
+func (*SetComponentEnabledReply) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *TraceSpans) GetSpan() []*Span {
-	if x != nil {
-		return x.Span
-	}
-	return nil
-}
-
-// Span is a proto-based representation of an open-telemetry Span [1].
-//
-// [1]: https://opentelemetry.io/docs/concepts/signals/traces/#spans
-// TODO(spetrovic): Rename to TraceSpan.
-type Span struct {
+// ForwardSignalRequest is a request from an envelope for a weavelet to handle
+// an OS signal received by the envelope, as if the signal was sent to the
+// weavelet process directly.
+type ForwardSignalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                       // Span name
-	TraceId      []byte            `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`                  // Span trace id, 16 bytes long.
-	SpanId       []byte            `protobuf:"bytes,3,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`                     // Unique span id, 8 bytes long.
-	ParentSpanId []byte            `protobuf:"bytes,4,opt,name=parent_span_id,json=parentSpanId,proto3" json:"parent_span_id,omitempty"` // Parent span id, 8 bytes long
-	Kind         Span_Kind         `protobuf:"varint,5,opt,name=kind,proto3,enum=runtime.Span_Kind" json:"kind,omitempty"`
-	StartMicros  int64             `protobuf:"fixed64,6,opt,name=start_micros,json=startMicros,proto3" json:"start_micros,omitempty"` // Span start time (microseconds since epoch)
-	EndMicros    int64             `protobuf:"fixed64,7,opt,name=end_micros,json=endMicros,proto3" json:"end_micros,omitempty"`       // Span end time (microseconds since epoch)
-	Attributes   []*Span_Attribute `protobuf:"bytes,8,rep,name=attributes,proto3" json:"attributes,omitempty"`
-	Links        []*Span_Link      `protobuf:"bytes,9,rep,name=links,proto3" json:"links,omitempty"`    // Links to other spans
-	Events       []*Span_Event     `protobuf:"bytes,10,rep,name=events,proto3" json:"events,omitempty"` // Events that occured in the span's lifetime
-	Status       *Span_Status      `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // Span status
-	Scope        *Span_Scope       `protobuf:"bytes,18,opt,name=scope,proto3" json:"scope,omitempty"`
-	Library      *Span_Library     `protobuf:"bytes,12,opt,name=library,proto3" json:"library,omitempty"` // Instrumentation library that created the span
-	Resource     *Span_Resource    `protobuf:"bytes,13,opt,name=resource,proto3" json:"resource,omitempty"`
-	// Number of span attributes dropped due to limits being reached.
-	DroppedAttributeCount int64 `protobuf:"varint,14,opt,name=dropped_attribute_count,json=droppedAttributeCount,proto3" json:"dropped_attribute_count,omitempty"`
-	// Number of links dropped due to limits being reached.
-	DroppedLinkCount int64 `protobuf:"varint,15,opt,name=dropped_link_count,json=droppedLinkCount,proto3" json:"dropped_link_count,omitempty"`
-	// Number of events dropped due to limits being reached.
-	DroppedEventCount int64 `protobuf:"varint,16,opt,name=dropped_event_count,json=droppedEventCount,proto3" json:"dropped_event_count,omitempty"`
-	// Number of spans that consider this span a direct parent.
-	ChildSpanCount int64 `protobuf:"varint,17,opt,name=child_span_count,json=childSpanCount,proto3" json:"child_span_count,omitempty"`
+	Signal int32 `protobuf:"varint,1,opt,name=signal,proto3" json:"signal,omitempty"` // signal number (e.g., 15 for SIGTERM)
 }
 
-func (x *Span) Reset() {
-	*x = Span{}
+func (x *ForwardSignalRequest) Reset() {
+	*x = ForwardSignalRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2617,13 +2978,13 @@ func (x *Span) Reset() {
 	}
 }
 
-func (x *Span) String() string {
+func (x *ForwardSignalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Span) ProtoMessage() {}
+func (*ForwardSignalRequest) ProtoMessage() {}
 
-func (x *Span) ProtoReflect() protoreflect.Message {
+func (x *ForwardSignalRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2635,166 +2996,181 @@ func (x *Span) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Span.ProtoReflect.Descriptor instead.
-func (*Span) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForwardSignalRequest.ProtoReflect.Descriptor instead.
+func (*ForwardSignalRequest) Descriptor() ([]byte, []int) {
 	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Span) GetName() string {
+func (x *ForwardSignalRequest) GetSignal() int32 {
 	if x != nil {
-		return x.Name
+		return x.Signal
 	}
-	return ""
+	return 0
 }
 
-func (x *Span) GetTraceId() []byte {
-	if x != nil {
-		return x.TraceId
-	}
-	return nil
-}
+// ForwardSignalReply is a reply to a ForwardSignalRequest.
+type ForwardSignalReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
 
-func (x *Span) GetSpanId() []byte {
-	if x != nil {
-		return x.SpanId
+func (x *ForwardSignalReply) Reset() {
+	*x = ForwardSignalReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Span) GetParentSpanId() []byte {
-	if x != nil {
-		return x.ParentSpanId
-	}
-	return nil
+func (x *ForwardSignalReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Span) GetKind() Span_Kind {
-	if x != nil {
-		return x.Kind
+func (*ForwardSignalReply) ProtoMessage() {}
+
+func (x *ForwardSignalReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return Span_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Span) GetStartMicros() int64 {
-	if x != nil {
-		return x.StartMicros
-	}
-	return 0
+// Deprecated: Use ForwardSignalReply.ProtoReflect.Descriptor instead.
+func (*ForwardSignalReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *Span) GetEndMicros() int64 {
-	if x != nil {
-		return x.EndMicros
-	}
-	return 0
+// GetReadinessRequest is a request from an envelope for a weavelet to report
+// the status of its readiness gates (see weaver.AddReadinessGate).
+type GetReadinessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Span) GetAttributes() []*Span_Attribute {
-	if x != nil {
-		return x.Attributes
+func (x *GetReadinessRequest) Reset() {
+	*x = GetReadinessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Span) GetLinks() []*Span_Link {
-	if x != nil {
-		return x.Links
-	}
-	return nil
+func (x *GetReadinessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Span) GetEvents() []*Span_Event {
-	if x != nil {
-		return x.Events
+func (*GetReadinessRequest) ProtoMessage() {}
+
+func (x *GetReadinessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Span) GetStatus() *Span_Status {
-	if x != nil {
-		return x.Status
-	}
-	return nil
+// Deprecated: Use GetReadinessRequest.ProtoReflect.Descriptor instead.
+func (*GetReadinessRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *Span) GetScope() *Span_Scope {
-	if x != nil {
-		return x.Scope
-	}
-	return nil
+// GetReadinessReply is a reply to a GetReadinessRequest.
+type GetReadinessReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The readiness of every registered gate, by gate name.
+	Gates map[string]bool `protobuf:"bytes,1,rep,name=gates,proto3" json:"gates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// True iff all gates are ready. A weavelet with no gates is ready.
+	Ready bool `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
 }
 
-func (x *Span) GetLibrary() *Span_Library {
-	if x != nil {
-		return x.Library
+func (x *GetReadinessReply) Reset() {
+	*x = GetReadinessReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Span) GetResource() *Span_Resource {
-	if x != nil {
-		return x.Resource
-	}
-	return nil
+func (x *GetReadinessReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Span) GetDroppedAttributeCount() int64 {
-	if x != nil {
-		return x.DroppedAttributeCount
+func (*GetReadinessReply) ProtoMessage() {}
+
+func (x *GetReadinessReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Span) GetDroppedLinkCount() int64 {
-	if x != nil {
-		return x.DroppedLinkCount
-	}
-	return 0
+// Deprecated: Use GetReadinessReply.ProtoReflect.Descriptor instead.
+func (*GetReadinessReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *Span) GetDroppedEventCount() int64 {
+func (x *GetReadinessReply) GetGates() map[string]bool {
 	if x != nil {
-		return x.DroppedEventCount
+		return x.Gates
 	}
-	return 0
+	return nil
 }
 
-func (x *Span) GetChildSpanCount() int64 {
+func (x *GetReadinessReply) GetReady() bool {
 	if x != nil {
-		return x.ChildSpanCount
+		return x.Ready
 	}
-	return 0
+	return false
 }
 
-// A redirect entry instructs the weavelet to direct calls made to component
-// to be instead sent to the component named target at the specified address.
-type WeaveletArgs_Redirect struct {
+// GetBuildFlagsRequest is a request from an envelope for a weavelet to report
+// the build flags registered by the application (see
+// weaver.RegisterBuildFlag).
+type GetBuildFlagsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
-	Target    string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
-	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *WeaveletArgs_Redirect) Reset() {
-	*x = WeaveletArgs_Redirect{}
+func (x *GetBuildFlagsRequest) Reset() {
+	*x = GetBuildFlagsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[39]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WeaveletArgs_Redirect) String() string {
+func (x *GetBuildFlagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WeaveletArgs_Redirect) ProtoMessage() {}
+func (*GetBuildFlagsRequest) ProtoMessage() {}
 
-func (x *WeaveletArgs_Redirect) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[39]
+func (x *GetBuildFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2805,58 +3181,71 @@ func (x *WeaveletArgs_Redirect) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WeaveletArgs_Redirect.ProtoReflect.Descriptor instead.
-func (*WeaveletArgs_Redirect) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use GetBuildFlagsRequest.ProtoReflect.Descriptor instead.
+func (*GetBuildFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *WeaveletArgs_Redirect) GetComponent() string {
-	if x != nil {
-		return x.Component
+// GetBuildFlagsReply is a reply to a GetBuildFlagsRequest.
+type GetBuildFlagsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags map[string]string `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // flag values, by flag name
+}
+
+func (x *GetBuildFlagsReply) Reset() {
+	*x = GetBuildFlagsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *WeaveletArgs_Redirect) GetTarget() string {
-	if x != nil {
-		return x.Target
+func (x *GetBuildFlagsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuildFlagsReply) ProtoMessage() {}
+
+func (x *GetBuildFlagsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *WeaveletArgs_Redirect) GetAddress() string {
+// Deprecated: Use GetBuildFlagsReply.ProtoReflect.Descriptor instead.
+func (*GetBuildFlagsReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetBuildFlagsReply) GetFlags() map[string]string {
 	if x != nil {
-		return x.Address
+		return x.Flags
 	}
-	return ""
+	return nil
 }
 
-// ComponentLoad includes load information for a component. The component's
-// key space is divided into a number of slices, each of which is associated
-// with a particular double-valued load.
-//
-// TODO(mwhittaker): In the short term, a weavelet will report one SliceLoad
-// for every slice it is assigned. In the future, however, we may report load
-// in more advanced ways.
-type LoadReport_ComponentLoad struct {
+// SetGCPercentRequest is a request from an envelope for a weavelet to set its
+// garbage collection target percentage (see runtime/debug.SetGCPercent).
+type SetGCPercentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// TODO(mwhittaker): In full generality, load is a generic
-	// application-specific double-valued measurement (e.g., total number of
-	// requests, size of components in memory). For now to simplify things, we
-	// assume load is measured as requests per second.
-	Load []*LoadReport_SliceLoad `protobuf:"bytes,1,rep,name=load,proto3" json:"load,omitempty"`
-	// TODO(mwhittaker): For now, load is reported with respect to a given
-	// assignment. When the assignment changes, the load is reset. This approach
-	// works for rate-based load like requests per second, but not other
-	// non-rate based load like size of cached components.
-	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Percent int32 `protobuf:"varint,1,opt,name=percent,proto3" json:"percent,omitempty"` // a negative percentage disables garbage collection
 }
 
-func (x *LoadReport_ComponentLoad) Reset() {
-	*x = LoadReport_ComponentLoad{}
+func (x *SetGCPercentRequest) Reset() {
+	*x = SetGCPercentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2864,13 +3253,13 @@ func (x *LoadReport_ComponentLoad) Reset() {
 	}
 }
 
-func (x *LoadReport_ComponentLoad) String() string {
+func (x *SetGCPercentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadReport_ComponentLoad) ProtoMessage() {}
+func (*SetGCPercentRequest) ProtoMessage() {}
 
-func (x *LoadReport_ComponentLoad) ProtoReflect() protoreflect.Message {
+func (x *SetGCPercentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2882,54 +3271,29 @@ func (x *LoadReport_ComponentLoad) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadReport_ComponentLoad.ProtoReflect.Descriptor instead.
-func (*LoadReport_ComponentLoad) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{14, 1}
-}
-
-func (x *LoadReport_ComponentLoad) GetLoad() []*LoadReport_SliceLoad {
-	if x != nil {
-		return x.Load
-	}
-	return nil
+// Deprecated: Use SetGCPercentRequest.ProtoReflect.Descriptor instead.
+func (*SetGCPercentRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *LoadReport_ComponentLoad) GetVersion() uint64 {
+func (x *SetGCPercentRequest) GetPercent() int32 {
 	if x != nil {
-		return x.Version
+		return x.Percent
 	}
 	return 0
 }
 
-// SliceLoad includes the load of a slice, [start, end).
-type LoadReport_SliceLoad struct {
+// SetGCPercentReply is a reply to a SetGCPercentRequest.
+type SetGCPercentReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Start  uint64                     `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"` // inclusive
-	End    uint64                     `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`     // exclusive
-	Load   float64                    `protobuf:"fixed64,3,opt,name=load,proto3" json:"load,omitempty"`
-	Splits []*LoadReport_SubsliceLoad `protobuf:"bytes,4,rep,name=splits,proto3" json:"splits,omitempty"`
-	// The "size" of the slice. Given a slice [a, b), it's tempting to think
-	// the slice has size b - a. This has a couple of problems.
-	//
-	//  1. Even if a slice is large, only a few keys in the slice may be
-	//     used. For example, a weavelet with slice [0, 2^60) may only
-	//     receive requests for keys in the range [0, 100).
-	//  2. a and b are not necessarily numeric. Imagine we are performing
-	//     range sharding on strings and have the slice ["ccbae",
-	//     "hhaefi"). What is the size of this slice?
-	//
-	// We instead measure the "size" of a slice as an estimate of the number
-	// of keys accessed in the slice. For example, if a slice [0, 1000)
-	// receives requests for keys 0, 1, 2, 3, and 10, then the size of the
-	// slice is 5.
-	Size uint64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Previous int32 `protobuf:"varint,1,opt,name=previous,proto3" json:"previous,omitempty"` // the previous percentage
 }
 
-func (x *LoadReport_SliceLoad) Reset() {
-	*x = LoadReport_SliceLoad{}
+func (x *SetGCPercentReply) Reset() {
+	*x = SetGCPercentReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_runtime_protos_runtime_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2937,13 +3301,13 @@ func (x *LoadReport_SliceLoad) Reset() {
 	}
 }
 
-func (x *LoadReport_SliceLoad) String() string {
+func (x *SetGCPercentReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadReport_SliceLoad) ProtoMessage() {}
+func (*SetGCPercentReply) ProtoMessage() {}
 
-func (x *LoadReport_SliceLoad) ProtoReflect() protoreflect.Message {
+func (x *SetGCPercentReply) ProtoReflect() protoreflect.Message {
 	mi := &file_runtime_protos_runtime_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2955,82 +3319,82 @@ func (x *LoadReport_SliceLoad) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadReport_SliceLoad.ProtoReflect.Descriptor instead.
-func (*LoadReport_SliceLoad) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{14, 2}
+// Deprecated: Use SetGCPercentReply.ProtoReflect.Descriptor instead.
+func (*SetGCPercentReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *LoadReport_SliceLoad) GetStart() uint64 {
+func (x *SetGCPercentReply) GetPrevious() int32 {
 	if x != nil {
-		return x.Start
+		return x.Previous
 	}
 	return 0
 }
 
-func (x *LoadReport_SliceLoad) GetEnd() uint64 {
-	if x != nil {
-		return x.End
-	}
-	return 0
+// ForceGCRequest is a request from an envelope for a weavelet to run a
+// garbage collection.
+type ForceGCRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *LoadReport_SliceLoad) GetLoad() float64 {
-	if x != nil {
-		return x.Load
+func (x *ForceGCRequest) Reset() {
+	*x = ForceGCRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *LoadReport_SliceLoad) GetSplits() []*LoadReport_SubsliceLoad {
-	if x != nil {
-		return x.Splits
-	}
-	return nil
+func (x *ForceGCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *LoadReport_SliceLoad) GetSize() uint64 {
-	if x != nil {
-		return x.Size
+func (*ForceGCRequest) ProtoMessage() {}
+
+func (x *ForceGCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-// SubsliceLoad divides the load of a slice into smaller subslices. For
-// example, given a slice [0, 100) and load 9.0, we may have the following
-// subslices:
-//
-//	SubsliceLoad{Start:  0, Load: 3.0} // [0, 20)
-//	SubsliceLoad{Start: 20, Load: 3.0} // [20, 80)
-//	SubsliceLoad{Start: 80, Load: 3.0} // [80, 100)
-//
-// TODO(mwhittaker): In the future, we likely want to report hot keys as
-// their own slice. Change SubsliceLoad to support that.
-type LoadReport_SubsliceLoad struct {
+// Deprecated: Use ForceGCRequest.ProtoReflect.Descriptor instead.
+func (*ForceGCRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{46}
+}
+
+// ForceGCReply is a reply to a ForceGCRequest.
+type ForceGCReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Start uint64  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	Load  float64 `protobuf:"fixed64,2,opt,name=load,proto3" json:"load,omitempty"`
 }
 
-func (x *LoadReport_SubsliceLoad) Reset() {
-	*x = LoadReport_SubsliceLoad{}
+func (x *ForceGCReply) Reset() {
+	*x = ForceGCReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[46]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoadReport_SubsliceLoad) String() string {
+func (x *ForceGCReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadReport_SubsliceLoad) ProtoMessage() {}
+func (*ForceGCReply) ProtoMessage() {}
 
-func (x *LoadReport_SubsliceLoad) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[46]
+func (x *ForceGCReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3041,58 +3405,77 @@ func (x *LoadReport_SubsliceLoad) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadReport_SubsliceLoad.ProtoReflect.Descriptor instead.
-func (*LoadReport_SubsliceLoad) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{14, 3}
+// Deprecated: Use ForceGCReply.ProtoReflect.Descriptor instead.
+func (*ForceGCReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *LoadReport_SubsliceLoad) GetStart() uint64 {
-	if x != nil {
-		return x.Start
+// GetDependenciesRequest is a request from an envelope for a weavelet to
+// report the call graph of its components.
+type GetDependenciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDependenciesRequest) Reset() {
+	*x = GetDependenciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *LoadReport_SubsliceLoad) GetLoad() float64 {
-	if x != nil {
-		return x.Load
+func (x *GetDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDependenciesRequest) ProtoMessage() {}
+
+func (x *GetDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-// A slice of the key space and the replicas to which it is assigned.
-type Assignment_Slice struct {
+// Deprecated: Use GetDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*GetDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{48}
+}
+
+// GetDependenciesReply is a reply to a GetDependenciesRequest.
+type GetDependenciesReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Inclusive start key. Strictly greater than the `start` value for the
-	// previous entry in the current assignment.
-	//
-	// The first slice should always set start to 0.
-	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	// Replicas assigned to host this slice. Every address is of the form
-	// "<net>://<addr>" (e.g., "tcp://host:1234", "unix:///tmp/unix.sock").
-	Replicas []string `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	Graph *CallGraph `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
 }
 
-func (x *Assignment_Slice) Reset() {
-	*x = Assignment_Slice{}
+func (x *GetDependenciesReply) Reset() {
+	*x = GetDependenciesReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[47]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Assignment_Slice) String() string {
+func (x *GetDependenciesReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Assignment_Slice) ProtoMessage() {}
+func (*GetDependenciesReply) ProtoMessage() {}
 
-func (x *Assignment_Slice) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[47]
+func (x *GetDependenciesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3103,52 +3486,45 @@ func (x *Assignment_Slice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Assignment_Slice.ProtoReflect.Descriptor instead.
-func (*Assignment_Slice) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{20, 0}
-}
-
-func (x *Assignment_Slice) GetStart() uint64 {
-	if x != nil {
-		return x.Start
-	}
-	return 0
+// Deprecated: Use GetDependenciesReply.ProtoReflect.Descriptor instead.
+func (*GetDependenciesReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *Assignment_Slice) GetReplicas() []string {
+func (x *GetDependenciesReply) GetGraph() *CallGraph {
 	if x != nil {
-		return x.Replicas
+		return x.Graph
 	}
 	return nil
 }
 
-// Span attributes.
-type Span_Attribute struct {
+// CallGraph is a component call graph, derived from the weaver.Ref fields of
+// the components registered in a binary.
+type CallGraph struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value *Span_Attribute_Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Edges []*CallGraphEdge `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
 }
 
-func (x *Span_Attribute) Reset() {
-	*x = Span_Attribute{}
+func (x *CallGraph) Reset() {
+	*x = CallGraph{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[48]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Span_Attribute) String() string {
+func (x *CallGraph) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Span_Attribute) ProtoMessage() {}
+func (*CallGraph) ProtoMessage() {}
 
-func (x *Span_Attribute) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[48]
+func (x *CallGraph) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3159,55 +3535,46 @@ func (x *Span_Attribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Span_Attribute.ProtoReflect.Descriptor instead.
-func (*Span_Attribute) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{38, 0}
-}
-
-func (x *Span_Attribute) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
+// Deprecated: Use CallGraph.ProtoReflect.Descriptor instead.
+func (*CallGraph) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Span_Attribute) GetValue() *Span_Attribute_Value {
+func (x *CallGraph) GetEdges() []*CallGraphEdge {
 	if x != nil {
-		return x.Value
+		return x.Edges
 	}
 	return nil
 }
 
-// Link represents the link a span has to another span.
-type Span_Link struct {
+// CallGraphEdge records that the caller component calls the callee component.
+type CallGraphEdge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TraceId    []byte            `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"` // Span trace id
-	SpanId     []byte            `protobuf:"bytes,2,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`    // Unique span id
-	Attributes []*Span_Attribute `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`          // Span attributes
-	// Number of span attributes dropped due to limits being reached
-	DroppedAttributeCount int64 `protobuf:"varint,4,opt,name=dropped_attribute_count,json=droppedAttributeCount,proto3" json:"dropped_attribute_count,omitempty"`
+	Caller  string                `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"` // caller component name
+	Callee  string                `protobuf:"bytes,2,opt,name=callee,proto3" json:"callee,omitempty"` // callee component name
+	Routing CallGraphEdge_Routing `protobuf:"varint,3,opt,name=routing,proto3,enum=runtime.CallGraphEdge_Routing" json:"routing,omitempty"`
 }
 
-func (x *Span_Link) Reset() {
-	*x = Span_Link{}
+func (x *CallGraphEdge) Reset() {
+	*x = CallGraphEdge{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[49]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Span_Link) String() string {
+func (x *CallGraphEdge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Span_Link) ProtoMessage() {}
+func (*CallGraphEdge) ProtoMessage() {}
 
-func (x *Span_Link) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[49]
+func (x *CallGraphEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3218,69 +3585,69 @@ func (x *Span_Link) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Span_Link.ProtoReflect.Descriptor instead.
-func (*Span_Link) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{38, 1}
-}
-
-func (x *Span_Link) GetTraceId() []byte {
-	if x != nil {
-		return x.TraceId
-	}
-	return nil
+// Deprecated: Use CallGraphEdge.ProtoReflect.Descriptor instead.
+func (*CallGraphEdge) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *Span_Link) GetSpanId() []byte {
+func (x *CallGraphEdge) GetCaller() string {
 	if x != nil {
-		return x.SpanId
+		return x.Caller
 	}
-	return nil
+	return ""
 }
 
-func (x *Span_Link) GetAttributes() []*Span_Attribute {
+func (x *CallGraphEdge) GetCallee() string {
 	if x != nil {
-		return x.Attributes
+		return x.Callee
 	}
-	return nil
+	return ""
 }
 
-func (x *Span_Link) GetDroppedAttributeCount() int64 {
+func (x *CallGraphEdge) GetRouting() CallGraphEdge_Routing {
 	if x != nil {
-		return x.DroppedAttributeCount
+		return x.Routing
 	}
-	return 0
+	return CallGraphEdge_UNKNOWN
 }
 
-// Event represent an event that occurred in the span's lifetime.
-type Span_Event struct {
+// GetSnapshotRequest is a request from an envelope for a snapshot of a
+// weavelet's state, which combines the replies to a GetHealthRequest, a
+// GetLoadRequest, and a GetMetricsRequest with runtime stats, all taken at
+// the same moment. Only the requested sections are included, so that cheap
+// polls stay cheap.
+type GetSnapshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                 // Even name
-	TimeMicros int64             `protobuf:"fixed64,2,opt,name=time_micros,json=timeMicros,proto3" json:"time_micros,omitempty"` // Time event was recorded (micros since epoch)
-	Attributes []*Span_Attribute `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`                     // Event attributes
-	// Number of attributes dropped due to limits being reached.
-	DroppedAttributeCount int64 `protobuf:"varint,4,opt,name=dropped_attribute_count,json=droppedAttributeCount,proto3" json:"dropped_attribute_count,omitempty"`
+	Health       bool `protobuf:"varint,1,opt,name=health,proto3" json:"health,omitempty"`                                 // include health?
+	Load         bool `protobuf:"varint,2,opt,name=load,proto3" json:"load,omitempty"`                                     // include load? Resets the load, like GetLoad.
+	RuntimeStats bool `protobuf:"varint,3,opt,name=runtime_stats,json=runtimeStats,proto3" json:"runtime_stats,omitempty"` // include runtime stats?
+	// If present, include metrics, as requested by a GetMetricsRequest. A
+	// snapshot's metrics are computed relative to the metrics previously
+	// reported by GetMetrics and GetSnapshot, so there can only be one
+	// outstanding request for metrics at a time.
+	Metrics *GetMetricsRequest `protobuf:"bytes,4,opt,name=metrics,proto3" json:"metrics,omitempty"`
 }
 
-func (x *Span_Event) Reset() {
-	*x = Span_Event{}
+func (x *GetSnapshotRequest) Reset() {
+	*x = GetSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[50]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Span_Event) String() string {
+func (x *GetSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Span_Event) ProtoMessage() {}
+func (*GetSnapshotRequest) ProtoMessage() {}
 
-func (x *Span_Event) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[50]
+func (x *GetSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3291,66 +3658,69 @@ func (x *Span_Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Span_Event.ProtoReflect.Descriptor instead.
-func (*Span_Event) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{38, 2}
+// Deprecated: Use GetSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *Span_Event) GetName() string {
+func (x *GetSnapshotRequest) GetHealth() bool {
 	if x != nil {
-		return x.Name
+		return x.Health
 	}
-	return ""
+	return false
 }
 
-func (x *Span_Event) GetTimeMicros() int64 {
+func (x *GetSnapshotRequest) GetLoad() bool {
 	if x != nil {
-		return x.TimeMicros
+		return x.Load
 	}
-	return 0
+	return false
 }
 
-func (x *Span_Event) GetAttributes() []*Span_Attribute {
+func (x *GetSnapshotRequest) GetRuntimeStats() bool {
 	if x != nil {
-		return x.Attributes
+		return x.RuntimeStats
 	}
-	return nil
+	return false
 }
 
-func (x *Span_Event) GetDroppedAttributeCount() int64 {
+func (x *GetSnapshotRequest) GetMetrics() *GetMetricsRequest {
 	if x != nil {
-		return x.DroppedAttributeCount
+		return x.Metrics
 	}
-	return 0
+	return nil
 }
 
-// Status represents the status for a span.
-type Span_Status struct {
+// GetSnapshotReply is a reply to a GetSnapshotRequest.
+type GetSnapshotReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  Span_Status_Code `protobuf:"varint,1,opt,name=code,proto3,enum=runtime.Span_Status_Code" json:"code,omitempty"` // Span status code
-	Error string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                              // Error text; set only for an ERROR code
+	// The snapshot, without metrics.
+	Snapshot *WeaveletSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// The metrics, if requested, as in a GetMetricsReply. The envelope adds
+	// them to the snapshot.
+	Metrics *MetricUpdate `protobuf:"bytes,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
 }
 
-func (x *Span_Status) Reset() {
-	*x = Span_Status{}
+func (x *GetSnapshotReply) Reset() {
+	*x = GetSnapshotReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[51]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Span_Status) String() string {
+func (x *GetSnapshotReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Span_Status) ProtoMessage() {}
+func (*GetSnapshotReply) ProtoMessage() {}
 
-func (x *Span_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[51]
+func (x *GetSnapshotReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3361,55 +3731,56 @@ func (x *Span_Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Span_Status.ProtoReflect.Descriptor instead.
-func (*Span_Status) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{38, 3}
+// Deprecated: Use GetSnapshotReply.ProtoReflect.Descriptor instead.
+func (*GetSnapshotReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *Span_Status) GetCode() Span_Status_Code {
+func (x *GetSnapshotReply) GetSnapshot() *WeaveletSnapshot {
 	if x != nil {
-		return x.Code
+		return x.Snapshot
 	}
-	return Span_Status_UNSET
+	return nil
 }
 
-func (x *Span_Status) GetError() string {
+func (x *GetSnapshotReply) GetMetrics() *MetricUpdate {
 	if x != nil {
-		return x.Error
+		return x.Metrics
 	}
-	return ""
+	return nil
 }
 
-// Scope stores information about the instrumentation scope that created the span.
-type Span_Scope struct {
+// WeaveletSnapshot is a snapshot of a weavelet's state. Sections that were
+// not requested are unset.
+type WeaveletSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name of the instrumentation library, typically the package name of
-	// the library.
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version   string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                      // The version of the instrumentation scope
-	SchemaUrl string `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"` // Schema url of the telemetry emitted by scope
+	TimeUnixMicros int64             `protobuf:"varint,1,opt,name=time_unix_micros,json=timeUnixMicros,proto3" json:"time_unix_micros,omitempty"` // when the weavelet took the snapshot
+	Health         *GetHealthReply   `protobuf:"bytes,2,opt,name=health,proto3" json:"health,omitempty"`
+	Load           *LoadReport       `protobuf:"bytes,3,opt,name=load,proto3" json:"load,omitempty"`
+	RuntimeStats   *RuntimeStats     `protobuf:"bytes,4,opt,name=runtime_stats,json=runtimeStats,proto3" json:"runtime_stats,omitempty"`
+	Metrics        []*MetricSnapshot `protobuf:"bytes,5,rep,name=metrics,proto3" json:"metrics,omitempty"`
 }
 
-func (x *Span_Scope) Reset() {
-	*x = Span_Scope{}
+func (x *WeaveletSnapshot) Reset() {
+	*x = WeaveletSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[52]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Span_Scope) String() string {
+func (x *WeaveletSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Span_Scope) ProtoMessage() {}
+func (*WeaveletSnapshot) ProtoMessage() {}
 
-func (x *Span_Scope) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[52]
+func (x *WeaveletSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3420,63 +3791,77 @@ func (x *Span_Scope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Span_Scope.ProtoReflect.Descriptor instead.
-func (*Span_Scope) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{38, 4}
+// Deprecated: Use WeaveletSnapshot.ProtoReflect.Descriptor instead.
+func (*WeaveletSnapshot) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *Span_Scope) GetName() string {
+func (x *WeaveletSnapshot) GetTimeUnixMicros() int64 {
 	if x != nil {
-		return x.Name
+		return x.TimeUnixMicros
 	}
-	return ""
+	return 0
 }
 
-func (x *Span_Scope) GetVersion() string {
+func (x *WeaveletSnapshot) GetHealth() *GetHealthReply {
 	if x != nil {
-		return x.Version
+		return x.Health
 	}
-	return ""
+	return nil
 }
 
-func (x *Span_Scope) GetSchemaUrl() string {
+func (x *WeaveletSnapshot) GetLoad() *LoadReport {
 	if x != nil {
-		return x.SchemaUrl
+		return x.Load
 	}
-	return ""
+	return nil
 }
 
-// Library stores information about the instrumentation library that
-// created the span.
-type Span_Library struct {
-	state         protoimpl.MessageState
+func (x *WeaveletSnapshot) GetRuntimeStats() *RuntimeStats {
+	if x != nil {
+		return x.RuntimeStats
+	}
+	return nil
+}
+
+func (x *WeaveletSnapshot) GetMetrics() []*MetricSnapshot {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// RuntimeStats contains Go runtime statistics of a weavelet.
+type RuntimeStats struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name of the instrumentation library, typically the package name of
-	// the library.
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version   string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                      // The version of the instrumentation library
-	SchemaUrl string `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"` // Schema url of the telemetry emitted by library
+	UptimeMicros   int64  `protobuf:"varint,1,opt,name=uptime_micros,json=uptimeMicros,proto3" json:"uptime_micros,omitempty"`           // time since the weavelet started
+	Goroutines     int64  `protobuf:"varint,2,opt,name=goroutines,proto3" json:"goroutines,omitempty"`                                   // number of goroutines
+	HeapAllocBytes uint64 `protobuf:"varint,3,opt,name=heap_alloc_bytes,json=heapAllocBytes,proto3" json:"heap_alloc_bytes,omitempty"`   // bytes of allocated heap objects
+	HeapSysBytes   uint64 `protobuf:"varint,4,opt,name=heap_sys_bytes,json=heapSysBytes,proto3" json:"heap_sys_bytes,omitempty"`         // bytes of heap memory obtained from the OS
+	NumGc          uint32 `protobuf:"varint,5,opt,name=num_gc,json=numGc,proto3" json:"num_gc,omitempty"`                                // number of completed GC cycles
+	GcPauseTotalNs uint64 `protobuf:"varint,6,opt,name=gc_pause_total_ns,json=gcPauseTotalNs,proto3" json:"gc_pause_total_ns,omitempty"` // cumulative GC pause time
 }
 
-func (x *Span_Library) Reset() {
-	*x = Span_Library{}
+func (x *RuntimeStats) Reset() {
+	*x = RuntimeStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[53]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Span_Library) String() string {
+func (x *RuntimeStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Span_Library) ProtoMessage() {}
+func (*RuntimeStats) ProtoMessage() {}
 
-func (x *Span_Library) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[53]
+func (x *RuntimeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3487,61 +3872,121 @@ func (x *Span_Library) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Span_Library.ProtoReflect.Descriptor instead.
-func (*Span_Library) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{38, 5}
+// Deprecated: Use RuntimeStats.ProtoReflect.Descriptor instead.
+func (*RuntimeStats) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *Span_Library) GetName() string {
+func (x *RuntimeStats) GetUptimeMicros() int64 {
 	if x != nil {
-		return x.Name
+		return x.UptimeMicros
 	}
-	return ""
+	return 0
 }
 
-func (x *Span_Library) GetVersion() string {
+func (x *RuntimeStats) GetGoroutines() int64 {
 	if x != nil {
-		return x.Version
+		return x.Goroutines
 	}
-	return ""
+	return 0
 }
 
-func (x *Span_Library) GetSchemaUrl() string {
+func (x *RuntimeStats) GetHeapAllocBytes() uint64 {
 	if x != nil {
-		return x.SchemaUrl
+		return x.HeapAllocBytes
 	}
-	return ""
+	return 0
 }
 
-// Resource describes an entity (e.g., a Service Weaver component) this span
-// belongs to.
-type Span_Resource struct {
+func (x *RuntimeStats) GetHeapSysBytes() uint64 {
+	if x != nil {
+		return x.HeapSysBytes
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetNumGc() uint32 {
+	if x != nil {
+		return x.NumGc
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetGcPauseTotalNs() uint64 {
+	if x != nil {
+		return x.GcPauseTotalNs
+	}
+	return 0
+}
+
+// GetFDCountRequest is a request from an envelope for the number of file
+// descriptors opened by a weavelet.
+type GetFDCountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// URL that describes the shape and a composition of data for this span.
-	SchemaUrl  string            `protobuf:"bytes,1,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
-	Attributes []*Span_Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"` // Resource attributes
+func (x *GetFDCountRequest) Reset() {
+	*x = GetFDCountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *Span_Resource) Reset() {
-	*x = Span_Resource{}
+func (x *GetFDCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFDCountRequest) ProtoMessage() {}
+
+func (x *GetFDCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFDCountRequest.ProtoReflect.Descriptor instead.
+func (*GetFDCountRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{56}
+}
+
+// GetFDCountReply is a reply to a GetFDCountRequest.
+type GetFDCountReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count     int64  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`                          // number of open file descriptors
+	SoftLimit uint64 `protobuf:"varint,2,opt,name=soft_limit,json=softLimit,proto3" json:"soft_limit,omitempty"` // soft limit on open file descriptors
+	HardLimit uint64 `protobuf:"varint,3,opt,name=hard_limit,json=hardLimit,proto3" json:"hard_limit,omitempty"` // hard limit on open file descriptors
+}
+
+func (x *GetFDCountReply) Reset() {
+	*x = GetFDCountReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[54]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Span_Resource) String() string {
+func (x *GetFDCountReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Span_Resource) ProtoMessage() {}
+func (*GetFDCountReply) ProtoMessage() {}
 
-func (x *Span_Resource) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[54]
+func (x *GetFDCountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3552,57 +3997,59 @@ func (x *Span_Resource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Span_Resource.ProtoReflect.Descriptor instead.
-func (*Span_Resource) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{38, 6}
+// Deprecated: Use GetFDCountReply.ProtoReflect.Descriptor instead.
+func (*GetFDCountReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *Span_Resource) GetSchemaUrl() string {
+func (x *GetFDCountReply) GetCount() int64 {
 	if x != nil {
-		return x.SchemaUrl
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *Span_Resource) GetAttributes() []*Span_Attribute {
+func (x *GetFDCountReply) GetSoftLimit() uint64 {
 	if x != nil {
-		return x.Attributes
+		return x.SoftLimit
 	}
-	return nil
+	return 0
 }
 
-type Span_Attribute_Value struct {
+func (x *GetFDCountReply) GetHardLimit() uint64 {
+	if x != nil {
+		return x.HardLimit
+	}
+	return 0
+}
+
+// GetRoutingInfoRequest is a request from an envelope for the routing info
+// that a weavelet has applied for a component.
+type GetRoutingInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type Span_Attribute_Value_Type `protobuf:"varint,1,opt,name=type,proto3,enum=runtime.Span_Attribute_Value_Type" json:"type,omitempty"` // Attribute type
-	// Types that are assignable to Value:
-	//
-	//	*Span_Attribute_Value_Num
-	//	*Span_Attribute_Value_Str
-	//	*Span_Attribute_Value_Nums
-	//	*Span_Attribute_Value_Strs
-	Value isSpan_Attribute_Value_Value `protobuf_oneof:"value"`
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
 }
 
-func (x *Span_Attribute_Value) Reset() {
-	*x = Span_Attribute_Value{}
+func (x *GetRoutingInfoRequest) Reset() {
+	*x = GetRoutingInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[55]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Span_Attribute_Value) String() string {
+func (x *GetRoutingInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Span_Attribute_Value) ProtoMessage() {}
+func (*GetRoutingInfoRequest) ProtoMessage() {}
 
-func (x *Span_Attribute_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[55]
+func (x *GetRoutingInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3613,106 +4060,144 @@ func (x *Span_Attribute_Value) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Span_Attribute_Value.ProtoReflect.Descriptor instead.
-func (*Span_Attribute_Value) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{38, 0, 0}
+// Deprecated: Use GetRoutingInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetRoutingInfoRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *Span_Attribute_Value) GetType() Span_Attribute_Value_Type {
+func (x *GetRoutingInfoRequest) GetComponent() string {
 	if x != nil {
-		return x.Type
+		return x.Component
 	}
-	return Span_Attribute_Value_INVALID
+	return ""
 }
 
-func (m *Span_Attribute_Value) GetValue() isSpan_Attribute_Value_Value {
-	if m != nil {
-		return m.Value
-	}
-	return nil
+// GetRoutingInfoReply is a reply to a GetRoutingInfoRequest.
+type GetRoutingInfoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The last routing info applied by the weavelet for the component, or
+	// unset if the weavelet hasn't received routing info for the component.
+	RoutingInfo *RoutingInfo `protobuf:"bytes,1,opt,name=routing_info,json=routingInfo,proto3" json:"routing_info,omitempty"`
+	// The state of the component's circuit breaker, or unset if the applied
+	// routing info doesn't configure one.
+	CircuitBreaker *CircuitBreakerState `protobuf:"bytes,2,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
 }
 
-func (x *Span_Attribute_Value) GetNum() uint64 {
-	if x, ok := x.GetValue().(*Span_Attribute_Value_Num); ok {
-		return x.Num
+func (x *GetRoutingInfoReply) Reset() {
+	*x = GetRoutingInfoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Span_Attribute_Value) GetStr() string {
-	if x, ok := x.GetValue().(*Span_Attribute_Value_Str); ok {
-		return x.Str
+func (x *GetRoutingInfoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoutingInfoReply) ProtoMessage() {}
+
+func (x *GetRoutingInfoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Span_Attribute_Value) GetNums() *Span_Attribute_Value_NumberList {
-	if x, ok := x.GetValue().(*Span_Attribute_Value_Nums); ok {
-		return x.Nums
+// Deprecated: Use GetRoutingInfoReply.ProtoReflect.Descriptor instead.
+func (*GetRoutingInfoReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetRoutingInfoReply) GetRoutingInfo() *RoutingInfo {
+	if x != nil {
+		return x.RoutingInfo
 	}
 	return nil
 }
 
-func (x *Span_Attribute_Value) GetStrs() *Span_Attribute_Value_StringList {
-	if x, ok := x.GetValue().(*Span_Attribute_Value_Strs); ok {
-		return x.Strs
+func (x *GetRoutingInfoReply) GetCircuitBreaker() *CircuitBreakerState {
+	if x != nil {
+		return x.CircuitBreaker
 	}
 	return nil
 }
 
-type isSpan_Attribute_Value_Value interface {
-	isSpan_Attribute_Value_Value()
+// GetFeatureFlagsRequest is a request from an envelope for the feature flags
+// registered by a weavelet (see weaver.RegisterFeatureFlag).
+type GetFeatureFlagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-type Span_Attribute_Value_Num struct {
-	Num uint64 `protobuf:"varint,2,opt,name=num,proto3,oneof"`
+func (x *GetFeatureFlagsRequest) Reset() {
+	*x = GetFeatureFlagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type Span_Attribute_Value_Str struct {
-	Str string `protobuf:"bytes,3,opt,name=str,proto3,oneof"`
+func (x *GetFeatureFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Span_Attribute_Value_Nums struct {
-	Nums *Span_Attribute_Value_NumberList `protobuf:"bytes,4,opt,name=nums,proto3,oneof"`
-}
+func (*GetFeatureFlagsRequest) ProtoMessage() {}
 
-type Span_Attribute_Value_Strs struct {
-	Strs *Span_Attribute_Value_StringList `protobuf:"bytes,5,opt,name=strs,proto3,oneof"`
+func (x *GetFeatureFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*Span_Attribute_Value_Num) isSpan_Attribute_Value_Value() {}
-
-func (*Span_Attribute_Value_Str) isSpan_Attribute_Value_Value() {}
-
-func (*Span_Attribute_Value_Nums) isSpan_Attribute_Value_Value() {}
-
-func (*Span_Attribute_Value_Strs) isSpan_Attribute_Value_Value() {}
+// Deprecated: Use GetFeatureFlagsRequest.ProtoReflect.Descriptor instead.
+func (*GetFeatureFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{60}
+}
 
-type Span_Attribute_Value_NumberList struct {
+// GetFeatureFlagsReply is a reply to a GetFeatureFlagsRequest.
+type GetFeatureFlagsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nums []uint64 `protobuf:"varint,1,rep,packed,name=nums,proto3" json:"nums,omitempty"`
+	Flags map[string]string `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // current flag values, by flag name
 }
 
-func (x *Span_Attribute_Value_NumberList) Reset() {
-	*x = Span_Attribute_Value_NumberList{}
+func (x *GetFeatureFlagsReply) Reset() {
+	*x = GetFeatureFlagsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[56]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Span_Attribute_Value_NumberList) String() string {
+func (x *GetFeatureFlagsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Span_Attribute_Value_NumberList) ProtoMessage() {}
+func (*GetFeatureFlagsReply) ProtoMessage() {}
 
-func (x *Span_Attribute_Value_NumberList) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[56]
+func (x *GetFeatureFlagsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3723,600 +4208,6649 @@ func (x *Span_Attribute_Value_NumberList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Span_Attribute_Value_NumberList.ProtoReflect.Descriptor instead.
-func (*Span_Attribute_Value_NumberList) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{38, 0, 0, 0}
+// Deprecated: Use GetFeatureFlagsReply.ProtoReflect.Descriptor instead.
+func (*GetFeatureFlagsReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *Span_Attribute_Value_NumberList) GetNums() []uint64 {
+func (x *GetFeatureFlagsReply) GetFlags() map[string]string {
 	if x != nil {
-		return x.Nums
+		return x.Flags
 	}
 	return nil
 }
 
-type Span_Attribute_Value_StringList struct {
+// SetFeatureFlagRequest is a request from an envelope for a weavelet to
+// override the value of a registered feature flag.
+type SetFeatureFlagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Strs []string `protobuf:"bytes,2,rep,name=strs,proto3" json:"strs,omitempty"`
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *Span_Attribute_Value_StringList) Reset() {
-	*x = Span_Attribute_Value_StringList{}
+func (x *SetFeatureFlagRequest) Reset() {
+	*x = SetFeatureFlagRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runtime_protos_runtime_proto_msgTypes[57]
+		mi := &file_runtime_protos_runtime_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Span_Attribute_Value_StringList) String() string {
+func (x *SetFeatureFlagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Span_Attribute_Value_StringList) ProtoMessage() {}
+func (*SetFeatureFlagRequest) ProtoMessage() {}
 
-func (x *Span_Attribute_Value_StringList) ProtoReflect() protoreflect.Message {
-	mi := &file_runtime_protos_runtime_proto_msgTypes[57]
+func (x *SetFeatureFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Span_Attribute_Value_StringList.ProtoReflect.Descriptor instead.
-func (*Span_Attribute_Value_StringList) Descriptor() ([]byte, []int) {
-	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{38, 0, 0, 1}
-}
-
-func (x *Span_Attribute_Value_StringList) GetStrs() []string {
-	if x != nil {
-		return x.Strs
-	}
-	return nil
-}
-
-var File_runtime_protos_runtime_proto protoreflect.FileDescriptor
-
-var file_runtime_protos_runtime_proto_rawDesc = []byte{
-	0x0a, 0x1c, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xf6, 0x02, 0x0a, 0x0c, 0x57, 0x65, 0x61, 0x76,
-	0x65, 0x6c, 0x65, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x72, 0x75, 0x6e, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x4d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x74,
-	0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x74, 0x6c, 0x73, 0x12, 0x29,
-	0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74,
-	0x12, 0x3c, 0x0a, 0x09, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x18, 0x0c, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x57, 0x65,
-	0x61, 0x76, 0x65, 0x6c, 0x65, 0x74, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x52, 0x09, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x5a,
-	0x0a, 0x08, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05,
-	0x22, 0x9a, 0x01, 0x0a, 0x13, 0x49, 0x6e, 0x69, 0x74, 0x57, 0x65, 0x61, 0x76, 0x65, 0x6c, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x57, 0x65, 0x61, 0x76, 0x65, 0x6c, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x1a, 0x3b, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5b, 0x0a,
-	0x11, 0x49, 0x6e, 0x69, 0x74, 0x57, 0x65, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12,
-	0x29, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x65, 0x6d, 0x56, 0x65,
-	0x72, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x06, 0x53, 0x65,
-	0x6d, 0x56, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69,
-	0x6e, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6e, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x72,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
-	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x40, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x22, 0x64, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x44, 0x65, 0x66, 0x52, 0x04, 0x64, 0x65, 0x66, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x44, 0x65, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x74, 0x79, 0x70,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x79, 0x70,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x6c, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x68, 0x65, 0x6c, 0x70, 0x12, 0x36, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x44, 0x65, 0x66, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x4b, 0x0a, 0x0b, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xad, 0x02, 0x0a,
-	0x0e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x74, 0x79, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x79, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65,
-	0x6c, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x70, 0x12, 0x3b,
-	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x62, 0x6f, 0x75,
-	0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x10, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27,
-	0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xcf, 0x03, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x1a, 0x5b, 0x0a, 0x0a,
-	0x4c, 0x6f, 0x61, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x0d, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x6c, 0x6f,
-	0x61, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x53, 0x6c,
-	0x69, 0x63, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x95, 0x01, 0x0a, 0x09, 0x53, 0x6c, 0x69, 0x63,
-	0x65, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65,
-	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6c, 0x6f, 0x61,
-	0x64, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x4c,
-	0x6f, 0x61, 0x64, 0x52, 0x06, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x1a,
-	0x38, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x74, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37,
-	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x70, 0x75, 0x5f, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0d, 0x63, 0x70, 0x75, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x73, 0x22,
-	0x25, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x53, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b,
-	0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x18, 0x0a, 0x16, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x92, 0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e,
-	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x0a, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x6c, 0x69,
-	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53,
-	0x6c, 0x69, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x39, 0x0a, 0x05, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x73, 0x22, 0x39, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x17, 0x0a, 0x15,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x50, 0x0a, 0x18, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x2f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x33, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4d, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x50, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a,
-	0x0d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x6c, 0x66, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x66,
-	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x63, 0x65, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x3f, 0x0a, 0x1e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x65, 0x72, 0x74,
-	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x65,
-	0x72, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x3e, 0x0a, 0x1c, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6a, 0x0a, 0x1e, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x65, 0x72,
-	0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x63,
-	0x65, 0x72, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0xef, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61,
-	0x70, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
-	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x10, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x73, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x74, 0x74, 0x72, 0x73, 0x22, 0x3c, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2b, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x70, 0x61, 0x6e,
-	0x73, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x04,
-	0x73, 0x70, 0x61, 0x6e, 0x22, 0xb9, 0x10, 0x0a, 0x04, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
-	0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73,
-	0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
-	0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x6b,
-	0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b,
-	0x69, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x69, 0x63,
-	0x72, 0x6f, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x10, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x6d, 0x69,
-	0x63, 0x72, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x10, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x4d,
-	0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x28,
-	0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x6e,
-	0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x53, 0x70, 0x61, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x12, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61,
-	0x6e, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x2f,
-	0x0a, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x4c,
-	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12,
-	0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e,
-	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x64,
-	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
-	0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x72, 0x6f,
-	0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x5f, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x70, 0x61, 0x6e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x1a, 0x8a, 0x04, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61,
-	0x6e, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xb5, 0x03, 0x0a, 0x05, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x22, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x03, 0x6e, 0x75,
-	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x12,
-	0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x73,
-	0x74, 0x72, 0x12, 0x3e, 0x0a, 0x04, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x28, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x75,
-	0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x04, 0x73, 0x74, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x28, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x73, 0x74,
-	0x72, 0x73, 0x1a, 0x20, 0x0a, 0x0a, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x04,
-	0x6e, 0x75, 0x6d, 0x73, 0x1a, 0x20, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x04, 0x73, 0x74, 0x72, 0x73, 0x22, 0x7f, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
-	0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x42,
-	0x4f, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x02,
-	0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x36, 0x34, 0x10, 0x03, 0x12, 0x0a, 0x0a,
-	0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x4f, 0x4f,
-	0x4c, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x36, 0x34,
-	0x4c, 0x49, 0x53, 0x54, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x36,
-	0x34, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x52, 0x49, 0x4e,
-	0x47, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x08, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x1a, 0xab, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x72, 0x61,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a,
-	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e,
-	0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xad,
-	0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x10, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x37, 0x0a,
-	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e,
-	0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x73,
-	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x24, 0x0a,
-	0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00,
-	0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4f,
-	0x4b, 0x10, 0x02, 0x1a, 0x54, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x72, 0x6c, 0x1a, 0x56, 0x0a, 0x07, 0x4c, 0x69, 0x62,
-	0x72, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x72,
-	0x6c, 0x1a, 0x62, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x37, 0x0a, 0x0a,
-	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0f, 0x0a,
-	0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c,
-	0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
-	0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x49, 0x45,
-	0x4e, 0x54, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52,
-	0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x53, 0x55, 0x4d, 0x45, 0x52, 0x10, 0x05,
-	0x2a, 0x47, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a,
-	0x07, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e,
-	0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x45, 0x52,
-	0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x40, 0x0a, 0x0a, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x4c,
-	0x49, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10,
-	0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x41, 0x55, 0x47, 0x45, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
-	0x48, 0x49, 0x53, 0x54, 0x4f, 0x47, 0x52, 0x41, 0x4d, 0x10, 0x03, 0x2a, 0x31, 0x0a, 0x0b, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x6e,
-	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48,
-	0x65, 0x61, 0x70, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x50, 0x55, 0x10, 0x02, 0x42, 0x30,
-	0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x57, 0x65, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x65,
-	0x72, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_runtime_protos_runtime_proto_rawDescOnce sync.Once
-	file_runtime_protos_runtime_proto_rawDescData = file_runtime_protos_runtime_proto_rawDesc
-)
-
-func file_runtime_protos_runtime_proto_rawDescGZIP() []byte {
-	file_runtime_protos_runtime_proto_rawDescOnce.Do(func() {
-		file_runtime_protos_runtime_proto_rawDescData = protoimpl.X.CompressGZIP(file_runtime_protos_runtime_proto_rawDescData)
-	})
-	return file_runtime_protos_runtime_proto_rawDescData
-}
-
-var file_runtime_protos_runtime_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_runtime_protos_runtime_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
-var file_runtime_protos_runtime_proto_goTypes = []interface{}{
-	(HealthStatus)(0),                       // 0: runtime.HealthStatus
-	(MetricType)(0),                         // 1: runtime.MetricType
-	(ProfileType)(0),                        // 2: runtime.ProfileType
-	(Span_Kind)(0),                          // 3: runtime.Span.Kind
-	(Span_Attribute_Value_Type)(0),          // 4: runtime.Span.Attribute.Value.Type
-	(Span_Status_Code)(0),                   // 5: runtime.Span.Status.Code
-	(*WeaveletArgs)(nil),                    // 6: runtime.WeaveletArgs
-	(*InitWeaveletRequest)(nil),             // 7: runtime.InitWeaveletRequest
-	(*InitWeaveletReply)(nil),               // 8: runtime.InitWeaveletReply
-	(*SemVer)(nil),                          // 9: runtime.SemVer
-	(*GetHealthRequest)(nil),                // 10: runtime.GetHealthRequest
-	(*GetHealthReply)(nil),                  // 11: runtime.GetHealthReply
-	(*GetMetricsRequest)(nil),               // 12: runtime.GetMetricsRequest
-	(*GetMetricsReply)(nil),                 // 13: runtime.GetMetricsReply
-	(*MetricUpdate)(nil),                    // 14: runtime.MetricUpdate
-	(*MetricDef)(nil),                       // 15: runtime.MetricDef
-	(*MetricValue)(nil),                     // 16: runtime.MetricValue
-	(*MetricSnapshot)(nil),                  // 17: runtime.MetricSnapshot
-	(*GetLoadRequest)(nil),                  // 18: runtime.GetLoadRequest
-	(*GetLoadReply)(nil),                    // 19: runtime.GetLoadReply
-	(*LoadReport)(nil),                      // 20: runtime.LoadReport
-	(*GetProfileRequest)(nil),               // 21: runtime.GetProfileRequest
-	(*GetProfileReply)(nil),                 // 22: runtime.GetProfileReply
-	(*UpdateRoutingInfoRequest)(nil),        // 23: runtime.UpdateRoutingInfoRequest
-	(*UpdateRoutingInfoReply)(nil),          // 24: runtime.UpdateRoutingInfoReply
-	(*RoutingInfo)(nil),                     // 25: runtime.RoutingInfo
-	(*Assignment)(nil),                      // 26: runtime.Assignment
-	(*UpdateComponentsRequest)(nil),         // 27: runtime.UpdateComponentsRequest
-	(*UpdateComponentsReply)(nil),           // 28: runtime.UpdateComponentsReply
-	(*ActivateComponentRequest)(nil),        // 29: runtime.ActivateComponentRequest
-	(*ActivateComponentReply)(nil),          // 30: runtime.ActivateComponentReply
-	(*GetListenerAddressRequest)(nil),       // 31: runtime.GetListenerAddressRequest
-	(*GetListenerAddressReply)(nil),         // 32: runtime.GetListenerAddressReply
-	(*ExportListenerRequest)(nil),           // 33: runtime.ExportListenerRequest
-	(*ExportListenerReply)(nil),             // 34: runtime.ExportListenerReply
-	(*GetSelfCertificateRequest)(nil),       // 35: runtime.GetSelfCertificateRequest
-	(*GetSelfCertificateReply)(nil),         // 36: runtime.GetSelfCertificateReply
-	(*VerifyClientCertificateRequest)(nil),  // 37: runtime.VerifyClientCertificateRequest
-	(*VerifyClientCertificateReply)(nil),    // 38: runtime.VerifyClientCertificateReply
-	(*VerifyServerCertificateRequest)(nil),  // 39: runtime.VerifyServerCertificateRequest
-	(*VerifyServerCertificateReply)(nil),    // 40: runtime.VerifyServerCertificateReply
-	(*LogEntry)(nil),                        // 41: runtime.LogEntry
-	(*LogEntryBatch)(nil),                   // 42: runtime.LogEntryBatch
-	(*TraceSpans)(nil),                      // 43: runtime.TraceSpans
-	(*Span)(nil),                            // 44: runtime.Span
-	(*WeaveletArgs_Redirect)(nil),           // 45: runtime.WeaveletArgs.Redirect
-	nil,                                     // 46: runtime.InitWeaveletRequest.SectionsEntry
-	nil,                                     // 47: runtime.MetricDef.LabelsEntry
-	nil,                                     // 48: runtime.MetricSnapshot.LabelsEntry
-	nil,                                     // 49: runtime.LoadReport.LoadsEntry
-	(*LoadReport_ComponentLoad)(nil),        // 50: runtime.LoadReport.ComponentLoad
-	(*LoadReport_SliceLoad)(nil),            // 51: runtime.LoadReport.SliceLoad
-	(*LoadReport_SubsliceLoad)(nil),         // 52: runtime.LoadReport.SubsliceLoad
-	(*Assignment_Slice)(nil),                // 53: runtime.Assignment.Slice
-	(*Span_Attribute)(nil),                  // 54: runtime.Span.Attribute
-	(*Span_Link)(nil),                       // 55: runtime.Span.Link
-	(*Span_Event)(nil),                      // 56: runtime.Span.Event
-	(*Span_Status)(nil),                     // 57: runtime.Span.Status
-	(*Span_Scope)(nil),                      // 58: runtime.Span.Scope
-	(*Span_Library)(nil),                    // 59: runtime.Span.Library
-	(*Span_Resource)(nil),                   // 60: runtime.Span.Resource
-	(*Span_Attribute_Value)(nil),            // 61: runtime.Span.Attribute.Value
-	(*Span_Attribute_Value_NumberList)(nil), // 62: runtime.Span.Attribute.Value.NumberList
-	(*Span_Attribute_Value_StringList)(nil), // 63: runtime.Span.Attribute.Value.StringList
-}
-var file_runtime_protos_runtime_proto_depIdxs = []int32{
-	45, // 0: runtime.WeaveletArgs.redirects:type_name -> runtime.WeaveletArgs.Redirect
-	46, // 1: runtime.InitWeaveletRequest.sections:type_name -> runtime.InitWeaveletRequest.SectionsEntry
-	9,  // 2: runtime.InitWeaveletReply.version:type_name -> runtime.SemVer
-	0,  // 3: runtime.GetHealthReply.status:type_name -> runtime.HealthStatus
-	14, // 4: runtime.GetMetricsReply.update:type_name -> runtime.MetricUpdate
-	15, // 5: runtime.MetricUpdate.defs:type_name -> runtime.MetricDef
-	16, // 6: runtime.MetricUpdate.values:type_name -> runtime.MetricValue
-	1,  // 7: runtime.MetricDef.typ:type_name -> runtime.MetricType
-	47, // 8: runtime.MetricDef.labels:type_name -> runtime.MetricDef.LabelsEntry
-	1,  // 9: runtime.MetricSnapshot.typ:type_name -> runtime.MetricType
-	48, // 10: runtime.MetricSnapshot.labels:type_name -> runtime.MetricSnapshot.LabelsEntry
-	20, // 11: runtime.GetLoadReply.load:type_name -> runtime.LoadReport
-	49, // 12: runtime.LoadReport.loads:type_name -> runtime.LoadReport.LoadsEntry
-	2,  // 13: runtime.GetProfileRequest.profile_type:type_name -> runtime.ProfileType
-	25, // 14: runtime.UpdateRoutingInfoRequest.routing_info:type_name -> runtime.RoutingInfo
-	26, // 15: runtime.RoutingInfo.assignment:type_name -> runtime.Assignment
-	53, // 16: runtime.Assignment.slices:type_name -> runtime.Assignment.Slice
-	41, // 17: runtime.LogEntryBatch.entries:type_name -> runtime.LogEntry
-	44, // 18: runtime.TraceSpans.span:type_name -> runtime.Span
-	3,  // 19: runtime.Span.kind:type_name -> runtime.Span.Kind
-	54, // 20: runtime.Span.attributes:type_name -> runtime.Span.Attribute
-	55, // 21: runtime.Span.links:type_name -> runtime.Span.Link
-	56, // 22: runtime.Span.events:type_name -> runtime.Span.Event
-	57, // 23: runtime.Span.status:type_name -> runtime.Span.Status
-	58, // 24: runtime.Span.scope:type_name -> runtime.Span.Scope
-	59, // 25: runtime.Span.library:type_name -> runtime.Span.Library
-	60, // 26: runtime.Span.resource:type_name -> runtime.Span.Resource
-	50, // 27: runtime.LoadReport.LoadsEntry.value:type_name -> runtime.LoadReport.ComponentLoad
-	51, // 28: runtime.LoadReport.ComponentLoad.load:type_name -> runtime.LoadReport.SliceLoad
-	52, // 29: runtime.LoadReport.SliceLoad.splits:type_name -> runtime.LoadReport.SubsliceLoad
-	61, // 30: runtime.Span.Attribute.value:type_name -> runtime.Span.Attribute.Value
-	54, // 31: runtime.Span.Link.attributes:type_name -> runtime.Span.Attribute
-	54, // 32: runtime.Span.Event.attributes:type_name -> runtime.Span.Attribute
-	5,  // 33: runtime.Span.Status.code:type_name -> runtime.Span.Status.Code
-	54, // 34: runtime.Span.Resource.attributes:type_name -> runtime.Span.Attribute
-	4,  // 35: runtime.Span.Attribute.Value.type:type_name -> runtime.Span.Attribute.Value.Type
-	62, // 36: runtime.Span.Attribute.Value.nums:type_name -> runtime.Span.Attribute.Value.NumberList
-	63, // 37: runtime.Span.Attribute.Value.strs:type_name -> runtime.Span.Attribute.Value.StringList
-	38, // [38:38] is the sub-list for method output_type
-	38, // [38:38] is the sub-list for method input_type
-	38, // [38:38] is the sub-list for extension type_name
-	38, // [38:38] is the sub-list for extension extendee
-	0,  // [0:38] is the sub-list for field type_name
-}
-
-func init() { file_runtime_protos_runtime_proto_init() }
-func file_runtime_protos_runtime_proto_init() {
-	if File_runtime_protos_runtime_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_runtime_protos_runtime_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WeaveletArgs); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeatureFlagRequest.ProtoReflect.Descriptor instead.
+func (*SetFeatureFlagRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetFeatureFlagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetFeatureFlagRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// SetFeatureFlagReply is a reply to a SetFeatureFlagRequest.
+type SetFeatureFlagReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetFeatureFlagReply) Reset() {
+	*x = SetFeatureFlagReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFeatureFlagReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeatureFlagReply) ProtoMessage() {}
+
+func (x *SetFeatureFlagReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeatureFlagReply.ProtoReflect.Descriptor instead.
+func (*SetFeatureFlagReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{63}
+}
+
+// RawRPCRequest is a request from an envelope for a weavelet to pass an opaque
+// payload to a raw handler registered by the application (see
+// weaver.RegisterRawHandler). Raw RPCs are unversioned and intended for
+// prototyping new control messages only.
+type RawRPCRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Handler string `protobuf:"bytes,1,opt,name=handler,proto3" json:"handler,omitempty"` // handler name
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *RawRPCRequest) Reset() {
+	*x = RawRPCRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawRPCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawRPCRequest) ProtoMessage() {}
+
+func (x *RawRPCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawRPCRequest.ProtoReflect.Descriptor instead.
+func (*RawRPCRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RawRPCRequest) GetHandler() string {
+	if x != nil {
+		return x.Handler
+	}
+	return ""
+}
+
+func (x *RawRPCRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// RawRPCReply is a reply to a RawRPCRequest.
+type RawRPCReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"` // the result returned by the handler
+}
+
+func (x *RawRPCReply) Reset() {
+	*x = RawRPCReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawRPCReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawRPCReply) ProtoMessage() {}
+
+func (x *RawRPCReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawRPCReply.ProtoReflect.Descriptor instead.
+func (*RawRPCReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RawRPCReply) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// CheckpointRequest is a request from an envelope for a weavelet to serialize
+// the state of a component. Only components that registered checkpoint
+// functions (see weaver.RegisterCheckpoint) support checkpointing.
+type CheckpointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"` // component name
+}
+
+func (x *CheckpointRequest) Reset() {
+	*x = CheckpointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointRequest) ProtoMessage() {}
+
+func (x *CheckpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointRequest.ProtoReflect.Descriptor instead.
+func (*CheckpointRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CheckpointRequest) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+// CheckpointReply is a reply to a CheckpointRequest.
+type CheckpointReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State []byte `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"` // serialized component state
+}
+
+func (x *CheckpointReply) Reset() {
+	*x = CheckpointReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckpointReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointReply) ProtoMessage() {}
+
+func (x *CheckpointReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointReply.ProtoReflect.Descriptor instead.
+func (*CheckpointReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CheckpointReply) GetState() []byte {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// RestoreRequest is a request from an envelope for a weavelet to restore the
+// state of a component from a state previously returned in a CheckpointReply.
+type RestoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"` // component name
+	State     []byte `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`         // serialized component state
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RestoreRequest) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *RestoreRequest) GetState() []byte {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// RestoreReply is a reply to a RestoreRequest.
+type RestoreReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestoreReply) Reset() {
+	*x = RestoreReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreReply) ProtoMessage() {}
+
+func (x *RestoreReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreReply.ProtoReflect.Descriptor instead.
+func (*RestoreReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{69}
+}
+
+// QuiesceComponentRequest is a request from an envelope for a weavelet to
+// quiesce a checkpointable component, e.g., to migrate it to another
+// weavelet. The weavelet disables the component (see
+// SetComponentEnabledRequest), waits for its in-flight calls to finish, and
+// checkpoints it. If any step fails, the component is left as it was.
+type QuiesceComponentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"` // component name
+	// If positive, how long to wait for in-flight calls to finish, in
+	// nanoseconds. If zero, the weavelet waits as long as the request allows.
+	TimeoutNs int64 `protobuf:"varint,2,opt,name=timeout_ns,json=timeoutNs,proto3" json:"timeout_ns,omitempty"`
+}
+
+func (x *QuiesceComponentRequest) Reset() {
+	*x = QuiesceComponentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuiesceComponentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuiesceComponentRequest) ProtoMessage() {}
+
+func (x *QuiesceComponentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuiesceComponentRequest.ProtoReflect.Descriptor instead.
+func (*QuiesceComponentRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *QuiesceComponentRequest) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *QuiesceComponentRequest) GetTimeoutNs() int64 {
+	if x != nil {
+		return x.TimeoutNs
+	}
+	return 0
+}
+
+// QuiesceComponentReply is a reply to a QuiesceComponentRequest.
+type QuiesceComponentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State []byte `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"` // serialized component state, as in a CheckpointReply
+}
+
+func (x *QuiesceComponentReply) Reset() {
+	*x = QuiesceComponentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuiesceComponentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuiesceComponentReply) ProtoMessage() {}
+
+func (x *QuiesceComponentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuiesceComponentReply.ProtoReflect.Descriptor instead.
+func (*QuiesceComponentReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *QuiesceComponentReply) GetState() []byte {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// DrainRequest is a request from an envelope for a weavelet to drain its
+// components in stages, e.g., before the weavelet is shut down. The
+// components of a stage are disabled (see SetComponentEnabledRequest) and
+// their in-flight calls are waited for concurrently. A stage starts only
+// once the calls of the previous stage have finished, so listing frontends
+// before the backends they call keeps the backends serving while the
+// frontends drain. If a stage fails to drain, the later stages are left
+// enabled.
+type DrainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stages []*DrainStage `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages,omitempty"`
+	// If positive, how long to wait for all stages to drain, in nanoseconds.
+	// If zero, the weavelet waits as long as the request allows.
+	TimeoutNs int64 `protobuf:"varint,2,opt,name=timeout_ns,json=timeoutNs,proto3" json:"timeout_ns,omitempty"`
+}
+
+func (x *DrainRequest) Reset() {
+	*x = DrainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainRequest) ProtoMessage() {}
+
+func (x *DrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainRequest.ProtoReflect.Descriptor instead.
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DrainRequest) GetStages() []*DrainStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *DrainRequest) GetTimeoutNs() int64 {
+	if x != nil {
+		return x.TimeoutNs
+	}
+	return 0
+}
+
+// DrainStage is a stage of a DrainRequest.
+type DrainStage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Components []string `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"` // component names
+}
+
+func (x *DrainStage) Reset() {
+	*x = DrainStage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainStage) ProtoMessage() {}
+
+func (x *DrainStage) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainStage.ProtoReflect.Descriptor instead.
+func (*DrainStage) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DrainStage) GetComponents() []string {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+// DrainReply is a reply to a DrainRequest.
+type DrainReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrainReply) Reset() {
+	*x = DrainReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainReply) ProtoMessage() {}
+
+func (x *DrainReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainReply.ProtoReflect.Descriptor instead.
+func (*DrainReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{74}
+}
+
+// UpdateRoutingInfoRequest is a request from an envelope to the weavelet to
+// update its routing information for a particular component.
+type UpdateRoutingInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoutingInfo *RoutingInfo `protobuf:"bytes,1,opt,name=routing_info,json=routingInfo,proto3" json:"routing_info,omitempty"`
+}
+
+func (x *UpdateRoutingInfoRequest) Reset() {
+	*x = UpdateRoutingInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoutingInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoutingInfoRequest) ProtoMessage() {}
+
+func (x *UpdateRoutingInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoutingInfoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoutingInfoRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdateRoutingInfoRequest) GetRoutingInfo() *RoutingInfo {
+	if x != nil {
+		return x.RoutingInfo
+	}
+	return nil
+}
+
+// UpdateRoutingInfoReply is a reply to an UpdateRoutingInfoRequest.
+type UpdateRoutingInfoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateRoutingInfoReply) Reset() {
+	*x = UpdateRoutingInfoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoutingInfoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoutingInfoReply) ProtoMessage() {}
+
+func (x *UpdateRoutingInfoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoutingInfoReply.ProtoReflect.Descriptor instead.
+func (*UpdateRoutingInfoReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{76}
+}
+
+// RoutingInfo contains routing information for a component. A weavelet uses a
+// RoutingInfo to know where to send a remote method call.
+type RoutingInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	// If true, perform method calls on the component locally. Replicas and
+	// assignment are ignored. Currently, local must always be true or always be
+	// false for a given component.
+	Local bool `protobuf:"varint,2,opt,name=local,proto3" json:"local,omitempty"`
+	// The addresses of the weavelets hosting a replica of the component. Every
+	// address is of the form "<net>://<addr>" (e.g., "tcp://host:1234",
+	// "unix:///tmp/unix.sock"). For non-local components, a weavelet will execute
+	// method calls on one of these replicas.
+	Replicas []string `protobuf:"bytes,3,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	// Routing assignment, if the component is routed.
+	Assignment *Assignment `protobuf:"bytes,4,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	// If positive, the rate (in requests per second) at which a weavelet
+	// hosting the component serves calls made to the component by other
+	// weavelets. The limit applies to all callers together and is enforced with
+	// a token bucket that holds up to max_burst tokens (at least one), so short
+	// bursts above the rate are allowed. Calls that exceed the limit fail
+	// immediately with a remote call error that wraps weaver.ErrRateLimited.
+	// Calls made by the hosting weavelet itself are not limited, and weavelets
+	// that don't host the component ignore the limit.
+	MaxRequestsPerSecond float64 `protobuf:"fixed64,5,opt,name=max_requests_per_second,json=maxRequestsPerSecond,proto3" json:"max_requests_per_second,omitempty"`
+	MaxBurst             int32   `protobuf:"varint,6,opt,name=max_burst,json=maxBurst,proto3" json:"max_burst,omitempty"`
+	// The version of the routing info. Versions must increase monotonically
+	// for a given component. A weavelet rejects routing info with a version
+	// lower than the version it last applied, so that a delayed update from a
+	// stale controller cannot roll back routing. Routing info with a zero
+	// version is unversioned and is always applied.
+	Version uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// Rules that pin ranges of shard keys to specific replicas, taking
+	// precedence over the assignment. For a routed method call, the weavelet
+	// uses the first rule whose range contains the call's shard key, and falls
+	// back to the assignment if no rule matches or if the pinned replica is
+	// not connected. Every pinned replica must be one of the replicas. The
+	// rules replace the rules of earlier routing info for the component.
+	Pins []*PinRule `protobuf:"bytes,8,rep,name=pins,proto3" json:"pins,omitempty"`
+	// The circuit breaker for calls to the component, if any. The breaker is
+	// ignored for local components.
+	CircuitBreaker *CircuitBreaker `protobuf:"bytes,9,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+}
+
+func (x *RoutingInfo) Reset() {
+	*x = RoutingInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoutingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutingInfo) ProtoMessage() {}
+
+func (x *RoutingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutingInfo.ProtoReflect.Descriptor instead.
+func (*RoutingInfo) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RoutingInfo) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *RoutingInfo) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+func (x *RoutingInfo) GetReplicas() []string {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *RoutingInfo) GetAssignment() *Assignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *RoutingInfo) GetMaxRequestsPerSecond() float64 {
+	if x != nil {
+		return x.MaxRequestsPerSecond
+	}
+	return 0
+}
+
+func (x *RoutingInfo) GetMaxBurst() int32 {
+	if x != nil {
+		return x.MaxBurst
+	}
+	return 0
+}
+
+func (x *RoutingInfo) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RoutingInfo) GetPins() []*PinRule {
+	if x != nil {
+		return x.Pins
+	}
+	return nil
+}
+
+func (x *RoutingInfo) GetCircuitBreaker() *CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreaker
+	}
+	return nil
+}
+
+// CircuitBreaker configures a circuit breaker for the calls that a weavelet
+// issues to a component. The breaker opens after failure_threshold
+// consecutive calls fail with a remote call error; errors returned by the
+// component's methods are not failures. While the breaker is open, calls fail
+// immediately with weaver.ErrCircuitOpen. After open_duration_ns, the breaker
+// is half-open: the weavelet issues a single probe call, and the breaker
+// closes if the probe succeeds and opens again otherwise. The state of the
+// breaker is reset whenever its configuration changes.
+type CircuitBreaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of consecutive failures that opens the breaker. If zero, the
+	// breaker only opens when forced.
+	FailureThreshold int32 `protobuf:"varint,1,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	// How long the breaker stays open before probing, in nanoseconds. If not
+	// positive, the breaker stays open for 10 seconds.
+	OpenDurationNs int64 `protobuf:"varint,2,opt,name=open_duration_ns,json=openDurationNs,proto3" json:"open_duration_ns,omitempty"`
+	// If true, the breaker is held open, without probing, until routing info
+	// without force_open is applied.
+	ForceOpen bool `protobuf:"varint,3,opt,name=force_open,json=forceOpen,proto3" json:"force_open,omitempty"`
+}
+
+func (x *CircuitBreaker) Reset() {
+	*x = CircuitBreaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreaker) ProtoMessage() {}
+
+func (x *CircuitBreaker) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CircuitBreaker) GetFailureThreshold() int32 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetOpenDurationNs() int64 {
+	if x != nil {
+		return x.OpenDurationNs
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetForceOpen() bool {
+	if x != nil {
+		return x.ForceOpen
+	}
+	return false
+}
+
+// CircuitBreakerState is the state of a circuit breaker. See CircuitBreaker.
+type CircuitBreakerState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State               CircuitBreakerState_State `protobuf:"varint,1,opt,name=state,proto3,enum=runtime.CircuitBreakerState_State" json:"state,omitempty"`
+	ConsecutiveFailures int32                     `protobuf:"varint,2,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"` // consecutive failed calls, while closed
+	OpenedUnixMicros    int64                     `protobuf:"varint,3,opt,name=opened_unix_micros,json=openedUnixMicros,proto3" json:"opened_unix_micros,omitempty"`        // when the breaker last opened, if ever
+}
+
+func (x *CircuitBreakerState) Reset() {
+	*x = CircuitBreakerState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreakerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreakerState) ProtoMessage() {}
+
+func (x *CircuitBreakerState) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitBreakerState.ProtoReflect.Descriptor instead.
+func (*CircuitBreakerState) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CircuitBreakerState) GetState() CircuitBreakerState_State {
+	if x != nil {
+		return x.State
+	}
+	return CircuitBreakerState_CLOSED
+}
+
+func (x *CircuitBreakerState) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *CircuitBreakerState) GetOpenedUnixMicros() int64 {
+	if x != nil {
+		return x.OpenedUnixMicros
+	}
+	return 0
+}
+
+// PinRule pins the shard keys in the inclusive range [start, end] to a
+// replica. Shard keys are the hashes of the keys returned by a component's
+// router (see weaver.WithRouter).
+type PinRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start   uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End     uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Replica string `protobuf:"bytes,3,opt,name=replica,proto3" json:"replica,omitempty"` // the address of the replica
+}
+
+func (x *PinRule) Reset() {
+	*x = PinRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinRule) ProtoMessage() {}
+
+func (x *PinRule) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinRule.ProtoReflect.Descriptor instead.
+func (*PinRule) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PinRule) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *PinRule) GetEnd() uint64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *PinRule) GetReplica() string {
+	if x != nil {
+		return x.Replica
+	}
+	return ""
+}
+
+// Assignment partitions a key space (e.g., the hash space [0, 2^64)) into a set
+// of subregions, called slices, and assigns each slice to a set of replicas.
+type Assignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slices []*Assignment_Slice `protobuf:"bytes,1,rep,name=slices,proto3" json:"slices,omitempty"`
+	// The assignment for a component can change over time. Every one of these
+	// assignments is given a monotonically increasing version. Versions are
+	// unique for a given component, but not unique across components.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Assignment) Reset() {
+	*x = Assignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Assignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignment) ProtoMessage() {}
+
+func (x *Assignment) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
+func (*Assignment) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Assignment) GetSlices() []*Assignment_Slice {
+	if x != nil {
+		return x.Slices
+	}
+	return nil
+}
+
+func (x *Assignment) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// UpdateComponentsRequest is a request from an envelope to the weavelet to
+// update the set of components it should be running. Currently, the set of
+// components must only ever increase over time.
+type UpdateComponentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Components []string `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	// If true, the weavelet only checks whether it can run the provided
+	// components, without starting any of them, and reports the problems it
+	// finds in the reply rather than returning an error.
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *UpdateComponentsRequest) Reset() {
+	*x = UpdateComponentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateComponentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateComponentsRequest) ProtoMessage() {}
+
+func (x *UpdateComponentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateComponentsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateComponentsRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateComponentsRequest) GetComponents() []string {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *UpdateComponentsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// UpdateComponentsReply is a reply to an UpdateComponentsRequest.
+type UpdateComponentsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Requested components that are not part of the weavelet's binary.
+	UnknownComponents []string `protobuf:"bytes,1,rep,name=unknown_components,json=unknownComponents,proto3" json:"unknown_components,omitempty"`
+	// Requested components that are disabled (see SetComponentEnabledRequest)
+	// and would reject calls.
+	DisabledComponents []string `protobuf:"bytes,2,rep,name=disabled_components,json=disabledComponents,proto3" json:"disabled_components,omitempty"`
+}
+
+func (x *UpdateComponentsReply) Reset() {
+	*x = UpdateComponentsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateComponentsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateComponentsReply) ProtoMessage() {}
+
+func (x *UpdateComponentsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateComponentsReply.ProtoReflect.Descriptor instead.
+func (*UpdateComponentsReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateComponentsReply) GetUnknownComponents() []string {
+	if x != nil {
+		return x.UnknownComponents
+	}
+	return nil
+}
+
+func (x *UpdateComponentsReply) GetDisabledComponents() []string {
+	if x != nil {
+		return x.DisabledComponents
+	}
+	return nil
+}
+
+// ActivateComponentRequest is a request from a weavelet to ensure that the
+// provided component is running somewhere. An ActivateComponentRequest also
+// implicitly signals that a weavelet is interested in receiving routing info
+// for the component via UpdateRoutingInfoRequest.
+type ActivateComponentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"` // component name
+	Routed    bool   `protobuf:"varint,2,opt,name=routed,proto3" json:"routed,omitempty"`      // is the component routed?
+}
+
+func (x *ActivateComponentRequest) Reset() {
+	*x = ActivateComponentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateComponentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateComponentRequest) ProtoMessage() {}
+
+func (x *ActivateComponentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateComponentRequest.ProtoReflect.Descriptor instead.
+func (*ActivateComponentRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ActivateComponentRequest) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *ActivateComponentRequest) GetRouted() bool {
+	if x != nil {
+		return x.Routed
+	}
+	return false
+}
+
+// ActivateComponentReply is a reply to an ActivateComponentRequest.
+type ActivateComponentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Annotations of the component (e.g., the color of the deployment the
+	// component belongs to during a blue/green rollout). The weavelet adds them
+	// as labels to the metrics it exports for the component, and as attributes
+	// to the trace spans of the component's method calls it makes. Annotations
+	// never override the labels or attributes set by Service Weaver.
+	Annotations map[string]string `protobuf:"bytes,1,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ActivateComponentReply) Reset() {
+	*x = ActivateComponentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateComponentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateComponentReply) ProtoMessage() {}
+
+func (x *ActivateComponentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateComponentReply.ProtoReflect.Descriptor instead.
+func (*ActivateComponentReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ActivateComponentReply) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+// GetListenerAddressRequest is a request from a weavelet for the address the
+// weavelet should listen on for a particular listener.
+//
+// Imagine a weavelet calls GetListener with LocalAdress localhost:9000. Here
+// are some examples of how different deployers may handle a
+// GetListenerAddressRequest.
+//
+//   - The singleprocess deployer may instruct the weavelet to listen directly
+//     on localhost:9000.
+//   - The multiprocess deployer may instruct the weavelet to listen on
+//     localhost:0. It will separately start a proxy on localhost:9000.
+//   - The SSH deployer may instruct the weavelet to listen on
+//     $HOSTNAME:0. It will separately start a proxy on localhost:9000.
+type GetListenerAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // listener name
+}
+
+func (x *GetListenerAddressRequest) Reset() {
+	*x = GetListenerAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListenerAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListenerAddressRequest) ProtoMessage() {}
+
+func (x *GetListenerAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListenerAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetListenerAddressRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetListenerAddressRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetListenerAddressReply is a reply to a GetListenerAddressRequest.
+type GetListenerAddressReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address to listen on. An address of the form "unix://<path>" makes the
+	// weavelet listen on a unix domain socket at the provided path, which is
+	// cheaper than TCP for clients running on the same machine. Unix domain
+	// sockets can't be reached from other machines, so deployers that return
+	// them must make sure that all clients or proxies of the listener run on
+	// the weavelet's machine. The weavelet removes the socket file when the
+	// listener is closed. Any other address is a TCP host:port address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *GetListenerAddressReply) Reset() {
+	*x = GetListenerAddressReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListenerAddressReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListenerAddressReply) ProtoMessage() {}
+
+func (x *GetListenerAddressReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListenerAddressReply.ProtoReflect.Descriptor instead.
+func (*GetListenerAddressReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetListenerAddressReply) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// ExportListenerRequest is a request from a weavelet to export the provided
+// listener. Exporting a listener typically, but not always, involves running a
+// proxy that forwards traffic to the provided address.
+type ExportListenerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listener string `protobuf:"bytes,1,opt,name=listener,proto3" json:"listener,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"` // bound address, "unix://<path>" for unix sockets
+	// A stable identifier of this listener registration, of the form
+	// "<weavelet id>/<listener name>". The token is the same across retries, so
+	// handlers can use it to register a listener idempotently, e.g., if the
+	// weavelet re-exports a listener after a deployer restart.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// How to check the health of the listener, e.g., when registering it with
+	// a load balancer. Derived from the config of the component that owns the
+	// listener (see weaver.ListenerHealthChecks), with defaults for the
+	// unspecified fields. Always set.
+	HealthCheck *ListenerHealthCheck `protobuf:"bytes,4,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+}
+
+func (x *ExportListenerRequest) Reset() {
+	*x = ExportListenerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportListenerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportListenerRequest) ProtoMessage() {}
+
+func (x *ExportListenerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportListenerRequest.ProtoReflect.Descriptor instead.
+func (*ExportListenerRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ExportListenerRequest) GetListener() string {
+	if x != nil {
+		return x.Listener
+	}
+	return ""
+}
+
+func (x *ExportListenerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ExportListenerRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ExportListenerRequest) GetHealthCheck() *ListenerHealthCheck {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+// ListenerHealthCheck describes an HTTP health check of an exported listener.
+type ListenerHealthCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path       string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`                                // URL path to request, e.g., "/healthz"
+	Status     int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                           // HTTP status code of a healthy reply
+	IntervalNs int64  `protobuf:"varint,3,opt,name=interval_ns,json=intervalNs,proto3" json:"interval_ns,omitempty"` // interval between checks
+}
+
+func (x *ListenerHealthCheck) Reset() {
+	*x = ListenerHealthCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListenerHealthCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenerHealthCheck) ProtoMessage() {}
+
+func (x *ListenerHealthCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenerHealthCheck.ProtoReflect.Descriptor instead.
+func (*ListenerHealthCheck) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListenerHealthCheck) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ListenerHealthCheck) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListenerHealthCheck) GetIntervalNs() int64 {
+	if x != nil {
+		return x.IntervalNs
+	}
+	return 0
+}
+
+// ExportListenerReply is a reply to an ExportListenerRequest.
+type ExportListenerReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address of the proxy that forwards traffic to the listener, or empty if
+	// there is no proxy.
+	ProxyAddress string `protobuf:"bytes,1,opt,name=proxy_address,json=proxyAddress,proto3" json:"proxy_address,omitempty"`
+	// If non-empty, a non-retriable error.
+	//
+	// TODO(mwhittaker): This field exists as a hack to avoid a weavelet retrying
+	// this error. In the future, we should have a more robust way to avoid
+	// retrying certain errors.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// If non-nil, the TLS material the weavelet should use to serve the
+	// listener. The material can later be rotated with an
+	// UpdateListenerTLSRequest.
+	Tls *ListenerTLS `protobuf:"bytes,3,opt,name=tls,proto3" json:"tls,omitempty"`
+}
+
+func (x *ExportListenerReply) Reset() {
+	*x = ExportListenerReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportListenerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportListenerReply) ProtoMessage() {}
+
+func (x *ExportListenerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportListenerReply.ProtoReflect.Descriptor instead.
+func (*ExportListenerReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ExportListenerReply) GetProxyAddress() string {
+	if x != nil {
+		return x.ProxyAddress
+	}
+	return ""
+}
+
+func (x *ExportListenerReply) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ExportListenerReply) GetTls() *ListenerTLS {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+// ListenerTLS contains the TLS material a weavelet uses to serve an exported
+// listener.
+type ListenerTLS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cert []byte `protobuf:"bytes,1,opt,name=cert,proto3" json:"cert,omitempty"` // PEM-encoded certificate chain
+	Key  []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`   // PEM-encoded private key corresponding to cert
+	// PEM-encoded CA certificates used to verify client certificates. If empty,
+	// client certificates are not requested.
+	Ca []byte `protobuf:"bytes,3,opt,name=ca,proto3" json:"ca,omitempty"`
+}
+
+func (x *ListenerTLS) Reset() {
+	*x = ListenerTLS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListenerTLS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenerTLS) ProtoMessage() {}
+
+func (x *ListenerTLS) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenerTLS.ProtoReflect.Descriptor instead.
+func (*ListenerTLS) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListenerTLS) GetCert() []byte {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
+func (x *ListenerTLS) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *ListenerTLS) GetCa() []byte {
+	if x != nil {
+		return x.Ca
+	}
+	return nil
+}
+
+// UpdateListenerTLSRequest is a request from an envelope to a weavelet to
+// rotate the TLS material of a listener that was exported with TLS. New
+// connections use the new material; existing connections are unaffected.
+type UpdateListenerTLSRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listener string       `protobuf:"bytes,1,opt,name=listener,proto3" json:"listener,omitempty"` // listener name
+	Tls      *ListenerTLS `protobuf:"bytes,2,opt,name=tls,proto3" json:"tls,omitempty"`
+}
+
+func (x *UpdateListenerTLSRequest) Reset() {
+	*x = UpdateListenerTLSRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateListenerTLSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateListenerTLSRequest) ProtoMessage() {}
+
+func (x *UpdateListenerTLSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateListenerTLSRequest.ProtoReflect.Descriptor instead.
+func (*UpdateListenerTLSRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UpdateListenerTLSRequest) GetListener() string {
+	if x != nil {
+		return x.Listener
+	}
+	return ""
+}
+
+func (x *UpdateListenerTLSRequest) GetTls() *ListenerTLS {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+// UpdateListenerTLSReply is a reply to an UpdateListenerTLSRequest.
+type UpdateListenerTLSReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateListenerTLSReply) Reset() {
+	*x = UpdateListenerTLSReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateListenerTLSReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateListenerTLSReply) ProtoMessage() {}
+
+func (x *UpdateListenerTLSReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateListenerTLSReply.ProtoReflect.Descriptor instead.
+func (*UpdateListenerTLSReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{93}
+}
+
+// ReexportListenersRequest is a request from an envelope to a weavelet to
+// export all of its listeners again, e.g., because the deployer lost its
+// record of the listeners when it restarted. The weavelet re-issues an
+// ExportListenerRequest, with the same address and token, for every listener
+// it has, and replies once they have all been exported. The proxy addresses in
+// the replies are ignored, but the TLS material of the listeners that were
+// exported with TLS is updated.
+type ReexportListenersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReexportListenersRequest) Reset() {
+	*x = ReexportListenersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReexportListenersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReexportListenersRequest) ProtoMessage() {}
+
+func (x *ReexportListenersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReexportListenersRequest.ProtoReflect.Descriptor instead.
+func (*ReexportListenersRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{94}
+}
+
+// ReexportListenersReply is a reply to a ReexportListenersRequest.
+type ReexportListenersReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listeners []string `protobuf:"bytes,1,rep,name=listeners,proto3" json:"listeners,omitempty"` // names of the re-exported listeners, sorted
+}
+
+func (x *ReexportListenersReply) Reset() {
+	*x = ReexportListenersReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReexportListenersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReexportListenersReply) ProtoMessage() {}
+
+func (x *ReexportListenersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReexportListenersReply.ProtoReflect.Descriptor instead.
+func (*ReexportListenersReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ReexportListenersReply) GetListeners() []string {
+	if x != nil {
+		return x.Listeners
+	}
+	return nil
+}
+
+// FlushTelemetryRequest is a request from an envelope for a weavelet to send
+// the log entries and trace spans it has buffered. The weavelet replies once
+// the envelope has received all log entries and trace spans produced before
+// the request.
+type FlushTelemetryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FlushTelemetryRequest) Reset() {
+	*x = FlushTelemetryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushTelemetryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushTelemetryRequest) ProtoMessage() {}
+
+func (x *FlushTelemetryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushTelemetryRequest.ProtoReflect.Descriptor instead.
+func (*FlushTelemetryRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{96}
+}
+
+// FlushTelemetryReply is a reply to a FlushTelemetryRequest.
+type FlushTelemetryReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FlushTelemetryReply) Reset() {
+	*x = FlushTelemetryReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushTelemetryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushTelemetryReply) ProtoMessage() {}
+
+func (x *FlushTelemetryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushTelemetryReply.ProtoReflect.Descriptor instead.
+func (*FlushTelemetryReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{97}
+}
+
+// GetInFlightRequest is a request from an envelope for the number of component
+// method calls currently executing in a weavelet.
+type GetInFlightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetInFlightRequest) Reset() {
+	*x = GetInFlightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInFlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInFlightRequest) ProtoMessage() {}
+
+func (x *GetInFlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInFlightRequest.ProtoReflect.Descriptor instead.
+func (*GetInFlightRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{98}
+}
+
+// GetInFlightReply is a reply to a GetInFlightRequest.
+type GetInFlightReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of in-flight calls received from other weavelets, keyed by
+	// the full name of every component started in the weavelet. Calls between
+	// components in the same weavelet are not counted.
+	Calls map[string]int64 `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GetInFlightReply) Reset() {
+	*x = GetInFlightReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInFlightReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInFlightReply) ProtoMessage() {}
+
+func (x *GetInFlightReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInFlightReply.ProtoReflect.Descriptor instead.
+func (*GetInFlightReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetInFlightReply) GetCalls() map[string]int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+// GetRecentErrorsRequest is a request from an envelope for the most recent
+// error log entries of a weavelet. A weavelet keeps a bounded number of them.
+type GetRecentErrorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	N int64 `protobuf:"varint,1,opt,name=n,proto3" json:"n,omitempty"` // maximum number of entries to return; all if zero
+}
+
+func (x *GetRecentErrorsRequest) Reset() {
+	*x = GetRecentErrorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecentErrorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentErrorsRequest) ProtoMessage() {}
+
+func (x *GetRecentErrorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentErrorsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentErrorsRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetRecentErrorsRequest) GetN() int64 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+// GetRecentErrorsReply is a reply to a GetRecentErrorsRequest.
+type GetRecentErrorsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // oldest first
+}
+
+func (x *GetRecentErrorsReply) Reset() {
+	*x = GetRecentErrorsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecentErrorsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentErrorsReply) ProtoMessage() {}
+
+func (x *GetRecentErrorsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentErrorsReply.ProtoReflect.Descriptor instead.
+func (*GetRecentErrorsReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetRecentErrorsReply) GetEntries() []*LogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// ClearRecentErrorsRequest is a request from an envelope for a weavelet to
+// forget its recent error log entries (see GetRecentErrorsRequest).
+type ClearRecentErrorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearRecentErrorsRequest) Reset() {
+	*x = ClearRecentErrorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearRecentErrorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRecentErrorsRequest) ProtoMessage() {}
+
+func (x *ClearRecentErrorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRecentErrorsRequest.ProtoReflect.Descriptor instead.
+func (*ClearRecentErrorsRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{102}
+}
+
+// ClearRecentErrorsReply is a reply to a ClearRecentErrorsRequest.
+type ClearRecentErrorsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearRecentErrorsReply) Reset() {
+	*x = ClearRecentErrorsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearRecentErrorsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRecentErrorsReply) ProtoMessage() {}
+
+func (x *ClearRecentErrorsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRecentErrorsReply.ProtoReflect.Descriptor instead.
+func (*ClearRecentErrorsReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{103}
+}
+
+// SetComponentLogLevelRequest is a request from an envelope for a weavelet to
+// write only the log entries of one of its components at or above the
+// provided level. The levels of the loggers of other components are left
+// unchanged. By default, a component logger writes entries of all levels.
+type SetComponentLogLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	// The level, e.g., "DEBUG" or "WARN+2" (see slog.Level.String). An empty
+	// level clears the component's level, restoring the default.
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *SetComponentLogLevelRequest) Reset() {
+	*x = SetComponentLogLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetComponentLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetComponentLogLevelRequest) ProtoMessage() {}
+
+func (x *SetComponentLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetComponentLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetComponentLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SetComponentLogLevelRequest) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *SetComponentLogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+// SetComponentLogLevelReply is a reply to a SetComponentLogLevelRequest.
+type SetComponentLogLevelReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetComponentLogLevelReply) Reset() {
+	*x = SetComponentLogLevelReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetComponentLogLevelReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetComponentLogLevelReply) ProtoMessage() {}
+
+func (x *SetComponentLogLevelReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetComponentLogLevelReply.ProtoReflect.Descriptor instead.
+func (*SetComponentLogLevelReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{105}
+}
+
+// GetLogLevelsRequest is a request from an envelope for the component log
+// levels set through SetComponentLogLevelRequest.
+type GetLogLevelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetLogLevelsRequest) Reset() {
+	*x = GetLogLevelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogLevelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogLevelsRequest) ProtoMessage() {}
+
+func (x *GetLogLevelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogLevelsRequest.ProtoReflect.Descriptor instead.
+func (*GetLogLevelsRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{106}
+}
+
+// GetLogLevelsReply is a reply to a GetLogLevelsRequest.
+type GetLogLevelsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Levels map[string]string `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // component name -> level
+}
+
+func (x *GetLogLevelsReply) Reset() {
+	*x = GetLogLevelsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogLevelsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogLevelsReply) ProtoMessage() {}
+
+func (x *GetLogLevelsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogLevelsReply.ProtoReflect.Descriptor instead.
+func (*GetLogLevelsReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetLogLevelsReply) GetLevels() map[string]string {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+// ReloadConfigRequest is a request from an envelope to a weavelet to reload
+// the config of its components from the provided config sections (see
+// AppConfig.sections), typically after the app config file has been edited.
+// The sections replace the weavelet's config sections, so components created
+// later use them.
+type ReloadConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sections map[string]string `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ReloadConfigRequest) Reset() {
+	*x = ReloadConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigRequest) ProtoMessage() {}
+
+func (x *ReloadConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigRequest.ProtoReflect.Descriptor instead.
+func (*ReloadConfigRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ReloadConfigRequest) GetSections() map[string]string {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+// ReloadConfigReply is a reply to a ReloadConfigRequest.
+type ReloadConfigReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Started components whose config changed and was reloaded.
+	Reloaded []string `protobuf:"bytes,1,rep,name=reloaded,proto3" json:"reloaded,omitempty"`
+	// Started components whose config changed but couldn't be reloaded, either
+	// because they don't support reloading their config or because reloading
+	// failed. They keep running with their old config until restarted.
+	RestartRequired []string `protobuf:"bytes,2,rep,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+}
+
+func (x *ReloadConfigReply) Reset() {
+	*x = ReloadConfigReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigReply) ProtoMessage() {}
+
+func (x *ReloadConfigReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigReply.ProtoReflect.Descriptor instead.
+func (*ReloadConfigReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ReloadConfigReply) GetReloaded() []string {
+	if x != nil {
+		return x.Reloaded
+	}
+	return nil
+}
+
+func (x *ReloadConfigReply) GetRestartRequired() []string {
+	if x != nil {
+		return x.RestartRequired
+	}
+	return nil
+}
+
+// GetSelfCertificateRequest is a request from a weavelet for its certificate
+// and the corresponding private key.
+type GetSelfCertificateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSelfCertificateRequest) Reset() {
+	*x = GetSelfCertificateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSelfCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSelfCertificateRequest) ProtoMessage() {}
+
+func (x *GetSelfCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSelfCertificateRequest.ProtoReflect.Descriptor instead.
+func (*GetSelfCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{110}
+}
+
+// GetSelfCertificateReply is a reply to a GetSelfCertificateRequest.
+type GetSelfCertificateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PEM-encoded blocks containing (1) the certificate identifying the
+	// weavelet and (2) the corresponding private key. Used for enforcing mTLS
+	// security across components.
+	Cert []byte `protobuf:"bytes,1,opt,name=cert,proto3" json:"cert,omitempty"` // certificate identifying the weavelet
+	Key  []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`   // private key corresponding to cert
+}
+
+func (x *GetSelfCertificateReply) Reset() {
+	*x = GetSelfCertificateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSelfCertificateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSelfCertificateReply) ProtoMessage() {}
+
+func (x *GetSelfCertificateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSelfCertificateReply.ProtoReflect.Descriptor instead.
+func (*GetSelfCertificateReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetSelfCertificateReply) GetCert() []byte {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
+func (x *GetSelfCertificateReply) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+// VerifyClientCertificateRequest is a request from a weavelet to verify
+// the identity of a client that is attempting to connect to the weavelet.
+type VerifyClientCertificateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Client's certificate chain. Each certificate in the chain is represented as
+	// a DER-encoded ASN.1 structure.
+	CertChain [][]byte `protobuf:"bytes,1,rep,name=cert_chain,json=certChain,proto3" json:"cert_chain,omitempty"`
+}
+
+func (x *VerifyClientCertificateRequest) Reset() {
+	*x = VerifyClientCertificateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyClientCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyClientCertificateRequest) ProtoMessage() {}
+
+func (x *VerifyClientCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyClientCertificateRequest.ProtoReflect.Descriptor instead.
+func (*VerifyClientCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *VerifyClientCertificateRequest) GetCertChain() [][]byte {
+	if x != nil {
+		return x.CertChain
+	}
+	return nil
+}
+
+// VerifyClientCertificateReply is a reply to a VerifyClientCertificateRequest.
+// If the client certificate cannot be verified, an error should be returned
+// rather than an empty reply.
+type VerifyClientCertificateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The set of components hosted by the weavelet that the client is allowed to
+	// invoke methods on.
+	Components []string `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+}
+
+func (x *VerifyClientCertificateReply) Reset() {
+	*x = VerifyClientCertificateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyClientCertificateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyClientCertificateReply) ProtoMessage() {}
+
+func (x *VerifyClientCertificateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyClientCertificateReply.ProtoReflect.Descriptor instead.
+func (*VerifyClientCertificateReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *VerifyClientCertificateReply) GetComponents() []string {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+// VerifyServerCertificateRequest is a request from a weavelet to verify
+// the identity of the server it is attempting to connect to.
+type VerifyServerCertificateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Server's certificate chain. Each certificate in the chain is represented as
+	// a DER-encoded ASN.1 structure.
+	CertChain [][]byte `protobuf:"bytes,1,rep,name=cert_chain,json=certChain,proto3" json:"cert_chain,omitempty"`
+	// Name of the component the server is meant to be hosting.
+	TargetComponent string `protobuf:"bytes,2,opt,name=target_component,json=targetComponent,proto3" json:"target_component,omitempty"`
+}
+
+func (x *VerifyServerCertificateRequest) Reset() {
+	*x = VerifyServerCertificateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyServerCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyServerCertificateRequest) ProtoMessage() {}
+
+func (x *VerifyServerCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyServerCertificateRequest.ProtoReflect.Descriptor instead.
+func (*VerifyServerCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *VerifyServerCertificateRequest) GetCertChain() [][]byte {
+	if x != nil {
+		return x.CertChain
+	}
+	return nil
+}
+
+func (x *VerifyServerCertificateRequest) GetTargetComponent() string {
+	if x != nil {
+		return x.TargetComponent
+	}
+	return ""
+}
+
+// VerifyServerCertificateReply is a reply to a VerifyServerCertificateRequest.
+type VerifyServerCertificateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VerifyServerCertificateReply) Reset() {
+	*x = VerifyServerCertificateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyServerCertificateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyServerCertificateReply) ProtoMessage() {}
+
+func (x *VerifyServerCertificateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyServerCertificateReply.ProtoReflect.Descriptor instead.
+func (*VerifyServerCertificateReply) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{115}
+}
+
+// LogEntry is a log entry. Every log entry consists of a message (the thing the
+// user logged) and a set of metadata describing the message.
+type LogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	App        string `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`                                   // application name
+	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                           // deployment id
+	Component  string `protobuf:"bytes,3,opt,name=component,proto3" json:"component,omitempty"`                       // component name
+	Node       string `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`                                 // weavelet id
+	TimeMicros int64  `protobuf:"fixed64,5,opt,name=time_micros,json=timeMicros,proto3" json:"time_micros,omitempty"` // time when logged (microseconds since epoch)
+	Level      string `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"`                               // log level (e.g., debug, info, error)
+	File       string `protobuf:"bytes,7,opt,name=file,proto3" json:"file,omitempty"`                                 // source file
+	Line       int32  `protobuf:"varint,8,opt,name=line,proto3" json:"line,omitempty"`                                // source line
+	Msg        string `protobuf:"bytes,9,opt,name=msg,proto3" json:"msg,omitempty"`                                   // user provided message
+	// User-provided attributes and their associated values. The storage format
+	// is a name followed by its value, i.e.:
+	//
+	//	name1, value1, name2, value2, name3, value3, ...
+	Attrs []string `protobuf:"bytes,10,rep,name=attrs,proto3" json:"attrs,omitempty"`
+	// User-provided attributes with their original types, in the order they
+	// were provided. Unlike attrs, the values are not flattened into strings,
+	// which allows log sinks to re-emit them as structured data.
+	TypedAttrs []*LogAttr `protobuf:"bytes,11,rep,name=typed_attrs,json=typedAttrs,proto3" json:"typed_attrs,omitempty"`
+}
+
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEntry) ProtoMessage() {}
+
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *LogEntry) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *LogEntry) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *LogEntry) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *LogEntry) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *LogEntry) GetTimeMicros() int64 {
+	if x != nil {
+		return x.TimeMicros
+	}
+	return 0
+}
+
+func (x *LogEntry) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *LogEntry) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *LogEntry) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *LogEntry) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *LogEntry) GetAttrs() []string {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+func (x *LogEntry) GetTypedAttrs() []*LogAttr {
+	if x != nil {
+		return x.TypedAttrs
+	}
+	return nil
+}
+
+// LogAttr is a typed log attribute.
+type LogAttr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Types that are assignable to Value:
+	//
+	//	*LogAttr_Str
+	//	*LogAttr_Int
+	//	*LogAttr_Float
+	//	*LogAttr_Bool
+	//	*LogAttr_TimeMicros
+	Value isLogAttr_Value `protobuf_oneof:"value"`
+}
+
+func (x *LogAttr) Reset() {
+	*x = LogAttr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogAttr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogAttr) ProtoMessage() {}
+
+func (x *LogAttr) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogAttr.ProtoReflect.Descriptor instead.
+func (*LogAttr) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *LogAttr) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (m *LogAttr) GetValue() isLogAttr_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *LogAttr) GetStr() string {
+	if x, ok := x.GetValue().(*LogAttr_Str); ok {
+		return x.Str
+	}
+	return ""
+}
+
+func (x *LogAttr) GetInt() int64 {
+	if x, ok := x.GetValue().(*LogAttr_Int); ok {
+		return x.Int
+	}
+	return 0
+}
+
+func (x *LogAttr) GetFloat() float64 {
+	if x, ok := x.GetValue().(*LogAttr_Float); ok {
+		return x.Float
+	}
+	return 0
+}
+
+func (x *LogAttr) GetBool() bool {
+	if x, ok := x.GetValue().(*LogAttr_Bool); ok {
+		return x.Bool
+	}
+	return false
+}
+
+func (x *LogAttr) GetTimeMicros() int64 {
+	if x, ok := x.GetValue().(*LogAttr_TimeMicros); ok {
+		return x.TimeMicros
+	}
+	return 0
+}
+
+type isLogAttr_Value interface {
+	isLogAttr_Value()
+}
+
+type LogAttr_Str struct {
+	Str string `protobuf:"bytes,2,opt,name=str,proto3,oneof"`
+}
+
+type LogAttr_Int struct {
+	Int int64 `protobuf:"varint,3,opt,name=int,proto3,oneof"`
+}
+
+type LogAttr_Float struct {
+	Float float64 `protobuf:"fixed64,4,opt,name=float,proto3,oneof"`
+}
+
+type LogAttr_Bool struct {
+	Bool bool `protobuf:"varint,5,opt,name=bool,proto3,oneof"`
+}
+
+type LogAttr_TimeMicros struct {
+	TimeMicros int64 `protobuf:"fixed64,6,opt,name=time_micros,json=timeMicros,proto3,oneof"` // time (microseconds since epoch)
+}
+
+func (*LogAttr_Str) isLogAttr_Value() {}
+
+func (*LogAttr_Int) isLogAttr_Value() {}
+
+func (*LogAttr_Float) isLogAttr_Value() {}
+
+func (*LogAttr_Bool) isLogAttr_Value() {}
+
+func (*LogAttr_TimeMicros) isLogAttr_Value() {}
+
+// LogEntryBatch is a list of log entries.
+type LogEntryBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// The sequence number of the batch among the batches sent by the weavelet,
+	// starting at 1, if WeaveletArgs.check_message_order is set, or zero
+	// otherwise. A batch may be received more than once if its delivery is
+	// retried, and a batch whose delivery fails is not resent, so the sequence
+	// numbers received by the envelope may have repeats and gaps.
+	Seq uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (x *LogEntryBatch) Reset() {
+	*x = LogEntryBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogEntryBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEntryBatch) ProtoMessage() {}
+
+func (x *LogEntryBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEntryBatch.ProtoReflect.Descriptor instead.
+func (*LogEntryBatch) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *LogEntryBatch) GetEntries() []*LogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *LogEntryBatch) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// TraceSpans is a list of Span messages.
+type TraceSpans struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Span []*Span `protobuf:"bytes,1,rep,name=span,proto3" json:"span,omitempty"`
+}
+
+func (x *TraceSpans) Reset() {
+	*x = TraceSpans{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceSpans) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceSpans) ProtoMessage() {}
+
+func (x *TraceSpans) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceSpans.ProtoReflect.Descriptor instead.
+func (*TraceSpans) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *TraceSpans) GetSpan() []*Span {
+	if x != nil {
+		return x.Span
+	}
+	return nil
+}
+
+// Span is a proto-based representation of an open-telemetry Span [1].
+//
+// [1]: https://opentelemetry.io/docs/concepts/signals/traces/#spans
+// TODO(spetrovic): Rename to TraceSpan.
+type Span struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                       // Span name
+	TraceId      []byte            `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`                  // Span trace id, 16 bytes long.
+	SpanId       []byte            `protobuf:"bytes,3,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`                     // Unique span id, 8 bytes long.
+	ParentSpanId []byte            `protobuf:"bytes,4,opt,name=parent_span_id,json=parentSpanId,proto3" json:"parent_span_id,omitempty"` // Parent span id, 8 bytes long
+	Kind         Span_Kind         `protobuf:"varint,5,opt,name=kind,proto3,enum=runtime.Span_Kind" json:"kind,omitempty"`
+	StartMicros  int64             `protobuf:"fixed64,6,opt,name=start_micros,json=startMicros,proto3" json:"start_micros,omitempty"` // Span start time (microseconds since epoch)
+	EndMicros    int64             `protobuf:"fixed64,7,opt,name=end_micros,json=endMicros,proto3" json:"end_micros,omitempty"`       // Span end time (microseconds since epoch)
+	Attributes   []*Span_Attribute `protobuf:"bytes,8,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	Links        []*Span_Link      `protobuf:"bytes,9,rep,name=links,proto3" json:"links,omitempty"`    // Links to other spans
+	Events       []*Span_Event     `protobuf:"bytes,10,rep,name=events,proto3" json:"events,omitempty"` // Events that occured in the span's lifetime
+	Status       *Span_Status      `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // Span status
+	Scope        *Span_Scope       `protobuf:"bytes,18,opt,name=scope,proto3" json:"scope,omitempty"`
+	Library      *Span_Library     `protobuf:"bytes,12,opt,name=library,proto3" json:"library,omitempty"` // Instrumentation library that created the span
+	Resource     *Span_Resource    `protobuf:"bytes,13,opt,name=resource,proto3" json:"resource,omitempty"`
+	// Number of span attributes dropped due to limits being reached.
+	DroppedAttributeCount int64 `protobuf:"varint,14,opt,name=dropped_attribute_count,json=droppedAttributeCount,proto3" json:"dropped_attribute_count,omitempty"`
+	// Number of links dropped due to limits being reached.
+	DroppedLinkCount int64 `protobuf:"varint,15,opt,name=dropped_link_count,json=droppedLinkCount,proto3" json:"dropped_link_count,omitempty"`
+	// Number of events dropped due to limits being reached.
+	DroppedEventCount int64 `protobuf:"varint,16,opt,name=dropped_event_count,json=droppedEventCount,proto3" json:"dropped_event_count,omitempty"`
+	// Number of spans that consider this span a direct parent.
+	ChildSpanCount int64 `protobuf:"varint,17,opt,name=child_span_count,json=childSpanCount,proto3" json:"child_span_count,omitempty"`
+}
+
+func (x *Span) Reset() {
+	*x = Span{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span) ProtoMessage() {}
+
+func (x *Span) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span.ProtoReflect.Descriptor instead.
+func (*Span) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *Span) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Span) GetTraceId() []byte {
+	if x != nil {
+		return x.TraceId
+	}
+	return nil
+}
+
+func (x *Span) GetSpanId() []byte {
+	if x != nil {
+		return x.SpanId
+	}
+	return nil
+}
+
+func (x *Span) GetParentSpanId() []byte {
+	if x != nil {
+		return x.ParentSpanId
+	}
+	return nil
+}
+
+func (x *Span) GetKind() Span_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Span_UNSPECIFIED
+}
+
+func (x *Span) GetStartMicros() int64 {
+	if x != nil {
+		return x.StartMicros
+	}
+	return 0
+}
+
+func (x *Span) GetEndMicros() int64 {
+	if x != nil {
+		return x.EndMicros
+	}
+	return 0
+}
+
+func (x *Span) GetAttributes() []*Span_Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Span) GetLinks() []*Span_Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *Span) GetEvents() []*Span_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Span) GetStatus() *Span_Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *Span) GetScope() *Span_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *Span) GetLibrary() *Span_Library {
+	if x != nil {
+		return x.Library
+	}
+	return nil
+}
+
+func (x *Span) GetResource() *Span_Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *Span) GetDroppedAttributeCount() int64 {
+	if x != nil {
+		return x.DroppedAttributeCount
+	}
+	return 0
+}
+
+func (x *Span) GetDroppedLinkCount() int64 {
+	if x != nil {
+		return x.DroppedLinkCount
+	}
+	return 0
+}
+
+func (x *Span) GetDroppedEventCount() int64 {
+	if x != nil {
+		return x.DroppedEventCount
+	}
+	return 0
+}
+
+func (x *Span) GetChildSpanCount() int64 {
+	if x != nil {
+		return x.ChildSpanCount
+	}
+	return 0
+}
+
+// A redirect entry instructs the weavelet to direct calls made to component
+// to be instead sent to the component named target at the specified address.
+type WeaveletArgs_Redirect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Target    string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *WeaveletArgs_Redirect) Reset() {
+	*x = WeaveletArgs_Redirect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeaveletArgs_Redirect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeaveletArgs_Redirect) ProtoMessage() {}
+
+func (x *WeaveletArgs_Redirect) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeaveletArgs_Redirect.ProtoReflect.Descriptor instead.
+func (*WeaveletArgs_Redirect) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *WeaveletArgs_Redirect) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *WeaveletArgs_Redirect) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *WeaveletArgs_Redirect) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// ComponentLoad includes load information for a component. The component's
+// key space is divided into a number of slices, each of which is associated
+// with a particular double-valued load.
+//
+// TODO(mwhittaker): In the short term, a weavelet will report one SliceLoad
+// for every slice it is assigned. In the future, however, we may report load
+// in more advanced ways.
+type LoadReport_ComponentLoad struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TODO(mwhittaker): In full generality, load is a generic
+	// application-specific double-valued measurement (e.g., total number of
+	// requests, size of components in memory). For now to simplify things, we
+	// assume load is measured as requests per second.
+	Load []*LoadReport_SliceLoad `protobuf:"bytes,1,rep,name=load,proto3" json:"load,omitempty"`
+	// TODO(mwhittaker): For now, load is reported with respect to a given
+	// assignment. When the assignment changes, the load is reset. This approach
+	// works for rate-based load like requests per second, but not other
+	// non-rate based load like size of cached components.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *LoadReport_ComponentLoad) Reset() {
+	*x = LoadReport_ComponentLoad{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadReport_ComponentLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadReport_ComponentLoad) ProtoMessage() {}
+
+func (x *LoadReport_ComponentLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadReport_ComponentLoad.ProtoReflect.Descriptor instead.
+func (*LoadReport_ComponentLoad) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{23, 1}
+}
+
+func (x *LoadReport_ComponentLoad) GetLoad() []*LoadReport_SliceLoad {
+	if x != nil {
+		return x.Load
+	}
+	return nil
+}
+
+func (x *LoadReport_ComponentLoad) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// SliceLoad includes the load of a slice, [start, end).
+type LoadReport_SliceLoad struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start  uint64                     `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"` // inclusive
+	End    uint64                     `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`     // exclusive
+	Load   float64                    `protobuf:"fixed64,3,opt,name=load,proto3" json:"load,omitempty"`
+	Splits []*LoadReport_SubsliceLoad `protobuf:"bytes,4,rep,name=splits,proto3" json:"splits,omitempty"`
+	// The "size" of the slice. Given a slice [a, b), it's tempting to think
+	// the slice has size b - a. This has a couple of problems.
+	//
+	//  1. Even if a slice is large, only a few keys in the slice may be
+	//     used. For example, a weavelet with slice [0, 2^60) may only
+	//     receive requests for keys in the range [0, 100).
+	//  2. a and b are not necessarily numeric. Imagine we are performing
+	//     range sharding on strings and have the slice ["ccbae",
+	//     "hhaefi"). What is the size of this slice?
+	//
+	// We instead measure the "size" of a slice as an estimate of the number
+	// of keys accessed in the slice. For example, if a slice [0, 1000)
+	// receives requests for keys 0, 1, 2, 3, and 10, then the size of the
+	// slice is 5.
+	Size uint64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *LoadReport_SliceLoad) Reset() {
+	*x = LoadReport_SliceLoad{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadReport_SliceLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadReport_SliceLoad) ProtoMessage() {}
+
+func (x *LoadReport_SliceLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadReport_SliceLoad.ProtoReflect.Descriptor instead.
+func (*LoadReport_SliceLoad) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{23, 2}
+}
+
+func (x *LoadReport_SliceLoad) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *LoadReport_SliceLoad) GetEnd() uint64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *LoadReport_SliceLoad) GetLoad() float64 {
+	if x != nil {
+		return x.Load
+	}
+	return 0
+}
+
+func (x *LoadReport_SliceLoad) GetSplits() []*LoadReport_SubsliceLoad {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *LoadReport_SliceLoad) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// SubsliceLoad divides the load of a slice into smaller subslices. For
+// example, given a slice [0, 100) and load 9.0, we may have the following
+// subslices:
+//
+//	SubsliceLoad{Start:  0, Load: 3.0} // [0, 20)
+//	SubsliceLoad{Start: 20, Load: 3.0} // [20, 80)
+//	SubsliceLoad{Start: 80, Load: 3.0} // [80, 100)
+//
+// TODO(mwhittaker): In the future, we likely want to report hot keys as
+// their own slice. Change SubsliceLoad to support that.
+type LoadReport_SubsliceLoad struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start uint64  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Load  float64 `protobuf:"fixed64,2,opt,name=load,proto3" json:"load,omitempty"`
+}
+
+func (x *LoadReport_SubsliceLoad) Reset() {
+	*x = LoadReport_SubsliceLoad{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadReport_SubsliceLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadReport_SubsliceLoad) ProtoMessage() {}
+
+func (x *LoadReport_SubsliceLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadReport_SubsliceLoad.ProtoReflect.Descriptor instead.
+func (*LoadReport_SubsliceLoad) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{23, 3}
+}
+
+func (x *LoadReport_SubsliceLoad) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *LoadReport_SubsliceLoad) GetLoad() float64 {
+	if x != nil {
+		return x.Load
+	}
+	return 0
+}
+
+// A slice of the key space and the replicas to which it is assigned.
+type Assignment_Slice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Inclusive start key. Strictly greater than the `start` value for the
+	// previous entry in the current assignment.
+	//
+	// The first slice should always set start to 0.
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// Replicas assigned to host this slice. Every address is of the form
+	// "<net>://<addr>" (e.g., "tcp://host:1234", "unix:///tmp/unix.sock").
+	Replicas []string `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+}
+
+func (x *Assignment_Slice) Reset() {
+	*x = Assignment_Slice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Assignment_Slice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignment_Slice) ProtoMessage() {}
+
+func (x *Assignment_Slice) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignment_Slice.ProtoReflect.Descriptor instead.
+func (*Assignment_Slice) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{81, 0}
+}
+
+func (x *Assignment_Slice) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Assignment_Slice) GetReplicas() []string {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+// Span attributes.
+type Span_Attribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value *Span_Attribute_Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Span_Attribute) Reset() {
+	*x = Span_Attribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span_Attribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span_Attribute) ProtoMessage() {}
+
+func (x *Span_Attribute) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span_Attribute.ProtoReflect.Descriptor instead.
+func (*Span_Attribute) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{120, 0}
+}
+
+func (x *Span_Attribute) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Span_Attribute) GetValue() *Span_Attribute_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// Link represents the link a span has to another span.
+type Span_Link struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TraceId    []byte            `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"` // Span trace id
+	SpanId     []byte            `protobuf:"bytes,2,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`    // Unique span id
+	Attributes []*Span_Attribute `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`          // Span attributes
+	// Number of span attributes dropped due to limits being reached
+	DroppedAttributeCount int64 `protobuf:"varint,4,opt,name=dropped_attribute_count,json=droppedAttributeCount,proto3" json:"dropped_attribute_count,omitempty"`
+}
+
+func (x *Span_Link) Reset() {
+	*x = Span_Link{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span_Link) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span_Link) ProtoMessage() {}
+
+func (x *Span_Link) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span_Link.ProtoReflect.Descriptor instead.
+func (*Span_Link) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{120, 1}
+}
+
+func (x *Span_Link) GetTraceId() []byte {
+	if x != nil {
+		return x.TraceId
+	}
+	return nil
+}
+
+func (x *Span_Link) GetSpanId() []byte {
+	if x != nil {
+		return x.SpanId
+	}
+	return nil
+}
+
+func (x *Span_Link) GetAttributes() []*Span_Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Span_Link) GetDroppedAttributeCount() int64 {
+	if x != nil {
+		return x.DroppedAttributeCount
+	}
+	return 0
+}
+
+// Event represent an event that occurred in the span's lifetime.
+type Span_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                 // Even name
+	TimeMicros int64             `protobuf:"fixed64,2,opt,name=time_micros,json=timeMicros,proto3" json:"time_micros,omitempty"` // Time event was recorded (micros since epoch)
+	Attributes []*Span_Attribute `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`                     // Event attributes
+	// Number of attributes dropped due to limits being reached.
+	DroppedAttributeCount int64 `protobuf:"varint,4,opt,name=dropped_attribute_count,json=droppedAttributeCount,proto3" json:"dropped_attribute_count,omitempty"`
+}
+
+func (x *Span_Event) Reset() {
+	*x = Span_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span_Event) ProtoMessage() {}
+
+func (x *Span_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span_Event.ProtoReflect.Descriptor instead.
+func (*Span_Event) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{120, 2}
+}
+
+func (x *Span_Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Span_Event) GetTimeMicros() int64 {
+	if x != nil {
+		return x.TimeMicros
+	}
+	return 0
+}
+
+func (x *Span_Event) GetAttributes() []*Span_Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Span_Event) GetDroppedAttributeCount() int64 {
+	if x != nil {
+		return x.DroppedAttributeCount
+	}
+	return 0
+}
+
+// Status represents the status for a span.
+type Span_Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  Span_Status_Code `protobuf:"varint,1,opt,name=code,proto3,enum=runtime.Span_Status_Code" json:"code,omitempty"` // Span status code
+	Error string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                              // Error text; set only for an ERROR code
+}
+
+func (x *Span_Status) Reset() {
+	*x = Span_Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span_Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span_Status) ProtoMessage() {}
+
+func (x *Span_Status) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span_Status.ProtoReflect.Descriptor instead.
+func (*Span_Status) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{120, 3}
+}
+
+func (x *Span_Status) GetCode() Span_Status_Code {
+	if x != nil {
+		return x.Code
+	}
+	return Span_Status_UNSET
+}
+
+func (x *Span_Status) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Scope stores information about the instrumentation scope that created the span.
+type Span_Scope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the instrumentation library, typically the package name of
+	// the library.
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version   string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                      // The version of the instrumentation scope
+	SchemaUrl string `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"` // Schema url of the telemetry emitted by scope
+}
+
+func (x *Span_Scope) Reset() {
+	*x = Span_Scope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span_Scope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span_Scope) ProtoMessage() {}
+
+func (x *Span_Scope) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span_Scope.ProtoReflect.Descriptor instead.
+func (*Span_Scope) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{120, 4}
+}
+
+func (x *Span_Scope) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Span_Scope) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Span_Scope) GetSchemaUrl() string {
+	if x != nil {
+		return x.SchemaUrl
+	}
+	return ""
+}
+
+// Library stores information about the instrumentation library that
+// created the span.
+type Span_Library struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the instrumentation library, typically the package name of
+	// the library.
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version   string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                      // The version of the instrumentation library
+	SchemaUrl string `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"` // Schema url of the telemetry emitted by library
+}
+
+func (x *Span_Library) Reset() {
+	*x = Span_Library{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span_Library) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span_Library) ProtoMessage() {}
+
+func (x *Span_Library) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span_Library.ProtoReflect.Descriptor instead.
+func (*Span_Library) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{120, 5}
+}
+
+func (x *Span_Library) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Span_Library) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Span_Library) GetSchemaUrl() string {
+	if x != nil {
+		return x.SchemaUrl
+	}
+	return ""
+}
+
+// Resource describes an entity (e.g., a Service Weaver component) this span
+// belongs to.
+type Span_Resource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URL that describes the shape and a composition of data for this span.
+	SchemaUrl  string            `protobuf:"bytes,1,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	Attributes []*Span_Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"` // Resource attributes
+}
+
+func (x *Span_Resource) Reset() {
+	*x = Span_Resource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span_Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span_Resource) ProtoMessage() {}
+
+func (x *Span_Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span_Resource.ProtoReflect.Descriptor instead.
+func (*Span_Resource) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{120, 6}
+}
+
+func (x *Span_Resource) GetSchemaUrl() string {
+	if x != nil {
+		return x.SchemaUrl
+	}
+	return ""
+}
+
+func (x *Span_Resource) GetAttributes() []*Span_Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type Span_Attribute_Value struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type Span_Attribute_Value_Type `protobuf:"varint,1,opt,name=type,proto3,enum=runtime.Span_Attribute_Value_Type" json:"type,omitempty"` // Attribute type
+	// Types that are assignable to Value:
+	//
+	//	*Span_Attribute_Value_Num
+	//	*Span_Attribute_Value_Str
+	//	*Span_Attribute_Value_Nums
+	//	*Span_Attribute_Value_Strs
+	Value isSpan_Attribute_Value_Value `protobuf_oneof:"value"`
+}
+
+func (x *Span_Attribute_Value) Reset() {
+	*x = Span_Attribute_Value{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span_Attribute_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span_Attribute_Value) ProtoMessage() {}
+
+func (x *Span_Attribute_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span_Attribute_Value.ProtoReflect.Descriptor instead.
+func (*Span_Attribute_Value) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{120, 0, 0}
+}
+
+func (x *Span_Attribute_Value) GetType() Span_Attribute_Value_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Span_Attribute_Value_INVALID
+}
+
+func (m *Span_Attribute_Value) GetValue() isSpan_Attribute_Value_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *Span_Attribute_Value) GetNum() uint64 {
+	if x, ok := x.GetValue().(*Span_Attribute_Value_Num); ok {
+		return x.Num
+	}
+	return 0
+}
+
+func (x *Span_Attribute_Value) GetStr() string {
+	if x, ok := x.GetValue().(*Span_Attribute_Value_Str); ok {
+		return x.Str
+	}
+	return ""
+}
+
+func (x *Span_Attribute_Value) GetNums() *Span_Attribute_Value_NumberList {
+	if x, ok := x.GetValue().(*Span_Attribute_Value_Nums); ok {
+		return x.Nums
+	}
+	return nil
+}
+
+func (x *Span_Attribute_Value) GetStrs() *Span_Attribute_Value_StringList {
+	if x, ok := x.GetValue().(*Span_Attribute_Value_Strs); ok {
+		return x.Strs
+	}
+	return nil
+}
+
+type isSpan_Attribute_Value_Value interface {
+	isSpan_Attribute_Value_Value()
+}
+
+type Span_Attribute_Value_Num struct {
+	Num uint64 `protobuf:"varint,2,opt,name=num,proto3,oneof"`
+}
+
+type Span_Attribute_Value_Str struct {
+	Str string `protobuf:"bytes,3,opt,name=str,proto3,oneof"`
+}
+
+type Span_Attribute_Value_Nums struct {
+	Nums *Span_Attribute_Value_NumberList `protobuf:"bytes,4,opt,name=nums,proto3,oneof"`
+}
+
+type Span_Attribute_Value_Strs struct {
+	Strs *Span_Attribute_Value_StringList `protobuf:"bytes,5,opt,name=strs,proto3,oneof"`
+}
+
+func (*Span_Attribute_Value_Num) isSpan_Attribute_Value_Value() {}
+
+func (*Span_Attribute_Value_Str) isSpan_Attribute_Value_Value() {}
+
+func (*Span_Attribute_Value_Nums) isSpan_Attribute_Value_Value() {}
+
+func (*Span_Attribute_Value_Strs) isSpan_Attribute_Value_Value() {}
+
+type Span_Attribute_Value_NumberList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nums []uint64 `protobuf:"varint,1,rep,packed,name=nums,proto3" json:"nums,omitempty"`
+}
+
+func (x *Span_Attribute_Value_NumberList) Reset() {
+	*x = Span_Attribute_Value_NumberList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span_Attribute_Value_NumberList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span_Attribute_Value_NumberList) ProtoMessage() {}
+
+func (x *Span_Attribute_Value_NumberList) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span_Attribute_Value_NumberList.ProtoReflect.Descriptor instead.
+func (*Span_Attribute_Value_NumberList) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{120, 0, 0, 0}
+}
+
+func (x *Span_Attribute_Value_NumberList) GetNums() []uint64 {
+	if x != nil {
+		return x.Nums
+	}
+	return nil
+}
+
+type Span_Attribute_Value_StringList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strs []string `protobuf:"bytes,2,rep,name=strs,proto3" json:"strs,omitempty"`
+}
+
+func (x *Span_Attribute_Value_StringList) Reset() {
+	*x = Span_Attribute_Value_StringList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_protos_runtime_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span_Attribute_Value_StringList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span_Attribute_Value_StringList) ProtoMessage() {}
+
+func (x *Span_Attribute_Value_StringList) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_protos_runtime_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span_Attribute_Value_StringList.ProtoReflect.Descriptor instead.
+func (*Span_Attribute_Value_StringList) Descriptor() ([]byte, []int) {
+	return file_runtime_protos_runtime_proto_rawDescGZIP(), []int{120, 0, 0, 1}
+}
+
+func (x *Span_Attribute_Value_StringList) GetStrs() []string {
+	if x != nil {
+		return x.Strs
+	}
+	return nil
+}
+
+var File_runtime_protos_runtime_proto protoreflect.FileDescriptor
+
+var file_runtime_protos_runtime_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xd6, 0x03, 0x0a, 0x0c, 0x57, 0x65, 0x61, 0x76,
+	0x65, 0x6c, 0x65, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x75, 0x6e, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x4d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x74,
+	0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x74, 0x6c, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x3c, 0x0a, 0x09, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x57, 0x65,
+	0x61, 0x76, 0x65, 0x6c, 0x65, 0x74, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x52, 0x09, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2e,
+	0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x72, 0x70, 0x63, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78,
+	0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x70, 0x63, 0x73, 0x1a, 0x5a,
+	0x0a, 0x08, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05,
+	0x22, 0x9a, 0x01, 0x0a, 0x13, 0x49, 0x6e, 0x69, 0x74, 0x57, 0x65, 0x61, 0x76, 0x65, 0x6c, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x57, 0x65, 0x61, 0x76, 0x65, 0x6c, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x3b, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xfb, 0x01,
+	0x0a, 0x11, 0x49, 0x6e, 0x69, 0x74, 0x57, 0x65, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x29, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x65, 0x6d, 0x56,
+	0x65, 0x72, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
+	0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x70,
+	0x63, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x70, 0x63, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x0e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x67, 0x6f, 0x6d, 0x61, 0x78, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x67, 0x6f, 0x6d, 0x61, 0x78, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x70, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6e, 0x75, 0x6d, 0x43, 0x70, 0x75, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x70, 0x75, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x06, 0x53, 0x65, 0x6d, 0x56,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x0b, 0x0a, 0x09, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x2d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f,
+	0x73, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8d, 0x02, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73,
+	0x12, 0x2f, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x75, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5f, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x22, 0x9b, 0x01,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x65,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x75, 0x6c, 0x6c,
+	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x3c, 0x0a,
+	0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6e, 0x0a, 0x11, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x12, 0x38, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d,
+	0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x78, 0x0a,
+	0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a,
+	0x04, 0x64, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x44, 0x65, 0x66, 0x52,
+	0x04, 0x64, 0x65, 0x66, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x22, 0xf5, 0x01, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x44, 0x65, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x74, 0x79, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x79, 0x70,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x6c, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x65, 0x6c, 0x70, 0x12, 0x36, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x44, 0x65, 0x66, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x7c, 0x0a, 0x0b, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x09,
+	0x65, 0x78, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x72, 0x52, 0x09, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x72, 0x73, 0x22, 0x8d, 0x01,
+	0x0a, 0x08, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x22, 0xde, 0x02,
+	0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x74, 0x79, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x79, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x65, 0x6c, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x70, 0x12,
+	0x3b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x72, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x45, 0x78, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x72, 0x52, 0x09, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x72, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x10,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x37, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x27, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xcf, 0x03, 0x0a, 0x0a, 0x4c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4c, 0x6f, 0x61,
+	0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x1a, 0x5b,
+	0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x64,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x0d, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x31, 0x0a, 0x04,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x53, 0x6c, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x95, 0x01, 0x0a, 0x09, 0x53, 0x6c,
+	0x69, 0x63, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x6c, 0x69, 0x63,
+	0x65, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x06, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x1a, 0x38, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x61,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x74, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x37, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x70, 0x75,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x63, 0x70, 0x75, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x73, 0x22, 0x25, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x7c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x63, 0x70, 0x75, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x70, 0x75, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x30, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x22, 0x5a, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x37, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x15, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x45, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0c, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x69,
+	0x63, 0x72, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x10, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x70, 0x75, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x63, 0x70, 0x75, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x73, 0x22,
+	0x26, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x5f, 0x0a,
+	0x17, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x75, 0x74, 0x65, 0x78,
+	0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x6d, 0x75, 0x74, 0x65, 0x78, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6f,
+	0x0a, 0x15, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x6d, 0x75, 0x74, 0x65, 0x78, 0x5f, 0x66, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72,
+	0x65, 0x76, 0x4d, 0x75, 0x74, 0x65, 0x78, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x54, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x2e, 0x0a, 0x14, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x22, 0x14, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa0,
+	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x67, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x67, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x1a, 0x38, 0x0a, 0x0a, 0x47, 0x61, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x3c, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x1a, 0x38,
+	0x0a, 0x0a, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x47,
+	0x43, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x11, 0x53, 0x65, 0x74,
+	0x47, 0x43, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x46, 0x6f,
+	0x72, 0x63, 0x65, 0x47, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c,
+	0x46, 0x6f, 0x72, 0x63, 0x65, 0x47, 0x43, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x18, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28,
+	0x0a, 0x05, 0x67, 0x72, 0x61, 0x70, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x52, 0x05, 0x67, 0x72, 0x61, 0x70, 0x68, 0x22, 0x39, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x6c,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x43,
+	0x61, 0x6c, 0x6c, 0x47, 0x72, 0x61, 0x70, 0x68, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64,
+	0x67, 0x65, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x6c, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x45, 0x64, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x61, 0x6c, 0x6c, 0x65, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x47, 0x72, 0x61, 0x70, 0x68, 0x45, 0x64, 0x67, 0x65, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x22,
+	0x2d, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x02, 0x22, 0x9b,
+	0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x7a, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x35, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x57, 0x65, 0x61,
+	0x76, 0x65, 0x6c, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x85, 0x02, 0x0a, 0x10, 0x57, 0x65, 0x61,
+	0x76, 0x65, 0x6c, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69,
+	0x78, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x3a, 0x0a, 0x0d, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x0c, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x31, 0x0a,
+	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x22, 0xe5, 0x01, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72,
+	0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
+	0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x67, 0x6f, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x68, 0x65, 0x61, 0x70, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x73, 0x79, 0x73, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x70, 0x53, 0x79,
+	0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x63,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x75, 0x6d, 0x47, 0x63, 0x12, 0x29, 0x0a,
+	0x11, 0x67, 0x63, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x67, 0x63, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46,
+	0x44, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x65, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x46, 0x44, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x6f, 0x66, 0x74,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x68, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x35, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0b, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x45, 0x0a, 0x0f,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x90, 0x01,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x41, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c,
+	0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x43, 0x0a, 0x0d, 0x52, 0x61,
+	0x77, 0x52, 0x50, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22,
+	0x27, 0x0a, 0x0b, 0x52, 0x61, 0x77, 0x52, 0x50, 0x43, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x31, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x0f, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x22, 0x44, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x56, 0x0a, 0x17, 0x51, 0x75,
+	0x69, 0x65, 0x73, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x4e, 0x73, 0x22, 0x2d, 0x0a, 0x15, 0x51, 0x75, 0x69, 0x65, 0x73, 0x63, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x22, 0x5a, 0x0a, 0x0c, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x72, 0x61, 0x69,
+	0x6e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4e, 0x73, 0x22, 0x2c, 0x0a,
+	0x0a, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x0c, 0x0a, 0x0a, 0x44,
+	0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x53, 0x0a, 0x18, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x18,
+	0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xe8, 0x02, 0x0a, 0x0b, 0x52, 0x6f, 0x75,
+	0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a,
+	0x17, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x75, 0x72, 0x73,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x75, 0x72, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x04, 0x70,
+	0x69, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x70, 0x69, 0x6e,
+	0x73, 0x12, 0x40, 0x0a, 0x0f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x52, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6f,
+	0x70, 0x65, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x22, 0xde, 0x01, 0x0a,
+	0x13, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x43, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31,
+	0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x78,
+	0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6f,
+	0x70, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x22,
+	0x2c, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x53,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x48, 0x41, 0x4c, 0x46, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x22, 0x4b, 0x0a,
+	0x07, 0x50, 0x69, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x22, 0x94, 0x01, 0x0a, 0x0a, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x6c, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53,
+	0x6c, 0x69, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x39, 0x0a, 0x05, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x22, 0x52, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x77, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d,
+	0x0a, 0x12, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x75, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a,
+	0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x50,
+	0x0a, 0x18, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x64,
+	0x22, 0xac, 0x01, 0x0a, 0x16, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x52, 0x0a, 0x0b, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x2f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x33, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x62, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4e, 0x73,
+	0x22, 0x78, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x54, 0x4c, 0x53, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x22, 0x43, 0x0a, 0x0b, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54, 0x4c, 0x53, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x65, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x63, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x63, 0x61, 0x22,
+	0x5e, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x54, 0x4c, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54, 0x4c, 0x53, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x22,
+	0x18, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x54, 0x4c, 0x53, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a, 0x16, 0x52, 0x65, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x17, 0x0a,
+	0x15, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x54,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x14, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x63,
+	0x61, 0x6c, 0x6c, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x26,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x01, 0x6e, 0x22, 0x43, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x51, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3e,
+	0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x1a, 0x39,
+	0x0a, 0x0b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9a, 0x01, 0x0a, 0x13, 0x52, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x46, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x52, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5a, 0x0a, 0x11, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x3f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x65,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x22, 0x3f, 0x0a, 0x1e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x65, 0x72, 0x74, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x22, 0x3e, 0x0a, 0x1c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x6a, 0x0a, 0x1e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x65, 0x72, 0x74, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x1e, 0x0a,
+	0x1c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xa2, 0x02,
+	0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x10, 0x52, 0x0a, 0x74,
+	0x69, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x74, 0x74,
+	0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x74, 0x74, 0x72, 0x73, 0x12,
+	0x31, 0x0a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4c,
+	0x6f, 0x67, 0x41, 0x74, 0x74, 0x72, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x64, 0x41, 0x74, 0x74,
+	0x72, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x41, 0x74, 0x74, 0x72, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x12, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x03, 0x73, 0x74, 0x72, 0x12, 0x12, 0x0a, 0x03, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x03, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x12, 0x14, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d,
+	0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x10, 0x48, 0x00, 0x52, 0x0a, 0x74,
+	0x69, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x4e, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x2b, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4c,
+	0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73,
+	0x65, 0x71, 0x22, 0x2f, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x73,
+	0x12, 0x21, 0x0a, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x04, 0x73,
+	0x70, 0x61, 0x6e, 0x22, 0xb9, 0x10, 0x0a, 0x04, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73,
+	0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x70,
+	0x61, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x69, 0x63, 0x72,
+	0x6f, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x10, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d,
+	0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x6d, 0x69, 0x63,
+	0x72, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x10, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x4d, 0x69,
+	0x63, 0x72, 0x6f, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a,
+	0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53,
+	0x70, 0x61, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e,
+	0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x2f, 0x0a,
+	0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x32,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x15, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x4c,
+	0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x5f, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x70, 0x61, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x8a, 0x04, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e,
+	0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xb5, 0x03, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x22, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x03, 0x6e, 0x75, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a,
+	0x03, 0x73, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x73, 0x74,
+	0x72, 0x12, 0x3e, 0x0a, 0x04, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x75, 0x6d,
+	0x73, 0x12, 0x3e, 0x0a, 0x04, 0x73, 0x74, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x73, 0x74, 0x72,
+	0x73, 0x1a, 0x20, 0x0a, 0x0a, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x04, 0x6e,
+	0x75, 0x6d, 0x73, 0x1a, 0x20, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x74, 0x72, 0x73, 0x22, 0x7f, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f,
+	0x4f, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x02, 0x12,
+	0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x36, 0x34, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06,
+	0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x4f, 0x4f, 0x4c,
+	0x4c, 0x49, 0x53, 0x54, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x4c,
+	0x49, 0x53, 0x54, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x36, 0x34,
+	0x4c, 0x49, 0x53, 0x54, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47,
+	0x4c, 0x49, 0x53, 0x54, 0x10, 0x08, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a,
+	0xab, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xad, 0x01,
+	0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x10,
+	0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x37, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x73, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x53, 0x70, 0x61, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x24, 0x0a, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
+	0x10, 0x02, 0x1a, 0x54, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x72, 0x6c, 0x1a, 0x56, 0x0a, 0x07, 0x4c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x72, 0x6c,
+	0x1a, 0x62, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x37, 0x0a, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0f, 0x0a, 0x0b,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a,
+	0x08, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x49, 0x45, 0x4e,
+	0x54, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x10,
+	0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x53, 0x55, 0x4d, 0x45, 0x52, 0x10, 0x05, 0x2a,
+	0xc2, 0x02, 0x0a, 0x0a, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x16,
+	0x0a, 0x12, 0x43, 0x41, 0x50, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x41, 0x50, 0x41, 0x42, 0x49,
+	0x4c, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f,
+	0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x41, 0x50,
+	0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x4c, 0x49, 0x53, 0x54,
+	0x45, 0x4e, 0x45, 0x52, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x1e,
+	0x0a, 0x1a, 0x43, 0x41, 0x50, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x45, 0x52, 0x10, 0x03, 0x12, 0x23,
+	0x0a, 0x1f, 0x43, 0x41, 0x50, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x47, 0x45, 0x54,
+	0x5f, 0x53, 0x45, 0x4c, 0x46, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
+	0x45, 0x10, 0x04, 0x12, 0x28, 0x0a, 0x24, 0x43, 0x41, 0x50, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54,
+	0x59, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f,
+	0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12, 0x28, 0x0a,
+	0x24, 0x43, 0x41, 0x50, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x56, 0x45, 0x52, 0x49,
+	0x46, 0x59, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x06, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x41, 0x50, 0x41, 0x42,
+	0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10,
+	0x07, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x41, 0x50, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f,
+	0x48, 0x41, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x50, 0x41,
+	0x4e, 0x53, 0x10, 0x08, 0x2a, 0x47, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x02, 0x12, 0x0e, 0x0a,
+	0x0a, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x4e, 0x0a,
+	0x13, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54,
+	0x45, 0x5f, 0x53, 0x55, 0x4d, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x47, 0x47, 0x52, 0x45,
+	0x47, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x47,
+	0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x10, 0x02, 0x2a, 0x40, 0x0a,
+	0x0a, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x55, 0x4e,
+	0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x41, 0x55, 0x47, 0x45, 0x10, 0x02,
+	0x12, 0x0d, 0x0a, 0x09, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x47, 0x52, 0x41, 0x4d, 0x10, 0x03, 0x2a,
+	0x62, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f,
+	0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x48, 0x65, 0x61, 0x70, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x50, 0x55,
+	0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x10, 0x03, 0x12, 0x09, 0x0a,
+	0x05, 0x4d, 0x75, 0x74, 0x65, 0x78, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x47, 0x6f, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x65, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x73, 0x10, 0x06, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x57, 0x65, 0x61, 0x76, 0x65, 0x72, 0x2f,
+	0x77, 0x65, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_runtime_protos_runtime_proto_rawDescOnce sync.Once
+	file_runtime_protos_runtime_proto_rawDescData = file_runtime_protos_runtime_proto_rawDesc
+)
+
+func file_runtime_protos_runtime_proto_rawDescGZIP() []byte {
+	file_runtime_protos_runtime_proto_rawDescOnce.Do(func() {
+		file_runtime_protos_runtime_proto_rawDescData = protoimpl.X.CompressGZIP(file_runtime_protos_runtime_proto_rawDescData)
+	})
+	return file_runtime_protos_runtime_proto_rawDescData
+}
+
+var file_runtime_protos_runtime_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_runtime_protos_runtime_proto_msgTypes = make([]protoimpl.MessageInfo, 147)
+var file_runtime_protos_runtime_proto_goTypes = []interface{}{
+	(Capability)(0),                         // 0: runtime.Capability
+	(HealthStatus)(0),                       // 1: runtime.HealthStatus
+	(AggregationFunction)(0),                // 2: runtime.AggregationFunction
+	(MetricType)(0),                         // 3: runtime.MetricType
+	(ProfileType)(0),                        // 4: runtime.ProfileType
+	(CallGraphEdge_Routing)(0),              // 5: runtime.CallGraphEdge.Routing
+	(CircuitBreakerState_State)(0),          // 6: runtime.CircuitBreakerState.State
+	(Span_Kind)(0),                          // 7: runtime.Span.Kind
+	(Span_Attribute_Value_Type)(0),          // 8: runtime.Span.Attribute.Value.Type
+	(Span_Status_Code)(0),                   // 9: runtime.Span.Status.Code
+	(*WeaveletArgs)(nil),                    // 10: runtime.WeaveletArgs
+	(*InitWeaveletRequest)(nil),             // 11: runtime.InitWeaveletRequest
+	(*InitWeaveletReply)(nil),               // 12: runtime.InitWeaveletReply
+	(*ResourceLimits)(nil),                  // 13: runtime.ResourceLimits
+	(*SemVer)(nil),                          // 14: runtime.SemVer
+	(*PingRequest)(nil),                     // 15: runtime.PingRequest
+	(*PingReply)(nil),                       // 16: runtime.PingReply
+	(*GetTimeRequest)(nil),                  // 17: runtime.GetTimeRequest
+	(*GetTimeReply)(nil),                    // 18: runtime.GetTimeReply
+	(*GetHealthRequest)(nil),                // 19: runtime.GetHealthRequest
+	(*GetHealthReply)(nil),                  // 20: runtime.GetHealthReply
+	(*HealthCheckResult)(nil),               // 21: runtime.HealthCheckResult
+	(*ListenerReadiness)(nil),               // 22: runtime.ListenerReadiness
+	(*GetMetricsRequest)(nil),               // 23: runtime.GetMetricsRequest
+	(*MetricAggregation)(nil),               // 24: runtime.MetricAggregation
+	(*GetMetricsReply)(nil),                 // 25: runtime.GetMetricsReply
+	(*MetricUpdate)(nil),                    // 26: runtime.MetricUpdate
+	(*MetricDef)(nil),                       // 27: runtime.MetricDef
+	(*MetricValue)(nil),                     // 28: runtime.MetricValue
+	(*Exemplar)(nil),                        // 29: runtime.Exemplar
+	(*MetricSnapshot)(nil),                  // 30: runtime.MetricSnapshot
+	(*GetLoadRequest)(nil),                  // 31: runtime.GetLoadRequest
+	(*GetLoadReply)(nil),                    // 32: runtime.GetLoadReply
+	(*LoadReport)(nil),                      // 33: runtime.LoadReport
+	(*GetProfileRequest)(nil),               // 34: runtime.GetProfileRequest
+	(*GetProfileReply)(nil),                 // 35: runtime.GetProfileReply
+	(*GetProfileBundleRequest)(nil),         // 36: runtime.GetProfileBundleRequest
+	(*GetProfileBundleReply)(nil),           // 37: runtime.GetProfileBundleReply
+	(*ProfileData)(nil),                     // 38: runtime.ProfileData
+	(*ListProfilesRequest)(nil),             // 39: runtime.ListProfilesRequest
+	(*ListProfilesReply)(nil),               // 40: runtime.ListProfilesReply
+	(*ProfileInfo)(nil),                     // 41: runtime.ProfileInfo
+	(*CancelProfileRequest)(nil),            // 42: runtime.CancelProfileRequest
+	(*CancelProfileReply)(nil),              // 43: runtime.CancelProfileReply
+	(*SetProfilingRateRequest)(nil),         // 44: runtime.SetProfilingRateRequest
+	(*SetProfilingRateReply)(nil),           // 45: runtime.SetProfilingRateReply
+	(*SetComponentEnabledRequest)(nil),      // 46: runtime.SetComponentEnabledRequest
+	(*SetComponentEnabledReply)(nil),        // 47: runtime.SetComponentEnabledReply
+	(*ForwardSignalRequest)(nil),            // 48: runtime.ForwardSignalRequest
+	(*ForwardSignalReply)(nil),              // 49: runtime.ForwardSignalReply
+	(*GetReadinessRequest)(nil),             // 50: runtime.GetReadinessRequest
+	(*GetReadinessReply)(nil),               // 51: runtime.GetReadinessReply
+	(*GetBuildFlagsRequest)(nil),            // 52: runtime.GetBuildFlagsRequest
+	(*GetBuildFlagsReply)(nil),              // 53: runtime.GetBuildFlagsReply
+	(*SetGCPercentRequest)(nil),             // 54: runtime.SetGCPercentRequest
+	(*SetGCPercentReply)(nil),               // 55: runtime.SetGCPercentReply
+	(*ForceGCRequest)(nil),                  // 56: runtime.ForceGCRequest
+	(*ForceGCReply)(nil),                    // 57: runtime.ForceGCReply
+	(*GetDependenciesRequest)(nil),          // 58: runtime.GetDependenciesRequest
+	(*GetDependenciesReply)(nil),            // 59: runtime.GetDependenciesReply
+	(*CallGraph)(nil),                       // 60: runtime.CallGraph
+	(*CallGraphEdge)(nil),                   // 61: runtime.CallGraphEdge
+	(*GetSnapshotRequest)(nil),              // 62: runtime.GetSnapshotRequest
+	(*GetSnapshotReply)(nil),                // 63: runtime.GetSnapshotReply
+	(*WeaveletSnapshot)(nil),                // 64: runtime.WeaveletSnapshot
+	(*RuntimeStats)(nil),                    // 65: runtime.RuntimeStats
+	(*GetFDCountRequest)(nil),               // 66: runtime.GetFDCountRequest
+	(*GetFDCountReply)(nil),                 // 67: runtime.GetFDCountReply
+	(*GetRoutingInfoRequest)(nil),           // 68: runtime.GetRoutingInfoRequest
+	(*GetRoutingInfoReply)(nil),             // 69: runtime.GetRoutingInfoReply
+	(*GetFeatureFlagsRequest)(nil),          // 70: runtime.GetFeatureFlagsRequest
+	(*GetFeatureFlagsReply)(nil),            // 71: runtime.GetFeatureFlagsReply
+	(*SetFeatureFlagRequest)(nil),           // 72: runtime.SetFeatureFlagRequest
+	(*SetFeatureFlagReply)(nil),             // 73: runtime.SetFeatureFlagReply
+	(*RawRPCRequest)(nil),                   // 74: runtime.RawRPCRequest
+	(*RawRPCReply)(nil),                     // 75: runtime.RawRPCReply
+	(*CheckpointRequest)(nil),               // 76: runtime.CheckpointRequest
+	(*CheckpointReply)(nil),                 // 77: runtime.CheckpointReply
+	(*RestoreRequest)(nil),                  // 78: runtime.RestoreRequest
+	(*RestoreReply)(nil),                    // 79: runtime.RestoreReply
+	(*QuiesceComponentRequest)(nil),         // 80: runtime.QuiesceComponentRequest
+	(*QuiesceComponentReply)(nil),           // 81: runtime.QuiesceComponentReply
+	(*DrainRequest)(nil),                    // 82: runtime.DrainRequest
+	(*DrainStage)(nil),                      // 83: runtime.DrainStage
+	(*DrainReply)(nil),                      // 84: runtime.DrainReply
+	(*UpdateRoutingInfoRequest)(nil),        // 85: runtime.UpdateRoutingInfoRequest
+	(*UpdateRoutingInfoReply)(nil),          // 86: runtime.UpdateRoutingInfoReply
+	(*RoutingInfo)(nil),                     // 87: runtime.RoutingInfo
+	(*CircuitBreaker)(nil),                  // 88: runtime.CircuitBreaker
+	(*CircuitBreakerState)(nil),             // 89: runtime.CircuitBreakerState
+	(*PinRule)(nil),                         // 90: runtime.PinRule
+	(*Assignment)(nil),                      // 91: runtime.Assignment
+	(*UpdateComponentsRequest)(nil),         // 92: runtime.UpdateComponentsRequest
+	(*UpdateComponentsReply)(nil),           // 93: runtime.UpdateComponentsReply
+	(*ActivateComponentRequest)(nil),        // 94: runtime.ActivateComponentRequest
+	(*ActivateComponentReply)(nil),          // 95: runtime.ActivateComponentReply
+	(*GetListenerAddressRequest)(nil),       // 96: runtime.GetListenerAddressRequest
+	(*GetListenerAddressReply)(nil),         // 97: runtime.GetListenerAddressReply
+	(*ExportListenerRequest)(nil),           // 98: runtime.ExportListenerRequest
+	(*ListenerHealthCheck)(nil),             // 99: runtime.ListenerHealthCheck
+	(*ExportListenerReply)(nil),             // 100: runtime.ExportListenerReply
+	(*ListenerTLS)(nil),                     // 101: runtime.ListenerTLS
+	(*UpdateListenerTLSRequest)(nil),        // 102: runtime.UpdateListenerTLSRequest
+	(*UpdateListenerTLSReply)(nil),          // 103: runtime.UpdateListenerTLSReply
+	(*ReexportListenersRequest)(nil),        // 104: runtime.ReexportListenersRequest
+	(*ReexportListenersReply)(nil),          // 105: runtime.ReexportListenersReply
+	(*FlushTelemetryRequest)(nil),           // 106: runtime.FlushTelemetryRequest
+	(*FlushTelemetryReply)(nil),             // 107: runtime.FlushTelemetryReply
+	(*GetInFlightRequest)(nil),              // 108: runtime.GetInFlightRequest
+	(*GetInFlightReply)(nil),                // 109: runtime.GetInFlightReply
+	(*GetRecentErrorsRequest)(nil),          // 110: runtime.GetRecentErrorsRequest
+	(*GetRecentErrorsReply)(nil),            // 111: runtime.GetRecentErrorsReply
+	(*ClearRecentErrorsRequest)(nil),        // 112: runtime.ClearRecentErrorsRequest
+	(*ClearRecentErrorsReply)(nil),          // 113: runtime.ClearRecentErrorsReply
+	(*SetComponentLogLevelRequest)(nil),     // 114: runtime.SetComponentLogLevelRequest
+	(*SetComponentLogLevelReply)(nil),       // 115: runtime.SetComponentLogLevelReply
+	(*GetLogLevelsRequest)(nil),             // 116: runtime.GetLogLevelsRequest
+	(*GetLogLevelsReply)(nil),               // 117: runtime.GetLogLevelsReply
+	(*ReloadConfigRequest)(nil),             // 118: runtime.ReloadConfigRequest
+	(*ReloadConfigReply)(nil),               // 119: runtime.ReloadConfigReply
+	(*GetSelfCertificateRequest)(nil),       // 120: runtime.GetSelfCertificateRequest
+	(*GetSelfCertificateReply)(nil),         // 121: runtime.GetSelfCertificateReply
+	(*VerifyClientCertificateRequest)(nil),  // 122: runtime.VerifyClientCertificateRequest
+	(*VerifyClientCertificateReply)(nil),    // 123: runtime.VerifyClientCertificateReply
+	(*VerifyServerCertificateRequest)(nil),  // 124: runtime.VerifyServerCertificateRequest
+	(*VerifyServerCertificateReply)(nil),    // 125: runtime.VerifyServerCertificateReply
+	(*LogEntry)(nil),                        // 126: runtime.LogEntry
+	(*LogAttr)(nil),                         // 127: runtime.LogAttr
+	(*LogEntryBatch)(nil),                   // 128: runtime.LogEntryBatch
+	(*TraceSpans)(nil),                      // 129: runtime.TraceSpans
+	(*Span)(nil),                            // 130: runtime.Span
+	(*WeaveletArgs_Redirect)(nil),           // 131: runtime.WeaveletArgs.Redirect
+	nil,                                     // 132: runtime.InitWeaveletRequest.SectionsEntry
+	nil,                                     // 133: runtime.MetricDef.LabelsEntry
+	nil,                                     // 134: runtime.MetricSnapshot.LabelsEntry
+	nil,                                     // 135: runtime.LoadReport.LoadsEntry
+	(*LoadReport_ComponentLoad)(nil),        // 136: runtime.LoadReport.ComponentLoad
+	(*LoadReport_SliceLoad)(nil),            // 137: runtime.LoadReport.SliceLoad
+	(*LoadReport_SubsliceLoad)(nil),         // 138: runtime.LoadReport.SubsliceLoad
+	nil,                                     // 139: runtime.GetReadinessReply.GatesEntry
+	nil,                                     // 140: runtime.GetBuildFlagsReply.FlagsEntry
+	nil,                                     // 141: runtime.GetFeatureFlagsReply.FlagsEntry
+	(*Assignment_Slice)(nil),                // 142: runtime.Assignment.Slice
+	nil,                                     // 143: runtime.ActivateComponentReply.AnnotationsEntry
+	nil,                                     // 144: runtime.GetInFlightReply.CallsEntry
+	nil,                                     // 145: runtime.GetLogLevelsReply.LevelsEntry
+	nil,                                     // 146: runtime.ReloadConfigRequest.SectionsEntry
+	(*Span_Attribute)(nil),                  // 147: runtime.Span.Attribute
+	(*Span_Link)(nil),                       // 148: runtime.Span.Link
+	(*Span_Event)(nil),                      // 149: runtime.Span.Event
+	(*Span_Status)(nil),                     // 150: runtime.Span.Status
+	(*Span_Scope)(nil),                      // 151: runtime.Span.Scope
+	(*Span_Library)(nil),                    // 152: runtime.Span.Library
+	(*Span_Resource)(nil),                   // 153: runtime.Span.Resource
+	(*Span_Attribute_Value)(nil),            // 154: runtime.Span.Attribute.Value
+	(*Span_Attribute_Value_NumberList)(nil), // 155: runtime.Span.Attribute.Value.NumberList
+	(*Span_Attribute_Value_StringList)(nil), // 156: runtime.Span.Attribute.Value.StringList
+}
+var file_runtime_protos_runtime_proto_depIdxs = []int32{
+	131, // 0: runtime.WeaveletArgs.redirects:type_name -> runtime.WeaveletArgs.Redirect
+	132, // 1: runtime.InitWeaveletRequest.sections:type_name -> runtime.InitWeaveletRequest.SectionsEntry
+	14,  // 2: runtime.InitWeaveletReply.version:type_name -> runtime.SemVer
+	13,  // 3: runtime.InitWeaveletReply.resources:type_name -> runtime.ResourceLimits
+	0,   // 4: runtime.InitWeaveletReply.capabilities:type_name -> runtime.Capability
+	1,   // 5: runtime.GetHealthReply.status:type_name -> runtime.HealthStatus
+	22,  // 6: runtime.GetHealthReply.listeners:type_name -> runtime.ListenerReadiness
+	21,  // 7: runtime.GetHealthReply.checks:type_name -> runtime.HealthCheckResult
+	24,  // 8: runtime.GetMetricsRequest.aggregation:type_name -> runtime.MetricAggregation
+	2,   // 9: runtime.MetricAggregation.function:type_name -> runtime.AggregationFunction
+	26,  // 10: runtime.GetMetricsReply.update:type_name -> runtime.MetricUpdate
+	27,  // 11: runtime.MetricUpdate.defs:type_name -> runtime.MetricDef
+	28,  // 12: runtime.MetricUpdate.values:type_name -> runtime.MetricValue
+	3,   // 13: runtime.MetricDef.typ:type_name -> runtime.MetricType
+	133, // 14: runtime.MetricDef.labels:type_name -> runtime.MetricDef.LabelsEntry
+	29,  // 15: runtime.MetricValue.exemplars:type_name -> runtime.Exemplar
+	3,   // 16: runtime.MetricSnapshot.typ:type_name -> runtime.MetricType
+	134, // 17: runtime.MetricSnapshot.labels:type_name -> runtime.MetricSnapshot.LabelsEntry
+	29,  // 18: runtime.MetricSnapshot.exemplars:type_name -> runtime.Exemplar
+	33,  // 19: runtime.GetLoadReply.load:type_name -> runtime.LoadReport
+	135, // 20: runtime.LoadReport.loads:type_name -> runtime.LoadReport.LoadsEntry
+	4,   // 21: runtime.GetProfileRequest.profile_type:type_name -> runtime.ProfileType
+	4,   // 22: runtime.GetProfileBundleRequest.profile_types:type_name -> runtime.ProfileType
+	38,  // 23: runtime.GetProfileBundleReply.profiles:type_name -> runtime.ProfileData
+	4,   // 24: runtime.ProfileData.profile_type:type_name -> runtime.ProfileType
+	41,  // 25: runtime.ListProfilesReply.profiles:type_name -> runtime.ProfileInfo
+	4,   // 26: runtime.ProfileInfo.profile_type:type_name -> runtime.ProfileType
+	139, // 27: runtime.GetReadinessReply.gates:type_name -> runtime.GetReadinessReply.GatesEntry
+	140, // 28: runtime.GetBuildFlagsReply.flags:type_name -> runtime.GetBuildFlagsReply.FlagsEntry
+	60,  // 29: runtime.GetDependenciesReply.graph:type_name -> runtime.CallGraph
+	61,  // 30: runtime.CallGraph.edges:type_name -> runtime.CallGraphEdge
+	5,   // 31: runtime.CallGraphEdge.routing:type_name -> runtime.CallGraphEdge.Routing
+	23,  // 32: runtime.GetSnapshotRequest.metrics:type_name -> runtime.GetMetricsRequest
+	64,  // 33: runtime.GetSnapshotReply.snapshot:type_name -> runtime.WeaveletSnapshot
+	26,  // 34: runtime.GetSnapshotReply.metrics:type_name -> runtime.MetricUpdate
+	20,  // 35: runtime.WeaveletSnapshot.health:type_name -> runtime.GetHealthReply
+	33,  // 36: runtime.WeaveletSnapshot.load:type_name -> runtime.LoadReport
+	65,  // 37: runtime.WeaveletSnapshot.runtime_stats:type_name -> runtime.RuntimeStats
+	30,  // 38: runtime.WeaveletSnapshot.metrics:type_name -> runtime.MetricSnapshot
+	87,  // 39: runtime.GetRoutingInfoReply.routing_info:type_name -> runtime.RoutingInfo
+	89,  // 40: runtime.GetRoutingInfoReply.circuit_breaker:type_name -> runtime.CircuitBreakerState
+	141, // 41: runtime.GetFeatureFlagsReply.flags:type_name -> runtime.GetFeatureFlagsReply.FlagsEntry
+	83,  // 42: runtime.DrainRequest.stages:type_name -> runtime.DrainStage
+	87,  // 43: runtime.UpdateRoutingInfoRequest.routing_info:type_name -> runtime.RoutingInfo
+	91,  // 44: runtime.RoutingInfo.assignment:type_name -> runtime.Assignment
+	90,  // 45: runtime.RoutingInfo.pins:type_name -> runtime.PinRule
+	88,  // 46: runtime.RoutingInfo.circuit_breaker:type_name -> runtime.CircuitBreaker
+	6,   // 47: runtime.CircuitBreakerState.state:type_name -> runtime.CircuitBreakerState.State
+	142, // 48: runtime.Assignment.slices:type_name -> runtime.Assignment.Slice
+	143, // 49: runtime.ActivateComponentReply.annotations:type_name -> runtime.ActivateComponentReply.AnnotationsEntry
+	99,  // 50: runtime.ExportListenerRequest.health_check:type_name -> runtime.ListenerHealthCheck
+	101, // 51: runtime.ExportListenerReply.tls:type_name -> runtime.ListenerTLS
+	101, // 52: runtime.UpdateListenerTLSRequest.tls:type_name -> runtime.ListenerTLS
+	144, // 53: runtime.GetInFlightReply.calls:type_name -> runtime.GetInFlightReply.CallsEntry
+	126, // 54: runtime.GetRecentErrorsReply.entries:type_name -> runtime.LogEntry
+	145, // 55: runtime.GetLogLevelsReply.levels:type_name -> runtime.GetLogLevelsReply.LevelsEntry
+	146, // 56: runtime.ReloadConfigRequest.sections:type_name -> runtime.ReloadConfigRequest.SectionsEntry
+	127, // 57: runtime.LogEntry.typed_attrs:type_name -> runtime.LogAttr
+	126, // 58: runtime.LogEntryBatch.entries:type_name -> runtime.LogEntry
+	130, // 59: runtime.TraceSpans.span:type_name -> runtime.Span
+	7,   // 60: runtime.Span.kind:type_name -> runtime.Span.Kind
+	147, // 61: runtime.Span.attributes:type_name -> runtime.Span.Attribute
+	148, // 62: runtime.Span.links:type_name -> runtime.Span.Link
+	149, // 63: runtime.Span.events:type_name -> runtime.Span.Event
+	150, // 64: runtime.Span.status:type_name -> runtime.Span.Status
+	151, // 65: runtime.Span.scope:type_name -> runtime.Span.Scope
+	152, // 66: runtime.Span.library:type_name -> runtime.Span.Library
+	153, // 67: runtime.Span.resource:type_name -> runtime.Span.Resource
+	136, // 68: runtime.LoadReport.LoadsEntry.value:type_name -> runtime.LoadReport.ComponentLoad
+	137, // 69: runtime.LoadReport.ComponentLoad.load:type_name -> runtime.LoadReport.SliceLoad
+	138, // 70: runtime.LoadReport.SliceLoad.splits:type_name -> runtime.LoadReport.SubsliceLoad
+	154, // 71: runtime.Span.Attribute.value:type_name -> runtime.Span.Attribute.Value
+	147, // 72: runtime.Span.Link.attributes:type_name -> runtime.Span.Attribute
+	147, // 73: runtime.Span.Event.attributes:type_name -> runtime.Span.Attribute
+	9,   // 74: runtime.Span.Status.code:type_name -> runtime.Span.Status.Code
+	147, // 75: runtime.Span.Resource.attributes:type_name -> runtime.Span.Attribute
+	8,   // 76: runtime.Span.Attribute.Value.type:type_name -> runtime.Span.Attribute.Value.Type
+	155, // 77: runtime.Span.Attribute.Value.nums:type_name -> runtime.Span.Attribute.Value.NumberList
+	156, // 78: runtime.Span.Attribute.Value.strs:type_name -> runtime.Span.Attribute.Value.StringList
+	79,  // [79:79] is the sub-list for method output_type
+	79,  // [79:79] is the sub-list for method input_type
+	79,  // [79:79] is the sub-list for extension type_name
+	79,  // [79:79] is the sub-list for extension extendee
+	0,   // [0:79] is the sub-list for field type_name
+}
+
+func init() { file_runtime_protos_runtime_proto_init() }
+func file_runtime_protos_runtime_proto_init() {
+	if File_runtime_protos_runtime_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_runtime_protos_runtime_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeaveletArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitWeaveletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitWeaveletReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SemVer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheckResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListenerReadiness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetricsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricAggregation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetricsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricDef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Exemplar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoadReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfileReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfileBundleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfileBundleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProfilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProfilesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelProfileReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProfilingRateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProfilingRateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetComponentEnabledRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetComponentEnabledReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardSignalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardSignalReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReadinessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReadinessReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBuildFlagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBuildFlagsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGCPercentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGCPercentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceGCRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceGCReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDependenciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDependenciesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallGraph); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallGraphEdge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSnapshotReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeaveletSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFDCountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFDCountReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoutingInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoutingInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFeatureFlagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFeatureFlagsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFeatureFlagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFeatureFlagReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RawRPCRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RawRPCReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckpointRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckpointReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuiesceComponentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuiesceComponentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainStage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoutingInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoutingInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoutingInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreaker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4327,8 +10861,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitWeaveletRequest); i {
+		file_runtime_protos_runtime_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreakerState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4339,8 +10873,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitWeaveletReply); i {
+		file_runtime_protos_runtime_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4351,8 +10885,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SemVer); i {
+		file_runtime_protos_runtime_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assignment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4363,8 +10897,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHealthRequest); i {
+		file_runtime_protos_runtime_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateComponentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4375,8 +10909,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHealthReply); i {
+		file_runtime_protos_runtime_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateComponentsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4387,8 +10921,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMetricsRequest); i {
+		file_runtime_protos_runtime_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateComponentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4399,8 +10933,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMetricsReply); i {
+		file_runtime_protos_runtime_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateComponentReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4411,8 +10945,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetricUpdate); i {
+		file_runtime_protos_runtime_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListenerAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4423,8 +10957,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetricDef); i {
+		file_runtime_protos_runtime_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListenerAddressReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4435,8 +10969,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetricValue); i {
+		file_runtime_protos_runtime_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportListenerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4447,8 +10981,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetricSnapshot); i {
+		file_runtime_protos_runtime_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListenerHealthCheck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4459,8 +10993,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLoadRequest); i {
+		file_runtime_protos_runtime_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportListenerReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4471,8 +11005,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLoadReply); i {
+		file_runtime_protos_runtime_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListenerTLS); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4483,8 +11017,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadReport); i {
+		file_runtime_protos_runtime_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateListenerTLSRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4495,8 +11029,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProfileRequest); i {
+		file_runtime_protos_runtime_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateListenerTLSReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4507,8 +11041,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProfileReply); i {
+		file_runtime_protos_runtime_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReexportListenersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4519,8 +11053,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRoutingInfoRequest); i {
+		file_runtime_protos_runtime_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReexportListenersReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4531,8 +11065,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRoutingInfoReply); i {
+		file_runtime_protos_runtime_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushTelemetryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4543,8 +11077,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoutingInfo); i {
+		file_runtime_protos_runtime_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushTelemetryReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4555,8 +11089,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assignment); i {
+		file_runtime_protos_runtime_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInFlightRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4567,8 +11101,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateComponentsRequest); i {
+		file_runtime_protos_runtime_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInFlightReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4579,8 +11113,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateComponentsReply); i {
+		file_runtime_protos_runtime_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecentErrorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4591,8 +11125,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateComponentRequest); i {
+		file_runtime_protos_runtime_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecentErrorsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4603,8 +11137,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateComponentReply); i {
+		file_runtime_protos_runtime_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearRecentErrorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4615,8 +11149,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetListenerAddressRequest); i {
+		file_runtime_protos_runtime_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearRecentErrorsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4627,8 +11161,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetListenerAddressReply); i {
+		file_runtime_protos_runtime_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetComponentLogLevelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4639,8 +11173,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExportListenerRequest); i {
+		file_runtime_protos_runtime_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetComponentLogLevelReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4651,8 +11185,8 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExportListenerReply); i {
+		file_runtime_protos_runtime_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogLevelsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4663,7 +11197,43 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogLevelsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSelfCertificateRequest); i {
 			case 0:
 				return &v.state
@@ -4675,7 +11245,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSelfCertificateReply); i {
 			case 0:
 				return &v.state
@@ -4687,7 +11257,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VerifyClientCertificateRequest); i {
 			case 0:
 				return &v.state
@@ -4699,7 +11269,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VerifyClientCertificateReply); i {
 			case 0:
 				return &v.state
@@ -4711,7 +11281,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VerifyServerCertificateRequest); i {
 			case 0:
 				return &v.state
@@ -4723,7 +11293,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VerifyServerCertificateReply); i {
 			case 0:
 				return &v.state
@@ -4735,7 +11305,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogEntry); i {
 			case 0:
 				return &v.state
@@ -4747,7 +11317,19 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogAttr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_protos_runtime_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogEntryBatch); i {
 			case 0:
 				return &v.state
@@ -4759,7 +11341,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TraceSpans); i {
 			case 0:
 				return &v.state
@@ -4771,7 +11353,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Span); i {
 			case 0:
 				return &v.state
@@ -4783,7 +11365,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WeaveletArgs_Redirect); i {
 			case 0:
 				return &v.state
@@ -4795,7 +11377,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoadReport_ComponentLoad); i {
 			case 0:
 				return &v.state
@@ -4807,7 +11389,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoadReport_SliceLoad); i {
 			case 0:
 				return &v.state
@@ -4819,7 +11401,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoadReport_SubsliceLoad); i {
 			case 0:
 				return &v.state
@@ -4831,7 +11413,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Assignment_Slice); i {
 			case 0:
 				return &v.state
@@ -4843,7 +11425,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Span_Attribute); i {
 			case 0:
 				return &v.state
@@ -4855,7 +11437,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Span_Link); i {
 			case 0:
 				return &v.state
@@ -4867,7 +11449,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Span_Event); i {
 			case 0:
 				return &v.state
@@ -4879,7 +11461,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Span_Status); i {
 			case 0:
 				return &v.state
@@ -4891,7 +11473,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Span_Scope); i {
 			case 0:
 				return &v.state
@@ -4903,7 +11485,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Span_Library); i {
 			case 0:
 				return &v.state
@@ -4915,7 +11497,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Span_Resource); i {
 			case 0:
 				return &v.state
@@ -4927,7 +11509,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Span_Attribute_Value); i {
 			case 0:
 				return &v.state
@@ -4939,7 +11521,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Span_Attribute_Value_NumberList); i {
 			case 0:
 				return &v.state
@@ -4951,7 +11533,7 @@ func file_runtime_protos_runtime_proto_init() {
 				return nil
 			}
 		}
-		file_runtime_protos_runtime_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_runtime_protos_runtime_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Span_Attribute_Value_StringList); i {
 			case 0:
 				return &v.state
@@ -4964,7 +11546,14 @@ func file_runtime_protos_runtime_proto_init() {
 			}
 		}
 	}
-	file_runtime_protos_runtime_proto_msgTypes[55].OneofWrappers = []interface{}{
+	file_runtime_protos_runtime_proto_msgTypes[117].OneofWrappers = []interface{}{
+		(*LogAttr_Str)(nil),
+		(*LogAttr_Int)(nil),
+		(*LogAttr_Float)(nil),
+		(*LogAttr_Bool)(nil),
+		(*LogAttr_TimeMicros)(nil),
+	}
+	file_runtime_protos_runtime_proto_msgTypes[144].OneofWrappers = []interface{}{
 		(*Span_Attribute_Value_Num)(nil),
 		(*Span_Attribute_Value_Str)(nil),
 		(*Span_Attribute_Value_Nums)(nil),
@@ -4975,8 +11564,8 @@ func file_runtime_protos_runtime_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_runtime_protos_runtime_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   58,
+			NumEnums:      10,
+			NumMessages:   147,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
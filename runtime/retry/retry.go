@@ -33,8 +33,20 @@ import (
 
 // Retry holds state for managing retry loops with exponential backoff and jitter.
 type Retry struct {
-	options Options
-	attempt int
+	backoff Backoff
+	started bool // has Continue been called since the loop began or was reset?
+}
+
+// A Backoff is a policy that determines how long a retry loop sleeps between
+// iterations. A Backoff holds the state of a single retry loop, so every loop
+// needs a Backoff of its own. Tests can supply a fake Backoff to control the
+// sleeps of a retry loop.
+type Backoff interface {
+	// Next returns how long to sleep before the next iteration.
+	Next() time.Duration
+
+	// Reset resets the Backoff to its initial state.
+	Reset()
 }
 
 // Options are the options that configure a retry loop. Before the ith
@@ -62,7 +74,7 @@ func Begin() *Retry {
 }
 
 // BeginWithOptions returns a new retry loop configured with the provided
-// options.
+// options. It is equivalent to BeginWithBackoff(Exponential(options)).
 //
 // Example: Sleep 1 second, then 2 seconds, then 4 seconds, and so on.
 //
@@ -74,18 +86,48 @@ func Begin() *Retry {
 //	  // Do nothing.
 //	}
 func BeginWithOptions(options Options) *Retry {
-	return &Retry{options: options}
+	return BeginWithBackoff(Exponential(options))
 }
 
-// Continue sleeps for an exponentially increasing interval (with jitter). It
-// stops its sleep early and returns false if context becomes done. If the
-// return value is false, ctx.Err() is guaranteed to be non-nil. The first
-// call does not sleep.
+// BeginWithBackoff returns a new retry loop that sleeps between iterations as
+// determined by the provided backoff.
+func BeginWithBackoff(backoff Backoff) *Retry {
+	return &Retry{backoff: backoff}
+}
+
+// Exponential returns a Backoff that sleeps for exponentially increasing
+// durations, with jitter, as configured by the provided options.
+func Exponential(options Options) Backoff {
+	return &exponential{options: options}
+}
+
+// exponential is the Backoff returned by Exponential.
+type exponential struct {
+	options Options
+	attempt int
+}
+
+// Next implements the Backoff interface.
+func (e *exponential) Next() time.Duration {
+	e.attempt++
+	return jittered(backoffDelay(e.attempt, e.options))
+}
+
+// Reset implements the Backoff interface.
+func (e *exponential) Reset() {
+	e.attempt = 0
+}
+
+// Continue sleeps for the interval determined by the retry loop's backoff
+// (by default, an exponentially increasing interval with jitter). It stops its
+// sleep early and returns false if context becomes done. If the return value
+// is false, ctx.Err() is guaranteed to be non-nil. The first call does not
+// sleep.
 func (r *Retry) Continue(ctx context.Context) bool {
-	if r.attempt != 0 {
-		randomized(ctx, backoffDelay(r.attempt, r.options))
+	if r.started {
+		sleep(ctx, r.backoff.Next())
 	}
-	r.attempt++
+	r.started = true
 	return ctx.Err() == nil
 }
 
@@ -102,7 +144,8 @@ func (r *Retry) Continue(ctx context.Context) bool {
 //	    r.Reset()
 //	}
 func (r *Retry) Reset() {
-	r.attempt = 0
+	r.started = false
+	r.backoff.Reset()
 }
 
 func backoffDelay(i int, opts Options) time.Duration {
@@ -113,9 +156,14 @@ func backoffDelay(i int, opts Options) time.Duration {
 // randomized sleeps for a random duration close to d, or until context is done,
 // whichever occurs first.
 func randomized(ctx context.Context, d time.Duration) {
+	sleep(ctx, jittered(d))
+}
+
+// jittered returns a random duration close to d.
+func jittered(d time.Duration) time.Duration {
 	const jitter = 0.4
 	mult := 1 - jitter*randomFloat() // Subtract up to 40%
-	sleep(ctx, time.Duration(float64(d)*mult))
+	return time.Duration(float64(d) * mult)
 }
 
 // sleep sleeps for the specified duration d, or until context is done,
@@ -44,6 +44,51 @@ func TestRetry(t *testing.T) {
 	}
 }
 
+// fakeBackoff is a Backoff that records its calls and doesn't sleep.
+type fakeBackoff struct {
+	nexts  int
+	resets int
+}
+
+func (f *fakeBackoff) Next() time.Duration { f.nexts++; return 0 }
+func (f *fakeBackoff) Reset()              { f.resets++ }
+
+func TestBeginWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	b := &fakeBackoff{}
+	r := BeginWithBackoff(b)
+	for i := 0; i < 3; i++ {
+		r.Continue(ctx)
+	}
+	if b.nexts != 2 {
+		t.Fatalf("Next called %d times, want 2", b.nexts)
+	}
+
+	// The first call to Continue after a Reset doesn't sleep.
+	r.Reset()
+	r.Continue(ctx)
+	if b.nexts != 2 || b.resets != 1 {
+		t.Fatalf("got %d calls to Next and %d calls to Reset, want 2 and 1", b.nexts, b.resets)
+	}
+	r.Continue(ctx)
+	if b.nexts != 3 {
+		t.Fatalf("Next called %d times, want 3", b.nexts)
+	}
+}
+
+func TestExponential(t *testing.T) {
+	b := Exponential(Options{BackoffMultiplier: 2, BackoffMinDuration: time.Second})
+	for i := 0; i < 2; i++ {
+		for _, want := range []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second} {
+			// Jitter subtracts up to 40%.
+			if got := b.Next(); got <= want*6/10 || got > want {
+				t.Fatalf("Next: got %v, want a duration in (%v, %v]", got, want*6/10, want)
+			}
+		}
+		b.Reset()
+	}
+}
+
 func TestSleepFor(t *testing.T) {
 	const N = 20
 	const delay = time.Millisecond * 10
@@ -0,0 +1,269 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scriptedweavelet
+
+import (
+	"context"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/internal/control"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// controller is the control component of a scripted weavelet. It records the
+// requests it receives and, except for InitWeavelet, GetHealth, and GetTime,
+// replies with empty replies.
+type controller struct {
+	w *Weavelet
+}
+
+var _ control.WeaveletControl = &controller{}
+
+// InitWeavelet implements the control.WeaveletControl interface. It replies
+// with the weavelet's info, and starts the script.
+func (c *controller) InitWeavelet(_ context.Context, req *protos.InitWeaveletRequest) (*protos.InitWeaveletReply, error) {
+	c.w.record(req)
+	c.w.initOnce.Do(func() { close(c.w.initialized) })
+	return c.w.info, nil
+}
+
+// GetHealth implements the control.WeaveletControl interface. A scripted
+// weavelet is always healthy.
+func (c *controller) GetHealth(_ context.Context, req *protos.GetHealthRequest) (*protos.GetHealthReply, error) {
+	c.w.record(req)
+	return &protos.GetHealthReply{Status: protos.HealthStatus_HEALTHY}, nil
+}
+
+// UpdateComponents implements the control.WeaveletControl interface.
+func (c *controller) UpdateComponents(_ context.Context, req *protos.UpdateComponentsRequest) (*protos.UpdateComponentsReply, error) {
+	c.w.record(req)
+	return &protos.UpdateComponentsReply{}, nil
+}
+
+// UpdateRoutingInfo implements the control.WeaveletControl interface.
+func (c *controller) UpdateRoutingInfo(_ context.Context, req *protos.UpdateRoutingInfoRequest) (*protos.UpdateRoutingInfoReply, error) {
+	c.w.record(req)
+	return &protos.UpdateRoutingInfoReply{}, nil
+}
+
+// Ping implements the control.WeaveletControl interface.
+func (c *controller) Ping(_ context.Context, req *protos.PingRequest) (*protos.PingReply, error) {
+	c.w.record(req)
+	return &protos.PingReply{}, nil
+}
+
+// GetTime implements the control.WeaveletControl interface.
+func (c *controller) GetTime(_ context.Context, req *protos.GetTimeRequest) (*protos.GetTimeReply, error) {
+	c.w.record(req)
+	return &protos.GetTimeReply{UnixNanos: time.Now().UnixNano()}, nil
+}
+
+// GetLoad implements the control.WeaveletControl interface.
+func (c *controller) GetLoad(_ context.Context, req *protos.GetLoadRequest) (*protos.GetLoadReply, error) {
+	c.w.record(req)
+	return &protos.GetLoadReply{}, nil
+}
+
+// GetMetrics implements the control.WeaveletControl interface.
+func (c *controller) GetMetrics(_ context.Context, req *protos.GetMetricsRequest) (*protos.GetMetricsReply, error) {
+	c.w.record(req)
+	return &protos.GetMetricsReply{}, nil
+}
+
+// GetProfile implements the control.WeaveletControl interface.
+func (c *controller) GetProfile(_ context.Context, req *protos.GetProfileRequest) (*protos.GetProfileReply, error) {
+	c.w.record(req)
+	return &protos.GetProfileReply{}, nil
+}
+
+// ListProfiles implements the control.WeaveletControl interface.
+func (c *controller) ListProfiles(_ context.Context, req *protos.ListProfilesRequest) (*protos.ListProfilesReply, error) {
+	c.w.record(req)
+	return &protos.ListProfilesReply{}, nil
+}
+
+// CancelProfile implements the control.WeaveletControl interface.
+func (c *controller) CancelProfile(_ context.Context, req *protos.CancelProfileRequest) (*protos.CancelProfileReply, error) {
+	c.w.record(req)
+	return &protos.CancelProfileReply{}, nil
+}
+
+// SetProfilingRate implements the control.WeaveletControl interface.
+func (c *controller) SetProfilingRate(_ context.Context, req *protos.SetProfilingRateRequest) (*protos.SetProfilingRateReply, error) {
+	c.w.record(req)
+	return &protos.SetProfilingRateReply{}, nil
+}
+
+// SetComponentEnabled implements the control.WeaveletControl interface.
+func (c *controller) SetComponentEnabled(_ context.Context, req *protos.SetComponentEnabledRequest) (*protos.SetComponentEnabledReply, error) {
+	c.w.record(req)
+	return &protos.SetComponentEnabledReply{}, nil
+}
+
+// ForwardSignal implements the control.WeaveletControl interface.
+func (c *controller) ForwardSignal(_ context.Context, req *protos.ForwardSignalRequest) (*protos.ForwardSignalReply, error) {
+	c.w.record(req)
+	return &protos.ForwardSignalReply{}, nil
+}
+
+// GetReadiness implements the control.WeaveletControl interface.
+func (c *controller) GetReadiness(_ context.Context, req *protos.GetReadinessRequest) (*protos.GetReadinessReply, error) {
+	c.w.record(req)
+	return &protos.GetReadinessReply{}, nil
+}
+
+// GetBuildFlags implements the control.WeaveletControl interface.
+func (c *controller) GetBuildFlags(_ context.Context, req *protos.GetBuildFlagsRequest) (*protos.GetBuildFlagsReply, error) {
+	c.w.record(req)
+	return &protos.GetBuildFlagsReply{}, nil
+}
+
+// SetGCPercent implements the control.WeaveletControl interface.
+func (c *controller) SetGCPercent(_ context.Context, req *protos.SetGCPercentRequest) (*protos.SetGCPercentReply, error) {
+	c.w.record(req)
+	return &protos.SetGCPercentReply{}, nil
+}
+
+// ForceGC implements the control.WeaveletControl interface.
+func (c *controller) ForceGC(_ context.Context, req *protos.ForceGCRequest) (*protos.ForceGCReply, error) {
+	c.w.record(req)
+	return &protos.ForceGCReply{}, nil
+}
+
+// GetDependencies implements the control.WeaveletControl interface.
+func (c *controller) GetDependencies(_ context.Context, req *protos.GetDependenciesRequest) (*protos.GetDependenciesReply, error) {
+	c.w.record(req)
+	return &protos.GetDependenciesReply{}, nil
+}
+
+// GetFDCount implements the control.WeaveletControl interface.
+func (c *controller) GetFDCount(_ context.Context, req *protos.GetFDCountRequest) (*protos.GetFDCountReply, error) {
+	c.w.record(req)
+	return &protos.GetFDCountReply{}, nil
+}
+
+// GetRoutingInfo implements the control.WeaveletControl interface.
+func (c *controller) GetRoutingInfo(_ context.Context, req *protos.GetRoutingInfoRequest) (*protos.GetRoutingInfoReply, error) {
+	c.w.record(req)
+	return &protos.GetRoutingInfoReply{}, nil
+}
+
+// GetFeatureFlags implements the control.WeaveletControl interface.
+func (c *controller) GetFeatureFlags(_ context.Context, req *protos.GetFeatureFlagsRequest) (*protos.GetFeatureFlagsReply, error) {
+	c.w.record(req)
+	return &protos.GetFeatureFlagsReply{}, nil
+}
+
+// SetFeatureFlag implements the control.WeaveletControl interface.
+func (c *controller) SetFeatureFlag(_ context.Context, req *protos.SetFeatureFlagRequest) (*protos.SetFeatureFlagReply, error) {
+	c.w.record(req)
+	return &protos.SetFeatureFlagReply{}, nil
+}
+
+// RawRPC implements the control.WeaveletControl interface.
+func (c *controller) RawRPC(_ context.Context, req *protos.RawRPCRequest) (*protos.RawRPCReply, error) {
+	c.w.record(req)
+	return &protos.RawRPCReply{}, nil
+}
+
+// Checkpoint implements the control.WeaveletControl interface.
+func (c *controller) Checkpoint(_ context.Context, req *protos.CheckpointRequest) (*protos.CheckpointReply, error) {
+	c.w.record(req)
+	return &protos.CheckpointReply{}, nil
+}
+
+// Restore implements the control.WeaveletControl interface.
+func (c *controller) Restore(_ context.Context, req *protos.RestoreRequest) (*protos.RestoreReply, error) {
+	c.w.record(req)
+	return &protos.RestoreReply{}, nil
+}
+
+// QuiesceComponent implements the control.WeaveletControl interface.
+func (c *controller) QuiesceComponent(_ context.Context, req *protos.QuiesceComponentRequest) (*protos.QuiesceComponentReply, error) {
+	c.w.record(req)
+	return &protos.QuiesceComponentReply{}, nil
+}
+
+// Drain implements the control.WeaveletControl interface.
+func (c *controller) Drain(_ context.Context, req *protos.DrainRequest) (*protos.DrainReply, error) {
+	c.w.record(req)
+	return &protos.DrainReply{}, nil
+}
+
+// UpdateListenerTLS implements the control.WeaveletControl interface.
+func (c *controller) UpdateListenerTLS(_ context.Context, req *protos.UpdateListenerTLSRequest) (*protos.UpdateListenerTLSReply, error) {
+	c.w.record(req)
+	return &protos.UpdateListenerTLSReply{}, nil
+}
+
+// ReexportListeners implements the control.WeaveletControl interface.
+func (c *controller) ReexportListeners(_ context.Context, req *protos.ReexportListenersRequest) (*protos.ReexportListenersReply, error) {
+	c.w.record(req)
+	return &protos.ReexportListenersReply{}, nil
+}
+
+// FlushTelemetry implements the control.WeaveletControl interface.
+func (c *controller) FlushTelemetry(_ context.Context, req *protos.FlushTelemetryRequest) (*protos.FlushTelemetryReply, error) {
+	c.w.record(req)
+	return &protos.FlushTelemetryReply{}, nil
+}
+
+// GetInFlight implements the control.WeaveletControl interface.
+func (c *controller) GetInFlight(_ context.Context, req *protos.GetInFlightRequest) (*protos.GetInFlightReply, error) {
+	c.w.record(req)
+	return &protos.GetInFlightReply{}, nil
+}
+
+// ReloadConfig implements the control.WeaveletControl interface.
+func (c *controller) ReloadConfig(_ context.Context, req *protos.ReloadConfigRequest) (*protos.ReloadConfigReply, error) {
+	c.w.record(req)
+	return &protos.ReloadConfigReply{}, nil
+}
+
+// GetSnapshot implements the control.WeaveletControl interface.
+func (c *controller) GetSnapshot(_ context.Context, req *protos.GetSnapshotRequest) (*protos.GetSnapshotReply, error) {
+	c.w.record(req)
+	return &protos.GetSnapshotReply{}, nil
+}
+
+// GetProfileBundle implements the control.WeaveletControl interface.
+func (c *controller) GetProfileBundle(_ context.Context, req *protos.GetProfileBundleRequest) (*protos.GetProfileBundleReply, error) {
+	c.w.record(req)
+	return &protos.GetProfileBundleReply{}, nil
+}
+
+// GetRecentErrors implements the control.WeaveletControl interface.
+func (c *controller) GetRecentErrors(_ context.Context, req *protos.GetRecentErrorsRequest) (*protos.GetRecentErrorsReply, error) {
+	c.w.record(req)
+	return &protos.GetRecentErrorsReply{}, nil
+}
+
+// ClearRecentErrors implements the control.WeaveletControl interface.
+func (c *controller) ClearRecentErrors(_ context.Context, req *protos.ClearRecentErrorsRequest) (*protos.ClearRecentErrorsReply, error) {
+	c.w.record(req)
+	return &protos.ClearRecentErrorsReply{}, nil
+}
+
+// SetComponentLogLevel implements the control.WeaveletControl interface.
+func (c *controller) SetComponentLogLevel(_ context.Context, req *protos.SetComponentLogLevelRequest) (*protos.SetComponentLogLevelReply, error) {
+	c.w.record(req)
+	return &protos.SetComponentLogLevelReply{}, nil
+}
+
+// GetLogLevels implements the control.WeaveletControl interface.
+func (c *controller) GetLogLevels(_ context.Context, req *protos.GetLogLevelsRequest) (*protos.GetLogLevelsReply, error) {
+	c.w.record(req)
+	return &protos.GetLogLevelsReply{}, nil
+}
@@ -0,0 +1,232 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package scriptedweavelet provides a fake weavelet, driven by a script, for
+// testing deployers.
+//
+// A scripted weavelet doesn't host any components. Instead, it completes the
+// handshake with its envelope and then plays the steps of its script in
+// order, issuing to the envelope the RPCs a real weavelet would (e.g.,
+// exporting listeners and activating components). This lets deployer tests
+// exercise [envelope.Envelope.Serve] against realistic sequences of messages,
+// deterministically. For example:
+//
+//	w := scriptedweavelet.New(nil,
+//	    scriptedweavelet.ExportListener("lis", "localhost:9000"),
+//	    scriptedweavelet.ActivateComponent("example.com/app/Cache", true),
+//	    scriptedweavelet.Log(&protos.LogEntry{Msg: "started"}),
+//	)
+//	env, err := envelope.NewEnvelope(ctx, args, config, envelope.Options{Child: w})
+//	...
+//	go env.Serve(handler)
+//	<-w.Done()
+//	if err := w.Err(); err != nil {
+//	    ...
+//	}
+//
+// The RPCs that the envelope issues to the scripted weavelet succeed with
+// empty replies, except for InitWeavelet and GetHealth, and are recorded (see
+// [Weavelet.Requests]).
+package scriptedweavelet
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/ServiceWeaver/weaver/internal/control"
+	"github.com/ServiceWeaver/weaver/internal/net/call"
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"github.com/ServiceWeaver/weaver/runtime/deployers"
+	"github.com/ServiceWeaver/weaver/runtime/envelope"
+	"github.com/ServiceWeaver/weaver/runtime/protomsg"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/ServiceWeaver/weaver/runtime/version"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/protobuf/proto"
+)
+
+// Weavelet is an envelope.Child that, instead of starting a weavelet, plays
+// the weavelet's side of the connection to the envelope from a script. Pass
+// it to the envelope as Options.Child. A Weavelet must be used at most once.
+type Weavelet struct {
+	info   *protos.InitWeaveletReply // reply to the handshake
+	script []Step                    // steps to play after the handshake
+
+	initialized chan struct{} // closed when the handshake is received
+	initOnce    sync.Once     // closes initialized
+	done        chan struct{} // closed when the script ends
+	err         error         // the error that ended the script, if any
+
+	ctx  context.Context      // set by Start
+	args *protos.WeaveletArgs // set by Start
+
+	mu       sync.Mutex
+	requests []proto.Message // requests received from the envelope
+	seq      uint64          // sequence number of the last log batch sent
+}
+
+var _ envelope.Child = &Weavelet{}
+
+// New returns a scripted weavelet that replies to the envelope's handshake
+// with the provided info and then plays the provided script. If info is nil,
+// the weavelet replies with a valid info for the current deployer API
+// version. A non-nil info lets tests exercise failed handshakes.
+func New(info *protos.InitWeaveletReply, script ...Step) *Weavelet {
+	if info == nil {
+		info = &protos.InitWeaveletReply{
+			DialAddr: "tcp://127.0.0.1:0",
+			Version: &protos.SemVer{
+				Major: version.DeployerMajor,
+				Minor: version.DeployerMinor,
+			},
+		}
+	}
+	return &Weavelet{
+		info:        info,
+		script:      script,
+		initialized: make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// Start implements the envelope.Child interface. It serves the weavelet's
+// control component and starts playing the script, in the background.
+func (w *Weavelet) Start(ctx context.Context, _ *protos.AppConfig, args *protos.WeaveletArgs) error {
+	w.ctx = ctx
+	w.args = protomsg.Clone(args)
+	lis, err := net.Listen("unix", args.ControlSocket)
+	if err != nil {
+		return fmt.Errorf("scriptedweavelet: %w", err)
+	}
+	go deployers.ServeComponents(ctx, lis, nil, map[string]any{
+		control.WeaveletPath: &controller{w},
+	})
+	go func() {
+		defer close(w.done)
+		w.err = w.play(ctx)
+	}()
+	return nil
+}
+
+// Wait implements the envelope.Child interface. Like a real weavelet, a
+// scripted weavelet keeps running after its script ends, until the context
+// passed to Start is cancelled.
+func (w *Weavelet) Wait() error {
+	<-w.ctx.Done()
+	return nil
+}
+
+// Stdout implements the envelope.Child interface. A scripted weavelet has no
+// stdout.
+func (w *Weavelet) Stdout() io.ReadCloser { return nil }
+
+// Stderr implements the envelope.Child interface. A scripted weavelet has no
+// stderr.
+func (w *Weavelet) Stderr() io.ReadCloser { return nil }
+
+// Pid implements the envelope.Child interface.
+func (w *Weavelet) Pid() (int, bool) { return 0, false }
+
+// Done returns a channel that is closed when the script ends, either because
+// all of its steps succeeded or because one of them failed.
+func (w *Weavelet) Done() <-chan struct{} {
+	return w.done
+}
+
+// Err returns the error of the step that ended the script, or nil if all
+// steps succeeded.
+//
+// REQUIRES: Done is closed.
+func (w *Weavelet) Err() error {
+	return w.err
+}
+
+// Requests returns the requests received from the envelope so far, in the
+// order they were received (e.g., an *protos.InitWeaveletRequest first).
+func (w *Weavelet) Requests() []proto.Message {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]proto.Message(nil), w.requests...)
+}
+
+// record records a request received from the envelope.
+func (w *Weavelet) record(req proto.Message) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.requests = append(w.requests, req)
+}
+
+// nextSeq returns the sequence number of the next log batch, or zero if the
+// envelope doesn't check the order of log batches.
+func (w *Weavelet) nextSeq() uint64 {
+	if !w.args.CheckMessageOrder {
+		return 0
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.seq++
+	return w.seq
+}
+
+// play waits for the handshake and plays the script.
+func (w *Weavelet) play(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-w.initialized:
+	}
+
+	deployer, err := w.dialDeployer(ctx)
+	if err != nil {
+		return err
+	}
+	for i, step := range w.script {
+		if err := step.run(ctx, w, deployer); err != nil {
+			return fmt.Errorf("scriptedweavelet: step %d (%s): %w", i, step.name, err)
+		}
+	}
+	return nil
+}
+
+// dialDeployer returns a stub of the deployer control component of the
+// envelope, as the weavelet's redirects name it.
+func (w *Weavelet) dialDeployer(ctx context.Context) (control.DeployerControl, error) {
+	var addr string
+	for _, r := range w.args.Redirects {
+		if r.Component == control.DeployerPath {
+			addr = r.Address
+		}
+	}
+	if addr == "" {
+		return nil, fmt.Errorf("scriptedweavelet: no redirect for %s", control.DeployerPath)
+	}
+	reg, ok := codegen.Find(control.DeployerPath)
+	if !ok {
+		return nil, fmt.Errorf("scriptedweavelet: component %s not found", control.DeployerPath)
+	}
+	endpoint, err := call.ParseNetEndpoint(addr)
+	if err != nil {
+		return nil, fmt.Errorf("scriptedweavelet: %w", err)
+	}
+	conn, err := call.Connect(ctx, call.NewConstantResolver(endpoint), call.ClientOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("scriptedweavelet: %w", err)
+	}
+	context.AfterFunc(ctx, conn.Close)
+	stub := call.NewStub(control.DeployerPath, reg, conn, noop.NewTracerProvider().Tracer(""), 0)
+	return reg.ClientStubFn(stub, "scriptedweavelet").(control.DeployerControl), nil
+}
@@ -0,0 +1,193 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scriptedweavelet_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/envelope"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/ServiceWeaver/weaver/runtime/scriptedweavelet"
+	"github.com/google/go-cmp/cmp"
+)
+
+// recorder is an EnvelopeHandler that records the calls it receives.
+type recorder struct {
+	mu    sync.Mutex
+	calls []string
+	fail  bool // fail ExportListener?
+}
+
+var _ envelope.EnvelopeHandler = &recorder{}
+
+func (r *recorder) record(format string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) ActivateComponent(_ context.Context, req *protos.ActivateComponentRequest) (*protos.ActivateComponentReply, error) {
+	r.record("ActivateComponent(%s)", req.Component)
+	return &protos.ActivateComponentReply{}, nil
+}
+
+func (r *recorder) GetListenerAddress(_ context.Context, req *protos.GetListenerAddressRequest) (*protos.GetListenerAddressReply, error) {
+	r.record("GetListenerAddress(%s)", req.Name)
+	return &protos.GetListenerAddressReply{Address: "localhost:9000"}, nil
+}
+
+func (r *recorder) ExportListener(_ context.Context, req *protos.ExportListenerRequest) (*protos.ExportListenerReply, error) {
+	r.record("ExportListener(%s, %s)", req.Listener, req.Address)
+	if r.fail {
+		return &protos.ExportListenerReply{Error: "address in use"}, nil
+	}
+	return &protos.ExportListenerReply{}, nil
+}
+
+func (r *recorder) GetSelfCertificate(context.Context, *protos.GetSelfCertificateRequest) (*protos.GetSelfCertificateReply, error) {
+	return nil, fmt.Errorf("unimplemented")
+}
+
+func (r *recorder) VerifyClientCertificate(context.Context, *protos.VerifyClientCertificateRequest) (*protos.VerifyClientCertificateReply, error) {
+	return nil, fmt.Errorf("unimplemented")
+}
+
+func (r *recorder) VerifyServerCertificate(context.Context, *protos.VerifyServerCertificateRequest) (*protos.VerifyServerCertificateReply, error) {
+	return nil, fmt.Errorf("unimplemented")
+}
+
+func (r *recorder) LogBatch(_ context.Context, batch *protos.LogEntryBatch) error {
+	for _, entry := range batch.Entries {
+		r.record("Log(%s)", entry.Msg)
+	}
+	return nil
+}
+
+func (r *recorder) HandleTraceSpans(_ context.Context, spans *protos.TraceSpans) error {
+	r.record("TraceSpans(%d)", len(spans.Span))
+	return nil
+}
+
+// play plays the provided script against an envelope that serves the
+// provided handler, and returns the envelope once the script ends.
+func play(t *testing.T, h envelope.EnvelopeHandler, w *scriptedweavelet.Weavelet) *envelope.Envelope {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	args := &protos.WeaveletArgs{
+		App:             "scriptedweavelet_test.go",
+		DeploymentId:    "deployment",
+		Id:              "weavelet",
+		InternalAddress: "localhost:0",
+	}
+	env, err := envelope.NewEnvelope(ctx, args, &protos.AppConfig{}, envelope.Options{
+		TmpDir: t.TempDir(),
+		Child:  w,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go env.Serve(h)
+	select {
+	case <-w.Done():
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the script to end")
+	}
+	return env
+}
+
+func TestScript(t *testing.T) {
+	h := &recorder{}
+	w := scriptedweavelet.New(nil,
+		scriptedweavelet.GetListenerAddress("lis", func(address string) error {
+			if address != "localhost:9000" {
+				return fmt.Errorf("got address %q, want %q", address, "localhost:9000")
+			}
+			return nil
+		}),
+		scriptedweavelet.ExportListener("lis", "localhost:9000"),
+		scriptedweavelet.ActivateComponent("a", false),
+		scriptedweavelet.Log(&protos.LogEntry{Msg: "hello"}, &protos.LogEntry{Msg: "world"}),
+		scriptedweavelet.TraceSpans(&protos.Span{Name: "span"}),
+	)
+	env := play(t, h, w)
+	if err := w.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The handler received the weavelet's messages in order.
+	want := []string{
+		"GetListenerAddress(lis)",
+		"ExportListener(lis, localhost:9000)",
+		"ActivateComponent(a)",
+		"Log(hello)",
+		"Log(world)",
+		"TraceSpans(1)",
+	}
+	h.mu.Lock()
+	got := h.calls
+	h.mu.Unlock()
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("calls (-want +got):\n%s", diff)
+	}
+
+	// The weavelet records the envelope's requests.
+	if _, err := env.MeasureRTT(); err != nil {
+		t.Fatal(err)
+	}
+	var requests []string
+	for _, req := range w.Requests() {
+		requests = append(requests, string(req.ProtoReflect().Descriptor().Name()))
+	}
+	if diff := cmp.Diff([]string{"InitWeaveletRequest", "PingRequest"}, requests); diff != "" {
+		t.Fatalf("requests (-want +got):\n%s", diff)
+	}
+}
+
+func TestScriptStepFails(t *testing.T) {
+	h := &recorder{fail: true}
+	w := scriptedweavelet.New(nil,
+		scriptedweavelet.ExportListener("lis", "localhost:9000"),
+		scriptedweavelet.ActivateComponent("a", false),
+	)
+	play(t, h, w)
+	if err := w.Err(); err == nil || !strings.Contains(err.Error(), "address in use") {
+		t.Fatalf("Err: got %v, want address in use error", err)
+	}
+
+	// The script ended at the failed step.
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if diff := cmp.Diff([]string{"ExportListener(lis, localhost:9000)"}, h.calls); diff != "" {
+		t.Fatalf("calls (-want +got):\n%s", diff)
+	}
+}
+
+func TestScriptBadInfo(t *testing.T) {
+	w := scriptedweavelet.New(&protos.InitWeaveletReply{DialAddr: "tcp://127.0.0.1:0"})
+	_, err := envelope.NewEnvelope(context.Background(), &protos.WeaveletArgs{
+		App:          "scriptedweavelet_test.go",
+		DeploymentId: "deployment",
+		Id:           "weavelet",
+	}, &protos.AppConfig{}, envelope.Options{TmpDir: t.TempDir(), Child: w})
+	if err == nil {
+		t.Fatal("NewEnvelope: unexpected success with a weavelet info without a version")
+	}
+}
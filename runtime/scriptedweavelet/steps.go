@@ -0,0 +1,117 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scriptedweavelet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ServiceWeaver/weaver/internal/control"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// A Step is a step of a script, e.g., an RPC issued to the envelope. A step
+// fails, ending the script, if the RPC fails.
+type Step struct {
+	name string // describes the step in errors
+	run  func(context.Context, *Weavelet, control.DeployerControl) error
+}
+
+// ActivateComponent returns a step that asks the envelope to activate the
+// provided component, as a weavelet does the first time it gets a client of
+// the component.
+func ActivateComponent(component string, routed bool) Step {
+	return Step{
+		name: fmt.Sprintf("ActivateComponent(%q)", component),
+		run: func(ctx context.Context, _ *Weavelet, d control.DeployerControl) error {
+			req := &protos.ActivateComponentRequest{Component: component, Routed: routed}
+			_, err := d.ActivateComponent(ctx, req)
+			return err
+		},
+	}
+}
+
+// GetListenerAddress returns a step that asks the envelope for the address
+// of the provided listener. If check is not nil, it is called with the
+// returned address, and the step fails if it returns an error.
+func GetListenerAddress(listener string, check func(address string) error) Step {
+	return Step{
+		name: fmt.Sprintf("GetListenerAddress(%q)", listener),
+		run: func(ctx context.Context, _ *Weavelet, d control.DeployerControl) error {
+			reply, err := d.GetListenerAddress(ctx, &protos.GetListenerAddressRequest{Name: listener})
+			if err != nil || check == nil {
+				return err
+			}
+			return check(reply.Address)
+		},
+	}
+}
+
+// ExportListener returns a step that tells the envelope that the provided
+// listener is bound to the provided address, as a weavelet does after it
+// starts listening.
+func ExportListener(listener, address string) Step {
+	return Step{
+		name: fmt.Sprintf("ExportListener(%q, %q)", listener, address),
+		run: func(ctx context.Context, w *Weavelet, d control.DeployerControl) error {
+			reply, err := d.ExportListener(ctx, &protos.ExportListenerRequest{
+				Listener: listener,
+				Address:  address,
+				Token:    w.args.Id + "/" + listener,
+			})
+			if err != nil {
+				return err
+			}
+			if reply.Error != "" {
+				return fmt.Errorf("%s", reply.Error)
+			}
+			return nil
+		},
+	}
+}
+
+// Log returns a step that sends the provided log entries to the envelope, in
+// a single batch.
+func Log(entries ...*protos.LogEntry) Step {
+	return Step{
+		name: fmt.Sprintf("Log(%d entries)", len(entries)),
+		run: func(ctx context.Context, w *Weavelet, d control.DeployerControl) error {
+			return d.LogBatch(ctx, &protos.LogEntryBatch{Entries: entries, Seq: w.nextSeq()})
+		},
+	}
+}
+
+// TraceSpans returns a step that sends the provided trace spans to the
+// envelope.
+func TraceSpans(spans ...*protos.Span) Step {
+	return Step{
+		name: fmt.Sprintf("TraceSpans(%d spans)", len(spans)),
+		run: func(ctx context.Context, _ *Weavelet, d control.DeployerControl) error {
+			return d.HandleTraceSpans(ctx, &protos.TraceSpans{Span: spans})
+		},
+	}
+}
+
+// Func returns a step that calls the provided function, e.g., to wait for
+// the test to make progress, or to check the state of the deployer between
+// steps.
+func Func(name string, f func(context.Context) error) Step {
+	return Step{
+		name: name,
+		run: func(ctx context.Context, _ *Weavelet, _ control.DeployerControl) error {
+			return f(ctx)
+		},
+	}
+}
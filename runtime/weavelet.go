@@ -40,5 +40,8 @@ func CheckWeaveletArgs(w *protos.WeaveletArgs) error {
 	if w.ControlSocket == "" {
 		return fmt.Errorf("WeaveletArgs: missing control socket")
 	}
+	if w.MaxConcurrentRpcs < 0 {
+		return fmt.Errorf("WeaveletArgs: negative max concurrent RPCs %d", w.MaxConcurrentRpcs)
+	}
 	return nil
 }
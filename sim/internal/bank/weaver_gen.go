@@ -210,8 +210,10 @@ func (s bank_client_stub) Deposit(ctx context.Context, a0 string, a1 int) (r0 in
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Bank", "Deposit", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -223,11 +225,17 @@ func (s bank_client_stub) Deposit(ctx context.Context, a0 string, a1 int) (r0 in
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Bank", "Deposit", codegen.DecodeResults)
 	return
 }
 
@@ -268,8 +276,10 @@ func (s bank_client_stub) Withdraw(ctx context.Context, a0 string, a1 int) (r0 i
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Bank", "Withdraw", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -281,11 +291,17 @@ func (s bank_client_stub) Withdraw(ctx context.Context, a0 string, a1 int) (r0 i
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Bank", "Withdraw", codegen.DecodeResults)
 	return
 }
 
@@ -335,8 +351,10 @@ func (s store_client_stub) Add(ctx context.Context, a0 string, a1 int) (r0 int,
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Store", "Add", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -348,11 +366,17 @@ func (s store_client_stub) Add(ctx context.Context, a0 string, a1 int) (r0 int,
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Store", "Add", codegen.DecodeResults)
 	return
 }
 
@@ -392,7 +416,9 @@ func (s store_client_stub) Get(ctx context.Context, a0 string) (r0 int, err erro
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Store", "Get", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -404,11 +430,17 @@ func (s store_client_stub) Get(ctx context.Context, a0 string) (r0 int, err erro
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Store", "Get", codegen.DecodeResults)
 	return
 }
 
@@ -466,11 +498,13 @@ func (s bank_server_stub) deposit(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Bank", "Deposit", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -478,9 +512,11 @@ func (s bank_server_stub) deposit(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.Deposit(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Bank", "Deposit", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -493,11 +529,13 @@ func (s bank_server_stub) withdraw(ctx context.Context, args []byte) (res []byte
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Bank", "Withdraw", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -505,9 +543,11 @@ func (s bank_server_stub) withdraw(ctx context.Context, args []byte) (res []byte
 	r0, appErr := s.impl.Withdraw(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Bank", "Withdraw", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -540,11 +580,13 @@ func (s store_server_stub) add(ctx context.Context, args []byte) (res []byte, er
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Store", "Add", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -552,9 +594,11 @@ func (s store_server_stub) add(ctx context.Context, args []byte) (res []byte, er
 	r0, appErr := s.impl.Add(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Store", "Add", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -567,9 +611,11 @@ func (s store_server_stub) get(ctx context.Context, args []byte) (res []byte, er
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Store", "Get", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -577,9 +623,11 @@ func (s store_server_stub) get(ctx context.Context, args []byte) (res []byte, er
 	r0, appErr := s.impl.Get(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/internal/bank/Store", "Get", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -366,10 +366,16 @@ func (s blocker_client_stub) Block(ctx context.Context) (err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/blocker", "Block", codegen.DecodeResults)
 	return
 }
 
@@ -418,8 +424,10 @@ func (s div_client_stub) Div(ctx context.Context, a0 int, a1 int) (r0 int, err e
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/div", "Div", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -431,11 +439,17 @@ func (s div_client_stub) Div(ctx context.Context, a0 int, a1 int) (r0 int, err e
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/div", "Div", codegen.DecodeResults)
 	return
 }
 
@@ -484,8 +498,10 @@ func (s divMod_client_stub) DivMod(ctx context.Context, a0 int, a1 int) (r0 int,
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/divMod", "DivMod", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -497,12 +513,18 @@ func (s divMod_client_stub) DivMod(ctx context.Context, a0 int, a1 int) (r0 int,
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	r1 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/divMod", "DivMod", codegen.DecodeResults)
 	return
 }
 
@@ -550,7 +572,9 @@ func (s identity_client_stub) Identity(ctx context.Context, a0 int) (r0 int, err
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/identity", "Identity", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -562,11 +586,17 @@ func (s identity_client_stub) Identity(ctx context.Context, a0 int) (r0 int, err
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/identity", "Identity", codegen.DecodeResults)
 	return
 }
 
@@ -615,8 +645,10 @@ func (s mod_client_stub) Mod(ctx context.Context, a0 int, a1 int) (r0 int, err e
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/mod", "Mod", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -628,11 +660,17 @@ func (s mod_client_stub) Mod(ctx context.Context, a0 int, a1 int) (r0 int, err e
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/mod", "Mod", codegen.DecodeResults)
 	return
 }
 
@@ -680,7 +718,9 @@ func (s panicker_client_stub) Panic(ctx context.Context, a0 bool) (err error) {
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Bool(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/panicker", "Panic", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -692,10 +732,16 @@ func (s panicker_client_stub) Panic(ctx context.Context, a0 bool) (err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/panicker", "Panic", codegen.DecodeResults)
 	return
 }
 
@@ -756,8 +802,10 @@ func (s blocker_server_stub) block(ctx context.Context, args []byte) (res []byte
 	appErr := s.impl.Block(ctx)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/blocker", "Block", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -788,11 +836,13 @@ func (s div_server_stub) div(ctx context.Context, args []byte) (res []byte, err
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/div", "Div", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -800,9 +850,11 @@ func (s div_server_stub) div(ctx context.Context, args []byte) (res []byte, err
 	r0, appErr := s.impl.Div(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/div", "Div", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -833,11 +885,13 @@ func (s divMod_server_stub) divMod(ctx context.Context, args []byte) (res []byte
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/divMod", "DivMod", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -845,10 +899,12 @@ func (s divMod_server_stub) divMod(ctx context.Context, args []byte) (res []byte
 	r0, r1, appErr := s.impl.DivMod(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Int(r1)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/divMod", "DivMod", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -879,9 +935,11 @@ func (s identity_server_stub) identity(ctx context.Context, args []byte) (res []
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/identity", "Identity", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -889,9 +947,11 @@ func (s identity_server_stub) identity(ctx context.Context, args []byte) (res []
 	r0, appErr := s.impl.Identity(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/identity", "Identity", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -922,11 +982,13 @@ func (s mod_server_stub) mod(ctx context.Context, args []byte) (res []byte, err
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/mod", "Mod", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -934,9 +996,11 @@ func (s mod_server_stub) mod(ctx context.Context, args []byte) (res []byte, err
 	r0, appErr := s.impl.Mod(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/mod", "Mod", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -967,9 +1031,11 @@ func (s panicker_server_stub) panic(ctx context.Context, args []byte) (res []byt
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 bool
 	a0 = dec.Bool()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/sim/panicker", "Panic", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -977,8 +1043,10 @@ func (s panicker_server_stub) panic(ctx context.Context, args []byte) (res []byt
 	appErr := s.impl.Panic(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/sim/panicker", "Panic", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -69,3 +69,163 @@ func (*noopWeaveletControl) GetMetrics(context.Context, *protos.GetMetricsReques
 func (*noopWeaveletControl) GetProfile(context.Context, *protos.GetProfileRequest) (*protos.GetProfileReply, error) {
 	return nil, fmt.Errorf("weaveletControl.GetProfile not implemented")
 }
+
+// ListProfiles implements weaveletControl interface.
+func (*noopWeaveletControl) ListProfiles(context.Context, *protos.ListProfilesRequest) (*protos.ListProfilesReply, error) {
+	return nil, fmt.Errorf("weaveletControl.ListProfiles not implemented")
+}
+
+// CancelProfile implements weaveletControl interface.
+func (*noopWeaveletControl) CancelProfile(context.Context, *protos.CancelProfileRequest) (*protos.CancelProfileReply, error) {
+	return nil, fmt.Errorf("weaveletControl.CancelProfile not implemented")
+}
+
+// SetProfilingRate implements weaveletControl interface.
+func (*noopWeaveletControl) SetProfilingRate(context.Context, *protos.SetProfilingRateRequest) (*protos.SetProfilingRateReply, error) {
+	return nil, fmt.Errorf("weaveletControl.SetProfilingRate not implemented")
+}
+
+// SetComponentEnabled implements weaveletControl interface.
+func (*noopWeaveletControl) SetComponentEnabled(context.Context, *protos.SetComponentEnabledRequest) (*protos.SetComponentEnabledReply, error) {
+	return nil, fmt.Errorf("weaveletControl.SetComponentEnabled not implemented")
+}
+
+// ForwardSignal implements weaveletControl interface.
+func (*noopWeaveletControl) ForwardSignal(context.Context, *protos.ForwardSignalRequest) (*protos.ForwardSignalReply, error) {
+	return nil, fmt.Errorf("weaveletControl.ForwardSignal not implemented")
+}
+
+// GetReadiness implements weaveletControl interface.
+func (*noopWeaveletControl) GetReadiness(context.Context, *protos.GetReadinessRequest) (*protos.GetReadinessReply, error) {
+	return nil, fmt.Errorf("weaveletControl.GetReadiness not implemented")
+}
+
+// GetBuildFlags implements weaveletControl interface.
+func (*noopWeaveletControl) GetBuildFlags(context.Context, *protos.GetBuildFlagsRequest) (*protos.GetBuildFlagsReply, error) {
+	return nil, fmt.Errorf("weaveletControl.GetBuildFlags not implemented")
+}
+
+// SetGCPercent implements weaveletControl interface.
+func (*noopWeaveletControl) SetGCPercent(context.Context, *protos.SetGCPercentRequest) (*protos.SetGCPercentReply, error) {
+	return nil, fmt.Errorf("weaveletControl.SetGCPercent not implemented")
+}
+
+// ForceGC implements weaveletControl interface.
+func (*noopWeaveletControl) ForceGC(context.Context, *protos.ForceGCRequest) (*protos.ForceGCReply, error) {
+	return nil, fmt.Errorf("weaveletControl.ForceGC not implemented")
+}
+
+// GetDependencies implements weaveletControl interface.
+func (*noopWeaveletControl) GetDependencies(context.Context, *protos.GetDependenciesRequest) (*protos.GetDependenciesReply, error) {
+	return nil, fmt.Errorf("weaveletControl.GetDependencies not implemented")
+}
+
+// GetFDCount implements weaveletControl interface.
+func (*noopWeaveletControl) GetFDCount(context.Context, *protos.GetFDCountRequest) (*protos.GetFDCountReply, error) {
+	return nil, fmt.Errorf("weaveletControl.GetFDCount not implemented")
+}
+
+// GetRoutingInfo implements weaveletControl interface.
+func (*noopWeaveletControl) GetRoutingInfo(context.Context, *protos.GetRoutingInfoRequest) (*protos.GetRoutingInfoReply, error) {
+	return nil, fmt.Errorf("weaveletControl.GetRoutingInfo not implemented")
+}
+
+// GetFeatureFlags implements weaveletControl interface.
+func (*noopWeaveletControl) GetFeatureFlags(context.Context, *protos.GetFeatureFlagsRequest) (*protos.GetFeatureFlagsReply, error) {
+	return nil, fmt.Errorf("weaveletControl.GetFeatureFlags not implemented")
+}
+
+// SetFeatureFlag implements weaveletControl interface.
+func (*noopWeaveletControl) SetFeatureFlag(context.Context, *protos.SetFeatureFlagRequest) (*protos.SetFeatureFlagReply, error) {
+	return nil, fmt.Errorf("weaveletControl.SetFeatureFlag not implemented")
+}
+
+// RawRPC implements weaveletControl interface.
+func (*noopWeaveletControl) RawRPC(context.Context, *protos.RawRPCRequest) (*protos.RawRPCReply, error) {
+	return nil, fmt.Errorf("weaveletControl.RawRPC not implemented")
+}
+
+// Checkpoint implements weaveletControl interface.
+func (*noopWeaveletControl) Checkpoint(context.Context, *protos.CheckpointRequest) (*protos.CheckpointReply, error) {
+	return nil, fmt.Errorf("weaveletControl.Checkpoint not implemented")
+}
+
+// Restore implements weaveletControl interface.
+func (*noopWeaveletControl) Restore(context.Context, *protos.RestoreRequest) (*protos.RestoreReply, error) {
+	return nil, fmt.Errorf("weaveletControl.Restore not implemented")
+}
+
+// UpdateListenerTLS implements weaveletControl interface.
+func (*noopWeaveletControl) UpdateListenerTLS(context.Context, *protos.UpdateListenerTLSRequest) (*protos.UpdateListenerTLSReply, error) {
+	return nil, fmt.Errorf("weaveletControl.UpdateListenerTLS not implemented")
+}
+
+// Ping implements weaveletControl interface.
+func (*noopWeaveletControl) Ping(context.Context, *protos.PingRequest) (*protos.PingReply, error) {
+	return nil, fmt.Errorf("weaveletControl.Ping not implemented")
+}
+
+// GetTime implements weaveletControl interface.
+func (*noopWeaveletControl) GetTime(context.Context, *protos.GetTimeRequest) (*protos.GetTimeReply, error) {
+	return nil, fmt.Errorf("weaveletControl.GetTime not implemented")
+}
+
+// QuiesceComponent implements weaveletControl interface.
+func (*noopWeaveletControl) QuiesceComponent(context.Context, *protos.QuiesceComponentRequest) (*protos.QuiesceComponentReply, error) {
+	return nil, fmt.Errorf("weaveletControl.QuiesceComponent not implemented")
+}
+
+// Drain implements weaveletControl interface.
+func (*noopWeaveletControl) Drain(context.Context, *protos.DrainRequest) (*protos.DrainReply, error) {
+	return nil, fmt.Errorf("weaveletControl.Drain not implemented")
+}
+
+// ReexportListeners implements weaveletControl interface.
+func (*noopWeaveletControl) ReexportListeners(context.Context, *protos.ReexportListenersRequest) (*protos.ReexportListenersReply, error) {
+	return nil, fmt.Errorf("weaveletControl.ReexportListeners not implemented")
+}
+
+// FlushTelemetry implements weaveletControl interface.
+func (*noopWeaveletControl) FlushTelemetry(context.Context, *protos.FlushTelemetryRequest) (*protos.FlushTelemetryReply, error) {
+	return nil, fmt.Errorf("weaveletControl.FlushTelemetry not implemented")
+}
+
+// GetInFlight implements weaveletControl interface.
+func (*noopWeaveletControl) GetInFlight(context.Context, *protos.GetInFlightRequest) (*protos.GetInFlightReply, error) {
+	return nil, fmt.Errorf("weaveletControl.GetInFlight not implemented")
+}
+
+// ReloadConfig implements weaveletControl interface.
+func (*noopWeaveletControl) ReloadConfig(context.Context, *protos.ReloadConfigRequest) (*protos.ReloadConfigReply, error) {
+	return nil, fmt.Errorf("weaveletControl.ReloadConfig not implemented")
+}
+
+// GetSnapshot implements weaveletControl interface.
+func (*noopWeaveletControl) GetSnapshot(context.Context, *protos.GetSnapshotRequest) (*protos.GetSnapshotReply, error) {
+	return nil, fmt.Errorf("weaveletControl.GetSnapshot not implemented")
+}
+
+// GetProfileBundle implements weaveletControl interface.
+func (*noopWeaveletControl) GetProfileBundle(context.Context, *protos.GetProfileBundleRequest) (*protos.GetProfileBundleReply, error) {
+	return nil, fmt.Errorf("weaveletControl.GetProfileBundle not implemented")
+}
+
+// GetRecentErrors implements weaveletControl interface.
+func (*noopWeaveletControl) GetRecentErrors(context.Context, *protos.GetRecentErrorsRequest) (*protos.GetRecentErrorsReply, error) {
+	return nil, fmt.Errorf("weaveletControl.GetRecentErrors not implemented")
+}
+
+// ClearRecentErrors implements weaveletControl interface.
+func (*noopWeaveletControl) ClearRecentErrors(context.Context, *protos.ClearRecentErrorsRequest) (*protos.ClearRecentErrorsReply, error) {
+	return nil, fmt.Errorf("weaveletControl.ClearRecentErrors not implemented")
+}
+
+// SetComponentLogLevel implements weaveletControl interface.
+func (*noopWeaveletControl) SetComponentLogLevel(context.Context, *protos.SetComponentLogLevelRequest) (*protos.SetComponentLogLevelReply, error) {
+	return nil, fmt.Errorf("weaveletControl.SetComponentLogLevel not implemented")
+}
+
+// GetLogLevels implements weaveletControl interface.
+func (*noopWeaveletControl) GetLogLevels(context.Context, *protos.GetLogLevelsRequest) (*protos.GetLogLevelsReply, error) {
+	return nil, fmt.Errorf("weaveletControl.GetLogLevels not implemented")
+}
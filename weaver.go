@@ -71,6 +71,21 @@ import (
 // example.
 var RemoteCallError = errors.New("Service Weaver remote call error")
 
+// ErrCircuitOpen indicates that a remote component method call was not issued
+// because the circuit breaker of the component is open. Deployers configure
+// circuit breakers to protect failing components. Method calls that fail with
+// ErrCircuitOpen also fail with RemoteCallError, but unlike other remote call
+// errors, the method is guaranteed not to have executed.
+var ErrCircuitOpen = weaver.ErrCircuitOpen
+
+// ErrRateLimited indicates that a remote component method call was rejected
+// because the weavelet hosting the component serves calls at a limited rate.
+// Deployers configure rate limits to protect overloaded components. Method
+// calls that fail with ErrRateLimited also fail with RemoteCallError, but
+// unlike other remote call errors, the method is guaranteed not to have
+// executed.
+var ErrRateLimited = weaver.ErrRateLimited
+
 // HealthzHandler is a health-check handler that returns an OK status for all
 // incoming HTTP requests.
 var HealthzHandler = func(w http.ResponseWriter, _ *http.Request) {
@@ -433,6 +448,24 @@ func (l *Listener) ProxyAddr() string {
 //
 //	["example.com/mypkg/Cache"]
 //	my_custom_name = 1000
+//
+// # Reloading
+//
+// A deployer may reload the config of a running component after the config
+// file has been edited (see envelope.Envelope.ReloadConfig). A component opts
+// into reloading by implementing a ReloadConfig method, which is passed a *T
+// holding the new config:
+//
+//	func (c *cache) ReloadConfig(_ context.Context, config any) error {
+//	    size := config.(*cacheConfig).Size
+//	    // Resize the cache...
+//	    return nil
+//	}
+//
+// ReloadConfig may be called concurrently with the component's methods.
+// Config keeps returning the config the component was created with. A
+// component that doesn't implement ReloadConfig, or whose ReloadConfig returns
+// an error, keeps its old config until it is restarted.
 type WithConfig[T any] struct {
 	config T
 }
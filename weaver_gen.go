@@ -37,10 +37,10 @@ func init() {
 		Iface: reflect.TypeOf((*weaveletControl)(nil)).Elem(),
 		Impl:  reflect.TypeOf(noopWeaveletControl{}),
 		LocalStubFn: func(impl any, caller string, tracer trace.Tracer) any {
-			return weaveletControl_local_stub{impl: impl.(weaveletControl), tracer: tracer, getHealthMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetHealth", Remote: false, Generated: true}), getLoadMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetLoad", Remote: false, Generated: true}), getMetricsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetMetrics", Remote: false, Generated: true}), getProfileMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetProfile", Remote: false, Generated: true}), initWeaveletMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "InitWeavelet", Remote: false, Generated: true}), updateComponentsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "UpdateComponents", Remote: false, Generated: true}), updateRoutingInfoMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "UpdateRoutingInfo", Remote: false, Generated: true})}
+			return weaveletControl_local_stub{impl: impl.(weaveletControl), tracer: tracer, cancelProfileMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "CancelProfile", Remote: false, Generated: true}), checkpointMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "Checkpoint", Remote: false, Generated: true}), clearRecentErrorsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "ClearRecentErrors", Remote: false, Generated: true}), drainMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "Drain", Remote: false, Generated: true}), flushTelemetryMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "FlushTelemetry", Remote: false, Generated: true}), forceGCMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "ForceGC", Remote: false, Generated: true}), forwardSignalMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "ForwardSignal", Remote: false, Generated: true}), getBuildFlagsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetBuildFlags", Remote: false, Generated: true}), getDependenciesMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetDependencies", Remote: false, Generated: true}), getFDCountMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetFDCount", Remote: false, Generated: true}), getFeatureFlagsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetFeatureFlags", Remote: false, Generated: true}), getHealthMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetHealth", Remote: false, Generated: true}), getInFlightMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetInFlight", Remote: false, Generated: true}), getLoadMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetLoad", Remote: false, Generated: true}), getLogLevelsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetLogLevels", Remote: false, Generated: true}), getMetricsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetMetrics", Remote: false, Generated: true}), getProfileMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetProfile", Remote: false, Generated: true}), getProfileBundleMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetProfileBundle", Remote: false, Generated: true}), getReadinessMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetReadiness", Remote: false, Generated: true}), getRecentErrorsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetRecentErrors", Remote: false, Generated: true}), getRoutingInfoMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetRoutingInfo", Remote: false, Generated: true}), getSnapshotMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetSnapshot", Remote: false, Generated: true}), getTimeMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetTime", Remote: false, Generated: true}), initWeaveletMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "InitWeavelet", Remote: false, Generated: true}), listProfilesMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "ListProfiles", Remote: false, Generated: true}), pingMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "Ping", Remote: false, Generated: true}), quiesceComponentMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "QuiesceComponent", Remote: false, Generated: true}), rawRPCMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "RawRPC", Remote: false, Generated: true}), reexportListenersMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "ReexportListeners", Remote: false, Generated: true}), reloadConfigMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "ReloadConfig", Remote: false, Generated: true}), restoreMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "Restore", Remote: false, Generated: true}), setComponentEnabledMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "SetComponentEnabled", Remote: false, Generated: true}), setComponentLogLevelMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "SetComponentLogLevel", Remote: false, Generated: true}), setFeatureFlagMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "SetFeatureFlag", Remote: false, Generated: true}), setGCPercentMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "SetGCPercent", Remote: false, Generated: true}), setProfilingRateMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "SetProfilingRate", Remote: false, Generated: true}), updateComponentsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "UpdateComponents", Remote: false, Generated: true}), updateListenerTLSMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "UpdateListenerTLS", Remote: false, Generated: true}), updateRoutingInfoMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "UpdateRoutingInfo", Remote: false, Generated: true})}
 		},
 		ClientStubFn: func(stub codegen.Stub, caller string) any {
-			return weaveletControl_client_stub{stub: stub, getHealthMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetHealth", Remote: true, Generated: true}), getLoadMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetLoad", Remote: true, Generated: true}), getMetricsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetMetrics", Remote: true, Generated: true}), getProfileMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetProfile", Remote: true, Generated: true}), initWeaveletMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "InitWeavelet", Remote: true, Generated: true}), updateComponentsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "UpdateComponents", Remote: true, Generated: true}), updateRoutingInfoMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "UpdateRoutingInfo", Remote: true, Generated: true})}
+			return weaveletControl_client_stub{stub: stub, cancelProfileMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "CancelProfile", Remote: true, Generated: true}), checkpointMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "Checkpoint", Remote: true, Generated: true}), clearRecentErrorsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "ClearRecentErrors", Remote: true, Generated: true}), drainMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "Drain", Remote: true, Generated: true}), flushTelemetryMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "FlushTelemetry", Remote: true, Generated: true}), forceGCMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "ForceGC", Remote: true, Generated: true}), forwardSignalMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "ForwardSignal", Remote: true, Generated: true}), getBuildFlagsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetBuildFlags", Remote: true, Generated: true}), getDependenciesMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetDependencies", Remote: true, Generated: true}), getFDCountMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetFDCount", Remote: true, Generated: true}), getFeatureFlagsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetFeatureFlags", Remote: true, Generated: true}), getHealthMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetHealth", Remote: true, Generated: true}), getInFlightMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetInFlight", Remote: true, Generated: true}), getLoadMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetLoad", Remote: true, Generated: true}), getLogLevelsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetLogLevels", Remote: true, Generated: true}), getMetricsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetMetrics", Remote: true, Generated: true}), getProfileMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetProfile", Remote: true, Generated: true}), getProfileBundleMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetProfileBundle", Remote: true, Generated: true}), getReadinessMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetReadiness", Remote: true, Generated: true}), getRecentErrorsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetRecentErrors", Remote: true, Generated: true}), getRoutingInfoMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetRoutingInfo", Remote: true, Generated: true}), getSnapshotMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetSnapshot", Remote: true, Generated: true}), getTimeMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "GetTime", Remote: true, Generated: true}), initWeaveletMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "InitWeavelet", Remote: true, Generated: true}), listProfilesMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "ListProfiles", Remote: true, Generated: true}), pingMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "Ping", Remote: true, Generated: true}), quiesceComponentMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "QuiesceComponent", Remote: true, Generated: true}), rawRPCMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "RawRPC", Remote: true, Generated: true}), reexportListenersMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "ReexportListeners", Remote: true, Generated: true}), reloadConfigMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "ReloadConfig", Remote: true, Generated: true}), restoreMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "Restore", Remote: true, Generated: true}), setComponentEnabledMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "SetComponentEnabled", Remote: true, Generated: true}), setComponentLogLevelMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "SetComponentLogLevel", Remote: true, Generated: true}), setFeatureFlagMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "SetFeatureFlag", Remote: true, Generated: true}), setGCPercentMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "SetGCPercent", Remote: true, Generated: true}), setProfilingRateMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "SetProfilingRate", Remote: true, Generated: true}), updateComponentsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "UpdateComponents", Remote: true, Generated: true}), updateListenerTLSMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "UpdateListenerTLS", Remote: true, Generated: true}), updateRoutingInfoMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weaveletControl", Method: "UpdateRoutingInfo", Remote: true, Generated: true})}
 		},
 		ServerStubFn: func(impl any, addLoad func(uint64, float64)) codegen.Server {
 			return weaveletControl_server_stub{impl: impl.(weaveletControl), addLoad: addLoad}
@@ -239,28 +239,60 @@ func (s deployerControl_local_stub) VerifyServerCertificate(ctx context.Context,
 }
 
 type weaveletControl_local_stub struct {
-	impl                     weaveletControl
-	tracer                   trace.Tracer
-	getHealthMetrics         *codegen.MethodMetrics
-	getLoadMetrics           *codegen.MethodMetrics
-	getMetricsMetrics        *codegen.MethodMetrics
-	getProfileMetrics        *codegen.MethodMetrics
-	initWeaveletMetrics      *codegen.MethodMetrics
-	updateComponentsMetrics  *codegen.MethodMetrics
-	updateRoutingInfoMetrics *codegen.MethodMetrics
+	impl                        weaveletControl
+	tracer                      trace.Tracer
+	cancelProfileMetrics        *codegen.MethodMetrics
+	checkpointMetrics           *codegen.MethodMetrics
+	clearRecentErrorsMetrics    *codegen.MethodMetrics
+	drainMetrics                *codegen.MethodMetrics
+	flushTelemetryMetrics       *codegen.MethodMetrics
+	forceGCMetrics              *codegen.MethodMetrics
+	forwardSignalMetrics        *codegen.MethodMetrics
+	getBuildFlagsMetrics        *codegen.MethodMetrics
+	getDependenciesMetrics      *codegen.MethodMetrics
+	getFDCountMetrics           *codegen.MethodMetrics
+	getFeatureFlagsMetrics      *codegen.MethodMetrics
+	getHealthMetrics            *codegen.MethodMetrics
+	getInFlightMetrics          *codegen.MethodMetrics
+	getLoadMetrics              *codegen.MethodMetrics
+	getLogLevelsMetrics         *codegen.MethodMetrics
+	getMetricsMetrics           *codegen.MethodMetrics
+	getProfileMetrics           *codegen.MethodMetrics
+	getProfileBundleMetrics     *codegen.MethodMetrics
+	getReadinessMetrics         *codegen.MethodMetrics
+	getRecentErrorsMetrics      *codegen.MethodMetrics
+	getRoutingInfoMetrics       *codegen.MethodMetrics
+	getSnapshotMetrics          *codegen.MethodMetrics
+	getTimeMetrics              *codegen.MethodMetrics
+	initWeaveletMetrics         *codegen.MethodMetrics
+	listProfilesMetrics         *codegen.MethodMetrics
+	pingMetrics                 *codegen.MethodMetrics
+	quiesceComponentMetrics     *codegen.MethodMetrics
+	rawRPCMetrics               *codegen.MethodMetrics
+	reexportListenersMetrics    *codegen.MethodMetrics
+	reloadConfigMetrics         *codegen.MethodMetrics
+	restoreMetrics              *codegen.MethodMetrics
+	setComponentEnabledMetrics  *codegen.MethodMetrics
+	setComponentLogLevelMetrics *codegen.MethodMetrics
+	setFeatureFlagMetrics       *codegen.MethodMetrics
+	setGCPercentMetrics         *codegen.MethodMetrics
+	setProfilingRateMetrics     *codegen.MethodMetrics
+	updateComponentsMetrics     *codegen.MethodMetrics
+	updateListenerTLSMetrics    *codegen.MethodMetrics
+	updateRoutingInfoMetrics    *codegen.MethodMetrics
 }
 
 // Check that weaveletControl_local_stub implements the weaveletControl interface.
 var _ weaveletControl = (*weaveletControl_local_stub)(nil)
 
-func (s weaveletControl_local_stub) GetHealth(ctx context.Context, a0 *protos.GetHealthRequest) (r0 *protos.GetHealthReply, err error) {
+func (s weaveletControl_local_stub) CancelProfile(ctx context.Context, a0 *protos.CancelProfileRequest) (r0 *protos.CancelProfileReply, err error) {
 	// Update metrics.
-	begin := s.getHealthMetrics.Begin()
-	defer func() { s.getHealthMetrics.End(begin, err != nil, 0, 0) }()
+	begin := s.cancelProfileMetrics.Begin()
+	defer func() { s.cancelProfileMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetHealth", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.CancelProfile", trace.WithSpanKind(trace.SpanKindInternal))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -270,17 +302,17 @@ func (s weaveletControl_local_stub) GetHealth(ctx context.Context, a0 *protos.Ge
 		}()
 	}
 
-	return s.impl.GetHealth(ctx, a0)
+	return s.impl.CancelProfile(ctx, a0)
 }
 
-func (s weaveletControl_local_stub) GetLoad(ctx context.Context, a0 *protos.GetLoadRequest) (r0 *protos.GetLoadReply, err error) {
+func (s weaveletControl_local_stub) Checkpoint(ctx context.Context, a0 *protos.CheckpointRequest) (r0 *protos.CheckpointReply, err error) {
 	// Update metrics.
-	begin := s.getLoadMetrics.Begin()
-	defer func() { s.getLoadMetrics.End(begin, err != nil, 0, 0) }()
+	begin := s.checkpointMetrics.Begin()
+	defer func() { s.checkpointMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetLoad", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.Checkpoint", trace.WithSpanKind(trace.SpanKindInternal))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -290,17 +322,17 @@ func (s weaveletControl_local_stub) GetLoad(ctx context.Context, a0 *protos.GetL
 		}()
 	}
 
-	return s.impl.GetLoad(ctx, a0)
+	return s.impl.Checkpoint(ctx, a0)
 }
 
-func (s weaveletControl_local_stub) GetMetrics(ctx context.Context, a0 *protos.GetMetricsRequest) (r0 *protos.GetMetricsReply, err error) {
+func (s weaveletControl_local_stub) ClearRecentErrors(ctx context.Context, a0 *protos.ClearRecentErrorsRequest) (r0 *protos.ClearRecentErrorsReply, err error) {
 	// Update metrics.
-	begin := s.getMetricsMetrics.Begin()
-	defer func() { s.getMetricsMetrics.End(begin, err != nil, 0, 0) }()
+	begin := s.clearRecentErrorsMetrics.Begin()
+	defer func() { s.clearRecentErrorsMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetMetrics", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.ClearRecentErrors", trace.WithSpanKind(trace.SpanKindInternal))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -310,17 +342,17 @@ func (s weaveletControl_local_stub) GetMetrics(ctx context.Context, a0 *protos.G
 		}()
 	}
 
-	return s.impl.GetMetrics(ctx, a0)
+	return s.impl.ClearRecentErrors(ctx, a0)
 }
 
-func (s weaveletControl_local_stub) GetProfile(ctx context.Context, a0 *protos.GetProfileRequest) (r0 *protos.GetProfileReply, err error) {
+func (s weaveletControl_local_stub) Drain(ctx context.Context, a0 *protos.DrainRequest) (r0 *protos.DrainReply, err error) {
 	// Update metrics.
-	begin := s.getProfileMetrics.Begin()
-	defer func() { s.getProfileMetrics.End(begin, err != nil, 0, 0) }()
+	begin := s.drainMetrics.Begin()
+	defer func() { s.drainMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetProfile", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.Drain", trace.WithSpanKind(trace.SpanKindInternal))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -330,17 +362,17 @@ func (s weaveletControl_local_stub) GetProfile(ctx context.Context, a0 *protos.G
 		}()
 	}
 
-	return s.impl.GetProfile(ctx, a0)
+	return s.impl.Drain(ctx, a0)
 }
 
-func (s weaveletControl_local_stub) InitWeavelet(ctx context.Context, a0 *protos.InitWeaveletRequest) (r0 *protos.InitWeaveletReply, err error) {
+func (s weaveletControl_local_stub) FlushTelemetry(ctx context.Context, a0 *protos.FlushTelemetryRequest) (r0 *protos.FlushTelemetryReply, err error) {
 	// Update metrics.
-	begin := s.initWeaveletMetrics.Begin()
-	defer func() { s.initWeaveletMetrics.End(begin, err != nil, 0, 0) }()
+	begin := s.flushTelemetryMetrics.Begin()
+	defer func() { s.flushTelemetryMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.InitWeavelet", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.FlushTelemetry", trace.WithSpanKind(trace.SpanKindInternal))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -350,17 +382,17 @@ func (s weaveletControl_local_stub) InitWeavelet(ctx context.Context, a0 *protos
 		}()
 	}
 
-	return s.impl.InitWeavelet(ctx, a0)
+	return s.impl.FlushTelemetry(ctx, a0)
 }
 
-func (s weaveletControl_local_stub) UpdateComponents(ctx context.Context, a0 *protos.UpdateComponentsRequest) (r0 *protos.UpdateComponentsReply, err error) {
+func (s weaveletControl_local_stub) ForceGC(ctx context.Context, a0 *protos.ForceGCRequest) (r0 *protos.ForceGCReply, err error) {
 	// Update metrics.
-	begin := s.updateComponentsMetrics.Begin()
-	defer func() { s.updateComponentsMetrics.End(begin, err != nil, 0, 0) }()
+	begin := s.forceGCMetrics.Begin()
+	defer func() { s.forceGCMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.UpdateComponents", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.ForceGC", trace.WithSpanKind(trace.SpanKindInternal))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -370,17 +402,17 @@ func (s weaveletControl_local_stub) UpdateComponents(ctx context.Context, a0 *pr
 		}()
 	}
 
-	return s.impl.UpdateComponents(ctx, a0)
+	return s.impl.ForceGC(ctx, a0)
 }
 
-func (s weaveletControl_local_stub) UpdateRoutingInfo(ctx context.Context, a0 *protos.UpdateRoutingInfoRequest) (r0 *protos.UpdateRoutingInfoReply, err error) {
+func (s weaveletControl_local_stub) ForwardSignal(ctx context.Context, a0 *protos.ForwardSignalRequest) (r0 *protos.ForwardSignalReply, err error) {
 	// Update metrics.
-	begin := s.updateRoutingInfoMetrics.Begin()
-	defer func() { s.updateRoutingInfoMetrics.End(begin, err != nil, 0, 0) }()
+	begin := s.forwardSignalMetrics.Begin()
+	defer func() { s.forwardSignalMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.UpdateRoutingInfo", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.ForwardSignal", trace.WithSpanKind(trace.SpanKindInternal))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -390,1357 +422,6365 @@ func (s weaveletControl_local_stub) UpdateRoutingInfo(ctx context.Context, a0 *p
 		}()
 	}
 
-	return s.impl.UpdateRoutingInfo(ctx, a0)
+	return s.impl.ForwardSignal(ctx, a0)
 }
 
-// Client stub implementations.
+func (s weaveletControl_local_stub) GetBuildFlags(ctx context.Context, a0 *protos.GetBuildFlagsRequest) (r0 *protos.GetBuildFlagsReply, err error) {
+	// Update metrics.
+	begin := s.getBuildFlagsMetrics.Begin()
+	defer func() { s.getBuildFlagsMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetBuildFlags", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
 
-type deployerControl_client_stub struct {
-	stub                           codegen.Stub
-	activateComponentMetrics       *codegen.MethodMetrics
-	exportListenerMetrics          *codegen.MethodMetrics
-	getListenerAddressMetrics      *codegen.MethodMetrics
-	getSelfCertificateMetrics      *codegen.MethodMetrics
-	handleTraceSpansMetrics        *codegen.MethodMetrics
-	logBatchMetrics                *codegen.MethodMetrics
-	verifyClientCertificateMetrics *codegen.MethodMetrics
-	verifyServerCertificateMetrics *codegen.MethodMetrics
+	return s.impl.GetBuildFlags(ctx, a0)
 }
 
-// Check that deployerControl_client_stub implements the deployerControl interface.
-var _ deployerControl = (*deployerControl_client_stub)(nil)
-
-func (s deployerControl_client_stub) ActivateComponent(ctx context.Context, a0 *protos.ActivateComponentRequest) (r0 *protos.ActivateComponentReply, err error) {
+func (s weaveletControl_local_stub) GetDependencies(ctx context.Context, a0 *protos.GetDependenciesRequest) (r0 *protos.GetDependenciesReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.activateComponentMetrics.Begin()
-	defer func() { s.activateComponentMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.getDependenciesMetrics.Begin()
+	defer func() { s.getDependenciesMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.ActivateComponent", trace.WithSpanKind(trace.SpanKindClient))
-	}
-
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetDependencies", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
-
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-
-	}()
-
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_ActivateComponentRequest_73adf343(enc, a0)
-	var shardKey uint64
-
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 0, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	r0 = serviceweaver_dec_ptr_ActivateComponentReply_5e57d605(dec)
-	err = dec.Error()
-	return
+	return s.impl.GetDependencies(ctx, a0)
 }
 
-func (s deployerControl_client_stub) ExportListener(ctx context.Context, a0 *protos.ExportListenerRequest) (r0 *protos.ExportListenerReply, err error) {
+func (s weaveletControl_local_stub) GetFDCount(ctx context.Context, a0 *protos.GetFDCountRequest) (r0 *protos.GetFDCountReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.exportListenerMetrics.Begin()
-	defer func() { s.exportListenerMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.getFDCountMetrics.Begin()
+	defer func() { s.getFDCountMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.ExportListener", trace.WithSpanKind(trace.SpanKindClient))
-	}
-
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetFDCount", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
-
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-
-	}()
-
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_ExportListenerRequest_b494514e(enc, a0)
-	var shardKey uint64
-
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 1, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	r0 = serviceweaver_dec_ptr_ExportListenerReply_b0fc34d0(dec)
-	err = dec.Error()
-	return
+	return s.impl.GetFDCount(ctx, a0)
 }
 
-func (s deployerControl_client_stub) GetListenerAddress(ctx context.Context, a0 *protos.GetListenerAddressRequest) (r0 *protos.GetListenerAddressReply, err error) {
+func (s weaveletControl_local_stub) GetFeatureFlags(ctx context.Context, a0 *protos.GetFeatureFlagsRequest) (r0 *protos.GetFeatureFlagsReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.getListenerAddressMetrics.Begin()
-	defer func() { s.getListenerAddressMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.getFeatureFlagsMetrics.Begin()
+	defer func() { s.getFeatureFlagsMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.GetListenerAddress", trace.WithSpanKind(trace.SpanKindClient))
-	}
-
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetFeatureFlags", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
-
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-
-	}()
-
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_GetListenerAddressRequest_5a58feb0(enc, a0)
-	var shardKey uint64
-
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 2, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	r0 = serviceweaver_dec_ptr_GetListenerAddressReply_8bfe2caa(dec)
-	err = dec.Error()
-	return
+	return s.impl.GetFeatureFlags(ctx, a0)
 }
 
-func (s deployerControl_client_stub) GetSelfCertificate(ctx context.Context, a0 *protos.GetSelfCertificateRequest) (r0 *protos.GetSelfCertificateReply, err error) {
+func (s weaveletControl_local_stub) GetHealth(ctx context.Context, a0 *protos.GetHealthRequest) (r0 *protos.GetHealthReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.getSelfCertificateMetrics.Begin()
-	defer func() { s.getSelfCertificateMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.getHealthMetrics.Begin()
+	defer func() { s.getHealthMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.GetSelfCertificate", trace.WithSpanKind(trace.SpanKindClient))
-	}
-
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetHealth", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
-
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-
-	}()
-
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_GetSelfCertificateRequest_0de4e3b4(enc, a0)
-	var shardKey uint64
-
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 3, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	r0 = serviceweaver_dec_ptr_GetSelfCertificateReply_12277ec8(dec)
-	err = dec.Error()
-	return
+	return s.impl.GetHealth(ctx, a0)
 }
 
-func (s deployerControl_client_stub) HandleTraceSpans(ctx context.Context, a0 *protos.TraceSpans) (err error) {
+func (s weaveletControl_local_stub) GetInFlight(ctx context.Context, a0 *protos.GetInFlightRequest) (r0 *protos.GetInFlightReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.handleTraceSpansMetrics.Begin()
-	defer func() { s.handleTraceSpansMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.getInFlightMetrics.Begin()
+	defer func() { s.getInFlightMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.HandleTraceSpans", trace.WithSpanKind(trace.SpanKindClient))
-	}
-
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetInFlight", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
-
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-
-	}()
-
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_TraceSpans_af16efd0(enc, a0)
-	var shardKey uint64
-
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 4, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	err = dec.Error()
-	return
+	return s.impl.GetInFlight(ctx, a0)
 }
 
-func (s deployerControl_client_stub) LogBatch(ctx context.Context, a0 *protos.LogEntryBatch) (err error) {
+func (s weaveletControl_local_stub) GetLoad(ctx context.Context, a0 *protos.GetLoadRequest) (r0 *protos.GetLoadReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.logBatchMetrics.Begin()
-	defer func() { s.logBatchMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.getLoadMetrics.Begin()
+	defer func() { s.getLoadMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.LogBatch", trace.WithSpanKind(trace.SpanKindClient))
-	}
-
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetLoad", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
-
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-
-	}()
-
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_LogEntryBatch_fec9a5d4(enc, a0)
-	var shardKey uint64
-
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 5, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	err = dec.Error()
-	return
+	return s.impl.GetLoad(ctx, a0)
 }
 
-func (s deployerControl_client_stub) VerifyClientCertificate(ctx context.Context, a0 *protos.VerifyClientCertificateRequest) (r0 *protos.VerifyClientCertificateReply, err error) {
+func (s weaveletControl_local_stub) GetLogLevels(ctx context.Context, a0 *protos.GetLogLevelsRequest) (r0 *protos.GetLogLevelsReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.verifyClientCertificateMetrics.Begin()
-	defer func() { s.verifyClientCertificateMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.getLogLevelsMetrics.Begin()
+	defer func() { s.getLogLevelsMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.VerifyClientCertificate", trace.WithSpanKind(trace.SpanKindClient))
-	}
-
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetLogLevels", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
-
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-
-	}()
-
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_VerifyClientCertificateRequest_f8d21781(enc, a0)
-	var shardKey uint64
-
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 6, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	r0 = serviceweaver_dec_ptr_VerifyClientCertificateReply_c76e39ec(dec)
-	err = dec.Error()
-	return
+	return s.impl.GetLogLevels(ctx, a0)
 }
 
-func (s deployerControl_client_stub) VerifyServerCertificate(ctx context.Context, a0 *protos.VerifyServerCertificateRequest) (r0 *protos.VerifyServerCertificateReply, err error) {
+func (s weaveletControl_local_stub) GetMetrics(ctx context.Context, a0 *protos.GetMetricsRequest) (r0 *protos.GetMetricsReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.verifyServerCertificateMetrics.Begin()
-	defer func() { s.verifyServerCertificateMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.getMetricsMetrics.Begin()
+	defer func() { s.getMetricsMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.VerifyServerCertificate", trace.WithSpanKind(trace.SpanKindClient))
-	}
-
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetMetrics", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
-
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-
-	}()
-
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_VerifyServerCertificateRequest_9c56ee67(enc, a0)
-	var shardKey uint64
-
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 7, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	r0 = serviceweaver_dec_ptr_VerifyServerCertificateReply_c0d4bd3b(dec)
-	err = dec.Error()
-	return
-}
-
-type weaveletControl_client_stub struct {
-	stub                     codegen.Stub
-	getHealthMetrics         *codegen.MethodMetrics
-	getLoadMetrics           *codegen.MethodMetrics
-	getMetricsMetrics        *codegen.MethodMetrics
-	getProfileMetrics        *codegen.MethodMetrics
-	initWeaveletMetrics      *codegen.MethodMetrics
-	updateComponentsMetrics  *codegen.MethodMetrics
-	updateRoutingInfoMetrics *codegen.MethodMetrics
+	return s.impl.GetMetrics(ctx, a0)
 }
 
-// Check that weaveletControl_client_stub implements the weaveletControl interface.
-var _ weaveletControl = (*weaveletControl_client_stub)(nil)
-
-func (s weaveletControl_client_stub) GetHealth(ctx context.Context, a0 *protos.GetHealthRequest) (r0 *protos.GetHealthReply, err error) {
+func (s weaveletControl_local_stub) GetProfile(ctx context.Context, a0 *protos.GetProfileRequest) (r0 *protos.GetProfileReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.getHealthMetrics.Begin()
-	defer func() { s.getHealthMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.getProfileMetrics.Begin()
+	defer func() { s.getProfileMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetHealth", trace.WithSpanKind(trace.SpanKindClient))
-	}
-
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetProfile", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
-
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-
-	}()
-
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_GetHealthRequest_fd6083fb(enc, a0)
-	var shardKey uint64
-
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 0, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	r0 = serviceweaver_dec_ptr_GetHealthReply_b2d11423(dec)
-	err = dec.Error()
-	return
+	return s.impl.GetProfile(ctx, a0)
 }
 
-func (s weaveletControl_client_stub) GetLoad(ctx context.Context, a0 *protos.GetLoadRequest) (r0 *protos.GetLoadReply, err error) {
+func (s weaveletControl_local_stub) GetProfileBundle(ctx context.Context, a0 *protos.GetProfileBundleRequest) (r0 *protos.GetProfileBundleReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.getLoadMetrics.Begin()
-	defer func() { s.getLoadMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.getProfileBundleMetrics.Begin()
+	defer func() { s.getProfileBundleMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetLoad", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetProfileBundle", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
 	}
 
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+	return s.impl.GetProfileBundle(ctx, a0)
+}
+
+func (s weaveletControl_local_stub) GetReadiness(ctx context.Context, a0 *protos.GetReadinessRequest) (r0 *protos.GetReadinessReply, err error) {
+	// Update metrics.
+	begin := s.getReadinessMetrics.Begin()
+	defer func() { s.getReadinessMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetReadiness", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
-
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-
-	}()
-
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_GetLoadRequest_d733b2cf(enc, a0)
-	var shardKey uint64
-
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 1, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	r0 = serviceweaver_dec_ptr_GetLoadReply_cf8279ad(dec)
-	err = dec.Error()
-	return
+	return s.impl.GetReadiness(ctx, a0)
 }
 
-func (s weaveletControl_client_stub) GetMetrics(ctx context.Context, a0 *protos.GetMetricsRequest) (r0 *protos.GetMetricsReply, err error) {
+func (s weaveletControl_local_stub) GetRecentErrors(ctx context.Context, a0 *protos.GetRecentErrorsRequest) (r0 *protos.GetRecentErrorsReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.getMetricsMetrics.Begin()
-	defer func() { s.getMetricsMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.getRecentErrorsMetrics.Begin()
+	defer func() { s.getRecentErrorsMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetMetrics", trace.WithSpanKind(trace.SpanKindClient))
-	}
-
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetRecentErrors", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
-
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-
-	}()
-
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_GetMetricsRequest_010b3cd9(enc, a0)
-	var shardKey uint64
-
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 2, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	r0 = serviceweaver_dec_ptr_GetMetricsReply_3c7180e4(dec)
-	err = dec.Error()
-	return
+	return s.impl.GetRecentErrors(ctx, a0)
 }
 
-func (s weaveletControl_client_stub) GetProfile(ctx context.Context, a0 *protos.GetProfileRequest) (r0 *protos.GetProfileReply, err error) {
+func (s weaveletControl_local_stub) GetRoutingInfo(ctx context.Context, a0 *protos.GetRoutingInfoRequest) (r0 *protos.GetRoutingInfoReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.getProfileMetrics.Begin()
-	defer func() { s.getProfileMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.getRoutingInfoMetrics.Begin()
+	defer func() { s.getRoutingInfoMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetProfile", trace.WithSpanKind(trace.SpanKindClient))
-	}
-
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetRoutingInfo", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
-
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-
-	}()
-
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_GetProfileRequest_d1544fcf(enc, a0)
-	var shardKey uint64
-
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 3, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	r0 = serviceweaver_dec_ptr_GetProfileReply_10a79dcc(dec)
-	err = dec.Error()
-	return
+	return s.impl.GetRoutingInfo(ctx, a0)
 }
 
-func (s weaveletControl_client_stub) InitWeavelet(ctx context.Context, a0 *protos.InitWeaveletRequest) (r0 *protos.InitWeaveletReply, err error) {
+func (s weaveletControl_local_stub) GetSnapshot(ctx context.Context, a0 *protos.GetSnapshotRequest) (r0 *protos.GetSnapshotReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.initWeaveletMetrics.Begin()
-	defer func() { s.initWeaveletMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.getSnapshotMetrics.Begin()
+	defer func() { s.getSnapshotMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.InitWeavelet", trace.WithSpanKind(trace.SpanKindClient))
-	}
-
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetSnapshot", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
-
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-
-	}()
-
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_InitWeaveletRequest_d1f5204c(enc, a0)
-	var shardKey uint64
-
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 4, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	r0 = serviceweaver_dec_ptr_InitWeaveletReply_565d8c96(dec)
-	err = dec.Error()
-	return
+	return s.impl.GetSnapshot(ctx, a0)
 }
 
-func (s weaveletControl_client_stub) UpdateComponents(ctx context.Context, a0 *protos.UpdateComponentsRequest) (r0 *protos.UpdateComponentsReply, err error) {
+func (s weaveletControl_local_stub) GetTime(ctx context.Context, a0 *protos.GetTimeRequest) (r0 *protos.GetTimeReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.updateComponentsMetrics.Begin()
-	defer func() { s.updateComponentsMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.getTimeMetrics.Begin()
+	defer func() { s.getTimeMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.UpdateComponents", trace.WithSpanKind(trace.SpanKindClient))
-	}
-
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.GetTime", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
-
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-
-	}()
-
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_UpdateComponentsRequest_d1b56e1f(enc, a0)
-	var shardKey uint64
-
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 5, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	r0 = serviceweaver_dec_ptr_UpdateComponentsReply_93bebb77(dec)
-	err = dec.Error()
-	return
+	return s.impl.GetTime(ctx, a0)
 }
 
-func (s weaveletControl_client_stub) UpdateRoutingInfo(ctx context.Context, a0 *protos.UpdateRoutingInfoRequest) (r0 *protos.UpdateRoutingInfoReply, err error) {
+func (s weaveletControl_local_stub) InitWeavelet(ctx context.Context, a0 *protos.InitWeaveletRequest) (r0 *protos.InitWeaveletReply, err error) {
 	// Update metrics.
-	var requestBytes, replyBytes int
-	begin := s.updateRoutingInfoMetrics.Begin()
-	defer func() { s.updateRoutingInfoMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
-
+	begin := s.initWeaveletMetrics.Begin()
+	defer func() { s.initWeaveletMetrics.End(begin, err != nil, 0, 0) }()
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.UpdateRoutingInfo", trace.WithSpanKind(trace.SpanKindClient))
-	}
-
-	defer func() {
-		// Catch and return any panics detected during encoding/decoding/rpc.
-		if err == nil {
-			err = codegen.CatchPanics(recover())
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.InitWeavelet", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
 			if err != nil {
-				err = errors.Join(RemoteCallError, err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
 			}
-		}
+			span.End()
+		}()
+	}
 
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
+	return s.impl.InitWeavelet(ctx, a0)
+}
 
-	}()
+func (s weaveletControl_local_stub) ListProfiles(ctx context.Context, a0 *protos.ListProfilesRequest) (r0 *protos.ListProfilesReply, err error) {
+	// Update metrics.
+	begin := s.listProfilesMetrics.Begin()
+	defer func() { s.listProfilesMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.ListProfiles", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
 
-	// Encode arguments.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_UpdateRoutingInfoRequest_e752cfad(enc, a0)
-	var shardKey uint64
+	return s.impl.ListProfiles(ctx, a0)
+}
 
-	// Call the remote method.
-	requestBytes = len(enc.Data())
-	var results []byte
-	results, err = s.stub.Run(ctx, 6, enc.Data(), shardKey)
-	replyBytes = len(results)
-	if err != nil {
-		err = errors.Join(RemoteCallError, err)
-		return
+func (s weaveletControl_local_stub) Ping(ctx context.Context, a0 *protos.PingRequest) (r0 *protos.PingReply, err error) {
+	// Update metrics.
+	begin := s.pingMetrics.Begin()
+	defer func() { s.pingMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.Ping", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
 	}
 
-	// Decode the results.
-	dec := codegen.NewDecoder(results)
-	r0 = serviceweaver_dec_ptr_UpdateRoutingInfoReply_d1854fd5(dec)
-	err = dec.Error()
-	return
+	return s.impl.Ping(ctx, a0)
 }
 
-// Note that "weaver generate" will always generate the error message below.
-// Everything is okay. The error message is only relevant if you see it when
-// you run "go build" or "go run".
-var _ codegen.LatestVersion = codegen.Version[[0][24]struct{}](`
-
-ERROR: You generated this file with 'weaver generate' (devel) (codegen
-version v0.24.0). The generated code is incompatible with the version of the
-github.com/ServiceWeaver/weaver module that you're using. The weaver module
-version can be found in your go.mod file or by running the following command.
+func (s weaveletControl_local_stub) QuiesceComponent(ctx context.Context, a0 *protos.QuiesceComponentRequest) (r0 *protos.QuiesceComponentReply, err error) {
+	// Update metrics.
+	begin := s.quiesceComponentMetrics.Begin()
+	defer func() { s.quiesceComponentMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.QuiesceComponent", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
 
-    go list -m github.com/ServiceWeaver/weaver
+	return s.impl.QuiesceComponent(ctx, a0)
+}
 
-We recommend updating the weaver module and the 'weaver generate' command by
-running the following.
+func (s weaveletControl_local_stub) RawRPC(ctx context.Context, a0 *protos.RawRPCRequest) (r0 *protos.RawRPCReply, err error) {
+	// Update metrics.
+	begin := s.rawRPCMetrics.Begin()
+	defer func() { s.rawRPCMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.RawRPC", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
 
-    go get github.com/ServiceWeaver/weaver@latest
-    go install github.com/ServiceWeaver/weaver/cmd/weaver@latest
+	return s.impl.RawRPC(ctx, a0)
+}
 
-Then, re-run 'weaver generate' and re-build your code. If the problem persists,
-please file an issue at https://github.com/ServiceWeaver/weaver/issues.
+func (s weaveletControl_local_stub) ReexportListeners(ctx context.Context, a0 *protos.ReexportListenersRequest) (r0 *protos.ReexportListenersReply, err error) {
+	// Update metrics.
+	begin := s.reexportListenersMetrics.Begin()
+	defer func() { s.reexportListenersMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.ReexportListeners", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
 
-`)
+	return s.impl.ReexportListeners(ctx, a0)
+}
 
-// Server stub implementations.
+func (s weaveletControl_local_stub) ReloadConfig(ctx context.Context, a0 *protos.ReloadConfigRequest) (r0 *protos.ReloadConfigReply, err error) {
+	// Update metrics.
+	begin := s.reloadConfigMetrics.Begin()
+	defer func() { s.reloadConfigMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.ReloadConfig", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
 
-type deployerControl_server_stub struct {
-	impl    deployerControl
-	addLoad func(key uint64, load float64)
+	return s.impl.ReloadConfig(ctx, a0)
 }
 
-// Check that deployerControl_server_stub implements the codegen.Server interface.
-var _ codegen.Server = (*deployerControl_server_stub)(nil)
+func (s weaveletControl_local_stub) Restore(ctx context.Context, a0 *protos.RestoreRequest) (r0 *protos.RestoreReply, err error) {
+	// Update metrics.
+	begin := s.restoreMetrics.Begin()
+	defer func() { s.restoreMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.Restore", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
 
-// GetStubFn implements the codegen.Server interface.
-func (s deployerControl_server_stub) GetStubFn(method string) func(ctx context.Context, args []byte) ([]byte, error) {
-	switch method {
-	case "ActivateComponent":
-		return s.activateComponent
-	case "ExportListener":
-		return s.exportListener
-	case "GetListenerAddress":
-		return s.getListenerAddress
-	case "GetSelfCertificate":
-		return s.getSelfCertificate
-	case "HandleTraceSpans":
-		return s.handleTraceSpans
-	case "LogBatch":
-		return s.logBatch
-	case "VerifyClientCertificate":
-		return s.verifyClientCertificate
-	case "VerifyServerCertificate":
-		return s.verifyServerCertificate
-	default:
-		return nil
+	return s.impl.Restore(ctx, a0)
+}
+
+func (s weaveletControl_local_stub) SetComponentEnabled(ctx context.Context, a0 *protos.SetComponentEnabledRequest) (r0 *protos.SetComponentEnabledReply, err error) {
+	// Update metrics.
+	begin := s.setComponentEnabledMetrics.Begin()
+	defer func() { s.setComponentEnabledMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.SetComponentEnabled", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
 	}
+
+	return s.impl.SetComponentEnabled(ctx, a0)
 }
 
-func (s deployerControl_server_stub) activateComponent(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
-	defer func() {
-		if err == nil {
-			err = codegen.CatchPanics(recover())
-		}
-	}()
+func (s weaveletControl_local_stub) SetComponentLogLevel(ctx context.Context, a0 *protos.SetComponentLogLevelRequest) (r0 *protos.SetComponentLogLevelReply, err error) {
+	// Update metrics.
+	begin := s.setComponentLogLevelMetrics.Begin()
+	defer func() { s.setComponentLogLevelMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.SetComponentLogLevel", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
 
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.ActivateComponentRequest
-	a0 = serviceweaver_dec_ptr_ActivateComponentRequest_73adf343(dec)
+	return s.impl.SetComponentLogLevel(ctx, a0)
+}
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	r0, appErr := s.impl.ActivateComponent(ctx, a0)
+func (s weaveletControl_local_stub) SetFeatureFlag(ctx context.Context, a0 *protos.SetFeatureFlagRequest) (r0 *protos.SetFeatureFlagReply, err error) {
+	// Update metrics.
+	begin := s.setFeatureFlagMetrics.Begin()
+	defer func() { s.setFeatureFlagMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.SetFeatureFlag", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
 
-	// Encode the results.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_ActivateComponentReply_5e57d605(enc, r0)
-	enc.Error(appErr)
-	return enc.Data(), nil
+	return s.impl.SetFeatureFlag(ctx, a0)
 }
 
-func (s deployerControl_server_stub) exportListener(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
-	defer func() {
-		if err == nil {
-			err = codegen.CatchPanics(recover())
-		}
-	}()
+func (s weaveletControl_local_stub) SetGCPercent(ctx context.Context, a0 *protos.SetGCPercentRequest) (r0 *protos.SetGCPercentReply, err error) {
+	// Update metrics.
+	begin := s.setGCPercentMetrics.Begin()
+	defer func() { s.setGCPercentMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.SetGCPercent", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
 
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.ExportListenerRequest
-	a0 = serviceweaver_dec_ptr_ExportListenerRequest_b494514e(dec)
+	return s.impl.SetGCPercent(ctx, a0)
+}
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	r0, appErr := s.impl.ExportListener(ctx, a0)
+func (s weaveletControl_local_stub) SetProfilingRate(ctx context.Context, a0 *protos.SetProfilingRateRequest) (r0 *protos.SetProfilingRateReply, err error) {
+	// Update metrics.
+	begin := s.setProfilingRateMetrics.Begin()
+	defer func() { s.setProfilingRateMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.SetProfilingRate", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
 
-	// Encode the results.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_ExportListenerReply_b0fc34d0(enc, r0)
-	enc.Error(appErr)
-	return enc.Data(), nil
+	return s.impl.SetProfilingRate(ctx, a0)
 }
 
-func (s deployerControl_server_stub) getListenerAddress(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
-	defer func() {
-		if err == nil {
-			err = codegen.CatchPanics(recover())
-		}
-	}()
+func (s weaveletControl_local_stub) UpdateComponents(ctx context.Context, a0 *protos.UpdateComponentsRequest) (r0 *protos.UpdateComponentsReply, err error) {
+	// Update metrics.
+	begin := s.updateComponentsMetrics.Begin()
+	defer func() { s.updateComponentsMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.UpdateComponents", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
 
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.GetListenerAddressRequest
-	a0 = serviceweaver_dec_ptr_GetListenerAddressRequest_5a58feb0(dec)
+	return s.impl.UpdateComponents(ctx, a0)
+}
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	r0, appErr := s.impl.GetListenerAddress(ctx, a0)
+func (s weaveletControl_local_stub) UpdateListenerTLS(ctx context.Context, a0 *protos.UpdateListenerTLSRequest) (r0 *protos.UpdateListenerTLSReply, err error) {
+	// Update metrics.
+	begin := s.updateListenerTLSMetrics.Begin()
+	defer func() { s.updateListenerTLSMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.UpdateListenerTLS", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
 
-	// Encode the results.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_GetListenerAddressReply_8bfe2caa(enc, r0)
-	enc.Error(appErr)
-	return enc.Data(), nil
+	return s.impl.UpdateListenerTLS(ctx, a0)
 }
 
-func (s deployerControl_server_stub) getSelfCertificate(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
-	defer func() {
-		if err == nil {
-			err = codegen.CatchPanics(recover())
-		}
-	}()
+func (s weaveletControl_local_stub) UpdateRoutingInfo(ctx context.Context, a0 *protos.UpdateRoutingInfoRequest) (r0 *protos.UpdateRoutingInfoReply, err error) {
+	// Update metrics.
+	begin := s.updateRoutingInfoMetrics.Begin()
+	defer func() { s.updateRoutingInfoMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "weaver.weaveletControl.UpdateRoutingInfo", trace.WithSpanKind(trace.SpanKindInternal))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
 
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.GetSelfCertificateRequest
-	a0 = serviceweaver_dec_ptr_GetSelfCertificateRequest_0de4e3b4(dec)
+	return s.impl.UpdateRoutingInfo(ctx, a0)
+}
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	r0, appErr := s.impl.GetSelfCertificate(ctx, a0)
+// Client stub implementations.
 
-	// Encode the results.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_GetSelfCertificateReply_12277ec8(enc, r0)
-	enc.Error(appErr)
-	return enc.Data(), nil
+type deployerControl_client_stub struct {
+	stub                           codegen.Stub
+	activateComponentMetrics       *codegen.MethodMetrics
+	exportListenerMetrics          *codegen.MethodMetrics
+	getListenerAddressMetrics      *codegen.MethodMetrics
+	getSelfCertificateMetrics      *codegen.MethodMetrics
+	handleTraceSpansMetrics        *codegen.MethodMetrics
+	logBatchMetrics                *codegen.MethodMetrics
+	verifyClientCertificateMetrics *codegen.MethodMetrics
+	verifyServerCertificateMetrics *codegen.MethodMetrics
 }
 
-func (s deployerControl_server_stub) handleTraceSpans(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
-	defer func() {
-		if err == nil {
-			err = codegen.CatchPanics(recover())
-		}
-	}()
-
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.TraceSpans
-	a0 = serviceweaver_dec_ptr_TraceSpans_af16efd0(dec)
+// Check that deployerControl_client_stub implements the deployerControl interface.
+var _ deployerControl = (*deployerControl_client_stub)(nil)
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	appErr := s.impl.HandleTraceSpans(ctx, a0)
+func (s deployerControl_client_stub) ActivateComponent(ctx context.Context, a0 *protos.ActivateComponentRequest) (r0 *protos.ActivateComponentReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.activateComponentMetrics.Begin()
+	defer func() { s.activateComponentMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
 
-	// Encode the results.
-	enc := codegen.NewEncoder()
-	enc.Error(appErr)
-	return enc.Data(), nil
-}
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.ActivateComponent", trace.WithSpanKind(trace.SpanKindClient))
+	}
 
-func (s deployerControl_server_stub) logBatch(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
 	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
 		if err == nil {
 			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
 		}
-	}()
 
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.LogEntryBatch
-	a0 = serviceweaver_dec_ptr_LogEntryBatch_fec9a5d4(dec)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	appErr := s.impl.LogBatch(ctx, a0)
+	}()
 
-	// Encode the results.
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
-	enc.Error(appErr)
-	return enc.Data(), nil
+	serviceweaver_enc_ptr_ActivateComponentRequest_73adf343(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "ActivateComponent", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 0, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_ActivateComponentReply_5e57d605(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "ActivateComponent", codegen.DecodeResults)
+	return
 }
 
-func (s deployerControl_server_stub) verifyClientCertificate(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
+func (s deployerControl_client_stub) ExportListener(ctx context.Context, a0 *protos.ExportListenerRequest) (r0 *protos.ExportListenerReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.exportListenerMetrics.Begin()
+	defer func() { s.exportListenerMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.ExportListener", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
 	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
 		if err == nil {
 			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
 		}
-	}()
 
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.VerifyClientCertificateRequest
-	a0 = serviceweaver_dec_ptr_VerifyClientCertificateRequest_f8d21781(dec)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	r0, appErr := s.impl.VerifyClientCertificate(ctx, a0)
+	}()
 
-	// Encode the results.
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_VerifyClientCertificateReply_c76e39ec(enc, r0)
-	enc.Error(appErr)
-	return enc.Data(), nil
+	serviceweaver_enc_ptr_ExportListenerRequest_b494514e(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "ExportListener", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 1, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_ExportListenerReply_b0fc34d0(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "ExportListener", codegen.DecodeResults)
+	return
 }
 
-func (s deployerControl_server_stub) verifyServerCertificate(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
+func (s deployerControl_client_stub) GetListenerAddress(ctx context.Context, a0 *protos.GetListenerAddressRequest) (r0 *protos.GetListenerAddressReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getListenerAddressMetrics.Begin()
+	defer func() { s.getListenerAddressMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.GetListenerAddress", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
 	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
 		if err == nil {
 			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
 		}
-	}()
 
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.VerifyServerCertificateRequest
-	a0 = serviceweaver_dec_ptr_VerifyServerCertificateRequest_9c56ee67(dec)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	r0, appErr := s.impl.VerifyServerCertificate(ctx, a0)
+	}()
 
-	// Encode the results.
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_VerifyServerCertificateReply_c0d4bd3b(enc, r0)
-	enc.Error(appErr)
-	return enc.Data(), nil
-}
+	serviceweaver_enc_ptr_GetListenerAddressRequest_5a58feb0(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "GetListenerAddress", codegen.EncodeArgs)
+	var shardKey uint64
 
-type weaveletControl_server_stub struct {
-	impl    weaveletControl
-	addLoad func(key uint64, load float64)
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 2, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetListenerAddressReply_8bfe2caa(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "GetListenerAddress", codegen.DecodeResults)
+	return
 }
 
-// Check that weaveletControl_server_stub implements the codegen.Server interface.
-var _ codegen.Server = (*weaveletControl_server_stub)(nil)
+func (s deployerControl_client_stub) GetSelfCertificate(ctx context.Context, a0 *protos.GetSelfCertificateRequest) (r0 *protos.GetSelfCertificateReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getSelfCertificateMetrics.Begin()
+	defer func() { s.getSelfCertificateMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
 
-// GetStubFn implements the codegen.Server interface.
-func (s weaveletControl_server_stub) GetStubFn(method string) func(ctx context.Context, args []byte) ([]byte, error) {
-	switch method {
-	case "GetHealth":
-		return s.getHealth
-	case "GetLoad":
-		return s.getLoad
-	case "GetMetrics":
-		return s.getMetrics
-	case "GetProfile":
-		return s.getProfile
-	case "InitWeavelet":
-		return s.initWeavelet
-	case "UpdateComponents":
-		return s.updateComponents
-	case "UpdateRoutingInfo":
-		return s.updateRoutingInfo
-	default:
-		return nil
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.GetSelfCertificate", trace.WithSpanKind(trace.SpanKindClient))
 	}
-}
 
-func (s weaveletControl_server_stub) getHealth(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
 	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
 		if err == nil {
 			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
 		}
-	}()
 
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.GetHealthRequest
-	a0 = serviceweaver_dec_ptr_GetHealthRequest_fd6083fb(dec)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	r0, appErr := s.impl.GetHealth(ctx, a0)
+	}()
 
-	// Encode the results.
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_GetHealthReply_b2d11423(enc, r0)
-	enc.Error(appErr)
-	return enc.Data(), nil
+	serviceweaver_enc_ptr_GetSelfCertificateRequest_0de4e3b4(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "GetSelfCertificate", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 3, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetSelfCertificateReply_12277ec8(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "GetSelfCertificate", codegen.DecodeResults)
+	return
 }
 
-func (s weaveletControl_server_stub) getLoad(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
+func (s deployerControl_client_stub) HandleTraceSpans(ctx context.Context, a0 *protos.TraceSpans) (err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.handleTraceSpansMetrics.Begin()
+	defer func() { s.handleTraceSpansMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.HandleTraceSpans", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
 	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
 		if err == nil {
 			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
 		}
-	}()
 
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.GetLoadRequest
-	a0 = serviceweaver_dec_ptr_GetLoadRequest_d733b2cf(dec)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	r0, appErr := s.impl.GetLoad(ctx, a0)
+	}()
 
-	// Encode the results.
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_GetLoadReply_cf8279ad(enc, r0)
-	enc.Error(appErr)
-	return enc.Data(), nil
+	serviceweaver_enc_ptr_TraceSpans_af16efd0(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "HandleTraceSpans", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 4, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "HandleTraceSpans", codegen.DecodeResults)
+	return
 }
 
-func (s weaveletControl_server_stub) getMetrics(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
+func (s deployerControl_client_stub) LogBatch(ctx context.Context, a0 *protos.LogEntryBatch) (err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.logBatchMetrics.Begin()
+	defer func() { s.logBatchMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.LogBatch", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
 	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
 		if err == nil {
 			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
 		}
-	}()
 
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.GetMetricsRequest
-	a0 = serviceweaver_dec_ptr_GetMetricsRequest_010b3cd9(dec)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	r0, appErr := s.impl.GetMetrics(ctx, a0)
+	}()
 
-	// Encode the results.
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_GetMetricsReply_3c7180e4(enc, r0)
-	enc.Error(appErr)
-	return enc.Data(), nil
+	serviceweaver_enc_ptr_LogEntryBatch_fec9a5d4(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "LogBatch", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 5, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "LogBatch", codegen.DecodeResults)
+	return
 }
 
-func (s weaveletControl_server_stub) getProfile(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
+func (s deployerControl_client_stub) VerifyClientCertificate(ctx context.Context, a0 *protos.VerifyClientCertificateRequest) (r0 *protos.VerifyClientCertificateReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.verifyClientCertificateMetrics.Begin()
+	defer func() { s.verifyClientCertificateMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.VerifyClientCertificate", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
 	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
 		if err == nil {
 			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
 		}
+		span.End()
+
 	}()
 
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.GetProfileRequest
-	a0 = serviceweaver_dec_ptr_GetProfileRequest_d1544fcf(dec)
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_VerifyClientCertificateRequest_f8d21781(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "VerifyClientCertificate", codegen.EncodeArgs)
+	var shardKey uint64
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	r0, appErr := s.impl.GetProfile(ctx, a0)
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 6, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
-	// Encode the results.
-	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_GetProfileReply_10a79dcc(enc, r0)
-	enc.Error(appErr)
-	return enc.Data(), nil
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_VerifyClientCertificateReply_c76e39ec(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "VerifyClientCertificate", codegen.DecodeResults)
+	return
 }
 
-func (s weaveletControl_server_stub) initWeavelet(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
+func (s deployerControl_client_stub) VerifyServerCertificate(ctx context.Context, a0 *protos.VerifyServerCertificateRequest) (r0 *protos.VerifyServerCertificateReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.verifyServerCertificateMetrics.Begin()
+	defer func() { s.verifyServerCertificateMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.deployerControl.VerifyServerCertificate", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
 	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
 		if err == nil {
 			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
 		}
-	}()
 
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.InitWeaveletRequest
-	a0 = serviceweaver_dec_ptr_InitWeaveletRequest_d1f5204c(dec)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	r0, appErr := s.impl.InitWeavelet(ctx, a0)
+	}()
 
-	// Encode the results.
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_InitWeaveletReply_565d8c96(enc, r0)
-	enc.Error(appErr)
-	return enc.Data(), nil
+	serviceweaver_enc_ptr_VerifyServerCertificateRequest_9c56ee67(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "VerifyServerCertificate", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 7, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_VerifyServerCertificateReply_c0d4bd3b(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "VerifyServerCertificate", codegen.DecodeResults)
+	return
 }
 
-func (s weaveletControl_server_stub) updateComponents(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
+type weaveletControl_client_stub struct {
+	stub                        codegen.Stub
+	cancelProfileMetrics        *codegen.MethodMetrics
+	checkpointMetrics           *codegen.MethodMetrics
+	clearRecentErrorsMetrics    *codegen.MethodMetrics
+	drainMetrics                *codegen.MethodMetrics
+	flushTelemetryMetrics       *codegen.MethodMetrics
+	forceGCMetrics              *codegen.MethodMetrics
+	forwardSignalMetrics        *codegen.MethodMetrics
+	getBuildFlagsMetrics        *codegen.MethodMetrics
+	getDependenciesMetrics      *codegen.MethodMetrics
+	getFDCountMetrics           *codegen.MethodMetrics
+	getFeatureFlagsMetrics      *codegen.MethodMetrics
+	getHealthMetrics            *codegen.MethodMetrics
+	getInFlightMetrics          *codegen.MethodMetrics
+	getLoadMetrics              *codegen.MethodMetrics
+	getLogLevelsMetrics         *codegen.MethodMetrics
+	getMetricsMetrics           *codegen.MethodMetrics
+	getProfileMetrics           *codegen.MethodMetrics
+	getProfileBundleMetrics     *codegen.MethodMetrics
+	getReadinessMetrics         *codegen.MethodMetrics
+	getRecentErrorsMetrics      *codegen.MethodMetrics
+	getRoutingInfoMetrics       *codegen.MethodMetrics
+	getSnapshotMetrics          *codegen.MethodMetrics
+	getTimeMetrics              *codegen.MethodMetrics
+	initWeaveletMetrics         *codegen.MethodMetrics
+	listProfilesMetrics         *codegen.MethodMetrics
+	pingMetrics                 *codegen.MethodMetrics
+	quiesceComponentMetrics     *codegen.MethodMetrics
+	rawRPCMetrics               *codegen.MethodMetrics
+	reexportListenersMetrics    *codegen.MethodMetrics
+	reloadConfigMetrics         *codegen.MethodMetrics
+	restoreMetrics              *codegen.MethodMetrics
+	setComponentEnabledMetrics  *codegen.MethodMetrics
+	setComponentLogLevelMetrics *codegen.MethodMetrics
+	setFeatureFlagMetrics       *codegen.MethodMetrics
+	setGCPercentMetrics         *codegen.MethodMetrics
+	setProfilingRateMetrics     *codegen.MethodMetrics
+	updateComponentsMetrics     *codegen.MethodMetrics
+	updateListenerTLSMetrics    *codegen.MethodMetrics
+	updateRoutingInfoMetrics    *codegen.MethodMetrics
+}
+
+// Check that weaveletControl_client_stub implements the weaveletControl interface.
+var _ weaveletControl = (*weaveletControl_client_stub)(nil)
+
+func (s weaveletControl_client_stub) CancelProfile(ctx context.Context, a0 *protos.CancelProfileRequest) (r0 *protos.CancelProfileReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.cancelProfileMetrics.Begin()
+	defer func() { s.cancelProfileMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.CancelProfile", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
 	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
 		if err == nil {
 			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
 		}
-	}()
 
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.UpdateComponentsRequest
-	a0 = serviceweaver_dec_ptr_UpdateComponentsRequest_d1b56e1f(dec)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	r0, appErr := s.impl.UpdateComponents(ctx, a0)
+	}()
 
-	// Encode the results.
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_UpdateComponentsReply_93bebb77(enc, r0)
-	enc.Error(appErr)
-	return enc.Data(), nil
+	serviceweaver_enc_ptr_CancelProfileRequest_278188db(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "CancelProfile", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 0, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_CancelProfileReply_fc332014(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "CancelProfile", codegen.DecodeResults)
+	return
 }
 
-func (s weaveletControl_server_stub) updateRoutingInfo(ctx context.Context, args []byte) (res []byte, err error) {
-	// Catch and return any panics detected during encoding/decoding/rpc.
+func (s weaveletControl_client_stub) Checkpoint(ctx context.Context, a0 *protos.CheckpointRequest) (r0 *protos.CheckpointReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.checkpointMetrics.Begin()
+	defer func() { s.checkpointMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.Checkpoint", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
 	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
 		if err == nil {
 			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
 		}
+		span.End()
+
 	}()
 
-	// Decode arguments.
-	dec := codegen.NewDecoder(args)
-	var a0 *protos.UpdateRoutingInfoRequest
-	a0 = serviceweaver_dec_ptr_UpdateRoutingInfoRequest_e752cfad(dec)
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_CheckpointRequest_5f3dea73(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Checkpoint", codegen.EncodeArgs)
+	var shardKey uint64
 
-	// TODO(rgrandl): The deferred function above will recover from panics in the
-	// user code: fix this.
-	// Call the local method.
-	r0, appErr := s.impl.UpdateRoutingInfo(ctx, a0)
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 1, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
-	// Encode the results.
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_CheckpointReply_465054aa(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Checkpoint", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) ClearRecentErrors(ctx context.Context, a0 *protos.ClearRecentErrorsRequest) (r0 *protos.ClearRecentErrorsReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.clearRecentErrorsMetrics.Begin()
+	defer func() { s.clearRecentErrorsMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.ClearRecentErrors", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
-	serviceweaver_enc_ptr_UpdateRoutingInfoReply_d1854fd5(enc, r0)
-	enc.Error(appErr)
-	return enc.Data(), nil
+	serviceweaver_enc_ptr_ClearRecentErrorsRequest_db7b9799(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ClearRecentErrors", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 2, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_ClearRecentErrorsReply_d86fffd8(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ClearRecentErrors", codegen.DecodeResults)
+	return
 }
 
-// Reflect stub implementations.
+func (s weaveletControl_client_stub) Drain(ctx context.Context, a0 *protos.DrainRequest) (r0 *protos.DrainReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.drainMetrics.Begin()
+	defer func() { s.drainMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
 
-type deployerControl_reflect_stub struct {
-	caller func(string, context.Context, []any, []any) error
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.Drain", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_DrainRequest_5a5dbd99(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Drain", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 3, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_DrainReply_dd3a4831(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Drain", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) FlushTelemetry(ctx context.Context, a0 *protos.FlushTelemetryRequest) (r0 *protos.FlushTelemetryReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.flushTelemetryMetrics.Begin()
+	defer func() { s.flushTelemetryMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.FlushTelemetry", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_FlushTelemetryRequest_075c3821(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "FlushTelemetry", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 4, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_FlushTelemetryReply_33be53d7(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "FlushTelemetry", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) ForceGC(ctx context.Context, a0 *protos.ForceGCRequest) (r0 *protos.ForceGCReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.forceGCMetrics.Begin()
+	defer func() { s.forceGCMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.ForceGC", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_ForceGCRequest_6ffdbce8(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ForceGC", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 5, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_ForceGCReply_461c2f32(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ForceGC", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) ForwardSignal(ctx context.Context, a0 *protos.ForwardSignalRequest) (r0 *protos.ForwardSignalReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.forwardSignalMetrics.Begin()
+	defer func() { s.forwardSignalMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.ForwardSignal", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_ForwardSignalRequest_0ac37b27(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ForwardSignal", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 6, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_ForwardSignalReply_d465e846(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ForwardSignal", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetBuildFlags(ctx context.Context, a0 *protos.GetBuildFlagsRequest) (r0 *protos.GetBuildFlagsReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getBuildFlagsMetrics.Begin()
+	defer func() { s.getBuildFlagsMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetBuildFlags", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetBuildFlagsRequest_28abc6bf(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetBuildFlags", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 7, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetBuildFlagsReply_a195f580(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetBuildFlags", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetDependencies(ctx context.Context, a0 *protos.GetDependenciesRequest) (r0 *protos.GetDependenciesReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getDependenciesMetrics.Begin()
+	defer func() { s.getDependenciesMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetDependencies", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetDependenciesRequest_541203a4(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetDependencies", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 8, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetDependenciesReply_f23c496c(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetDependencies", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetFDCount(ctx context.Context, a0 *protos.GetFDCountRequest) (r0 *protos.GetFDCountReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getFDCountMetrics.Begin()
+	defer func() { s.getFDCountMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetFDCount", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetFDCountRequest_3fb412a8(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetFDCount", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 9, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetFDCountReply_ab2f0371(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetFDCount", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetFeatureFlags(ctx context.Context, a0 *protos.GetFeatureFlagsRequest) (r0 *protos.GetFeatureFlagsReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getFeatureFlagsMetrics.Begin()
+	defer func() { s.getFeatureFlagsMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetFeatureFlags", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetFeatureFlagsRequest_af6ec205(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetFeatureFlags", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 10, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetFeatureFlagsReply_555ad1cb(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetFeatureFlags", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetHealth(ctx context.Context, a0 *protos.GetHealthRequest) (r0 *protos.GetHealthReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getHealthMetrics.Begin()
+	defer func() { s.getHealthMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetHealth", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetHealthRequest_fd6083fb(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetHealth", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 11, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetHealthReply_b2d11423(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetHealth", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetInFlight(ctx context.Context, a0 *protos.GetInFlightRequest) (r0 *protos.GetInFlightReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getInFlightMetrics.Begin()
+	defer func() { s.getInFlightMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetInFlight", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetInFlightRequest_6b2a2543(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetInFlight", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 12, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetInFlightReply_3d951940(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetInFlight", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetLoad(ctx context.Context, a0 *protos.GetLoadRequest) (r0 *protos.GetLoadReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getLoadMetrics.Begin()
+	defer func() { s.getLoadMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetLoad", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetLoadRequest_d733b2cf(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetLoad", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 13, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetLoadReply_cf8279ad(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetLoad", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetLogLevels(ctx context.Context, a0 *protos.GetLogLevelsRequest) (r0 *protos.GetLogLevelsReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getLogLevelsMetrics.Begin()
+	defer func() { s.getLogLevelsMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetLogLevels", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetLogLevelsRequest_427560ab(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetLogLevels", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 14, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetLogLevelsReply_573c2877(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetLogLevels", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetMetrics(ctx context.Context, a0 *protos.GetMetricsRequest) (r0 *protos.GetMetricsReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getMetricsMetrics.Begin()
+	defer func() { s.getMetricsMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetMetrics", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetMetricsRequest_010b3cd9(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetMetrics", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 15, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetMetricsReply_3c7180e4(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetMetrics", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetProfile(ctx context.Context, a0 *protos.GetProfileRequest) (r0 *protos.GetProfileReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getProfileMetrics.Begin()
+	defer func() { s.getProfileMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetProfile", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetProfileRequest_d1544fcf(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetProfile", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 16, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetProfileReply_10a79dcc(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetProfile", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetProfileBundle(ctx context.Context, a0 *protos.GetProfileBundleRequest) (r0 *protos.GetProfileBundleReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getProfileBundleMetrics.Begin()
+	defer func() { s.getProfileBundleMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetProfileBundle", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetProfileBundleRequest_77c50c11(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetProfileBundle", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 17, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetProfileBundleReply_8d371819(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetProfileBundle", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetReadiness(ctx context.Context, a0 *protos.GetReadinessRequest) (r0 *protos.GetReadinessReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getReadinessMetrics.Begin()
+	defer func() { s.getReadinessMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetReadiness", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetReadinessRequest_63491c51(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetReadiness", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 18, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetReadinessReply_7a8ec3b3(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetReadiness", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetRecentErrors(ctx context.Context, a0 *protos.GetRecentErrorsRequest) (r0 *protos.GetRecentErrorsReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getRecentErrorsMetrics.Begin()
+	defer func() { s.getRecentErrorsMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetRecentErrors", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetRecentErrorsRequest_34dc3808(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetRecentErrors", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 19, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetRecentErrorsReply_4ef6a017(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetRecentErrors", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetRoutingInfo(ctx context.Context, a0 *protos.GetRoutingInfoRequest) (r0 *protos.GetRoutingInfoReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getRoutingInfoMetrics.Begin()
+	defer func() { s.getRoutingInfoMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetRoutingInfo", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetRoutingInfoRequest_983250ad(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetRoutingInfo", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 20, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetRoutingInfoReply_42cd4ec9(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetRoutingInfo", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetSnapshot(ctx context.Context, a0 *protos.GetSnapshotRequest) (r0 *protos.GetSnapshotReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getSnapshotMetrics.Begin()
+	defer func() { s.getSnapshotMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetSnapshot", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetSnapshotRequest_84c86b88(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetSnapshot", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 21, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetSnapshotReply_0d47f7de(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetSnapshot", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) GetTime(ctx context.Context, a0 *protos.GetTimeRequest) (r0 *protos.GetTimeReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.getTimeMetrics.Begin()
+	defer func() { s.getTimeMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.GetTime", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetTimeRequest_2f3e4516(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetTime", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 22, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_GetTimeReply_cc42dca9(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetTime", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) InitWeavelet(ctx context.Context, a0 *protos.InitWeaveletRequest) (r0 *protos.InitWeaveletReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.initWeaveletMetrics.Begin()
+	defer func() { s.initWeaveletMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.InitWeavelet", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_InitWeaveletRequest_d1f5204c(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "InitWeavelet", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 23, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_InitWeaveletReply_565d8c96(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "InitWeavelet", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) ListProfiles(ctx context.Context, a0 *protos.ListProfilesRequest) (r0 *protos.ListProfilesReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.listProfilesMetrics.Begin()
+	defer func() { s.listProfilesMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.ListProfiles", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_ListProfilesRequest_acde4dcc(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ListProfiles", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 24, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_ListProfilesReply_c18f139e(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ListProfiles", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) Ping(ctx context.Context, a0 *protos.PingRequest) (r0 *protos.PingReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.pingMetrics.Begin()
+	defer func() { s.pingMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.Ping", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_PingRequest_616db268(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Ping", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 25, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_PingReply_c773eaa3(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Ping", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) QuiesceComponent(ctx context.Context, a0 *protos.QuiesceComponentRequest) (r0 *protos.QuiesceComponentReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.quiesceComponentMetrics.Begin()
+	defer func() { s.quiesceComponentMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.QuiesceComponent", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_QuiesceComponentRequest_dd0c3718(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "QuiesceComponent", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 26, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_QuiesceComponentReply_1c4f5aed(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "QuiesceComponent", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) RawRPC(ctx context.Context, a0 *protos.RawRPCRequest) (r0 *protos.RawRPCReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.rawRPCMetrics.Begin()
+	defer func() { s.rawRPCMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.RawRPC", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_RawRPCRequest_970334b1(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "RawRPC", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 27, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_RawRPCReply_8a9c1f7f(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "RawRPC", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) ReexportListeners(ctx context.Context, a0 *protos.ReexportListenersRequest) (r0 *protos.ReexportListenersReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.reexportListenersMetrics.Begin()
+	defer func() { s.reexportListenersMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.ReexportListeners", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_ReexportListenersRequest_abdf2558(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ReexportListeners", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 28, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_ReexportListenersReply_77aa7b1b(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ReexportListeners", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) ReloadConfig(ctx context.Context, a0 *protos.ReloadConfigRequest) (r0 *protos.ReloadConfigReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.reloadConfigMetrics.Begin()
+	defer func() { s.reloadConfigMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.ReloadConfig", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_ReloadConfigRequest_21ad743d(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ReloadConfig", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 29, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_ReloadConfigReply_a517fa10(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ReloadConfig", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) Restore(ctx context.Context, a0 *protos.RestoreRequest) (r0 *protos.RestoreReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.restoreMetrics.Begin()
+	defer func() { s.restoreMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.Restore", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_RestoreRequest_1914cf9e(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Restore", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 30, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_RestoreReply_2a1abcef(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Restore", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) SetComponentEnabled(ctx context.Context, a0 *protos.SetComponentEnabledRequest) (r0 *protos.SetComponentEnabledReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.setComponentEnabledMetrics.Begin()
+	defer func() { s.setComponentEnabledMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.SetComponentEnabled", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_SetComponentEnabledRequest_4bda8fbf(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetComponentEnabled", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 31, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_SetComponentEnabledReply_e8585d5c(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetComponentEnabled", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) SetComponentLogLevel(ctx context.Context, a0 *protos.SetComponentLogLevelRequest) (r0 *protos.SetComponentLogLevelReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.setComponentLogLevelMetrics.Begin()
+	defer func() { s.setComponentLogLevelMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.SetComponentLogLevel", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_SetComponentLogLevelRequest_136baaeb(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetComponentLogLevel", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 32, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_SetComponentLogLevelReply_887ba43e(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetComponentLogLevel", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) SetFeatureFlag(ctx context.Context, a0 *protos.SetFeatureFlagRequest) (r0 *protos.SetFeatureFlagReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.setFeatureFlagMetrics.Begin()
+	defer func() { s.setFeatureFlagMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.SetFeatureFlag", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_SetFeatureFlagRequest_269de439(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetFeatureFlag", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 33, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_SetFeatureFlagReply_d4b60ada(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetFeatureFlag", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) SetGCPercent(ctx context.Context, a0 *protos.SetGCPercentRequest) (r0 *protos.SetGCPercentReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.setGCPercentMetrics.Begin()
+	defer func() { s.setGCPercentMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.SetGCPercent", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_SetGCPercentRequest_778863bd(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetGCPercent", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 34, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_SetGCPercentReply_5813b222(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetGCPercent", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) SetProfilingRate(ctx context.Context, a0 *protos.SetProfilingRateRequest) (r0 *protos.SetProfilingRateReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.setProfilingRateMetrics.Begin()
+	defer func() { s.setProfilingRateMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.SetProfilingRate", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_SetProfilingRateRequest_e056081b(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetProfilingRate", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 35, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_SetProfilingRateReply_663abac9(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetProfilingRate", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) UpdateComponents(ctx context.Context, a0 *protos.UpdateComponentsRequest) (r0 *protos.UpdateComponentsReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.updateComponentsMetrics.Begin()
+	defer func() { s.updateComponentsMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.UpdateComponents", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_UpdateComponentsRequest_d1b56e1f(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "UpdateComponents", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 36, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_UpdateComponentsReply_93bebb77(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "UpdateComponents", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) UpdateListenerTLS(ctx context.Context, a0 *protos.UpdateListenerTLSRequest) (r0 *protos.UpdateListenerTLSReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.updateListenerTLSMetrics.Begin()
+	defer func() { s.updateListenerTLSMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.UpdateListenerTLS", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_UpdateListenerTLSRequest_6b338b3e(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "UpdateListenerTLS", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 37, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_UpdateListenerTLSReply_819f1c4f(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "UpdateListenerTLS", codegen.DecodeResults)
+	return
+}
+
+func (s weaveletControl_client_stub) UpdateRoutingInfo(ctx context.Context, a0 *protos.UpdateRoutingInfoRequest) (r0 *protos.UpdateRoutingInfoReply, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.updateRoutingInfoMetrics.Begin()
+	defer func() { s.updateRoutingInfoMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "weaver.weaveletControl.UpdateRoutingInfo", trace.WithSpanKind(trace.SpanKindClient))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_UpdateRoutingInfoRequest_e752cfad(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "UpdateRoutingInfo", codegen.EncodeArgs)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 38, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(RemoteCallError, err)
+		return
+	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
+
+	// Decode the results.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_UpdateRoutingInfoReply_d1854fd5(dec)
+	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "UpdateRoutingInfo", codegen.DecodeResults)
+	return
+}
+
+// Note that "weaver generate" will always generate the error message below.
+// Everything is okay. The error message is only relevant if you see it when
+// you run "go build" or "go run".
+var _ codegen.LatestVersion = codegen.Version[[0][24]struct{}](`
+
+ERROR: You generated this file with 'weaver generate' (devel) (codegen
+version v0.24.0). The generated code is incompatible with the version of the
+github.com/ServiceWeaver/weaver module that you're using. The weaver module
+version can be found in your go.mod file or by running the following command.
+
+    go list -m github.com/ServiceWeaver/weaver
+
+We recommend updating the weaver module and the 'weaver generate' command by
+running the following.
+
+    go get github.com/ServiceWeaver/weaver@latest
+    go install github.com/ServiceWeaver/weaver/cmd/weaver@latest
+
+Then, re-run 'weaver generate' and re-build your code. If the problem persists,
+please file an issue at https://github.com/ServiceWeaver/weaver/issues.
+
+`)
+
+// Server stub implementations.
+
+type deployerControl_server_stub struct {
+	impl    deployerControl
+	addLoad func(key uint64, load float64)
+}
+
+// Check that deployerControl_server_stub implements the codegen.Server interface.
+var _ codegen.Server = (*deployerControl_server_stub)(nil)
+
+// GetStubFn implements the codegen.Server interface.
+func (s deployerControl_server_stub) GetStubFn(method string) func(ctx context.Context, args []byte) ([]byte, error) {
+	switch method {
+	case "ActivateComponent":
+		return s.activateComponent
+	case "ExportListener":
+		return s.exportListener
+	case "GetListenerAddress":
+		return s.getListenerAddress
+	case "GetSelfCertificate":
+		return s.getSelfCertificate
+	case "HandleTraceSpans":
+		return s.handleTraceSpans
+	case "LogBatch":
+		return s.logBatch
+	case "VerifyClientCertificate":
+		return s.verifyClientCertificate
+	case "VerifyServerCertificate":
+		return s.verifyServerCertificate
+	default:
+		return nil
+	}
+}
+
+func (s deployerControl_server_stub) activateComponent(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.ActivateComponentRequest
+	a0 = serviceweaver_dec_ptr_ActivateComponentRequest_73adf343(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "ActivateComponent", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.ActivateComponent(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_ActivateComponentReply_5e57d605(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "ActivateComponent", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s deployerControl_server_stub) exportListener(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.ExportListenerRequest
+	a0 = serviceweaver_dec_ptr_ExportListenerRequest_b494514e(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "ExportListener", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.ExportListener(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_ExportListenerReply_b0fc34d0(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "ExportListener", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s deployerControl_server_stub) getListenerAddress(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetListenerAddressRequest
+	a0 = serviceweaver_dec_ptr_GetListenerAddressRequest_5a58feb0(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "GetListenerAddress", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetListenerAddress(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetListenerAddressReply_8bfe2caa(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "GetListenerAddress", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s deployerControl_server_stub) getSelfCertificate(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetSelfCertificateRequest
+	a0 = serviceweaver_dec_ptr_GetSelfCertificateRequest_0de4e3b4(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "GetSelfCertificate", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetSelfCertificate(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetSelfCertificateReply_12277ec8(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "GetSelfCertificate", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s deployerControl_server_stub) handleTraceSpans(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.TraceSpans
+	a0 = serviceweaver_dec_ptr_TraceSpans_af16efd0(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "HandleTraceSpans", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	appErr := s.impl.HandleTraceSpans(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "HandleTraceSpans", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s deployerControl_server_stub) logBatch(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.LogEntryBatch
+	a0 = serviceweaver_dec_ptr_LogEntryBatch_fec9a5d4(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "LogBatch", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	appErr := s.impl.LogBatch(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "LogBatch", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s deployerControl_server_stub) verifyClientCertificate(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.VerifyClientCertificateRequest
+	a0 = serviceweaver_dec_ptr_VerifyClientCertificateRequest_f8d21781(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "VerifyClientCertificate", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.VerifyClientCertificate(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_VerifyClientCertificateReply_c76e39ec(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "VerifyClientCertificate", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s deployerControl_server_stub) verifyServerCertificate(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.VerifyServerCertificateRequest
+	a0 = serviceweaver_dec_ptr_VerifyServerCertificateRequest_9c56ee67(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/deployerControl", "VerifyServerCertificate", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.VerifyServerCertificate(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_VerifyServerCertificateReply_c0d4bd3b(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/deployerControl", "VerifyServerCertificate", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+type weaveletControl_server_stub struct {
+	impl    weaveletControl
+	addLoad func(key uint64, load float64)
+}
+
+// Check that weaveletControl_server_stub implements the codegen.Server interface.
+var _ codegen.Server = (*weaveletControl_server_stub)(nil)
+
+// GetStubFn implements the codegen.Server interface.
+func (s weaveletControl_server_stub) GetStubFn(method string) func(ctx context.Context, args []byte) ([]byte, error) {
+	switch method {
+	case "CancelProfile":
+		return s.cancelProfile
+	case "Checkpoint":
+		return s.checkpoint
+	case "ClearRecentErrors":
+		return s.clearRecentErrors
+	case "Drain":
+		return s.drain
+	case "FlushTelemetry":
+		return s.flushTelemetry
+	case "ForceGC":
+		return s.forceGC
+	case "ForwardSignal":
+		return s.forwardSignal
+	case "GetBuildFlags":
+		return s.getBuildFlags
+	case "GetDependencies":
+		return s.getDependencies
+	case "GetFDCount":
+		return s.getFDCount
+	case "GetFeatureFlags":
+		return s.getFeatureFlags
+	case "GetHealth":
+		return s.getHealth
+	case "GetInFlight":
+		return s.getInFlight
+	case "GetLoad":
+		return s.getLoad
+	case "GetLogLevels":
+		return s.getLogLevels
+	case "GetMetrics":
+		return s.getMetrics
+	case "GetProfile":
+		return s.getProfile
+	case "GetProfileBundle":
+		return s.getProfileBundle
+	case "GetReadiness":
+		return s.getReadiness
+	case "GetRecentErrors":
+		return s.getRecentErrors
+	case "GetRoutingInfo":
+		return s.getRoutingInfo
+	case "GetSnapshot":
+		return s.getSnapshot
+	case "GetTime":
+		return s.getTime
+	case "InitWeavelet":
+		return s.initWeavelet
+	case "ListProfiles":
+		return s.listProfiles
+	case "Ping":
+		return s.ping
+	case "QuiesceComponent":
+		return s.quiesceComponent
+	case "RawRPC":
+		return s.rawRPC
+	case "ReexportListeners":
+		return s.reexportListeners
+	case "ReloadConfig":
+		return s.reloadConfig
+	case "Restore":
+		return s.restore
+	case "SetComponentEnabled":
+		return s.setComponentEnabled
+	case "SetComponentLogLevel":
+		return s.setComponentLogLevel
+	case "SetFeatureFlag":
+		return s.setFeatureFlag
+	case "SetGCPercent":
+		return s.setGCPercent
+	case "SetProfilingRate":
+		return s.setProfilingRate
+	case "UpdateComponents":
+		return s.updateComponents
+	case "UpdateListenerTLS":
+		return s.updateListenerTLS
+	case "UpdateRoutingInfo":
+		return s.updateRoutingInfo
+	default:
+		return nil
+	}
+}
+
+func (s weaveletControl_server_stub) cancelProfile(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.CancelProfileRequest
+	a0 = serviceweaver_dec_ptr_CancelProfileRequest_278188db(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "CancelProfile", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.CancelProfile(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_CancelProfileReply_fc332014(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "CancelProfile", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) checkpoint(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.CheckpointRequest
+	a0 = serviceweaver_dec_ptr_CheckpointRequest_5f3dea73(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Checkpoint", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.Checkpoint(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_CheckpointReply_465054aa(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Checkpoint", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) clearRecentErrors(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.ClearRecentErrorsRequest
+	a0 = serviceweaver_dec_ptr_ClearRecentErrorsRequest_db7b9799(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ClearRecentErrors", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.ClearRecentErrors(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_ClearRecentErrorsReply_d86fffd8(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ClearRecentErrors", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) drain(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.DrainRequest
+	a0 = serviceweaver_dec_ptr_DrainRequest_5a5dbd99(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Drain", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.Drain(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_DrainReply_dd3a4831(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Drain", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) flushTelemetry(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.FlushTelemetryRequest
+	a0 = serviceweaver_dec_ptr_FlushTelemetryRequest_075c3821(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "FlushTelemetry", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.FlushTelemetry(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_FlushTelemetryReply_33be53d7(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "FlushTelemetry", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) forceGC(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.ForceGCRequest
+	a0 = serviceweaver_dec_ptr_ForceGCRequest_6ffdbce8(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ForceGC", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.ForceGC(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_ForceGCReply_461c2f32(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ForceGC", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) forwardSignal(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.ForwardSignalRequest
+	a0 = serviceweaver_dec_ptr_ForwardSignalRequest_0ac37b27(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ForwardSignal", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.ForwardSignal(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_ForwardSignalReply_d465e846(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ForwardSignal", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getBuildFlags(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetBuildFlagsRequest
+	a0 = serviceweaver_dec_ptr_GetBuildFlagsRequest_28abc6bf(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetBuildFlags", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetBuildFlags(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetBuildFlagsReply_a195f580(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetBuildFlags", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getDependencies(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetDependenciesRequest
+	a0 = serviceweaver_dec_ptr_GetDependenciesRequest_541203a4(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetDependencies", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetDependencies(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetDependenciesReply_f23c496c(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetDependencies", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getFDCount(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetFDCountRequest
+	a0 = serviceweaver_dec_ptr_GetFDCountRequest_3fb412a8(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetFDCount", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetFDCount(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetFDCountReply_ab2f0371(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetFDCount", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getFeatureFlags(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetFeatureFlagsRequest
+	a0 = serviceweaver_dec_ptr_GetFeatureFlagsRequest_af6ec205(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetFeatureFlags", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetFeatureFlags(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetFeatureFlagsReply_555ad1cb(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetFeatureFlags", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getHealth(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetHealthRequest
+	a0 = serviceweaver_dec_ptr_GetHealthRequest_fd6083fb(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetHealth", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetHealth(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetHealthReply_b2d11423(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetHealth", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getInFlight(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetInFlightRequest
+	a0 = serviceweaver_dec_ptr_GetInFlightRequest_6b2a2543(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetInFlight", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetInFlight(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetInFlightReply_3d951940(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetInFlight", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getLoad(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetLoadRequest
+	a0 = serviceweaver_dec_ptr_GetLoadRequest_d733b2cf(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetLoad", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetLoad(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetLoadReply_cf8279ad(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetLoad", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getLogLevels(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetLogLevelsRequest
+	a0 = serviceweaver_dec_ptr_GetLogLevelsRequest_427560ab(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetLogLevels", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetLogLevels(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetLogLevelsReply_573c2877(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetLogLevels", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getMetrics(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetMetricsRequest
+	a0 = serviceweaver_dec_ptr_GetMetricsRequest_010b3cd9(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetMetrics", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetMetrics(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetMetricsReply_3c7180e4(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetMetrics", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getProfile(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetProfileRequest
+	a0 = serviceweaver_dec_ptr_GetProfileRequest_d1544fcf(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetProfile", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetProfile(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetProfileReply_10a79dcc(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetProfile", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getProfileBundle(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetProfileBundleRequest
+	a0 = serviceweaver_dec_ptr_GetProfileBundleRequest_77c50c11(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetProfileBundle", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetProfileBundle(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetProfileBundleReply_8d371819(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetProfileBundle", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getReadiness(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetReadinessRequest
+	a0 = serviceweaver_dec_ptr_GetReadinessRequest_63491c51(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetReadiness", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetReadiness(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetReadinessReply_7a8ec3b3(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetReadiness", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getRecentErrors(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetRecentErrorsRequest
+	a0 = serviceweaver_dec_ptr_GetRecentErrorsRequest_34dc3808(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetRecentErrors", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetRecentErrors(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetRecentErrorsReply_4ef6a017(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetRecentErrors", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getRoutingInfo(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetRoutingInfoRequest
+	a0 = serviceweaver_dec_ptr_GetRoutingInfoRequest_983250ad(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetRoutingInfo", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetRoutingInfo(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetRoutingInfoReply_42cd4ec9(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetRoutingInfo", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getSnapshot(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetSnapshotRequest
+	a0 = serviceweaver_dec_ptr_GetSnapshotRequest_84c86b88(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetSnapshot", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetSnapshot(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetSnapshotReply_0d47f7de(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetSnapshot", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) getTime(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.GetTimeRequest
+	a0 = serviceweaver_dec_ptr_GetTimeRequest_2f3e4516(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetTime", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.GetTime(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_GetTimeReply_cc42dca9(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "GetTime", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) initWeavelet(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.InitWeaveletRequest
+	a0 = serviceweaver_dec_ptr_InitWeaveletRequest_d1f5204c(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "InitWeavelet", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.InitWeavelet(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_InitWeaveletReply_565d8c96(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "InitWeavelet", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) listProfiles(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.ListProfilesRequest
+	a0 = serviceweaver_dec_ptr_ListProfilesRequest_acde4dcc(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ListProfiles", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.ListProfiles(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_ListProfilesReply_c18f139e(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ListProfiles", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) ping(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.PingRequest
+	a0 = serviceweaver_dec_ptr_PingRequest_616db268(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Ping", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.Ping(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_PingReply_c773eaa3(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Ping", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) quiesceComponent(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.QuiesceComponentRequest
+	a0 = serviceweaver_dec_ptr_QuiesceComponentRequest_dd0c3718(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "QuiesceComponent", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.QuiesceComponent(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_QuiesceComponentReply_1c4f5aed(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "QuiesceComponent", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) rawRPC(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.RawRPCRequest
+	a0 = serviceweaver_dec_ptr_RawRPCRequest_970334b1(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "RawRPC", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.RawRPC(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_RawRPCReply_8a9c1f7f(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "RawRPC", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) reexportListeners(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.ReexportListenersRequest
+	a0 = serviceweaver_dec_ptr_ReexportListenersRequest_abdf2558(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ReexportListeners", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.ReexportListeners(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_ReexportListenersReply_77aa7b1b(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ReexportListeners", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) reloadConfig(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.ReloadConfigRequest
+	a0 = serviceweaver_dec_ptr_ReloadConfigRequest_21ad743d(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ReloadConfig", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.ReloadConfig(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_ReloadConfigReply_a517fa10(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "ReloadConfig", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) restore(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.RestoreRequest
+	a0 = serviceweaver_dec_ptr_RestoreRequest_1914cf9e(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Restore", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.Restore(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_RestoreReply_2a1abcef(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "Restore", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) setComponentEnabled(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.SetComponentEnabledRequest
+	a0 = serviceweaver_dec_ptr_SetComponentEnabledRequest_4bda8fbf(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetComponentEnabled", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.SetComponentEnabled(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_SetComponentEnabledReply_e8585d5c(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetComponentEnabled", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) setComponentLogLevel(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.SetComponentLogLevelRequest
+	a0 = serviceweaver_dec_ptr_SetComponentLogLevelRequest_136baaeb(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetComponentLogLevel", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.SetComponentLogLevel(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_SetComponentLogLevelReply_887ba43e(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetComponentLogLevel", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) setFeatureFlag(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.SetFeatureFlagRequest
+	a0 = serviceweaver_dec_ptr_SetFeatureFlagRequest_269de439(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetFeatureFlag", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.SetFeatureFlag(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_SetFeatureFlagReply_d4b60ada(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetFeatureFlag", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) setGCPercent(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.SetGCPercentRequest
+	a0 = serviceweaver_dec_ptr_SetGCPercentRequest_778863bd(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetGCPercent", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.SetGCPercent(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_SetGCPercentReply_5813b222(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetGCPercent", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) setProfilingRate(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.SetProfilingRateRequest
+	a0 = serviceweaver_dec_ptr_SetProfilingRateRequest_e056081b(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetProfilingRate", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.SetProfilingRate(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_SetProfilingRateReply_663abac9(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "SetProfilingRate", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) updateComponents(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.UpdateComponentsRequest
+	a0 = serviceweaver_dec_ptr_UpdateComponentsRequest_d1b56e1f(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "UpdateComponents", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.UpdateComponents(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_UpdateComponentsReply_93bebb77(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "UpdateComponents", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) updateListenerTLS(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.UpdateListenerTLSRequest
+	a0 = serviceweaver_dec_ptr_UpdateListenerTLSRequest_6b338b3e(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "UpdateListenerTLS", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.UpdateListenerTLS(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_UpdateListenerTLSReply_819f1c4f(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "UpdateListenerTLS", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+func (s weaveletControl_server_stub) updateRoutingInfo(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	decStart := codegen.BeginSerialization()
+	dec := codegen.NewDecoder(args)
+	var a0 *protos.UpdateRoutingInfoRequest
+	a0 = serviceweaver_dec_ptr_UpdateRoutingInfoRequest_e752cfad(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weaveletControl", "UpdateRoutingInfo", codegen.DecodeArgs)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.UpdateRoutingInfo(ctx, a0)
+
+	// Encode the results.
+	encStart := codegen.BeginSerialization()
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_UpdateRoutingInfoReply_d1854fd5(enc, r0)
+	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weaveletControl", "UpdateRoutingInfo", codegen.EncodeResults)
+	return enc.Data(), nil
+}
+
+// Reflect stub implementations.
+
+type deployerControl_reflect_stub struct {
+	caller func(string, context.Context, []any, []any) error
+}
+
+// Check that deployerControl_reflect_stub implements the deployerControl interface.
+var _ deployerControl = (*deployerControl_reflect_stub)(nil)
+
+func (s deployerControl_reflect_stub) ActivateComponent(ctx context.Context, a0 *protos.ActivateComponentRequest) (r0 *protos.ActivateComponentReply, err error) {
+	err = s.caller("ActivateComponent", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s deployerControl_reflect_stub) ExportListener(ctx context.Context, a0 *protos.ExportListenerRequest) (r0 *protos.ExportListenerReply, err error) {
+	err = s.caller("ExportListener", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s deployerControl_reflect_stub) GetListenerAddress(ctx context.Context, a0 *protos.GetListenerAddressRequest) (r0 *protos.GetListenerAddressReply, err error) {
+	err = s.caller("GetListenerAddress", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s deployerControl_reflect_stub) GetSelfCertificate(ctx context.Context, a0 *protos.GetSelfCertificateRequest) (r0 *protos.GetSelfCertificateReply, err error) {
+	err = s.caller("GetSelfCertificate", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s deployerControl_reflect_stub) HandleTraceSpans(ctx context.Context, a0 *protos.TraceSpans) (err error) {
+	err = s.caller("HandleTraceSpans", ctx, []any{a0}, []any{})
+	return
+}
+
+func (s deployerControl_reflect_stub) LogBatch(ctx context.Context, a0 *protos.LogEntryBatch) (err error) {
+	err = s.caller("LogBatch", ctx, []any{a0}, []any{})
+	return
+}
+
+func (s deployerControl_reflect_stub) VerifyClientCertificate(ctx context.Context, a0 *protos.VerifyClientCertificateRequest) (r0 *protos.VerifyClientCertificateReply, err error) {
+	err = s.caller("VerifyClientCertificate", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s deployerControl_reflect_stub) VerifyServerCertificate(ctx context.Context, a0 *protos.VerifyServerCertificateRequest) (r0 *protos.VerifyServerCertificateReply, err error) {
+	err = s.caller("VerifyServerCertificate", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+type weaveletControl_reflect_stub struct {
+	caller func(string, context.Context, []any, []any) error
+}
+
+// Check that weaveletControl_reflect_stub implements the weaveletControl interface.
+var _ weaveletControl = (*weaveletControl_reflect_stub)(nil)
+
+func (s weaveletControl_reflect_stub) CancelProfile(ctx context.Context, a0 *protos.CancelProfileRequest) (r0 *protos.CancelProfileReply, err error) {
+	err = s.caller("CancelProfile", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) Checkpoint(ctx context.Context, a0 *protos.CheckpointRequest) (r0 *protos.CheckpointReply, err error) {
+	err = s.caller("Checkpoint", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) ClearRecentErrors(ctx context.Context, a0 *protos.ClearRecentErrorsRequest) (r0 *protos.ClearRecentErrorsReply, err error) {
+	err = s.caller("ClearRecentErrors", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) Drain(ctx context.Context, a0 *protos.DrainRequest) (r0 *protos.DrainReply, err error) {
+	err = s.caller("Drain", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) FlushTelemetry(ctx context.Context, a0 *protos.FlushTelemetryRequest) (r0 *protos.FlushTelemetryReply, err error) {
+	err = s.caller("FlushTelemetry", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) ForceGC(ctx context.Context, a0 *protos.ForceGCRequest) (r0 *protos.ForceGCReply, err error) {
+	err = s.caller("ForceGC", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) ForwardSignal(ctx context.Context, a0 *protos.ForwardSignalRequest) (r0 *protos.ForwardSignalReply, err error) {
+	err = s.caller("ForwardSignal", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetBuildFlags(ctx context.Context, a0 *protos.GetBuildFlagsRequest) (r0 *protos.GetBuildFlagsReply, err error) {
+	err = s.caller("GetBuildFlags", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetDependencies(ctx context.Context, a0 *protos.GetDependenciesRequest) (r0 *protos.GetDependenciesReply, err error) {
+	err = s.caller("GetDependencies", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetFDCount(ctx context.Context, a0 *protos.GetFDCountRequest) (r0 *protos.GetFDCountReply, err error) {
+	err = s.caller("GetFDCount", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetFeatureFlags(ctx context.Context, a0 *protos.GetFeatureFlagsRequest) (r0 *protos.GetFeatureFlagsReply, err error) {
+	err = s.caller("GetFeatureFlags", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetHealth(ctx context.Context, a0 *protos.GetHealthRequest) (r0 *protos.GetHealthReply, err error) {
+	err = s.caller("GetHealth", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetInFlight(ctx context.Context, a0 *protos.GetInFlightRequest) (r0 *protos.GetInFlightReply, err error) {
+	err = s.caller("GetInFlight", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetLoad(ctx context.Context, a0 *protos.GetLoadRequest) (r0 *protos.GetLoadReply, err error) {
+	err = s.caller("GetLoad", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetLogLevels(ctx context.Context, a0 *protos.GetLogLevelsRequest) (r0 *protos.GetLogLevelsReply, err error) {
+	err = s.caller("GetLogLevels", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetMetrics(ctx context.Context, a0 *protos.GetMetricsRequest) (r0 *protos.GetMetricsReply, err error) {
+	err = s.caller("GetMetrics", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetProfile(ctx context.Context, a0 *protos.GetProfileRequest) (r0 *protos.GetProfileReply, err error) {
+	err = s.caller("GetProfile", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetProfileBundle(ctx context.Context, a0 *protos.GetProfileBundleRequest) (r0 *protos.GetProfileBundleReply, err error) {
+	err = s.caller("GetProfileBundle", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetReadiness(ctx context.Context, a0 *protos.GetReadinessRequest) (r0 *protos.GetReadinessReply, err error) {
+	err = s.caller("GetReadiness", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetRecentErrors(ctx context.Context, a0 *protos.GetRecentErrorsRequest) (r0 *protos.GetRecentErrorsReply, err error) {
+	err = s.caller("GetRecentErrors", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetRoutingInfo(ctx context.Context, a0 *protos.GetRoutingInfoRequest) (r0 *protos.GetRoutingInfoReply, err error) {
+	err = s.caller("GetRoutingInfo", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetSnapshot(ctx context.Context, a0 *protos.GetSnapshotRequest) (r0 *protos.GetSnapshotReply, err error) {
+	err = s.caller("GetSnapshot", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) GetTime(ctx context.Context, a0 *protos.GetTimeRequest) (r0 *protos.GetTimeReply, err error) {
+	err = s.caller("GetTime", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) InitWeavelet(ctx context.Context, a0 *protos.InitWeaveletRequest) (r0 *protos.InitWeaveletReply, err error) {
+	err = s.caller("InitWeavelet", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) ListProfiles(ctx context.Context, a0 *protos.ListProfilesRequest) (r0 *protos.ListProfilesReply, err error) {
+	err = s.caller("ListProfiles", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) Ping(ctx context.Context, a0 *protos.PingRequest) (r0 *protos.PingReply, err error) {
+	err = s.caller("Ping", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) QuiesceComponent(ctx context.Context, a0 *protos.QuiesceComponentRequest) (r0 *protos.QuiesceComponentReply, err error) {
+	err = s.caller("QuiesceComponent", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) RawRPC(ctx context.Context, a0 *protos.RawRPCRequest) (r0 *protos.RawRPCReply, err error) {
+	err = s.caller("RawRPC", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) ReexportListeners(ctx context.Context, a0 *protos.ReexportListenersRequest) (r0 *protos.ReexportListenersReply, err error) {
+	err = s.caller("ReexportListeners", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) ReloadConfig(ctx context.Context, a0 *protos.ReloadConfigRequest) (r0 *protos.ReloadConfigReply, err error) {
+	err = s.caller("ReloadConfig", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) Restore(ctx context.Context, a0 *protos.RestoreRequest) (r0 *protos.RestoreReply, err error) {
+	err = s.caller("Restore", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) SetComponentEnabled(ctx context.Context, a0 *protos.SetComponentEnabledRequest) (r0 *protos.SetComponentEnabledReply, err error) {
+	err = s.caller("SetComponentEnabled", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) SetComponentLogLevel(ctx context.Context, a0 *protos.SetComponentLogLevelRequest) (r0 *protos.SetComponentLogLevelReply, err error) {
+	err = s.caller("SetComponentLogLevel", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) SetFeatureFlag(ctx context.Context, a0 *protos.SetFeatureFlagRequest) (r0 *protos.SetFeatureFlagReply, err error) {
+	err = s.caller("SetFeatureFlag", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) SetGCPercent(ctx context.Context, a0 *protos.SetGCPercentRequest) (r0 *protos.SetGCPercentReply, err error) {
+	err = s.caller("SetGCPercent", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) SetProfilingRate(ctx context.Context, a0 *protos.SetProfilingRateRequest) (r0 *protos.SetProfilingRateReply, err error) {
+	err = s.caller("SetProfilingRate", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) UpdateComponents(ctx context.Context, a0 *protos.UpdateComponentsRequest) (r0 *protos.UpdateComponentsReply, err error) {
+	err = s.caller("UpdateComponents", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) UpdateListenerTLS(ctx context.Context, a0 *protos.UpdateListenerTLSRequest) (r0 *protos.UpdateListenerTLSReply, err error) {
+	err = s.caller("UpdateListenerTLS", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s weaveletControl_reflect_stub) UpdateRoutingInfo(ctx context.Context, a0 *protos.UpdateRoutingInfoRequest) (r0 *protos.UpdateRoutingInfoReply, err error) {
+	err = s.caller("UpdateRoutingInfo", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+// Encoding/decoding implementations.
+
+func serviceweaver_enc_ptr_ActivateComponentRequest_73adf343(enc *codegen.Encoder, arg *protos.ActivateComponentRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_ActivateComponentRequest_73adf343(dec *codegen.Decoder) *protos.ActivateComponentRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.ActivateComponentRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_ActivateComponentReply_5e57d605(enc *codegen.Encoder, arg *protos.ActivateComponentReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_ActivateComponentReply_5e57d605(dec *codegen.Decoder) *protos.ActivateComponentReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.ActivateComponentReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_ExportListenerRequest_b494514e(enc *codegen.Encoder, arg *protos.ExportListenerRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_ExportListenerRequest_b494514e(dec *codegen.Decoder) *protos.ExportListenerRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.ExportListenerRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_ExportListenerReply_b0fc34d0(enc *codegen.Encoder, arg *protos.ExportListenerReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_ExportListenerReply_b0fc34d0(dec *codegen.Decoder) *protos.ExportListenerReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.ExportListenerReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetListenerAddressRequest_5a58feb0(enc *codegen.Encoder, arg *protos.GetListenerAddressRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetListenerAddressRequest_5a58feb0(dec *codegen.Decoder) *protos.GetListenerAddressRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetListenerAddressRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetListenerAddressReply_8bfe2caa(enc *codegen.Encoder, arg *protos.GetListenerAddressReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetListenerAddressReply_8bfe2caa(dec *codegen.Decoder) *protos.GetListenerAddressReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetListenerAddressReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetSelfCertificateRequest_0de4e3b4(enc *codegen.Encoder, arg *protos.GetSelfCertificateRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetSelfCertificateRequest_0de4e3b4(dec *codegen.Decoder) *protos.GetSelfCertificateRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetSelfCertificateRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetSelfCertificateReply_12277ec8(enc *codegen.Encoder, arg *protos.GetSelfCertificateReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetSelfCertificateReply_12277ec8(dec *codegen.Decoder) *protos.GetSelfCertificateReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetSelfCertificateReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_TraceSpans_af16efd0(enc *codegen.Encoder, arg *protos.TraceSpans) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_TraceSpans_af16efd0(dec *codegen.Decoder) *protos.TraceSpans {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.TraceSpans
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_LogEntryBatch_fec9a5d4(enc *codegen.Encoder, arg *protos.LogEntryBatch) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_LogEntryBatch_fec9a5d4(dec *codegen.Decoder) *protos.LogEntryBatch {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.LogEntryBatch
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_VerifyClientCertificateRequest_f8d21781(enc *codegen.Encoder, arg *protos.VerifyClientCertificateRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_VerifyClientCertificateRequest_f8d21781(dec *codegen.Decoder) *protos.VerifyClientCertificateRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.VerifyClientCertificateRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_VerifyClientCertificateReply_c76e39ec(enc *codegen.Encoder, arg *protos.VerifyClientCertificateReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_VerifyClientCertificateReply_c76e39ec(dec *codegen.Decoder) *protos.VerifyClientCertificateReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.VerifyClientCertificateReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_VerifyServerCertificateRequest_9c56ee67(enc *codegen.Encoder, arg *protos.VerifyServerCertificateRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_VerifyServerCertificateRequest_9c56ee67(dec *codegen.Decoder) *protos.VerifyServerCertificateRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.VerifyServerCertificateRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_VerifyServerCertificateReply_c0d4bd3b(enc *codegen.Encoder, arg *protos.VerifyServerCertificateReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_VerifyServerCertificateReply_c0d4bd3b(dec *codegen.Decoder) *protos.VerifyServerCertificateReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.VerifyServerCertificateReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_CancelProfileRequest_278188db(enc *codegen.Encoder, arg *protos.CancelProfileRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_CancelProfileRequest_278188db(dec *codegen.Decoder) *protos.CancelProfileRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.CancelProfileRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_CancelProfileReply_fc332014(enc *codegen.Encoder, arg *protos.CancelProfileReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_CancelProfileReply_fc332014(dec *codegen.Decoder) *protos.CancelProfileReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.CancelProfileReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_CheckpointRequest_5f3dea73(enc *codegen.Encoder, arg *protos.CheckpointRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_CheckpointRequest_5f3dea73(dec *codegen.Decoder) *protos.CheckpointRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.CheckpointRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_CheckpointReply_465054aa(enc *codegen.Encoder, arg *protos.CheckpointReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_CheckpointReply_465054aa(dec *codegen.Decoder) *protos.CheckpointReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.CheckpointReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_ClearRecentErrorsRequest_db7b9799(enc *codegen.Encoder, arg *protos.ClearRecentErrorsRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_ClearRecentErrorsRequest_db7b9799(dec *codegen.Decoder) *protos.ClearRecentErrorsRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.ClearRecentErrorsRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_ClearRecentErrorsReply_d86fffd8(enc *codegen.Encoder, arg *protos.ClearRecentErrorsReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_ClearRecentErrorsReply_d86fffd8(dec *codegen.Decoder) *protos.ClearRecentErrorsReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.ClearRecentErrorsReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_DrainRequest_5a5dbd99(enc *codegen.Encoder, arg *protos.DrainRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_DrainRequest_5a5dbd99(dec *codegen.Decoder) *protos.DrainRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.DrainRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_DrainReply_dd3a4831(enc *codegen.Encoder, arg *protos.DrainReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_DrainReply_dd3a4831(dec *codegen.Decoder) *protos.DrainReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.DrainReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_FlushTelemetryRequest_075c3821(enc *codegen.Encoder, arg *protos.FlushTelemetryRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_FlushTelemetryRequest_075c3821(dec *codegen.Decoder) *protos.FlushTelemetryRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.FlushTelemetryRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_FlushTelemetryReply_33be53d7(enc *codegen.Encoder, arg *protos.FlushTelemetryReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_FlushTelemetryReply_33be53d7(dec *codegen.Decoder) *protos.FlushTelemetryReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.FlushTelemetryReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_ForceGCRequest_6ffdbce8(enc *codegen.Encoder, arg *protos.ForceGCRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_ForceGCRequest_6ffdbce8(dec *codegen.Decoder) *protos.ForceGCRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.ForceGCRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_ForceGCReply_461c2f32(enc *codegen.Encoder, arg *protos.ForceGCReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_ForceGCReply_461c2f32(dec *codegen.Decoder) *protos.ForceGCReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.ForceGCReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_ForwardSignalRequest_0ac37b27(enc *codegen.Encoder, arg *protos.ForwardSignalRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_ForwardSignalRequest_0ac37b27(dec *codegen.Decoder) *protos.ForwardSignalRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.ForwardSignalRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_ForwardSignalReply_d465e846(enc *codegen.Encoder, arg *protos.ForwardSignalReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_ForwardSignalReply_d465e846(dec *codegen.Decoder) *protos.ForwardSignalReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.ForwardSignalReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetBuildFlagsRequest_28abc6bf(enc *codegen.Encoder, arg *protos.GetBuildFlagsRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetBuildFlagsRequest_28abc6bf(dec *codegen.Decoder) *protos.GetBuildFlagsRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetBuildFlagsRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetBuildFlagsReply_a195f580(enc *codegen.Encoder, arg *protos.GetBuildFlagsReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetBuildFlagsReply_a195f580(dec *codegen.Decoder) *protos.GetBuildFlagsReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetBuildFlagsReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetDependenciesRequest_541203a4(enc *codegen.Encoder, arg *protos.GetDependenciesRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetDependenciesRequest_541203a4(dec *codegen.Decoder) *protos.GetDependenciesRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetDependenciesRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetDependenciesReply_f23c496c(enc *codegen.Encoder, arg *protos.GetDependenciesReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetDependenciesReply_f23c496c(dec *codegen.Decoder) *protos.GetDependenciesReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetDependenciesReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetFDCountRequest_3fb412a8(enc *codegen.Encoder, arg *protos.GetFDCountRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetFDCountRequest_3fb412a8(dec *codegen.Decoder) *protos.GetFDCountRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetFDCountRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetFDCountReply_ab2f0371(enc *codegen.Encoder, arg *protos.GetFDCountReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetFDCountReply_ab2f0371(dec *codegen.Decoder) *protos.GetFDCountReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetFDCountReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetFeatureFlagsRequest_af6ec205(enc *codegen.Encoder, arg *protos.GetFeatureFlagsRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetFeatureFlagsRequest_af6ec205(dec *codegen.Decoder) *protos.GetFeatureFlagsRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetFeatureFlagsRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetFeatureFlagsReply_555ad1cb(enc *codegen.Encoder, arg *protos.GetFeatureFlagsReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetFeatureFlagsReply_555ad1cb(dec *codegen.Decoder) *protos.GetFeatureFlagsReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetFeatureFlagsReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetHealthRequest_fd6083fb(enc *codegen.Encoder, arg *protos.GetHealthRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetHealthRequest_fd6083fb(dec *codegen.Decoder) *protos.GetHealthRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetHealthRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetHealthReply_b2d11423(enc *codegen.Encoder, arg *protos.GetHealthReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetHealthReply_b2d11423(dec *codegen.Decoder) *protos.GetHealthReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetHealthReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetInFlightRequest_6b2a2543(enc *codegen.Encoder, arg *protos.GetInFlightRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetInFlightRequest_6b2a2543(dec *codegen.Decoder) *protos.GetInFlightRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetInFlightRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetInFlightReply_3d951940(enc *codegen.Encoder, arg *protos.GetInFlightReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetInFlightReply_3d951940(dec *codegen.Decoder) *protos.GetInFlightReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetInFlightReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetLoadRequest_d733b2cf(enc *codegen.Encoder, arg *protos.GetLoadRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetLoadRequest_d733b2cf(dec *codegen.Decoder) *protos.GetLoadRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetLoadRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetLoadReply_cf8279ad(enc *codegen.Encoder, arg *protos.GetLoadReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetLoadReply_cf8279ad(dec *codegen.Decoder) *protos.GetLoadReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetLoadReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetLogLevelsRequest_427560ab(enc *codegen.Encoder, arg *protos.GetLogLevelsRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetLogLevelsRequest_427560ab(dec *codegen.Decoder) *protos.GetLogLevelsRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetLogLevelsRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetLogLevelsReply_573c2877(enc *codegen.Encoder, arg *protos.GetLogLevelsReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetLogLevelsReply_573c2877(dec *codegen.Decoder) *protos.GetLogLevelsReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetLogLevelsReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetMetricsRequest_010b3cd9(enc *codegen.Encoder, arg *protos.GetMetricsRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetMetricsRequest_010b3cd9(dec *codegen.Decoder) *protos.GetMetricsRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetMetricsRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetMetricsReply_3c7180e4(enc *codegen.Encoder, arg *protos.GetMetricsReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetMetricsReply_3c7180e4(dec *codegen.Decoder) *protos.GetMetricsReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetMetricsReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetProfileRequest_d1544fcf(enc *codegen.Encoder, arg *protos.GetProfileRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetProfileRequest_d1544fcf(dec *codegen.Decoder) *protos.GetProfileRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetProfileRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetProfileReply_10a79dcc(enc *codegen.Encoder, arg *protos.GetProfileReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetProfileReply_10a79dcc(dec *codegen.Decoder) *protos.GetProfileReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetProfileReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetProfileBundleRequest_77c50c11(enc *codegen.Encoder, arg *protos.GetProfileBundleRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetProfileBundleRequest_77c50c11(dec *codegen.Decoder) *protos.GetProfileBundleRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetProfileBundleRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetProfileBundleReply_8d371819(enc *codegen.Encoder, arg *protos.GetProfileBundleReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetProfileBundleReply_8d371819(dec *codegen.Decoder) *protos.GetProfileBundleReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetProfileBundleReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetReadinessRequest_63491c51(enc *codegen.Encoder, arg *protos.GetReadinessRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetReadinessRequest_63491c51(dec *codegen.Decoder) *protos.GetReadinessRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetReadinessRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetReadinessReply_7a8ec3b3(enc *codegen.Encoder, arg *protos.GetReadinessReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetReadinessReply_7a8ec3b3(dec *codegen.Decoder) *protos.GetReadinessReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetReadinessReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetRecentErrorsRequest_34dc3808(enc *codegen.Encoder, arg *protos.GetRecentErrorsRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_GetRecentErrorsRequest_34dc3808(dec *codegen.Decoder) *protos.GetRecentErrorsRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetRecentErrorsRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_GetRecentErrorsReply_4ef6a017(enc *codegen.Encoder, arg *protos.GetRecentErrorsReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
 }
 
-// Check that deployerControl_reflect_stub implements the deployerControl interface.
-var _ deployerControl = (*deployerControl_reflect_stub)(nil)
-
-func (s deployerControl_reflect_stub) ActivateComponent(ctx context.Context, a0 *protos.ActivateComponentRequest) (r0 *protos.ActivateComponentReply, err error) {
-	err = s.caller("ActivateComponent", ctx, []any{a0}, []any{&r0})
-	return
+func serviceweaver_dec_ptr_GetRecentErrorsReply_4ef6a017(dec *codegen.Decoder) *protos.GetRecentErrorsReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetRecentErrorsReply
+	dec.DecodeProto(&res)
+	return &res
 }
 
-func (s deployerControl_reflect_stub) ExportListener(ctx context.Context, a0 *protos.ExportListenerRequest) (r0 *protos.ExportListenerReply, err error) {
-	err = s.caller("ExportListener", ctx, []any{a0}, []any{&r0})
-	return
+func serviceweaver_enc_ptr_GetRoutingInfoRequest_983250ad(enc *codegen.Encoder, arg *protos.GetRoutingInfoRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
 }
 
-func (s deployerControl_reflect_stub) GetListenerAddress(ctx context.Context, a0 *protos.GetListenerAddressRequest) (r0 *protos.GetListenerAddressReply, err error) {
-	err = s.caller("GetListenerAddress", ctx, []any{a0}, []any{&r0})
-	return
+func serviceweaver_dec_ptr_GetRoutingInfoRequest_983250ad(dec *codegen.Decoder) *protos.GetRoutingInfoRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetRoutingInfoRequest
+	dec.DecodeProto(&res)
+	return &res
 }
 
-func (s deployerControl_reflect_stub) GetSelfCertificate(ctx context.Context, a0 *protos.GetSelfCertificateRequest) (r0 *protos.GetSelfCertificateReply, err error) {
-	err = s.caller("GetSelfCertificate", ctx, []any{a0}, []any{&r0})
-	return
+func serviceweaver_enc_ptr_GetRoutingInfoReply_42cd4ec9(enc *codegen.Encoder, arg *protos.GetRoutingInfoReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
 }
 
-func (s deployerControl_reflect_stub) HandleTraceSpans(ctx context.Context, a0 *protos.TraceSpans) (err error) {
-	err = s.caller("HandleTraceSpans", ctx, []any{a0}, []any{})
-	return
+func serviceweaver_dec_ptr_GetRoutingInfoReply_42cd4ec9(dec *codegen.Decoder) *protos.GetRoutingInfoReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetRoutingInfoReply
+	dec.DecodeProto(&res)
+	return &res
 }
 
-func (s deployerControl_reflect_stub) LogBatch(ctx context.Context, a0 *protos.LogEntryBatch) (err error) {
-	err = s.caller("LogBatch", ctx, []any{a0}, []any{})
-	return
+func serviceweaver_enc_ptr_GetSnapshotRequest_84c86b88(enc *codegen.Encoder, arg *protos.GetSnapshotRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
 }
 
-func (s deployerControl_reflect_stub) VerifyClientCertificate(ctx context.Context, a0 *protos.VerifyClientCertificateRequest) (r0 *protos.VerifyClientCertificateReply, err error) {
-	err = s.caller("VerifyClientCertificate", ctx, []any{a0}, []any{&r0})
-	return
+func serviceweaver_dec_ptr_GetSnapshotRequest_84c86b88(dec *codegen.Decoder) *protos.GetSnapshotRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetSnapshotRequest
+	dec.DecodeProto(&res)
+	return &res
 }
 
-func (s deployerControl_reflect_stub) VerifyServerCertificate(ctx context.Context, a0 *protos.VerifyServerCertificateRequest) (r0 *protos.VerifyServerCertificateReply, err error) {
-	err = s.caller("VerifyServerCertificate", ctx, []any{a0}, []any{&r0})
-	return
+func serviceweaver_enc_ptr_GetSnapshotReply_0d47f7de(enc *codegen.Encoder, arg *protos.GetSnapshotReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
 }
 
-type weaveletControl_reflect_stub struct {
-	caller func(string, context.Context, []any, []any) error
+func serviceweaver_dec_ptr_GetSnapshotReply_0d47f7de(dec *codegen.Decoder) *protos.GetSnapshotReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetSnapshotReply
+	dec.DecodeProto(&res)
+	return &res
 }
 
-// Check that weaveletControl_reflect_stub implements the weaveletControl interface.
-var _ weaveletControl = (*weaveletControl_reflect_stub)(nil)
-
-func (s weaveletControl_reflect_stub) GetHealth(ctx context.Context, a0 *protos.GetHealthRequest) (r0 *protos.GetHealthReply, err error) {
-	err = s.caller("GetHealth", ctx, []any{a0}, []any{&r0})
-	return
+func serviceweaver_enc_ptr_GetTimeRequest_2f3e4516(enc *codegen.Encoder, arg *protos.GetTimeRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
 }
 
-func (s weaveletControl_reflect_stub) GetLoad(ctx context.Context, a0 *protos.GetLoadRequest) (r0 *protos.GetLoadReply, err error) {
-	err = s.caller("GetLoad", ctx, []any{a0}, []any{&r0})
-	return
+func serviceweaver_dec_ptr_GetTimeRequest_2f3e4516(dec *codegen.Decoder) *protos.GetTimeRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetTimeRequest
+	dec.DecodeProto(&res)
+	return &res
 }
 
-func (s weaveletControl_reflect_stub) GetMetrics(ctx context.Context, a0 *protos.GetMetricsRequest) (r0 *protos.GetMetricsReply, err error) {
-	err = s.caller("GetMetrics", ctx, []any{a0}, []any{&r0})
-	return
+func serviceweaver_enc_ptr_GetTimeReply_cc42dca9(enc *codegen.Encoder, arg *protos.GetTimeReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
 }
 
-func (s weaveletControl_reflect_stub) GetProfile(ctx context.Context, a0 *protos.GetProfileRequest) (r0 *protos.GetProfileReply, err error) {
-	err = s.caller("GetProfile", ctx, []any{a0}, []any{&r0})
-	return
+func serviceweaver_dec_ptr_GetTimeReply_cc42dca9(dec *codegen.Decoder) *protos.GetTimeReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.GetTimeReply
+	dec.DecodeProto(&res)
+	return &res
 }
 
-func (s weaveletControl_reflect_stub) InitWeavelet(ctx context.Context, a0 *protos.InitWeaveletRequest) (r0 *protos.InitWeaveletReply, err error) {
-	err = s.caller("InitWeavelet", ctx, []any{a0}, []any{&r0})
-	return
+func serviceweaver_enc_ptr_InitWeaveletRequest_d1f5204c(enc *codegen.Encoder, arg *protos.InitWeaveletRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
 }
 
-func (s weaveletControl_reflect_stub) UpdateComponents(ctx context.Context, a0 *protos.UpdateComponentsRequest) (r0 *protos.UpdateComponentsReply, err error) {
-	err = s.caller("UpdateComponents", ctx, []any{a0}, []any{&r0})
-	return
+func serviceweaver_dec_ptr_InitWeaveletRequest_d1f5204c(dec *codegen.Decoder) *protos.InitWeaveletRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.InitWeaveletRequest
+	dec.DecodeProto(&res)
+	return &res
 }
 
-func (s weaveletControl_reflect_stub) UpdateRoutingInfo(ctx context.Context, a0 *protos.UpdateRoutingInfoRequest) (r0 *protos.UpdateRoutingInfoReply, err error) {
-	err = s.caller("UpdateRoutingInfo", ctx, []any{a0}, []any{&r0})
-	return
+func serviceweaver_enc_ptr_InitWeaveletReply_565d8c96(enc *codegen.Encoder, arg *protos.InitWeaveletReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
 }
 
-// Encoding/decoding implementations.
+func serviceweaver_dec_ptr_InitWeaveletReply_565d8c96(dec *codegen.Decoder) *protos.InitWeaveletReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.InitWeaveletReply
+	dec.DecodeProto(&res)
+	return &res
+}
 
-func serviceweaver_enc_ptr_ActivateComponentRequest_73adf343(enc *codegen.Encoder, arg *protos.ActivateComponentRequest) {
+func serviceweaver_enc_ptr_ListProfilesRequest_acde4dcc(enc *codegen.Encoder, arg *protos.ListProfilesRequest) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -1749,16 +6789,16 @@ func serviceweaver_enc_ptr_ActivateComponentRequest_73adf343(enc *codegen.Encode
 	}
 }
 
-func serviceweaver_dec_ptr_ActivateComponentRequest_73adf343(dec *codegen.Decoder) *protos.ActivateComponentRequest {
+func serviceweaver_dec_ptr_ListProfilesRequest_acde4dcc(dec *codegen.Decoder) *protos.ListProfilesRequest {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.ActivateComponentRequest
+	var res protos.ListProfilesRequest
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_ActivateComponentReply_5e57d605(enc *codegen.Encoder, arg *protos.ActivateComponentReply) {
+func serviceweaver_enc_ptr_ListProfilesReply_c18f139e(enc *codegen.Encoder, arg *protos.ListProfilesReply) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -1767,16 +6807,16 @@ func serviceweaver_enc_ptr_ActivateComponentReply_5e57d605(enc *codegen.Encoder,
 	}
 }
 
-func serviceweaver_dec_ptr_ActivateComponentReply_5e57d605(dec *codegen.Decoder) *protos.ActivateComponentReply {
+func serviceweaver_dec_ptr_ListProfilesReply_c18f139e(dec *codegen.Decoder) *protos.ListProfilesReply {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.ActivateComponentReply
+	var res protos.ListProfilesReply
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_ExportListenerRequest_b494514e(enc *codegen.Encoder, arg *protos.ExportListenerRequest) {
+func serviceweaver_enc_ptr_PingRequest_616db268(enc *codegen.Encoder, arg *protos.PingRequest) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -1785,16 +6825,16 @@ func serviceweaver_enc_ptr_ExportListenerRequest_b494514e(enc *codegen.Encoder,
 	}
 }
 
-func serviceweaver_dec_ptr_ExportListenerRequest_b494514e(dec *codegen.Decoder) *protos.ExportListenerRequest {
+func serviceweaver_dec_ptr_PingRequest_616db268(dec *codegen.Decoder) *protos.PingRequest {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.ExportListenerRequest
+	var res protos.PingRequest
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_ExportListenerReply_b0fc34d0(enc *codegen.Encoder, arg *protos.ExportListenerReply) {
+func serviceweaver_enc_ptr_PingReply_c773eaa3(enc *codegen.Encoder, arg *protos.PingReply) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -1803,16 +6843,16 @@ func serviceweaver_enc_ptr_ExportListenerReply_b0fc34d0(enc *codegen.Encoder, ar
 	}
 }
 
-func serviceweaver_dec_ptr_ExportListenerReply_b0fc34d0(dec *codegen.Decoder) *protos.ExportListenerReply {
+func serviceweaver_dec_ptr_PingReply_c773eaa3(dec *codegen.Decoder) *protos.PingReply {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.ExportListenerReply
+	var res protos.PingReply
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_GetListenerAddressRequest_5a58feb0(enc *codegen.Encoder, arg *protos.GetListenerAddressRequest) {
+func serviceweaver_enc_ptr_QuiesceComponentRequest_dd0c3718(enc *codegen.Encoder, arg *protos.QuiesceComponentRequest) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -1821,16 +6861,16 @@ func serviceweaver_enc_ptr_GetListenerAddressRequest_5a58feb0(enc *codegen.Encod
 	}
 }
 
-func serviceweaver_dec_ptr_GetListenerAddressRequest_5a58feb0(dec *codegen.Decoder) *protos.GetListenerAddressRequest {
+func serviceweaver_dec_ptr_QuiesceComponentRequest_dd0c3718(dec *codegen.Decoder) *protos.QuiesceComponentRequest {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.GetListenerAddressRequest
+	var res protos.QuiesceComponentRequest
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_GetListenerAddressReply_8bfe2caa(enc *codegen.Encoder, arg *protos.GetListenerAddressReply) {
+func serviceweaver_enc_ptr_QuiesceComponentReply_1c4f5aed(enc *codegen.Encoder, arg *protos.QuiesceComponentReply) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -1839,16 +6879,16 @@ func serviceweaver_enc_ptr_GetListenerAddressReply_8bfe2caa(enc *codegen.Encoder
 	}
 }
 
-func serviceweaver_dec_ptr_GetListenerAddressReply_8bfe2caa(dec *codegen.Decoder) *protos.GetListenerAddressReply {
+func serviceweaver_dec_ptr_QuiesceComponentReply_1c4f5aed(dec *codegen.Decoder) *protos.QuiesceComponentReply {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.GetListenerAddressReply
+	var res protos.QuiesceComponentReply
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_GetSelfCertificateRequest_0de4e3b4(enc *codegen.Encoder, arg *protos.GetSelfCertificateRequest) {
+func serviceweaver_enc_ptr_RawRPCRequest_970334b1(enc *codegen.Encoder, arg *protos.RawRPCRequest) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -1857,16 +6897,16 @@ func serviceweaver_enc_ptr_GetSelfCertificateRequest_0de4e3b4(enc *codegen.Encod
 	}
 }
 
-func serviceweaver_dec_ptr_GetSelfCertificateRequest_0de4e3b4(dec *codegen.Decoder) *protos.GetSelfCertificateRequest {
+func serviceweaver_dec_ptr_RawRPCRequest_970334b1(dec *codegen.Decoder) *protos.RawRPCRequest {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.GetSelfCertificateRequest
+	var res protos.RawRPCRequest
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_GetSelfCertificateReply_12277ec8(enc *codegen.Encoder, arg *protos.GetSelfCertificateReply) {
+func serviceweaver_enc_ptr_RawRPCReply_8a9c1f7f(enc *codegen.Encoder, arg *protos.RawRPCReply) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -1875,16 +6915,16 @@ func serviceweaver_enc_ptr_GetSelfCertificateReply_12277ec8(enc *codegen.Encoder
 	}
 }
 
-func serviceweaver_dec_ptr_GetSelfCertificateReply_12277ec8(dec *codegen.Decoder) *protos.GetSelfCertificateReply {
+func serviceweaver_dec_ptr_RawRPCReply_8a9c1f7f(dec *codegen.Decoder) *protos.RawRPCReply {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.GetSelfCertificateReply
+	var res protos.RawRPCReply
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_TraceSpans_af16efd0(enc *codegen.Encoder, arg *protos.TraceSpans) {
+func serviceweaver_enc_ptr_ReexportListenersRequest_abdf2558(enc *codegen.Encoder, arg *protos.ReexportListenersRequest) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -1893,16 +6933,16 @@ func serviceweaver_enc_ptr_TraceSpans_af16efd0(enc *codegen.Encoder, arg *protos
 	}
 }
 
-func serviceweaver_dec_ptr_TraceSpans_af16efd0(dec *codegen.Decoder) *protos.TraceSpans {
+func serviceweaver_dec_ptr_ReexportListenersRequest_abdf2558(dec *codegen.Decoder) *protos.ReexportListenersRequest {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.TraceSpans
+	var res protos.ReexportListenersRequest
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_LogEntryBatch_fec9a5d4(enc *codegen.Encoder, arg *protos.LogEntryBatch) {
+func serviceweaver_enc_ptr_ReexportListenersReply_77aa7b1b(enc *codegen.Encoder, arg *protos.ReexportListenersReply) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -1911,16 +6951,16 @@ func serviceweaver_enc_ptr_LogEntryBatch_fec9a5d4(enc *codegen.Encoder, arg *pro
 	}
 }
 
-func serviceweaver_dec_ptr_LogEntryBatch_fec9a5d4(dec *codegen.Decoder) *protos.LogEntryBatch {
+func serviceweaver_dec_ptr_ReexportListenersReply_77aa7b1b(dec *codegen.Decoder) *protos.ReexportListenersReply {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.LogEntryBatch
+	var res protos.ReexportListenersReply
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_VerifyClientCertificateRequest_f8d21781(enc *codegen.Encoder, arg *protos.VerifyClientCertificateRequest) {
+func serviceweaver_enc_ptr_ReloadConfigRequest_21ad743d(enc *codegen.Encoder, arg *protos.ReloadConfigRequest) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -1929,16 +6969,16 @@ func serviceweaver_enc_ptr_VerifyClientCertificateRequest_f8d21781(enc *codegen.
 	}
 }
 
-func serviceweaver_dec_ptr_VerifyClientCertificateRequest_f8d21781(dec *codegen.Decoder) *protos.VerifyClientCertificateRequest {
+func serviceweaver_dec_ptr_ReloadConfigRequest_21ad743d(dec *codegen.Decoder) *protos.ReloadConfigRequest {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.VerifyClientCertificateRequest
+	var res protos.ReloadConfigRequest
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_VerifyClientCertificateReply_c76e39ec(enc *codegen.Encoder, arg *protos.VerifyClientCertificateReply) {
+func serviceweaver_enc_ptr_ReloadConfigReply_a517fa10(enc *codegen.Encoder, arg *protos.ReloadConfigReply) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -1947,16 +6987,16 @@ func serviceweaver_enc_ptr_VerifyClientCertificateReply_c76e39ec(enc *codegen.En
 	}
 }
 
-func serviceweaver_dec_ptr_VerifyClientCertificateReply_c76e39ec(dec *codegen.Decoder) *protos.VerifyClientCertificateReply {
+func serviceweaver_dec_ptr_ReloadConfigReply_a517fa10(dec *codegen.Decoder) *protos.ReloadConfigReply {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.VerifyClientCertificateReply
+	var res protos.ReloadConfigReply
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_VerifyServerCertificateRequest_9c56ee67(enc *codegen.Encoder, arg *protos.VerifyServerCertificateRequest) {
+func serviceweaver_enc_ptr_RestoreRequest_1914cf9e(enc *codegen.Encoder, arg *protos.RestoreRequest) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -1965,16 +7005,16 @@ func serviceweaver_enc_ptr_VerifyServerCertificateRequest_9c56ee67(enc *codegen.
 	}
 }
 
-func serviceweaver_dec_ptr_VerifyServerCertificateRequest_9c56ee67(dec *codegen.Decoder) *protos.VerifyServerCertificateRequest {
+func serviceweaver_dec_ptr_RestoreRequest_1914cf9e(dec *codegen.Decoder) *protos.RestoreRequest {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.VerifyServerCertificateRequest
+	var res protos.RestoreRequest
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_VerifyServerCertificateReply_c0d4bd3b(enc *codegen.Encoder, arg *protos.VerifyServerCertificateReply) {
+func serviceweaver_enc_ptr_RestoreReply_2a1abcef(enc *codegen.Encoder, arg *protos.RestoreReply) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -1983,16 +7023,16 @@ func serviceweaver_enc_ptr_VerifyServerCertificateReply_c0d4bd3b(enc *codegen.En
 	}
 }
 
-func serviceweaver_dec_ptr_VerifyServerCertificateReply_c0d4bd3b(dec *codegen.Decoder) *protos.VerifyServerCertificateReply {
+func serviceweaver_dec_ptr_RestoreReply_2a1abcef(dec *codegen.Decoder) *protos.RestoreReply {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.VerifyServerCertificateReply
+	var res protos.RestoreReply
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_GetHealthRequest_fd6083fb(enc *codegen.Encoder, arg *protos.GetHealthRequest) {
+func serviceweaver_enc_ptr_SetComponentEnabledRequest_4bda8fbf(enc *codegen.Encoder, arg *protos.SetComponentEnabledRequest) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -2001,16 +7041,16 @@ func serviceweaver_enc_ptr_GetHealthRequest_fd6083fb(enc *codegen.Encoder, arg *
 	}
 }
 
-func serviceweaver_dec_ptr_GetHealthRequest_fd6083fb(dec *codegen.Decoder) *protos.GetHealthRequest {
+func serviceweaver_dec_ptr_SetComponentEnabledRequest_4bda8fbf(dec *codegen.Decoder) *protos.SetComponentEnabledRequest {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.GetHealthRequest
+	var res protos.SetComponentEnabledRequest
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_GetHealthReply_b2d11423(enc *codegen.Encoder, arg *protos.GetHealthReply) {
+func serviceweaver_enc_ptr_SetComponentEnabledReply_e8585d5c(enc *codegen.Encoder, arg *protos.SetComponentEnabledReply) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -2019,16 +7059,16 @@ func serviceweaver_enc_ptr_GetHealthReply_b2d11423(enc *codegen.Encoder, arg *pr
 	}
 }
 
-func serviceweaver_dec_ptr_GetHealthReply_b2d11423(dec *codegen.Decoder) *protos.GetHealthReply {
+func serviceweaver_dec_ptr_SetComponentEnabledReply_e8585d5c(dec *codegen.Decoder) *protos.SetComponentEnabledReply {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.GetHealthReply
+	var res protos.SetComponentEnabledReply
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_GetLoadRequest_d733b2cf(enc *codegen.Encoder, arg *protos.GetLoadRequest) {
+func serviceweaver_enc_ptr_SetComponentLogLevelRequest_136baaeb(enc *codegen.Encoder, arg *protos.SetComponentLogLevelRequest) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -2037,16 +7077,16 @@ func serviceweaver_enc_ptr_GetLoadRequest_d733b2cf(enc *codegen.Encoder, arg *pr
 	}
 }
 
-func serviceweaver_dec_ptr_GetLoadRequest_d733b2cf(dec *codegen.Decoder) *protos.GetLoadRequest {
+func serviceweaver_dec_ptr_SetComponentLogLevelRequest_136baaeb(dec *codegen.Decoder) *protos.SetComponentLogLevelRequest {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.GetLoadRequest
+	var res protos.SetComponentLogLevelRequest
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_GetLoadReply_cf8279ad(enc *codegen.Encoder, arg *protos.GetLoadReply) {
+func serviceweaver_enc_ptr_SetComponentLogLevelReply_887ba43e(enc *codegen.Encoder, arg *protos.SetComponentLogLevelReply) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -2055,16 +7095,16 @@ func serviceweaver_enc_ptr_GetLoadReply_cf8279ad(enc *codegen.Encoder, arg *prot
 	}
 }
 
-func serviceweaver_dec_ptr_GetLoadReply_cf8279ad(dec *codegen.Decoder) *protos.GetLoadReply {
+func serviceweaver_dec_ptr_SetComponentLogLevelReply_887ba43e(dec *codegen.Decoder) *protos.SetComponentLogLevelReply {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.GetLoadReply
+	var res protos.SetComponentLogLevelReply
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_GetMetricsRequest_010b3cd9(enc *codegen.Encoder, arg *protos.GetMetricsRequest) {
+func serviceweaver_enc_ptr_SetFeatureFlagRequest_269de439(enc *codegen.Encoder, arg *protos.SetFeatureFlagRequest) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -2073,16 +7113,16 @@ func serviceweaver_enc_ptr_GetMetricsRequest_010b3cd9(enc *codegen.Encoder, arg
 	}
 }
 
-func serviceweaver_dec_ptr_GetMetricsRequest_010b3cd9(dec *codegen.Decoder) *protos.GetMetricsRequest {
+func serviceweaver_dec_ptr_SetFeatureFlagRequest_269de439(dec *codegen.Decoder) *protos.SetFeatureFlagRequest {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.GetMetricsRequest
+	var res protos.SetFeatureFlagRequest
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_GetMetricsReply_3c7180e4(enc *codegen.Encoder, arg *protos.GetMetricsReply) {
+func serviceweaver_enc_ptr_SetFeatureFlagReply_d4b60ada(enc *codegen.Encoder, arg *protos.SetFeatureFlagReply) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -2091,16 +7131,16 @@ func serviceweaver_enc_ptr_GetMetricsReply_3c7180e4(enc *codegen.Encoder, arg *p
 	}
 }
 
-func serviceweaver_dec_ptr_GetMetricsReply_3c7180e4(dec *codegen.Decoder) *protos.GetMetricsReply {
+func serviceweaver_dec_ptr_SetFeatureFlagReply_d4b60ada(dec *codegen.Decoder) *protos.SetFeatureFlagReply {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.GetMetricsReply
+	var res protos.SetFeatureFlagReply
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_GetProfileRequest_d1544fcf(enc *codegen.Encoder, arg *protos.GetProfileRequest) {
+func serviceweaver_enc_ptr_SetGCPercentRequest_778863bd(enc *codegen.Encoder, arg *protos.SetGCPercentRequest) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -2109,16 +7149,16 @@ func serviceweaver_enc_ptr_GetProfileRequest_d1544fcf(enc *codegen.Encoder, arg
 	}
 }
 
-func serviceweaver_dec_ptr_GetProfileRequest_d1544fcf(dec *codegen.Decoder) *protos.GetProfileRequest {
+func serviceweaver_dec_ptr_SetGCPercentRequest_778863bd(dec *codegen.Decoder) *protos.SetGCPercentRequest {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.GetProfileRequest
+	var res protos.SetGCPercentRequest
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_GetProfileReply_10a79dcc(enc *codegen.Encoder, arg *protos.GetProfileReply) {
+func serviceweaver_enc_ptr_SetGCPercentReply_5813b222(enc *codegen.Encoder, arg *protos.SetGCPercentReply) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -2127,16 +7167,16 @@ func serviceweaver_enc_ptr_GetProfileReply_10a79dcc(enc *codegen.Encoder, arg *p
 	}
 }
 
-func serviceweaver_dec_ptr_GetProfileReply_10a79dcc(dec *codegen.Decoder) *protos.GetProfileReply {
+func serviceweaver_dec_ptr_SetGCPercentReply_5813b222(dec *codegen.Decoder) *protos.SetGCPercentReply {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.GetProfileReply
+	var res protos.SetGCPercentReply
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_InitWeaveletRequest_d1f5204c(enc *codegen.Encoder, arg *protos.InitWeaveletRequest) {
+func serviceweaver_enc_ptr_SetProfilingRateRequest_e056081b(enc *codegen.Encoder, arg *protos.SetProfilingRateRequest) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -2145,16 +7185,16 @@ func serviceweaver_enc_ptr_InitWeaveletRequest_d1f5204c(enc *codegen.Encoder, ar
 	}
 }
 
-func serviceweaver_dec_ptr_InitWeaveletRequest_d1f5204c(dec *codegen.Decoder) *protos.InitWeaveletRequest {
+func serviceweaver_dec_ptr_SetProfilingRateRequest_e056081b(dec *codegen.Decoder) *protos.SetProfilingRateRequest {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.InitWeaveletRequest
+	var res protos.SetProfilingRateRequest
 	dec.DecodeProto(&res)
 	return &res
 }
 
-func serviceweaver_enc_ptr_InitWeaveletReply_565d8c96(enc *codegen.Encoder, arg *protos.InitWeaveletReply) {
+func serviceweaver_enc_ptr_SetProfilingRateReply_663abac9(enc *codegen.Encoder, arg *protos.SetProfilingRateReply) {
 	if arg == nil {
 		enc.Bool(false)
 	} else {
@@ -2163,11 +7203,11 @@ func serviceweaver_enc_ptr_InitWeaveletReply_565d8c96(enc *codegen.Encoder, arg
 	}
 }
 
-func serviceweaver_dec_ptr_InitWeaveletReply_565d8c96(dec *codegen.Decoder) *protos.InitWeaveletReply {
+func serviceweaver_dec_ptr_SetProfilingRateReply_663abac9(dec *codegen.Decoder) *protos.SetProfilingRateReply {
 	if !dec.Bool() {
 		return nil
 	}
-	var res protos.InitWeaveletReply
+	var res protos.SetProfilingRateReply
 	dec.DecodeProto(&res)
 	return &res
 }
@@ -2208,6 +7248,42 @@ func serviceweaver_dec_ptr_UpdateComponentsReply_93bebb77(dec *codegen.Decoder)
 	return &res
 }
 
+func serviceweaver_enc_ptr_UpdateListenerTLSRequest_6b338b3e(enc *codegen.Encoder, arg *protos.UpdateListenerTLSRequest) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_UpdateListenerTLSRequest_6b338b3e(dec *codegen.Decoder) *protos.UpdateListenerTLSRequest {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.UpdateListenerTLSRequest
+	dec.DecodeProto(&res)
+	return &res
+}
+
+func serviceweaver_enc_ptr_UpdateListenerTLSReply_819f1c4f(enc *codegen.Encoder, arg *protos.UpdateListenerTLSReply) {
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		enc.EncodeProto(arg)
+	}
+}
+
+func serviceweaver_dec_ptr_UpdateListenerTLSReply_819f1c4f(dec *codegen.Decoder) *protos.UpdateListenerTLSReply {
+	if !dec.Bool() {
+		return nil
+	}
+	var res protos.UpdateListenerTLSReply
+	dec.DecodeProto(&res)
+	return &res
+}
+
 func serviceweaver_enc_ptr_UpdateRoutingInfoRequest_e752cfad(enc *codegen.Encoder, arg *protos.UpdateRoutingInfoRequest) {
 	if arg == nil {
 		enc.Bool(false)
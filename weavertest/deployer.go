@@ -170,6 +170,8 @@ func (d *deployer) start(opts weaver.RemoteWeaveletOptions) (*weaver.RemoteWeave
 			TmpDir: d.tmpDir,
 			Logger: d.sysLogger,
 			Child:  child,
+			// Fail the test if log batches are received out of order.
+			OnOrderViolation: d.stop,
 		})
 		if err != nil {
 			d.stop(err)
@@ -373,7 +375,8 @@ func (d *deployer) startGroup(g *group) error {
 			Write: d.log,
 		})
 		e, err := envelope.NewEnvelope(d.ctx, wlet, d.config, envelope.Options{
-			Logger: logger,
+			Logger:           logger,
+			OnOrderViolation: d.stop,
 		})
 		if err != nil {
 			return err
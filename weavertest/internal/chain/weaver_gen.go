@@ -215,7 +215,9 @@ func (s a_client_stub) Propagate(ctx context.Context, a0 int) (err error) {
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/chain/A", "Propagate", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -227,10 +229,16 @@ func (s a_client_stub) Propagate(ctx context.Context, a0 int) (err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/chain/A", "Propagate", codegen.DecodeResults)
 	return
 }
 
@@ -278,7 +286,9 @@ func (s b_client_stub) Propagate(ctx context.Context, a0 int) (err error) {
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/chain/B", "Propagate", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -290,10 +300,16 @@ func (s b_client_stub) Propagate(ctx context.Context, a0 int) (err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/chain/B", "Propagate", codegen.DecodeResults)
 	return
 }
 
@@ -341,7 +357,9 @@ func (s c_client_stub) Propagate(ctx context.Context, a0 int) (err error) {
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/chain/C", "Propagate", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -353,10 +371,16 @@ func (s c_client_stub) Propagate(ctx context.Context, a0 int) (err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/chain/C", "Propagate", codegen.DecodeResults)
 	return
 }
 
@@ -412,9 +436,11 @@ func (s a_server_stub) propagate(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/chain/A", "Propagate", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -422,8 +448,10 @@ func (s a_server_stub) propagate(ctx context.Context, args []byte) (res []byte,
 	appErr := s.impl.Propagate(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/chain/A", "Propagate", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -454,9 +482,11 @@ func (s b_server_stub) propagate(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/chain/B", "Propagate", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -464,8 +494,10 @@ func (s b_server_stub) propagate(ctx context.Context, args []byte) (res []byte,
 	appErr := s.impl.Propagate(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/chain/B", "Propagate", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -496,9 +528,11 @@ func (s c_server_stub) propagate(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/chain/C", "Propagate", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -506,8 +540,10 @@ func (s c_server_stub) propagate(ctx context.Context, args []byte) (res []byte,
 	appErr := s.impl.Propagate(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/chain/C", "Propagate", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
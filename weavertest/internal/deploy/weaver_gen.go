@@ -166,7 +166,9 @@ func (s started_client_stub) MarkStarted(ctx context.Context, a0 string) (err er
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/deploy/Started", "MarkStarted", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -178,10 +180,16 @@ func (s started_client_stub) MarkStarted(ctx context.Context, a0 string) (err er
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/deploy/Started", "MarkStarted", codegen.DecodeResults)
 	return
 }
 
@@ -229,7 +237,9 @@ func (s widget_client_stub) Use(ctx context.Context, a0 string) (err error) {
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/deploy/Widget", "Use", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -241,10 +251,16 @@ func (s widget_client_stub) Use(ctx context.Context, a0 string) (err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/deploy/Widget", "Use", codegen.DecodeResults)
 	return
 }
 
@@ -300,9 +316,11 @@ func (s started_server_stub) markStarted(ctx context.Context, args []byte) (res
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/deploy/Started", "MarkStarted", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -310,8 +328,10 @@ func (s started_server_stub) markStarted(ctx context.Context, args []byte) (res
 	appErr := s.impl.MarkStarted(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/deploy/Started", "MarkStarted", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -342,9 +362,11 @@ func (s widget_server_stub) use(ctx context.Context, args []byte) (res []byte, e
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/deploy/Widget", "Use", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -352,8 +374,10 @@ func (s widget_server_stub) use(ctx context.Context, args []byte) (res []byte, e
 	appErr := s.impl.Use(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/deploy/Widget", "Use", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -167,7 +167,9 @@ func (s errer_client_stub) Err(ctx context.Context, a0 int) (err error) {
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/diverge/Errer", "Err", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -179,10 +181,16 @@ func (s errer_client_stub) Err(ctx context.Context, a0 int) (err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/diverge/Errer", "Err", codegen.DecodeResults)
 	return
 }
 
@@ -233,11 +241,17 @@ func (s pointer_client_stub) Get(ctx context.Context) (r0 Pair, err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	(&r0).WeaverUnmarshal(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/diverge/Pointer", "Get", codegen.DecodeResults)
 	return
 }
 
@@ -293,9 +307,11 @@ func (s errer_server_stub) err(ctx context.Context, args []byte) (res []byte, er
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/diverge/Errer", "Err", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -303,8 +319,10 @@ func (s errer_server_stub) err(ctx context.Context, args []byte) (res []byte, er
 	appErr := s.impl.Err(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/diverge/Errer", "Err", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -340,9 +358,11 @@ func (s pointer_server_stub) get(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.Get(ctx)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	(r0).WeaverMarshal(enc)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/diverge/Pointer", "Get", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
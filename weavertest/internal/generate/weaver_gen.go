@@ -163,8 +163,10 @@ func (s testApp_client_stub) DivMod(ctx context.Context, a0 int, a1 int) (r0 int
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.Int(a0)
 	enc.Int(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", "DivMod", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -176,12 +178,18 @@ func (s testApp_client_stub) DivMod(ctx context.Context, a0 int, a1 int) (r0 int
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	r1 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", "DivMod", codegen.DecodeResults)
 	return
 }
 
@@ -222,8 +230,10 @@ func (s testApp_client_stub) Get(ctx context.Context, a0 string, a1 behaviorType
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
 	enc.Int((int)(a1))
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", "Get", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -235,11 +245,17 @@ func (s testApp_client_stub) Get(ctx context.Context, a0 string, a1 behaviorType
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", "Get", codegen.DecodeResults)
 	return
 }
 
@@ -279,7 +295,9 @@ func (s testApp_client_stub) IncPointer(ctx context.Context, a0 *int) (r0 *int,
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	serviceweaver_enc_ptr_int_98a2a745(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", "IncPointer", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -291,11 +309,17 @@ func (s testApp_client_stub) IncPointer(ctx context.Context, a0 *int) (r0 *int,
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = serviceweaver_dec_ptr_int_98a2a745(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", "IncPointer", codegen.DecodeResults)
 	return
 }
 
@@ -355,11 +379,13 @@ func (s testApp_server_stub) divMod(ctx context.Context, args []byte) (res []byt
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 int
 	a0 = dec.Int()
 	var a1 int
 	a1 = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", "DivMod", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -367,10 +393,12 @@ func (s testApp_server_stub) divMod(ctx context.Context, args []byte) (res []byt
 	r0, r1, appErr := s.impl.DivMod(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Int(r1)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", "DivMod", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -383,11 +411,13 @@ func (s testApp_server_stub) get(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
 	var a1 behaviorType
 	*(*int)(&a1) = dec.Int()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", "Get", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -395,9 +425,11 @@ func (s testApp_server_stub) get(ctx context.Context, args []byte) (res []byte,
 	r0, appErr := s.impl.Get(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", "Get", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -410,9 +442,11 @@ func (s testApp_server_stub) incPointer(ctx context.Context, args []byte) (res [
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 *int
 	a0 = serviceweaver_dec_ptr_int_98a2a745(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", "IncPointer", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -420,9 +454,11 @@ func (s testApp_server_stub) incPointer(ctx context.Context, args []byte) (res [
 	r0, appErr := s.impl.IncPointer(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	serviceweaver_enc_ptr_int_98a2a745(enc, r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", "IncPointer", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -111,8 +111,10 @@ func (s pingPonger_client_stub) Ping(ctx context.Context, a0 *Ping) (r0 *Pong, e
 	}()
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	serviceweaver_enc_ptr_Ping_53efca65(enc, a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/protos/PingPonger", "Ping", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -124,11 +126,17 @@ func (s pingPonger_client_stub) Ping(ctx context.Context, a0 *Ping) (r0 *Pong, e
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = serviceweaver_dec_ptr_Pong_10ae1a4e(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/protos/PingPonger", "Ping", codegen.DecodeResults)
 	return
 }
 
@@ -184,9 +192,11 @@ func (s pingPonger_server_stub) ping(ctx context.Context, args []byte) (res []by
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 *Ping
 	a0 = serviceweaver_dec_ptr_Ping_53efca65(dec)
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/protos/PingPonger", "Ping", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -194,9 +204,11 @@ func (s pingPonger_server_stub) ping(ctx context.Context, args []byte) (res []by
 	r0, appErr := s.impl.Ping(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	serviceweaver_enc_ptr_Pong_10ae1a4e(enc, r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/protos/PingPonger", "Ping", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
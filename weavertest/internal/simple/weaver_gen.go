@@ -392,7 +392,9 @@ func (s destination_client_stub) GetAll(ctx context.Context, a0 string) (r0 []st
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "GetAll", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -404,11 +406,17 @@ func (s destination_client_stub) GetAll(ctx context.Context, a0 string) (r0 []st
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = serviceweaver_dec_slice_string_4af10117(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "GetAll", codegen.DecodeResults)
 	return
 }
 
@@ -451,11 +459,17 @@ func (s destination_client_stub) GetMetadata(ctx context.Context) (r0 map[string
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = serviceweaver_dec_map_string_string_219dd46d(dec)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "GetMetadata", codegen.DecodeResults)
 	return
 }
 
@@ -498,11 +512,17 @@ func (s destination_client_stub) Getpid(ctx context.Context) (r0 int, err error)
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.Int()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "Getpid", codegen.DecodeResults)
 	return
 }
 
@@ -543,8 +563,10 @@ func (s destination_client_stub) Record(ctx context.Context, a0 string, a1 strin
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
 	enc.String(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "Record", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -556,10 +578,16 @@ func (s destination_client_stub) Record(ctx context.Context, a0 string, a1 strin
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "Record", codegen.DecodeResults)
 	return
 }
 
@@ -600,8 +628,10 @@ func (s destination_client_stub) RoutedRecord(ctx context.Context, a0 string, a1
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
 	enc.String(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "RoutedRecord", codegen.EncodeArgs)
 
 	// Set the shardKey.
 	var r destRouter
@@ -616,10 +646,16 @@ func (s destination_client_stub) RoutedRecord(ctx context.Context, a0 string, a1
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "RoutedRecord", codegen.DecodeResults)
 	return
 }
 
@@ -662,10 +698,16 @@ func (s destination_client_stub) UpdateMetadata(ctx context.Context) (err error)
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "UpdateMetadata", codegen.DecodeResults)
 	return
 }
 
@@ -718,11 +760,17 @@ func (s server_client_stub) Address(ctx context.Context) (r0 string, err error)
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.String()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server", "Address", codegen.DecodeResults)
 	return
 }
 
@@ -765,11 +813,17 @@ func (s server_client_stub) ProxyAddress(ctx context.Context) (r0 string, err er
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	r0 = dec.String()
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server", "ProxyAddress", codegen.DecodeResults)
 	return
 }
 
@@ -812,10 +866,16 @@ func (s server_client_stub) Shutdown(ctx context.Context) (err error) {
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server", "Shutdown", codegen.DecodeResults)
 	return
 }
 
@@ -864,8 +924,10 @@ func (s source_client_stub) Emit(ctx context.Context, a0 string, a1 string) (err
 	enc.Reset(size)
 
 	// Encode arguments.
+	encStart := codegen.BeginSerialization()
 	enc.String(a0)
 	enc.String(a1)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Source", "Emit", codegen.EncodeArgs)
 	var shardKey uint64
 
 	// Call the remote method.
@@ -877,10 +939,16 @@ func (s source_client_stub) Emit(ctx context.Context, a0 string, a1 string) (err
 		err = errors.Join(weaver.RemoteCallError, err)
 		return
 	}
+	if results == nil {
+		// The call was sent without waiting for a reply.
+		return
+	}
 
 	// Decode the results.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(results)
 	err = dec.Error()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Source", "Emit", codegen.DecodeResults)
 	return
 }
 
@@ -946,9 +1014,11 @@ func (s destination_server_stub) getAll(ctx context.Context, args []byte) (res [
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "GetAll", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -956,9 +1026,11 @@ func (s destination_server_stub) getAll(ctx context.Context, args []byte) (res [
 	r0, appErr := s.impl.GetAll(ctx, a0)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	serviceweaver_enc_slice_string_4af10117(enc, r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "GetAll", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -976,9 +1048,11 @@ func (s destination_server_stub) getMetadata(ctx context.Context, args []byte) (
 	r0, appErr := s.impl.GetMetadata(ctx)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	serviceweaver_enc_map_string_string_219dd46d(enc, r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "GetMetadata", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -996,9 +1070,11 @@ func (s destination_server_stub) getpid(ctx context.Context, args []byte) (res [
 	r0, appErr := s.impl.Getpid(ctx)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Int(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "Getpid", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -1011,11 +1087,13 @@ func (s destination_server_stub) record(ctx context.Context, args []byte) (res [
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
 	var a1 string
 	a1 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "Record", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -1023,8 +1101,10 @@ func (s destination_server_stub) record(ctx context.Context, args []byte) (res [
 	appErr := s.impl.Record(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "Record", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -1037,11 +1117,13 @@ func (s destination_server_stub) routedRecord(ctx context.Context, args []byte)
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
 	var a1 string
 	a1 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "RoutedRecord", codegen.DecodeArgs)
 	var r destRouter
 	s.addLoad(_hashDestination(r.RoutedRecord(ctx, a0, a1)), 1.0)
 
@@ -1051,8 +1133,10 @@ func (s destination_server_stub) routedRecord(ctx context.Context, args []byte)
 	appErr := s.impl.RoutedRecord(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "RoutedRecord", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -1070,8 +1154,10 @@ func (s destination_server_stub) updateMetadata(ctx context.Context, args []byte
 	appErr := s.impl.UpdateMetadata(ctx)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", "UpdateMetadata", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -1111,9 +1197,11 @@ func (s server_server_stub) address(ctx context.Context, args []byte) (res []byt
 	r0, appErr := s.impl.Address(ctx)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.String(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server", "Address", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -1131,9 +1219,11 @@ func (s server_server_stub) proxyAddress(ctx context.Context, args []byte) (res
 	r0, appErr := s.impl.ProxyAddress(ctx)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.String(r0)
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server", "ProxyAddress", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -1151,8 +1241,10 @@ func (s server_server_stub) shutdown(ctx context.Context, args []byte) (res []by
 	appErr := s.impl.Shutdown(ctx)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server", "Shutdown", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 
@@ -1183,11 +1275,13 @@ func (s source_server_stub) emit(ctx context.Context, args []byte) (res []byte,
 	}()
 
 	// Decode arguments.
+	decStart := codegen.BeginSerialization()
 	dec := codegen.NewDecoder(args)
 	var a0 string
 	a0 = dec.String()
 	var a1 string
 	a1 = dec.String()
+	codegen.EndSerialization(decStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Source", "Emit", codegen.DecodeArgs)
 
 	// TODO(rgrandl): The deferred function above will recover from panics in the
 	// user code: fix this.
@@ -1195,8 +1289,10 @@ func (s source_server_stub) emit(ctx context.Context, args []byte) (res []byte,
 	appErr := s.impl.Emit(ctx, a0, a1)
 
 	// Encode the results.
+	encStart := codegen.BeginSerialization()
 	enc := codegen.NewEncoder()
 	enc.Error(appErr)
+	codegen.EndSerialization(encStart, "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Source", "Emit", codegen.EncodeResults)
 	return enc.Data(), nil
 }
 